@@ -27,8 +27,10 @@ import (
 	etcdclient "go.etcd.io/etcd/client/v3"
 	runtimelog "sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/radius-project/radius/pkg/armrpc/audit"
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
 	metricsservice "github.com/radius-project/radius/pkg/metrics/service"
 	profilerservice "github.com/radius-project/radius/pkg/profiler/service"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
@@ -40,6 +42,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/hosting"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
+	corerp_datamodel "github.com/radius-project/radius/pkg/corerp/datamodel"
 	corerp_setup "github.com/radius-project/radius/pkg/corerp/setup"
 	daprrp_setup "github.com/radius-project/radius/pkg/daprrp/setup"
 	dsrp_setup "github.com/radius-project/radius/pkg/datastoresrp/setup"
@@ -108,6 +111,21 @@ func main() {
 		server.NewAsyncWorker(options, builders),
 	)
 
+	if options.Config.Server != nil && options.Config.Server.Audit.IsStoreEnabled() {
+		hostingSvc = append(hostingSvc, audit.NewPurgeService(
+			options.Config.Server.Audit,
+			dataprovider.NewStorageProvider(options.Config.StorageProvider)))
+	}
+
+	if options.Config.Server != nil && options.Config.Server.SoftDelete.IsEnabled() {
+		hostingSvc = append(hostingSvc, softdelete.NewPurgeService(
+			options.Config.Server.SoftDelete,
+			corerp_datamodel.EnvironmentResourceType,
+			softdelete.EnvironmentChildren,
+			dataprovider.NewStorageProvider(options.Config.StorageProvider),
+			softdelete.NewDeleter(options.UCPConnection)))
+	}
+
 	tracerOpts := options.Config.TracerProvider
 	tracerOpts.ServiceName = serviceName
 	hostingSvc = append(hostingSvc, &trace.Service{Options: tracerOpts})
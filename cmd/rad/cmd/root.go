@@ -32,30 +32,42 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	app_connections "github.com/radius-project/radius/pkg/cli/cmd/app/connections"
 	app_delete "github.com/radius-project/radius/pkg/cli/cmd/app/delete"
+	app_history "github.com/radius-project/radius/pkg/cli/cmd/app/history"
 	app_list "github.com/radius-project/radius/pkg/cli/cmd/app/list"
+	app_rollback "github.com/radius-project/radius/pkg/cli/cmd/app/rollback"
 	app_show "github.com/radius-project/radius/pkg/cli/cmd/app/show"
 	app_status "github.com/radius-project/radius/pkg/cli/cmd/app/status"
+	"github.com/radius-project/radius/pkg/cli/cmd/audit"
 	bicep_publish "github.com/radius-project/radius/pkg/cli/cmd/bicep/publish"
 	credential "github.com/radius-project/radius/pkg/cli/cmd/credential"
+	"github.com/radius-project/radius/pkg/cli/cmd/debug"
 	cmd_deploy "github.com/radius-project/radius/pkg/cli/cmd/deploy"
+	cmd_diff "github.com/radius-project/radius/pkg/cli/cmd/diff"
 	env_create "github.com/radius-project/radius/pkg/cli/cmd/env/create"
 	env_delete "github.com/radius-project/radius/pkg/cli/cmd/env/delete"
+	env_import "github.com/radius-project/radius/pkg/cli/cmd/env/envimport"
 	env_switch "github.com/radius-project/radius/pkg/cli/cmd/env/envswitch"
+	env_export "github.com/radius-project/radius/pkg/cli/cmd/env/export"
 	env_list "github.com/radius-project/radius/pkg/cli/cmd/env/list"
 	"github.com/radius-project/radius/pkg/cli/cmd/env/namespace"
+	env_restore "github.com/radius-project/radius/pkg/cli/cmd/env/restore"
 	env_show "github.com/radius-project/radius/pkg/cli/cmd/env/show"
 	env_update "github.com/radius-project/radius/pkg/cli/cmd/env/update"
 	group "github.com/radius-project/radius/pkg/cli/cmd/group"
 	"github.com/radius-project/radius/pkg/cli/cmd/install"
 	install_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/install/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/cmd/lock"
+	"github.com/radius-project/radius/pkg/cli/cmd/plane"
 	"github.com/radius-project/radius/pkg/cli/cmd/radinit"
 	recipe_list "github.com/radius-project/radius/pkg/cli/cmd/recipe/list"
 	recipe_register "github.com/radius-project/radius/pkg/cli/cmd/recipe/register"
 	recipe_show "github.com/radius-project/radius/pkg/cli/cmd/recipe/show"
 	recipe_unregister "github.com/radius-project/radius/pkg/cli/cmd/recipe/unregister"
+	resource_create "github.com/radius-project/radius/pkg/cli/cmd/resource/create"
 	resource_delete "github.com/radius-project/radius/pkg/cli/cmd/resource/delete"
 	resource_list "github.com/radius-project/radius/pkg/cli/cmd/resource/list"
 	resource_show "github.com/radius-project/radius/pkg/cli/cmd/resource/show"
+	resource_update "github.com/radius-project/radius/pkg/cli/cmd/resource/update"
 	"github.com/radius-project/radius/pkg/cli/cmd/run"
 	"github.com/radius-project/radius/pkg/cli/cmd/uninstall"
 	uninstall_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/uninstall/kubernetes"
@@ -69,6 +81,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/deploy"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/helm"
+	"github.com/radius-project/radius/pkg/cli/history"
 	"github.com/radius-project/radius/pkg/cli/kubernetes"
 	"github.com/radius-project/radius/pkg/cli/kubernetes/logstream"
 	"github.com/radius-project/radius/pkg/cli/kubernetes/portforward"
@@ -203,11 +216,15 @@ func initSubCommands() {
 		NamespaceInterface:  &namespace.Impl{},
 		AWSClient:           aws.NewClient(),
 		AzureClient:         azure.NewClient(),
+		History:             &history.FileStore{},
 	}
 
 	deployCmd, _ := cmd_deploy.NewCommand(framework)
 	RootCmd.AddCommand(deployCmd)
 
+	diffCmd, _ := cmd_diff.NewCommand(framework)
+	RootCmd.AddCommand(diffCmd)
+
 	runCmd, _ := run.NewCommand(framework)
 	RootCmd.AddCommand(runCmd)
 
@@ -220,6 +237,12 @@ func initSubCommands() {
 	deleteCmd, _ := resource_delete.NewCommand(framework)
 	resourceCmd.AddCommand(deleteCmd)
 
+	createResourceCmd, _ := resource_create.NewCommand(framework)
+	resourceCmd.AddCommand(createResourceCmd)
+
+	updateResourceCmd, _ := resource_update.NewCommand(framework)
+	resourceCmd.AddCommand(updateResourceCmd)
+
 	listRecipeCmd, _ := recipe_list.NewCommand(framework)
 	recipeCmd.AddCommand(listRecipeCmd)
 
@@ -238,6 +261,18 @@ func initSubCommands() {
 	groupCmd := group.NewCommand(framework)
 	RootCmd.AddCommand(groupCmd)
 
+	planeCmd := plane.NewCommand(framework)
+	RootCmd.AddCommand(planeCmd)
+
+	auditCmd := audit.NewCommand(framework)
+	RootCmd.AddCommand(auditCmd)
+
+	lockCmd := lock.NewCommand(framework)
+	RootCmd.AddCommand(lockCmd)
+
+	debugCmd := debug.NewCommand(framework)
+	RootCmd.AddCommand(debugCmd)
+
 	initCmd, _ := radinit.NewCommand(framework)
 	RootCmd.AddCommand(initCmd)
 
@@ -250,12 +285,21 @@ func initSubCommands() {
 	envListCmd, _ := env_list.NewCommand(framework)
 	envCmd.AddCommand(envListCmd)
 
+	envRestoreCmd, _ := env_restore.NewCommand(framework)
+	envCmd.AddCommand(envRestoreCmd)
+
 	envShowCmd, _ := env_show.NewCommand(framework)
 	envCmd.AddCommand(envShowCmd)
 
 	envUpdateCmd, _ := env_update.NewCommand(framework)
 	envCmd.AddCommand(envUpdateCmd)
 
+	envExportCmd, _ := env_export.NewCommand(framework)
+	envCmd.AddCommand(envExportCmd)
+
+	envImportCmd, _ := env_import.NewCommand(framework)
+	envCmd.AddCommand(envImportCmd)
+
 	workspaceCreateCmd, _ := workspace_create.NewCommand(framework)
 	workspaceCmd.AddCommand(workspaceCreateCmd)
 
@@ -286,6 +330,12 @@ func initSubCommands() {
 	appConnectionsCmd, _ := app_connections.NewCommand(framework)
 	applicationCmd.AddCommand(appConnectionsCmd)
 
+	appHistoryCmd, _ := app_history.NewCommand(framework)
+	applicationCmd.AddCommand(appHistoryCmd)
+
+	appRollbackCmd, _ := app_rollback.NewCommand(framework)
+	applicationCmd.AddCommand(appRollbackCmd)
+
 	envSwitchCmd, _ := env_switch.NewCommand(framework)
 	envCmd.AddCommand(envSwitchCmd)
 
@@ -42,6 +42,13 @@ const (
 
 	// TopParameterName is an optional query parameter that defines the number of records requested by the client.
 	TopParameterName = "top"
+
+	// TagNameParameterName is an optional query parameter that filters a list to the resources that have the tag.
+	TagNameParameterName = "tagName"
+
+	// TagValueParameterName is an optional query parameter that filters a list to the resources whose tag named by
+	// TagNameParameterName has the value. It can only be used together with TagNameParameterName.
+	TagValueParameterName = "tagValue"
 )
 
 // The constants below define the default, max, and min values for the number of records to be returned by the server.
@@ -119,6 +126,10 @@ var (
 var (
 	// ErrTopQueryParamOutOfBounds represents the error of top query parameter being out of defined bounds.
 	ErrTopQueryParamOutOfBounds = errors.New("top query parameter is not within the limits")
+
+	// ErrTagValueWithoutTagName represents the error of the tagValue query parameter being used without tagName. It is
+	// returned by the controllers that list resources, the other operations ignore the tag query parameters.
+	ErrTagValueWithoutTagName = errors.New("tagValue query parameter requires the tagName query parameter")
 )
 
 // ARMRequestContext represents the service context including proxy request header values.
@@ -170,6 +181,10 @@ type ARMRequestContext struct {
 	SkipToken string
 	// Top is the maximum number of records to be returned by the server. The validation will be handled downstream.
 	Top int
+	// TagName is the name of the tag used to filter lists of resources. The validation will be handled downstream.
+	TagName string
+	// TagValue is the value of the tag used to filter lists of resources.
+	TagValue string
 
 	// HTTPMethod represents the original method.
 	HTTPMethod string
@@ -228,6 +243,8 @@ func FromARMRequest(r *http.Request, pathBase, location string) (*ARMRequestCont
 
 		SkipToken: r.URL.Query().Get(SkipTokenParameterName),
 		Top:       queryItemCount,
+		TagName:   r.URL.Query().Get(TagNameParameterName),
+		TagValue:  r.URL.Query().Get(TagValueParameterName),
 
 		HTTPMethod: r.Method,
 		OrignalURL: *r.URL,
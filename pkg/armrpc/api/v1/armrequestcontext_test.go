@@ -154,6 +154,37 @@ func TestTopQueryParam(t *testing.T) {
 	}
 }
 
+func TestTagQueryParams(t *testing.T) {
+	tagQueryParamCases := []struct {
+		desc     string
+		tagName  string
+		tagValue string
+	}{
+		{"no-tag-query-params", "", ""},
+		{"tag-name-query-param", "team", ""},
+		{"tag-name-and-value-query-params", "team", "payments"},
+		// The tag query parameters are validated by the controllers that list resources.
+		{"tag-value-without-tag-name-query-param", "", "payments"},
+	}
+
+	for _, tt := range tagQueryParamCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			req, err := getTestHTTPRequest("./testdata/armrpcheaders.json")
+			require.NoError(t, err)
+
+			q := req.URL.Query()
+			q.Add(TagNameParameterName, tt.tagName)
+			q.Add(TagValueParameterName, tt.tagValue)
+			req.URL.RawQuery = q.Encode()
+
+			serviceCtx, err := FromARMRequest(req, "", LocationGlobal)
+			require.NoError(t, err)
+			require.Equal(t, tt.tagName, serviceCtx.TagName)
+			require.Equal(t, tt.tagValue, serviceCtx.TagValue)
+		})
+	}
+}
+
 func getTestHTTPRequest(headerFile string) (*http.Request, error) {
 	jsonData, err := os.ReadFile(headerFile)
 	if err != nil {
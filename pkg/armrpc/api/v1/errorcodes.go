@@ -41,6 +41,9 @@ const (
 	// Used for CodeInvalidAuthenticationInfo.
 	CodeInvalidAuthenticationInfo = "InvalidAuthenticationInfo"
 
+	// Used when an authenticated caller is not permitted to perform the operation.
+	CodeAuthorizationFailed = "AuthorizationFailed"
+
 	// Used for the cases when the precondition of a request fails.
 	CodePreconditionFailed = "PreconditionFailed"
 
@@ -61,4 +64,13 @@ const (
 
 	// Used for failed invalid spec api validation.
 	CodeHTTPRequestPayloadAPISpecValidationFailed = "HttpRequestPayloadAPISpecValidationFailed"
+
+	// Used when a caller exceeds the configured request rate limit.
+	CodeTooManyRequests = "TooManyRequests"
+
+	// Used when creating a resource would exceed the configured resource quota.
+	CodeQuotaExceeded = "QuotaExceeded"
+
+	// Used when a client requires a soft deletion and soft delete is not enabled.
+	CodeSoftDeleteNotEnabled = "SoftDeleteNotEnabled"
 )
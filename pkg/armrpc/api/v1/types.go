@@ -76,6 +76,17 @@ func (o OperationMethod) HTTPMethod() string {
 	return m
 }
 
+// IsMutatingHTTPMethod returns true if requests with the given HTTP method can change resources. Custom actions are
+// POST requests, so they are treated as mutating.
+func IsMutatingHTTPMethod(method string) bool {
+	switch method {
+	case http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodPost:
+		return true
+	default:
+		return false
+	}
+}
+
 const (
 	// Predefined Operation methods.
 	OperationPlaneScopeList   OperationMethod = "LISTPLANESCOPE"
@@ -181,6 +192,11 @@ type InternalMetadata struct {
 	UpdatedAPIVersion string `json:"updatedApiVersion,omitempty"`
 	// AsyncProvisioningState is the provisioning state for async operation.
 	AsyncProvisioningState ProvisioningState `json:"provisioningState,omitempty"`
+	// DeletedTime is the time the resource was soft deleted, or nil if the resource is not deleted.
+	DeletedTime *time.Time `json:"deletedTime,omitempty"`
+	// DeletedBy is the ID of the resource group or environment whose deletion soft deleted the resource, or empty if
+	// the resource is not deleted or was deleted on its own.
+	DeletedBy string `json:"deletedBy,omitempty"`
 }
 
 // BaseResource represents common resource properties used for all resources.
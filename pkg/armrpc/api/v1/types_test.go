@@ -17,6 +17,7 @@ limitations under the License.
 package v1
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -73,6 +74,15 @@ func TestOperationType_ParseOperationType(t *testing.T) {
 	}
 }
 
+func TestIsMutatingHTTPMethod(t *testing.T) {
+	for _, method := range []string{http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodPost} {
+		require.True(t, IsMutatingHTTPMethod(method), method)
+	}
+	for _, method := range []string{http.MethodGet, http.MethodHead, http.MethodOptions} {
+		require.False(t, IsMutatingHTTPMethod(method), method)
+	}
+}
+
 func TestBaseResource_UpdateMetadata(t *testing.T) {
 	oldResource := BaseResource{
 		TrackedResource: TrackedResource{
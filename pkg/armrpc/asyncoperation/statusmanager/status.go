@@ -41,6 +41,12 @@ type Status struct {
 	// ClientObjectID is async operation caller's client id such as the value from x-ms-client-object-id header.
 	ClientObjectID string `json:"clientObjectID,omitempty"`
 
+	// OperationType is the type of the async operation, such as "APPLICATIONS.CORE/CONTAINERS|PUT".
+	OperationType string `json:"operationType,omitempty"`
+
+	// ResourceEventType is the type of the resource event published when the operation succeeds.
+	ResourceEventType string `json:"resourceEventType,omitempty"`
+
 	// LastUpdatedTime represents the async operation last updated time.
 	LastUpdatedTime time.Time `json:"lastUpdatedTime,omitempty"`
 }
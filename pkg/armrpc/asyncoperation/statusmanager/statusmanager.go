@@ -25,6 +25,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/events"
 	"github.com/radius-project/radius/pkg/metrics"
 	"github.com/radius-project/radius/pkg/trace"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
@@ -40,6 +41,7 @@ type statusManager struct {
 	storeProvider dataprovider.DataStorageProvider
 	queue         queue.Client
 	location      string
+	publisher     events.Publisher
 }
 
 // QueueOperationOptions is the options type provided when queueing an async operation.
@@ -48,6 +50,9 @@ type QueueOperationOptions struct {
 	OperationTimeout time.Duration
 	// RetryAfter specifies the value of the Retry-After header that will be used for async operations.
 	RetryAfter time.Duration
+	// ResourceEventType specifies the type of the resource event published when the async operation succeeds,
+	// such as Radius.Resource.Created. No resource event is published if it is empty.
+	ResourceEventType string
 }
 
 //go:generate mockgen -destination=./mock_statusmanager.go -package=statusmanager -self_package github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager StatusManager
@@ -66,10 +71,17 @@ type StatusManager interface {
 
 // New creates statusManager instance.
 func New(dataProvider dataprovider.DataStorageProvider, q queue.Client, location string) StatusManager {
+	return NewWithPublisher(dataProvider, q, location, nil)
+}
+
+// NewWithPublisher creates statusManager instance which publishes an event whenever an async operation changes state.
+// The publisher can be nil.
+func NewWithPublisher(dataProvider dataprovider.DataStorageProvider, q queue.Client, location string, publisher events.Publisher) StatusManager {
 	return &statusManager{
 		storeProvider: dataProvider,
 		queue:         q,
 		location:      location,
+		publisher:     publisher,
 	}
 }
 
@@ -104,11 +116,13 @@ func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMR
 			Status:    v1.ProvisioningStateAccepted,
 			StartTime: time.Now().UTC(),
 		},
-		LinkedResourceID: sCtx.ResourceID.String(),
-		Location:         aom.location,
-		RetryAfter:       options.RetryAfter,
-		HomeTenantID:     sCtx.HomeTenantID,
-		ClientObjectID:   sCtx.ClientObjectID,
+		LinkedResourceID:  sCtx.ResourceID.String(),
+		Location:          aom.location,
+		RetryAfter:        options.RetryAfter,
+		HomeTenantID:      sCtx.HomeTenantID,
+		ClientObjectID:    sCtx.ClientObjectID,
+		OperationType:     sCtx.OperationType.String(),
+		ResourceEventType: options.ResourceEventType,
 	}
 
 	storeClient, err := aom.getClient(ctx, sCtx.ResourceID)
@@ -190,7 +204,40 @@ func (aom *statusManager) Update(ctx context.Context, id resources.ID, operation
 
 	obj.Data = s
 
-	return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
+	if err := storeClient.Save(ctx, obj, store.WithETag(obj.ETag)); err != nil {
+		return err
+	}
+
+	aom.publish(ctx, id, operationID, s)
+	return nil
+}
+
+// publish publishes the event for the state transition of the async operation. When the async operation succeeds,
+// it also publishes the resource event requested when the operation was queued, or the deletion of the resource
+// for an async delete operation.
+func (aom *statusManager) publish(ctx context.Context, id resources.ID, operationID uuid.UUID, s *Status) {
+	if aom.publisher == nil {
+		return
+	}
+
+	data := events.EventData{
+		ProvisioningState: s.Status,
+		OperationID:       operationID.String(),
+		OperationType:     s.OperationType,
+		Error:             s.Error,
+	}
+	aom.publisher.Publish(ctx, events.NewEvent(events.OperationEventType(s.Status), id, data))
+
+	if s.Status != v1.ProvisioningStateSucceeded {
+		return
+	}
+
+	data.Error = nil
+	if s.ResourceEventType != "" {
+		aom.publisher.Publish(ctx, events.NewEvent(s.ResourceEventType, id, data))
+	} else if opType, ok := v1.ParseOperationType(s.OperationType); ok && opType.Method == v1.OperationDelete {
+		aom.publisher.Publish(ctx, events.NewEvent(events.EventTypeResourceDeleted, id, data))
+	}
 }
 
 // Delete deletes the operation status resource associated with the given ID and
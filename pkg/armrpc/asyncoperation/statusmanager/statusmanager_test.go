@@ -25,6 +25,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/events"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
@@ -328,3 +329,96 @@ func TestUpdateAsyncOperationStatus(t *testing.T) {
 		})
 	}
 }
+
+type testPublisher struct {
+	events []*events.Event
+}
+
+func (p *testPublisher) Publish(ctx context.Context, event *events.Event) {
+	p.events = append(p.events, event)
+}
+
+func TestUpdateAsyncOperationStatus_PublishesEvents(t *testing.T) {
+	cases := []struct {
+		Desc              string
+		OperationType     string
+		ResourceEventType string
+		State             v1.ProvisioningState
+		Expected          []string
+	}{
+		{
+			Desc:          "put_succeeded",
+			OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|PUT",
+			State:         v1.ProvisioningStateSucceeded,
+			Expected:      []string{"Radius.Operation.Succeeded"},
+		},
+		{
+			Desc:              "put_succeeded_with_resource_event",
+			OperationType:     "APPLICATIONS.CORE/ENVIRONMENTS|PUT",
+			ResourceEventType: events.EventTypeResourceCreated,
+			State:             v1.ProvisioningStateSucceeded,
+			Expected:          []string{"Radius.Operation.Succeeded", events.EventTypeResourceCreated},
+		},
+		{
+			Desc:              "put_failed_with_resource_event",
+			OperationType:     "APPLICATIONS.CORE/ENVIRONMENTS|PUT",
+			ResourceEventType: events.EventTypeResourceUpdated,
+			State:             v1.ProvisioningStateFailed,
+			Expected:          []string{"Radius.Operation.Failed"},
+		},
+		{
+			Desc:          "delete_succeeded",
+			OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|DELETE",
+			State:         v1.ProvisioningStateSucceeded,
+			Expected:      []string{"Radius.Operation.Succeeded", events.EventTypeResourceDeleted},
+		},
+		{
+			Desc:          "delete_failed",
+			OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|DELETE",
+			State:         v1.ProvisioningStateFailed,
+			Expected:      []string{"Radius.Operation.Failed"},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Desc, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			dp := dataprovider.NewMockDataStorageProvider(mctrl)
+			sc := store.NewMockStorageClient(mctrl)
+			dp.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/operationstatuses").Return(sc, nil)
+
+			status := &Status{
+				AsyncOperationStatus: v1.AsyncOperationStatus{ID: opID.String(), Name: opID.String(), Status: v1.ProvisioningStateAccepted},
+				LinkedResourceID:     ucpEnvResourceID,
+				OperationType:        tt.OperationType,
+				ResourceEventType:    tt.ResourceEventType,
+			}
+			sc.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&store.Object{Metadata: store.Metadata{ID: opID.String(), ETag: "etag"}, Data: status}, nil)
+			sc.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+			publisher := &testPublisher{}
+			manager := NewWithPublisher(dp, nil, "test-location", publisher)
+
+			var opError *v1.ErrorDetails
+			if tt.State == v1.ProvisioningStateFailed {
+				opError = &v1.ErrorDetails{Code: v1.CodeInternal, Message: "failed"}
+			}
+
+			err := manager.Update(context.TODO(), resources.MustParse(ucpEnvResourceID), opID, tt.State, nil, opError)
+			require.NoError(t, err)
+
+			require.Len(t, publisher.events, len(tt.Expected))
+			for i, expected := range tt.Expected {
+				event := publisher.events[i]
+				require.Equal(t, expected, event.Type)
+				require.Equal(t, ucpEnvResourceID, event.Subject)
+				require.Equal(t, opID.String(), event.Data.OperationID)
+				require.Equal(t, tt.OperationType, event.Data.OperationType)
+				require.Equal(t, tt.State, event.Data.ProvisioningState)
+			}
+			if opError != nil {
+				require.Equal(t, opError, publisher.events[0].Data.Error)
+			}
+		})
+	}
+}
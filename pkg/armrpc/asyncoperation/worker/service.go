@@ -20,10 +20,12 @@ import (
 	"context"
 
 	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/events"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	qprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
+	sprovider "github.com/radius-project/radius/pkg/ucp/secret/provider"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
@@ -41,10 +43,12 @@ type Service struct {
 	Controllers *ControllerRegistry
 	// RequestQueue is the queue client for async operation request message.
 	RequestQueue queue.Client
+	// EventPublisher publishes async operation events to webhook subscriptions.
+	EventPublisher *events.Dispatcher
 }
 
-// Init initializes worker service - it initializes the StorageProvider, RequestQueue, OperationStatusManager, Controllers, KubeClient and
-// returns an error if any of these operations fail.
+// Init initializes worker service - it initializes the StorageProvider, RequestQueue, EventPublisher, OperationStatusManager, Controllers,
+// KubeClient and returns an error if any of these operations fail.
 func (s *Service) Init(ctx context.Context) error {
 	s.StorageProvider = dataprovider.NewStorageProvider(s.Options.Config.StorageProvider)
 	qp := qprovider.New(s.Options.Config.QueueProvider)
@@ -53,7 +57,11 @@ func (s *Service) Init(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	s.OperationStatusManager = manager.New(s.StorageProvider, s.RequestQueue, s.Options.Config.Env.RoleLocation)
+	s.EventPublisher, err = events.New(s.Options.Config.Events, s.StorageProvider, sprovider.NewSecretProvider(s.Options.Config.SecretProvider))
+	if err != nil {
+		return err
+	}
+	s.OperationStatusManager = manager.NewWithPublisher(s.StorageProvider, s.RequestQueue, s.Options.Config.Env.RoleLocation, s.EventPublisher.AsPublisher())
 	s.Controllers = NewControllerRegistry(s.StorageProvider)
 	return nil
 }
@@ -71,6 +79,9 @@ func (s *Service) Start(ctx context.Context, opt Options) error {
 		logger.Error(err, "failed to start worker...")
 	}
 
+	// Deliver the events of the last operations before the process exits.
+	s.EventPublisher.Shutdown(ctx)
+
 	logger.Info("Worker stopped...")
 	return nil
 }
@@ -0,0 +1,199 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	chi_middleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// asyncOperationHeader is the header of the operation status URL of asynchronous operations.
+	asyncOperationHeader = "Azure-AsyncOperation"
+
+	// unauthenticatedCaller prefixes the caller of requests that were not authenticated by this server. The rest of
+	// the caller is taken from headers set by the client, or by UCP when the request was proxied, so it is not trusted.
+	unauthenticatedCaller = "unauthenticated"
+)
+
+// Auditor records an audit record for every mutating request.
+type Auditor struct {
+	sink            Sink
+	storageProvider dataprovider.DataStorageProvider
+}
+
+// New creates an Auditor from the options. It returns nil if auditing is not enabled. The storage provider is used by
+// the store sink and, when CaptureETags is set, to look up the ETag of resources before and after a request.
+func New(options *Options, storageProvider dataprovider.DataStorageProvider) (*Auditor, error) {
+	if options == nil || !options.Enabled {
+		return nil, nil
+	}
+
+	var sink Sink
+	switch options.Sink {
+	case SinkKindFile:
+		if options.FilePath == "" {
+			return nil, errors.New("filePath is required for the file audit sink")
+		}
+
+		fileSink, err := NewFileSink(options.FilePath)
+		if err != nil {
+			return nil, err
+		}
+		sink = fileSink
+	case SinkKindStore:
+		if storageProvider == nil {
+			return nil, errors.New("the store audit sink requires a storage provider")
+		}
+		sink = NewStoreSink(storageProvider, options.Scope)
+	default:
+		return nil, fmt.Errorf("unsupported audit sink %q", options.Sink)
+	}
+
+	if !options.CaptureETags {
+		return NewAuditor(sink, nil), nil
+	}
+
+	return NewAuditor(sink, storageProvider), nil
+}
+
+// NewAuditor creates an Auditor that writes to the given sink. storageProvider may be nil, in which case the stored
+// resource is not read and only the ETag returned in the response is recorded.
+func NewAuditor(sink Sink, storageProvider dataprovider.DataStorageProvider) *Auditor {
+	return &Auditor{sink: sink, storageProvider: storageProvider}
+}
+
+// Middleware records an audit record for PUT, PATCH, DELETE and POST requests after they are handled. It must be
+// registered after the ARM request context middleware. Failures to write a record are logged and do not fail the request.
+func (a *Auditor) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !v1.IsMutatingHTTPMethod(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := r.Context()
+		rpcCtx := v1.ARMRequestContextFromContext(ctx)
+		record := &Record{
+			Name:          rpcCtx.OperationID.String(),
+			Timestamp:     time.Now().UTC(),
+			Caller:        caller(ctx, rpcCtx),
+			ResourceID:    rpcCtx.ResourceID.String(),
+			Method:        r.Method,
+			APIVersion:    rpcCtx.APIVersion,
+			CorrelationID: rpcCtx.CorrelationID,
+			OperationID:   rpcCtx.OperationID.String(),
+		}
+		if rpcCtx.OperationID == uuid.Nil {
+			record.Name = uuid.NewString()
+		}
+		if record.ResourceID == "" {
+			record.ResourceID = r.URL.Path
+		}
+		record.ResourceGroup = strings.ToLower(rpcCtx.ResourceID.ResourceGroupScope())
+
+		record.ETagBefore = a.etag(ctx, rpcCtx.ResourceID)
+
+		ww := chi_middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r)
+
+		record.StatusCode = ww.Status()
+		if record.StatusCode == 0 {
+			record.StatusCode = http.StatusOK
+		}
+		record.Result = result(record.StatusCode, ww.Header())
+		if rpcCtx.OperationType.Type != "" {
+			record.OperationType = rpcCtx.OperationType.String()
+		}
+
+		record.ETagAfter = ww.Header().Get("ETag")
+		if record.ETagAfter == "" && r.Method != http.MethodDelete && record.Result != ResultFailed {
+			record.ETagAfter = a.etag(ctx, rpcCtx.ResourceID)
+		}
+
+		if err := a.sink.Write(ctx, record); err != nil {
+			logger := ucplog.FromContextOrDiscard(ctx)
+			logger.Error(err, "failed to write audit record", "resourceId", record.ResourceID)
+		}
+	})
+}
+
+// etag returns the ETag of the stored resource, or an empty string if it can't be found.
+func (a *Auditor) etag(ctx context.Context, id resources.ID) string {
+	if a.storageProvider == nil || !(id.IsResource() || id.IsScope()) {
+		return ""
+	}
+
+	client, err := a.storageProvider.GetStorageClient(ctx, id.Type())
+	if err != nil {
+		return ""
+	}
+
+	// Not found is expected when the resource is being created or was deleted.
+	obj, err := client.Get(ctx, id.String())
+	if err != nil {
+		return ""
+	}
+
+	return obj.ETag
+}
+
+// result returns the outcome of a request from its response. Asynchronous operations are accepted with a 202 response,
+// or a 201 response with an operation status URL, and are recorded as accepted rather than succeeded.
+func result(statusCode int, header http.Header) Result {
+	switch {
+	case statusCode >= http.StatusBadRequest:
+		return ResultFailed
+	case statusCode == http.StatusAccepted:
+		return ResultAccepted
+	case statusCode == http.StatusCreated && header.Get(asyncOperationHeader) != "":
+		return ResultAccepted
+	default:
+		return ResultSucceeded
+	}
+}
+
+// caller returns the name of the authenticated principal. Other callers are recorded as "unauthenticated", followed by
+// the principal name or object ID header of the request if there is one.
+func caller(ctx context.Context, rpcCtx *v1.ARMRequestContext) string {
+	if principal := authentication.PrincipalFromContext(ctx); principal != nil {
+		return principal.Name
+	}
+
+	if rpcCtx.ClientPrincipalName != "" {
+		return unauthenticatedCaller + ":" + rpcCtx.ClientPrincipalName
+	}
+
+	if rpcCtx.ClientObjectID != "" {
+		return unauthenticatedCaller + ":" + rpcCtx.ClientObjectID
+	}
+
+	return unauthenticatedCaller
+}
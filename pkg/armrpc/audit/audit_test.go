@@ -0,0 +1,225 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const testResourceID = "/planes/radius/local/resourceGroups/rg1/providers/Applications.Core/applications/app"
+
+type recordingSink struct {
+	records []*Record
+	err     error
+}
+
+func (s *recordingSink) Write(ctx context.Context, record *Record) error {
+	s.records = append(s.records, record)
+	return s.err
+}
+
+func newRequest(t *testing.T, method string) *http.Request {
+	id, err := resources.ParseResource(testResourceID)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(method, testResourceID+"?api-version=2023-10-01-preview", nil)
+	ctx := v1.WithARMRequestContext(req.Context(), &v1.ARMRequestContext{
+		ResourceID:    id,
+		OperationID:   uuid.New(),
+		OperationType: v1.OperationType{Type: "APPLICATIONS.CORE/APPLICATIONS", Method: v1.OperationMethod(method)},
+		APIVersion:    "2023-10-01-preview",
+		CorrelationID: "correlation",
+	})
+	ctx = authentication.WithPrincipal(ctx, &authentication.Principal{Name: "alice"})
+	return req.WithContext(ctx)
+}
+
+func Test_New(t *testing.T) {
+	auditor, err := New(nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, auditor)
+
+	auditor, err = New(&Options{Enabled: false, Sink: SinkKindStore}, nil)
+	require.NoError(t, err)
+	require.Nil(t, auditor)
+
+	_, err = New(&Options{Enabled: true, Sink: SinkKindFile}, nil)
+	require.EqualError(t, err, "filePath is required for the file audit sink")
+
+	_, err = New(&Options{Enabled: true, Sink: SinkKindStore}, nil)
+	require.EqualError(t, err, "the store audit sink requires a storage provider")
+
+	_, err = New(&Options{Enabled: true, Sink: "syslog"}, nil)
+	require.EqualError(t, err, `unsupported audit sink "syslog"`)
+
+	mockCtrl := gomock.NewController(t)
+	storageProvider := dataprovider.NewMockDataStorageProvider(mockCtrl)
+
+	auditor, err = New(&Options{Enabled: true, Sink: SinkKindStore}, storageProvider)
+	require.NoError(t, err)
+	require.Nil(t, auditor.storageProvider)
+
+	auditor, err = New(&Options{Enabled: true, Sink: SinkKindStore, CaptureETags: true}, storageProvider)
+	require.NoError(t, err)
+	require.Equal(t, storageProvider, auditor.storageProvider)
+}
+
+func Test_Caller(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *authentication.Principal
+		rpcCtx    *v1.ARMRequestContext
+		expected  string
+	}{
+		{
+			name:      "authenticated",
+			principal: &authentication.Principal{Name: "alice"},
+			rpcCtx:    &v1.ARMRequestContext{ClientPrincipalName: "mallory"},
+			expected:  "alice",
+		},
+		{
+			name:     "principal name header",
+			rpcCtx:   &v1.ARMRequestContext{ClientPrincipalName: "mallory", ClientObjectID: "object"},
+			expected: "unauthenticated:mallory",
+		},
+		{
+			name:     "object id header",
+			rpcCtx:   &v1.ARMRequestContext{ClientObjectID: "object"},
+			expected: "unauthenticated:object",
+		},
+		{
+			name:     "anonymous",
+			rpcCtx:   &v1.ARMRequestContext{},
+			expected: "unauthenticated",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = authentication.WithPrincipal(ctx, tt.principal)
+			}
+			require.Equal(t, tt.expected, caller(ctx, tt.rpcCtx))
+		})
+	}
+}
+
+func Test_Middleware(t *testing.T) {
+	t.Run("read requests are not audited", func(t *testing.T) {
+		sink := &recordingSink{}
+		handler := NewAuditor(sink, nil).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, testResourceID, nil))
+		require.Empty(t, sink.records)
+	})
+
+	t.Run("successful request", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		storageClient := store.NewMockStorageClient(mockCtrl)
+		storageProvider := dataprovider.NewMockDataStorageProvider(mockCtrl)
+		storageProvider.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/applications").Return(storageClient, nil).AnyTimes()
+		storageClient.EXPECT().Get(gomock.Any(), testResourceID).Return(&store.Object{Metadata: store.Metadata{ETag: "before"}}, nil)
+
+		sink := &recordingSink{}
+		handler := NewAuditor(sink, storageProvider).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("ETag", "after")
+			w.WriteHeader(http.StatusCreated)
+		}))
+
+		req := newRequest(t, http.MethodPut)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusCreated, w.Code)
+		require.Len(t, sink.records, 1)
+
+		record := sink.records[0]
+		rpcCtx := v1.ARMRequestContextFromContext(req.Context())
+		require.Equal(t, rpcCtx.OperationID.String(), record.Name)
+		require.Equal(t, rpcCtx.OperationID.String(), record.OperationID)
+		require.Equal(t, "alice", record.Caller)
+		require.Equal(t, testResourceID, record.ResourceID)
+		require.Equal(t, http.MethodPut, record.Method)
+		require.Equal(t, "APPLICATIONS.CORE/APPLICATIONS|PUT", record.OperationType)
+		require.Equal(t, "2023-10-01-preview", record.APIVersion)
+		require.Equal(t, "correlation", record.CorrelationID)
+		require.Equal(t, http.StatusCreated, record.StatusCode)
+		require.Equal(t, ResultSucceeded, record.Result)
+		require.Equal(t, "before", record.ETagBefore)
+		require.Equal(t, "after", record.ETagAfter)
+		require.False(t, record.Timestamp.IsZero())
+	})
+
+	t.Run("failed request", func(t *testing.T) {
+		sink := &recordingSink{err: errors.New("sink is unavailable")}
+		handler := NewAuditor(sink, nil).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest(t, http.MethodDelete))
+
+		// A failure to write the record doesn't change the response.
+		require.Equal(t, http.StatusForbidden, w.Code)
+		require.Len(t, sink.records, 1)
+		require.Equal(t, http.StatusForbidden, sink.records[0].StatusCode)
+		require.Equal(t, ResultFailed, sink.records[0].Result)
+		require.Empty(t, sink.records[0].ETagBefore)
+		require.Empty(t, sink.records[0].ETagAfter)
+	})
+}
+
+func Test_Result(t *testing.T) {
+	asyncHeader := http.Header{}
+	asyncHeader.Set("Azure-AsyncOperation", "http://localhost/operationStatuses/op")
+
+	tests := []struct {
+		name       string
+		statusCode int
+		header     http.Header
+		expected   Result
+	}{
+		{name: "ok", statusCode: http.StatusOK, header: http.Header{}, expected: ResultSucceeded},
+		{name: "created", statusCode: http.StatusCreated, header: http.Header{}, expected: ResultSucceeded},
+		{name: "no content", statusCode: http.StatusNoContent, header: http.Header{}, expected: ResultSucceeded},
+		{name: "async created", statusCode: http.StatusCreated, header: asyncHeader, expected: ResultAccepted},
+		{name: "accepted", statusCode: http.StatusAccepted, header: asyncHeader, expected: ResultAccepted},
+		{name: "conflict", statusCode: http.StatusConflict, header: http.Header{}, expected: ResultFailed},
+		{name: "server error", statusCode: http.StatusInternalServerError, header: http.Header{}, expected: ResultFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, result(tt.statusCode, tt.header))
+		})
+	}
+}
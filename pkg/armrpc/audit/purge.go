@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/hosting"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// purgePageSize is the number of audit records read at once by the purge.
+	purgePageSize = 500
+)
+
+var _ hosting.Service = (*PurgeService)(nil)
+
+// PurgeService periodically deletes the audit records saved by the store sink that are older than the retention
+// period.
+type PurgeService struct {
+	storageProvider dataprovider.DataStorageProvider
+	scope           string
+	retention       time.Duration
+	interval        time.Duration
+
+	// now returns the current time. It is replaced in tests.
+	now func() time.Time
+}
+
+// NewPurgeService creates a PurgeService for the audit records saved by the store sink with the given options.
+func NewPurgeService(options *Options, storageProvider dataprovider.DataStorageProvider) *PurgeService {
+	scope := DefaultScope
+	if options != nil && options.Scope != "" {
+		scope = strings.TrimSuffix(options.Scope, "/")
+	}
+
+	return &PurgeService{
+		storageProvider: storageProvider,
+		scope:           scope,
+		retention:       options.Retention(),
+		interval:        options.Interval(),
+		now:             time.Now,
+	}
+}
+
+// Name returns the name of the service.
+func (s *PurgeService) Name() string {
+	return "audit record purge"
+}
+
+// Run purges the expired audit records immediately and then at every interval until the context is cancelled.
+func (s *PurgeService) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.PurgeAll(ctx); err != nil {
+			logger.Error(err, "failed to purge audit records")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeAll deletes the audit records older than the retention period. The records are read one page at a time.
+func (s *PurgeService) PurgeAll(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	client, err := s.storageProvider.GetStorageClient(ctx, ResourceType)
+	if err != nil {
+		return err
+	}
+
+	cutoff := s.now().Add(-s.retention)
+	purged := 0
+	token := ""
+	for {
+		result, err := client.Query(ctx, store.Query{RootScope: s.scope, ResourceType: ResourceType}, store.WithPaginationToken(token), store.WithMaxQueryItemCount(purgePageSize))
+		if err != nil {
+			return err
+		}
+
+		for _, item := range result.Items {
+			record := &Record{}
+			if err := item.As(record); err != nil || !record.Timestamp.Before(cutoff) {
+				continue
+			}
+
+			// Another replica can purge the same record concurrently.
+			if err := client.Delete(ctx, item.ID); err != nil && !errors.Is(err, &store.ErrNotFound{}) {
+				return fmt.Errorf("failed to delete audit record %q: %w", item.ID, err)
+			}
+			purged++
+		}
+
+		if result.PaginationToken == "" {
+			break
+		}
+		token = result.PaginationToken
+	}
+
+	if purged > 0 {
+		logger.Info(fmt.Sprintf("Purged %d audit records older than %s", purged, cutoff.Format(time.RFC3339)))
+	}
+	return nil
+}
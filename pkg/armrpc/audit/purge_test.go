@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+func Test_PurgeService_PurgeAll(t *testing.T) {
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	mockCtrl := gomock.NewController(t)
+	storageClient := store.NewMockStorageClient(mockCtrl)
+	storageProvider := dataprovider.NewMockDataStorageProvider(mockCtrl)
+	storageProvider.EXPECT().GetStorageClient(gomock.Any(), ResourceType).Return(storageClient, nil)
+
+	pages := map[string]*store.ObjectQueryResult{
+		"": {
+			Items: []store.Object{
+				{Metadata: store.Metadata{ID: "expired-1"}, Data: &Record{Timestamp: now.Add(-48 * time.Hour)}},
+				{Metadata: store.Metadata{ID: "recent"}, Data: &Record{Timestamp: now.Add(-1 * time.Hour)}},
+			},
+			PaginationToken: "page-2",
+		},
+		"page-2": {
+			Items: []store.Object{
+				{Metadata: store.Metadata{ID: "expired-2"}, Data: &Record{Timestamp: now.Add(-25 * time.Hour)}},
+			},
+		},
+	}
+	storageClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: DefaultScope, ResourceType: ResourceType}, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+			config := store.NewQueryConfig(options...)
+			require.Equal(t, purgePageSize, config.MaxQueryItemCount)
+			return pages[config.PaginationToken], nil
+		}).Times(2)
+
+	storageClient.EXPECT().Delete(gomock.Any(), "expired-1").Return(nil)
+	storageClient.EXPECT().Delete(gomock.Any(), "expired-2").Return(&store.ErrNotFound{ID: "expired-2"})
+
+	service := NewPurgeService(&Options{RetentionPeriod: 24 * time.Hour}, storageProvider)
+	service.now = func() time.Time { return now }
+
+	require.NoError(t, service.PurgeAll(context.Background()))
+}
+
+func Test_Options_Defaults(t *testing.T) {
+	var options *Options
+	require.False(t, options.IsStoreEnabled())
+	require.Equal(t, defaultRetentionPeriod, options.Retention())
+	require.Equal(t, defaultPurgeInterval, options.Interval())
+
+	options = &Options{Enabled: true, Sink: SinkKindStore, RetentionPeriod: time.Hour, PurgeInterval: time.Minute}
+	require.True(t, options.IsStoreEnabled())
+	require.Equal(t, time.Hour, options.Retention())
+	require.Equal(t, time.Minute, options.Interval())
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// DefaultScope is the default scope audit records are saved in by the store sink.
+	DefaultScope = "/planes/radius/local"
+)
+
+var _ Sink = (*FileSink)(nil)
+
+// FileSink writes audit records as JSON lines to a file.
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileSink opens the file at path for appending and creates a FileSink that writes to it.
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileSink{file: file}, nil
+}
+
+// Write appends the record as a single line of JSON.
+func (s *FileSink) Write(ctx context.Context, record *Record) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err = s.file.Write(append(b, '\n'))
+	return err
+}
+
+// Close closes the underlying file.
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
+
+var _ Sink = (*StoreSink)(nil)
+
+// StoreSink saves audit records as resources in the data store.
+type StoreSink struct {
+	storageProvider dataprovider.DataStorageProvider
+	scope           string
+}
+
+// NewStoreSink creates a StoreSink that saves audit records below the given scope.
+func NewStoreSink(storageProvider dataprovider.DataStorageProvider, scope string) *StoreSink {
+	if scope == "" {
+		scope = DefaultScope
+	}
+
+	return &StoreSink{storageProvider: storageProvider, scope: strings.TrimSuffix(scope, "/")}
+}
+
+// Write saves the record with an ID in the configured scope.
+func (s *StoreSink) Write(ctx context.Context, record *Record) error {
+	client, err := s.storageProvider.GetStorageClient(ctx, ResourceType)
+	if err != nil {
+		return err
+	}
+
+	record.ID = s.scope + "/providers/" + ResourceType + "/" + record.Name
+	return client.Save(ctx, &store.Object{
+		Metadata: store.Metadata{ID: record.ID},
+		Data:     record,
+	})
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+func Test_FileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Write(context.Background(), &Record{Name: "record-1", ResourceID: testResourceID}))
+	require.NoError(t, sink.Write(context.Background(), &Record{Name: "record-2", ResourceID: testResourceID}))
+	require.NoError(t, sink.Close())
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	require.Len(t, lines, 2)
+
+	record := Record{}
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &record))
+	require.Equal(t, "record-2", record.Name)
+}
+
+func Test_StoreSink(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	storageClient := store.NewMockStorageClient(mockCtrl)
+	storageProvider := dataprovider.NewMockDataStorageProvider(mockCtrl)
+	storageProvider.EXPECT().GetStorageClient(gomock.Any(), ResourceType).Return(storageClient, nil)
+
+	expectedID := "/planes/radius/local/providers/System.Resources/auditRecords/record-1"
+	storageClient.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+		require.Equal(t, expectedID, obj.ID)
+		require.Equal(t, expectedID, obj.Data.(*Record).ID)
+		return nil
+	})
+
+	sink := NewStoreSink(storageProvider, "")
+	err := sink.Write(context.Background(), &Record{Name: "record-1", ResourceID: testResourceID})
+	require.NoError(t, err)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// ResourceType is the resource type of audit records stored by the store sink.
+	ResourceType = "System.Resources/auditRecords"
+
+	defaultRetentionPeriod = 30 * 24 * time.Hour
+	defaultPurgeInterval   = time.Hour
+)
+
+// Result is the outcome of an audited operation.
+type Result string
+
+const (
+	// ResultSucceeded means the request completed successfully.
+	ResultSucceeded Result = "Succeeded"
+	// ResultAccepted means the request was accepted and is completed by an asynchronous operation. The outcome of the
+	// operation is reported by the status of the operation with the OperationID of the record.
+	ResultAccepted Result = "Accepted"
+	// ResultFailed means the request was rejected or failed.
+	ResultFailed Result = "Failed"
+)
+
+// Record is a single audit record of a mutating request.
+type Record struct {
+	// ID is the resource ID of the record. It is only set for records written to the store sink.
+	ID string `json:"id,omitempty"`
+	// Name is the unique name of the record.
+	Name string `json:"name"`
+	// Timestamp is the time the request was received.
+	Timestamp time.Time `json:"timestamp"`
+	// Caller is the identity of the caller. Callers that were not authenticated by the server are prefixed with
+	// "unauthenticated" because their identity is taken from request headers.
+	Caller string `json:"caller,omitempty"`
+	// ResourceID is the ID of the resource targeted by the request.
+	ResourceID string `json:"resourceId"`
+	// ResourceGroup is the lowercased scope of the resource group of the resource targeted by the request, or empty if
+	// the resource is not in a resource group. It is used to query the records of a resource group.
+	ResourceGroup string `json:"resourceGroup,omitempty"`
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+	// OperationType is the operation type of the request.
+	OperationType string `json:"operationType,omitempty"`
+	// APIVersion is the api-version of the request.
+	APIVersion string `json:"apiVersion,omitempty"`
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"statusCode"`
+	// Result is the outcome of the request.
+	Result Result `json:"result"`
+	// CorrelationID is the correlation ID of the request.
+	CorrelationID string `json:"correlationId,omitempty"`
+	// OperationID is the operation ID assigned to the request.
+	OperationID string `json:"operationId,omitempty"`
+	// ETagBefore is the ETag of the resource before the request.
+	ETagBefore string `json:"etagBefore,omitempty"`
+	// ETagAfter is the ETag of the resource after the request.
+	ETagAfter string `json:"etagAfter,omitempty"`
+}
+
+// ResourceTypeName returns the resource type of the audit record.
+func (r *Record) ResourceTypeName() string {
+	return ResourceType
+}
+
+// Sink is the destination of audit records.
+type Sink interface {
+	// Write persists the audit record.
+	Write(ctx context.Context, record *Record) error
+}
+
+// SinkKind is the kind of audit sink.
+type SinkKind string
+
+const (
+	// SinkKindFile writes audit records as JSON lines to a file.
+	SinkKindFile SinkKind = "file"
+	// SinkKindStore saves audit records as resources in the data store so they can be queried through UCP.
+	SinkKindStore SinkKind = "store"
+)
+
+// Options represents the audit options.
+type Options struct {
+	// Enabled enables auditing of mutating requests.
+	Enabled bool `yaml:"enabled"`
+	// Sink is the kind of sink audit records are written to.
+	Sink SinkKind `yaml:"sink"`
+	// FilePath is the path of the file written by the file sink.
+	FilePath string `yaml:"filePath,omitempty"`
+	// Scope is the scope the store sink saves audit records in. Defaults to "/planes/radius/local".
+	Scope string `yaml:"scope,omitempty"`
+	// RetentionPeriod is how long the store sink keeps audit records before they are purged. Defaults to 720h (30 days).
+	RetentionPeriod time.Duration `yaml:"retentionPeriod,omitempty"`
+	// PurgeInterval is the interval between two purges of the expired audit records. Defaults to 1h.
+	PurgeInterval time.Duration `yaml:"purgeInterval,omitempty"`
+	// CaptureETags records the ETag of the stored resource before and after each request. This reads the resource
+	// from the store up to twice per request, so it is disabled by default and only the ETag of the response is recorded.
+	CaptureETags bool `yaml:"captureETags,omitempty"`
+}
+
+// IsStoreEnabled returns true if audit records are saved by the store sink. It is safe to call on nil Options.
+func (o *Options) IsStoreEnabled() bool {
+	return o != nil && o.Enabled && o.Sink == SinkKindStore
+}
+
+// Retention returns the retention period, or the default if it is not set.
+func (o *Options) Retention() time.Duration {
+	if o == nil || o.RetentionPeriod <= 0 {
+		return defaultRetentionPeriod
+	}
+	return o.RetentionPeriod
+}
+
+// Interval returns the purge interval, or the default if it is not set.
+func (o *Options) Interval() time.Duration {
+	if o == nil || o.PurgeInterval <= 0 {
+		return defaultPurgeInterval
+	}
+	return o.PurgeInterval
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// jwksMinRefreshInterval is the minimum interval between two fetches of the key set. It prevents
+	// tokens with unknown key ids from causing a request to the issuer for every incoming request.
+	jwksMinRefreshInterval = 1 * time.Minute
+
+	// openIDConfigurationPath is the well-known path of the OpenID provider metadata document.
+	openIDConfigurationPath = "/.well-known/openid-configuration"
+)
+
+// ErrKeyNotFound is returned when the key set does not contain a key with the requested key id.
+var ErrKeyNotFound = errors.New("signing key not found in key set")
+
+// jsonWebKey is a single entry of a JSON Web Key Set (RFC 7517).
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use,omitempty"`
+
+	// RSA public key parameters.
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+
+	// EC public key parameters.
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type openIDConfiguration struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+// keySet fetches and caches the signing keys of an OIDC issuer. Keys are refreshed when a token
+// references a key id that is not in the cache, so key rotation at the issuer is picked up without
+// a restart.
+type keySet struct {
+	issuer  string
+	jwksURL string
+	client  *http.Client
+
+	mu        sync.Mutex
+	keys      map[string]any
+	lastFetch time.Time
+}
+
+func newKeySet(issuer string, jwksURL string, client *http.Client) *keySet {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	return &keySet{
+		issuer:  issuer,
+		jwksURL: jwksURL,
+		client:  client,
+		keys:    map[string]any{},
+	}
+}
+
+// Key returns the public key with the given key id. An empty key id is only accepted when the key set
+// contains a single key.
+func (k *keySet) Key(ctx context.Context, kid string) (any, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if key, ok := k.lookup(kid); ok {
+		return key, nil
+	}
+
+	if !k.lastFetch.IsZero() && time.Since(k.lastFetch) < jwksMinRefreshInterval {
+		return nil, ErrKeyNotFound
+	}
+
+	if err := k.refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	if key, ok := k.lookup(kid); ok {
+		return key, nil
+	}
+
+	return nil, ErrKeyNotFound
+}
+
+func (k *keySet) lookup(kid string) (any, bool) {
+	if kid == "" && len(k.keys) == 1 {
+		for _, key := range k.keys {
+			return key, true
+		}
+	}
+
+	key, ok := k.keys[kid]
+	return key, ok
+}
+
+// refresh fetches the key set, discovering its location from the issuer metadata when no URL was configured.
+// The caller must hold k.mu.
+func (k *keySet) refresh(ctx context.Context) error {
+	k.lastFetch = time.Now()
+
+	if k.jwksURL == "" {
+		config := openIDConfiguration{}
+		if err := k.getJSON(ctx, strings.TrimSuffix(k.issuer, "/")+openIDConfigurationPath, &config); err != nil {
+			return fmt.Errorf("failed to discover OIDC configuration of issuer %q: %w", k.issuer, err)
+		}
+
+		if config.Issuer != k.issuer {
+			return fmt.Errorf("OIDC configuration issuer %q does not match the configured issuer %q", config.Issuer, k.issuer)
+		}
+
+		if config.JWKSURI == "" {
+			return fmt.Errorf("OIDC configuration of issuer %q does not contain a jwks_uri", k.issuer)
+		}
+
+		k.jwksURL = config.JWKSURI
+	}
+
+	set := jsonWebKeySet{}
+	if err := k.getJSON(ctx, k.jwksURL, &set); err != nil {
+		return fmt.Errorf("failed to fetch key set from %q: %w", k.jwksURL, err)
+	}
+
+	keys := map[string]any{}
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := jwk.publicKey()
+		if err != nil {
+			// Skip keys we can't use rather than failing the whole set. Issuers may publish key types we don't support.
+			continue
+		}
+
+		keys[jwk.Kid] = key
+	}
+
+	k.keys = keys
+	return nil
+}
+
+func (k *keySet) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// publicKey converts the JSON Web Key to a *rsa.PublicKey or *ecdsa.PublicKey.
+func (jwk jsonWebKey) publicKey() (any, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeBigInt(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := decodeBigInt(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Crv)
+		}
+
+		x, err := decodeBigInt(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := decodeBigInt(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", jwk.Kty)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	if value == "" {
+		return nil, errors.New("missing key parameter")
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
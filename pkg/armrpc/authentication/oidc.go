@@ -0,0 +1,195 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// AuthorizationHeader is the HTTP header that carries the bearer token.
+	AuthorizationHeader = "Authorization"
+
+	// defaultUsernameClaim is the claim used as the principal name when none is configured.
+	defaultUsernameClaim = "sub"
+
+	// defaultGroupsClaim is the claim used as the principal groups when none is configured.
+	defaultGroupsClaim = "groups"
+
+	bearerPrefix = "Bearer "
+)
+
+// supportedSigningMethods is the list of JWS algorithms accepted for bearer tokens. Symmetric algorithms
+// are intentionally excluded since the keys are published by the issuer.
+var supportedSigningMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// OIDCOptions represents the options of bearer token authentication against an OIDC issuer.
+type OIDCOptions struct {
+	// Issuer is the URL of the OIDC issuer. Tokens must have a matching "iss" claim.
+	Issuer string `yaml:"issuer"`
+	// Audience is the expected "aud" claim of the tokens.
+	Audience string `yaml:"audience"`
+	// JWKSURL is the URL of the JSON Web Key Set used to verify token signatures. When empty it is
+	// discovered from the issuer's OpenID configuration.
+	JWKSURL string `yaml:"jwksUrl,omitempty"`
+	// UsernameClaim is the claim used as the name of the principal. Defaults to "sub".
+	UsernameClaim string `yaml:"usernameClaim,omitempty"`
+	// GroupsClaim is the claim that lists the groups of the principal. Defaults to "groups".
+	GroupsClaim string `yaml:"groupsClaim,omitempty"`
+}
+
+// OIDCValidator validates bearer tokens issued by an OIDC issuer.
+type OIDCValidator struct {
+	options OIDCOptions
+	keys    *keySet
+	parser  *jwt.Parser
+
+	// now returns the current time. It is replaced in tests.
+	now func() time.Time
+}
+
+// NewOIDCValidator creates an OIDCValidator from the given options. The signing keys are fetched lazily on first use
+// so that an unavailable issuer does not prevent the server from starting.
+func NewOIDCValidator(options OIDCOptions, client *http.Client) (*OIDCValidator, error) {
+	if options.Issuer == "" {
+		return nil, errors.New("OIDC issuer is required")
+	}
+	if options.Audience == "" {
+		return nil, errors.New("OIDC audience is required")
+	}
+	if options.UsernameClaim == "" {
+		options.UsernameClaim = defaultUsernameClaim
+	}
+	if options.GroupsClaim == "" {
+		options.GroupsClaim = defaultGroupsClaim
+	}
+
+	return &OIDCValidator{
+		options: options,
+		keys:    newKeySet(options.Issuer, options.JWKSURL, client),
+		// Time based claims are verified in Validate so that a missing "exp" claim is rejected.
+		parser: jwt.NewParser(jwt.WithValidMethods(supportedSigningMethods), jwt.WithoutClaimsValidation()),
+		now:    time.Now,
+	}, nil
+}
+
+// Validate verifies the signature, issuer, audience and lifetime of the token and returns the principal it represents.
+func (v *OIDCValidator) Validate(ctx context.Context, token string) (*Principal, error) {
+	claims := jwt.MapClaims{}
+	_, err := v.parser.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return v.keys.Key(ctx, kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := v.now().Unix()
+	if !claims.VerifyExpiresAt(now, true) {
+		return nil, errors.New("token is expired or has no expiry")
+	}
+	if !claims.VerifyNotBefore(now, false) {
+		return nil, errors.New("token is not valid yet")
+	}
+	if !claims.VerifyIssuer(v.options.Issuer, true) {
+		return nil, fmt.Errorf("token issuer does not match %q", v.options.Issuer)
+	}
+	if !claims.VerifyAudience(v.options.Audience, true) {
+		return nil, fmt.Errorf("token audience does not match %q", v.options.Audience)
+	}
+
+	name, _ := claims[v.options.UsernameClaim].(string)
+	if name == "" {
+		return nil, fmt.Errorf("token does not contain the %q claim", v.options.UsernameClaim)
+	}
+
+	return &Principal{
+		Name:   name,
+		Groups: stringsClaim(claims[v.options.GroupsClaim]),
+		Issuer: v.options.Issuer,
+	}, nil
+}
+
+// stringsClaim converts a claim that is either a string or a list of strings to a slice.
+func stringsClaim(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		result := []string{}
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	default:
+		return nil
+	}
+}
+
+// BearerTokenValidator authenticates requests with the bearer token in the Authorization header, stores the
+// resulting principal in the request context and removes the token from the request. Requests to the health and version endpoints and to the given
+// anonymous paths are not authenticated.
+func BearerTokenValidator(validator *OIDCValidator, anonymousPaths ...string) func(http.Handler) http.Handler {
+	anonymous := map[string]bool{"/version": true, "/healthz": true}
+	for _, path := range anonymousPaths {
+		anonymous[strings.ToLower(path)] = true
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			log := logr.FromContextOrDiscard(r.Context())
+			if anonymous[strings.ToLower(r.URL.Path)] {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			header := r.Header.Get(AuthorizationHeader)
+			if len(header) <= len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+				log.V(ucplog.LevelDebug).Info("Bearer token is missing")
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				handleErr(r.Context(), w, r)
+				return
+			}
+
+			principal, err := validator.Validate(r.Context(), strings.TrimSpace(header[len(bearerPrefix):]))
+			if err != nil {
+				log.V(ucplog.LevelDebug).Info(fmt.Sprintf("Bearer token validation failed: %v", err))
+				w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+				handleErr(r.Context(), w, r)
+				return
+			}
+
+			// The token is only meant for UCP. Remove it so that the reverse proxies do not forward the caller's
+			// identity token to downstream resource providers and cloud endpoints.
+			r = r.WithContext(WithPrincipal(r.Context(), principal))
+			r.Header.Del(AuthorizationHeader)
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAudience = "radius"
+	testKeyID    = "test-key"
+)
+
+type testIssuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	keyID  string
+
+	discoveryCalls int
+	jwksCalls      int
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	issuer := &testIssuer{key: key, keyID: testKeyID}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(openIDConfigurationPath, func(w http.ResponseWriter, r *http.Request) {
+		issuer.discoveryCalls++
+		_ = json.NewEncoder(w).Encode(openIDConfiguration{Issuer: issuer.server.URL, JWKSURI: issuer.server.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		issuer.jwksCalls++
+		_ = json.NewEncoder(w).Encode(jsonWebKeySet{
+			Keys: []jsonWebKey{
+				{
+					Kid: issuer.keyID,
+					Kty: "RSA",
+					Use: "sig",
+					N:   base64.RawURLEncoding.EncodeToString(key.PublicKey.N.Bytes()),
+					E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.PublicKey.E)).Bytes()),
+				},
+			},
+		})
+	})
+
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+
+	return issuer
+}
+
+func (i *testIssuer) token(t *testing.T, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = testKeyID
+
+	signed, err := token.SignedString(i.key)
+	require.NoError(t, err)
+	return signed
+}
+
+func (i *testIssuer) claims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":    i.server.URL,
+		"aud":    testAudience,
+		"sub":    "alice",
+		"groups": []string{"team-a", "team-b"},
+		"exp":    time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func TestNewOIDCValidator(t *testing.T) {
+	_, err := NewOIDCValidator(OIDCOptions{Audience: testAudience}, nil)
+	require.EqualError(t, err, "OIDC issuer is required")
+
+	_, err = NewOIDCValidator(OIDCOptions{Issuer: "https://issuer"}, nil)
+	require.EqualError(t, err, "OIDC audience is required")
+}
+
+func TestOIDCValidator_Validate(t *testing.T) {
+	issuer := newTestIssuer(t)
+
+	tests := []struct {
+		name   string
+		claims func(jwt.MapClaims)
+		err    string
+	}{
+		{
+			name:   "valid",
+			claims: func(c jwt.MapClaims) {},
+		},
+		{
+			name:   "expired",
+			claims: func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() },
+			err:    "token is expired or has no expiry",
+		},
+		{
+			name:   "missing expiry",
+			claims: func(c jwt.MapClaims) { delete(c, "exp") },
+			err:    "token is expired or has no expiry",
+		},
+		{
+			name:   "not valid yet",
+			claims: func(c jwt.MapClaims) { c["nbf"] = time.Now().Add(time.Hour).Unix() },
+			err:    "token is not valid yet",
+		},
+		{
+			name:   "wrong issuer",
+			claims: func(c jwt.MapClaims) { c["iss"] = "https://other" },
+			err:    "token issuer does not match",
+		},
+		{
+			name:   "wrong audience",
+			claims: func(c jwt.MapClaims) { c["aud"] = []string{"other"} },
+			err:    "token audience does not match",
+		},
+		{
+			name:   "missing subject",
+			claims: func(c jwt.MapClaims) { delete(c, "sub") },
+			err:    `token does not contain the "sub" claim`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			validator, err := NewOIDCValidator(OIDCOptions{Issuer: issuer.server.URL, Audience: testAudience}, issuer.server.Client())
+			require.NoError(t, err)
+
+			claims := issuer.claims()
+			tc.claims(claims)
+
+			principal, err := validator.Validate(context.Background(), issuer.token(t, claims))
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, &Principal{Name: "alice", Groups: []string{"team-a", "team-b"}, Issuer: issuer.server.URL}, principal)
+		})
+	}
+}
+
+func TestOIDCValidator_Validate_Signature(t *testing.T) {
+	issuer := newTestIssuer(t)
+	validator, err := NewOIDCValidator(OIDCOptions{Issuer: issuer.server.URL, Audience: testAudience}, issuer.server.Client())
+	require.NoError(t, err)
+
+	t.Run("signed by unknown key", func(t *testing.T) {
+		other, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, issuer.claims())
+		token.Header["kid"] = testKeyID
+		signed, err := token.SignedString(other)
+		require.NoError(t, err)
+
+		_, err = validator.Validate(context.Background(), signed)
+		require.Error(t, err)
+	})
+
+	t.Run("symmetric algorithm is rejected", func(t *testing.T) {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, issuer.claims())
+		signed, err := token.SignedString([]byte("secret"))
+		require.NoError(t, err)
+
+		_, err = validator.Validate(context.Background(), signed)
+		require.Error(t, err)
+	})
+
+	t.Run("keys are cached", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			_, err := validator.Validate(context.Background(), issuer.token(t, issuer.claims()))
+			require.NoError(t, err)
+		}
+
+		require.Equal(t, 1, issuer.discoveryCalls)
+		require.Equal(t, 1, issuer.jwksCalls)
+	})
+}
+
+func TestOIDCValidator_CustomClaims(t *testing.T) {
+	issuer := newTestIssuer(t)
+	validator, err := NewOIDCValidator(OIDCOptions{
+		Issuer:        issuer.server.URL,
+		Audience:      testAudience,
+		JWKSURL:       issuer.server.URL + "/keys",
+		UsernameClaim: "email",
+		GroupsClaim:   "roles",
+	}, issuer.server.Client())
+	require.NoError(t, err)
+
+	claims := issuer.claims()
+	claims["email"] = "alice@example.com"
+	claims["roles"] = "admins"
+
+	principal, err := validator.Validate(context.Background(), issuer.token(t, claims))
+	require.NoError(t, err)
+	require.Equal(t, "alice@example.com", principal.Name)
+	require.Equal(t, []string{"admins"}, principal.Groups)
+	require.Equal(t, 0, issuer.discoveryCalls)
+}
+
+func TestBearerTokenValidator(t *testing.T) {
+	issuer := newTestIssuer(t)
+	validator, err := NewOIDCValidator(OIDCOptions{Issuer: issuer.server.URL, Audience: testAudience}, issuer.server.Client())
+	require.NoError(t, err)
+
+	var principal *Principal
+	handler := BearerTokenValidator(validator, "/openapi/v2")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal = PrincipalFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name          string
+		path          string
+		authorization string
+		expectedCode  int
+		expectedName  string
+	}{
+		{
+			name:          "valid token",
+			path:          "/planes/radius/local",
+			authorization: "Bearer " + issuer.token(t, issuer.claims()),
+			expectedCode:  http.StatusOK,
+			expectedName:  "alice",
+		},
+		{
+			name:         "missing token",
+			path:         "/planes/radius/local",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:          "invalid token",
+			path:          "/planes/radius/local",
+			authorization: "Bearer invalid",
+			expectedCode:  http.StatusUnauthorized,
+		},
+		{
+			name:         "health endpoint",
+			path:         "/healthz",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "anonymous path",
+			path:         "/openapi/v2",
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			principal = nil
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			if tc.authorization != "" {
+				req.Header.Set(AuthorizationHeader, tc.authorization)
+			}
+
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code)
+			if tc.expectedCode == http.StatusUnauthorized {
+				require.Contains(t, w.Header().Get("WWW-Authenticate"), "Bearer")
+			}
+			if tc.expectedName != "" {
+				require.NotNil(t, principal)
+				require.Equal(t, tc.expectedName, principal.Name)
+			} else {
+				require.Nil(t, principal)
+			}
+		})
+	}
+}
+
+func TestBearerTokenValidator_DoesNotForwardToken(t *testing.T) {
+	issuer := newTestIssuer(t)
+	validator, err := NewOIDCValidator(OIDCOptions{Issuer: issuer.server.URL, Audience: testAudience}, issuer.server.Client())
+	require.NoError(t, err)
+
+	var forwarded http.Header
+	downstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		forwarded = r.Header.Clone()
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(downstream.Close)
+
+	downstreamURL, err := url.Parse(downstream.URL)
+	require.NoError(t, err)
+	handler := BearerTokenValidator(validator)(httputil.NewSingleHostReverseProxy(downstreamURL))
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+	req.Header.Set(AuthorizationHeader, "Bearer "+issuer.token(t, issuer.claims()))
+
+	handler.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NotNil(t, forwarded)
+	require.Empty(t, forwarded.Get(AuthorizationHeader))
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import "context"
+
+type principalContextKey struct{}
+
+// Principal represents the authenticated caller of a request.
+type Principal struct {
+	// Name is the name of the caller, taken from the configured username claim of the token.
+	Name string
+	// Groups is the list of groups the caller belongs to.
+	Groups []string
+	// Issuer is the issuer of the token that authenticated the caller.
+	Issuer string
+}
+
+// WithPrincipal returns a copy of ctx that carries the given principal.
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal stored in ctx, or nil if the request was not authenticated.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(principalContextKey{}).(*Principal)
+	return principal
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// Role is the name of a built-in role.
+type Role string
+
+const (
+	// RoleReader allows reading resources in the assigned scope.
+	RoleReader Role = "Reader"
+	// RoleContributor allows reading, creating, updating and deleting resources in the assigned scope, and invoking
+	// actions on them. It does not allow creating or deleting the scopes themselves, such as resource groups.
+	RoleContributor Role = "Contributor"
+	// RoleOwner allows all operations in the assigned scope, including managing planes and resource groups.
+	RoleOwner Role = "Owner"
+)
+
+// Options represents the authorization options.
+type Options struct {
+	// RoleAssignments is the list of role assignments. A request is allowed when at least one assignment grants it.
+	RoleAssignments []RoleAssignment `yaml:"roleAssignments,omitempty"`
+}
+
+// RoleAssignment grants a role to a user or group for a scope.
+type RoleAssignment struct {
+	// User is the name of the principal the role is assigned to. Exactly one of User and Group must be set.
+	User string `yaml:"user,omitempty"`
+	// Group is the name of the group the role is assigned to. Exactly one of User and Group must be set.
+	Group string `yaml:"group,omitempty"`
+	// Role is the assigned role.
+	Role Role `yaml:"role"`
+	// Scope is the scope the assignment applies to, for example "/planes/radius/local/resourceGroups/team-a".
+	// The assignment applies to the scope and everything below it. "/" applies to all scopes.
+	Scope string `yaml:"scope"`
+	// ResourceTypes restricts the assignment to the given resource types, for example "Applications.Core/containers".
+	// The assignment applies to all resource types when empty.
+	ResourceTypes []string `yaml:"resourceTypes,omitempty"`
+}
+
+// Authorizer decides whether a principal may perform a request based on the configured role assignments.
+type Authorizer struct {
+	assignments []RoleAssignment
+}
+
+// NewAuthorizer validates the role assignments in the options and creates an Authorizer.
+func NewAuthorizer(options Options) (*Authorizer, error) {
+	assignments := []RoleAssignment{}
+	for i, assignment := range options.RoleAssignments {
+		if (assignment.User == "") == (assignment.Group == "") {
+			return nil, fmt.Errorf("role assignment %d must specify exactly one of user or group", i)
+		}
+
+		switch assignment.Role {
+		case RoleReader, RoleContributor, RoleOwner:
+		default:
+			return nil, fmt.Errorf("role assignment %d has unknown role %q", i, assignment.Role)
+		}
+
+		if !strings.HasPrefix(assignment.Scope, "/") {
+			return nil, fmt.Errorf("role assignment %d has invalid scope %q, scope must begin with '/'", i, assignment.Scope)
+		}
+
+		assignment.Scope = normalizePath(assignment.Scope)
+		assignments = append(assignments, assignment)
+	}
+
+	if len(assignments) == 0 {
+		return nil, errors.New("at least one role assignment is required")
+	}
+
+	return &Authorizer{assignments: assignments}, nil
+}
+
+// Authorize returns true if the principal is allowed to perform the request with the given HTTP method on the given
+// path. The path must not include the path base of the server.
+func (a *Authorizer) Authorize(principal *authentication.Principal, method string, path string) bool {
+	if principal == nil {
+		return false
+	}
+
+	path = normalizePath(path)
+	resourceType := ""
+	isScope := false
+	if id, err := resources.ParseByMethod(path, method); err == nil {
+		resourceType = id.Type()
+		isScope = id.IsScope()
+	}
+
+	for _, assignment := range a.assignments {
+		if !assignment.appliesTo(principal) || !assignment.coversPath(path) || !assignment.coversType(resourceType) {
+			continue
+		}
+
+		if assignment.Role.allows(method, isScope) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r RoleAssignment) appliesTo(principal *authentication.Principal) bool {
+	if r.User != "" {
+		return r.User == principal.Name
+	}
+
+	for _, group := range principal.Groups {
+		if r.Group == group {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r RoleAssignment) coversPath(path string) bool {
+	return r.Scope == "/" || path == r.Scope || strings.HasPrefix(path, r.Scope+"/")
+}
+
+func (r RoleAssignment) coversType(resourceType string) bool {
+	if len(r.ResourceTypes) == 0 {
+		return true
+	}
+
+	for _, t := range r.ResourceTypes {
+		if strings.EqualFold(t, resourceType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r Role) allows(method string, isScope bool) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+
+	switch r {
+	case RoleOwner:
+		return true
+	case RoleContributor:
+		return !isScope
+	default:
+		return false
+	}
+}
+
+func normalizePath(path string) string {
+	path = strings.ToLower(strings.TrimSuffix(path, "/"))
+	if path == "" {
+		return "/"
+	}
+
+	return path
+}
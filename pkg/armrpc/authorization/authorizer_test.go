@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuthorizer(t *testing.T) {
+	tests := []struct {
+		name        string
+		assignments []RoleAssignment
+		err         string
+	}{
+		{
+			name:        "no assignments",
+			assignments: []RoleAssignment{},
+			err:         "at least one role assignment is required",
+		},
+		{
+			name:        "user and group",
+			assignments: []RoleAssignment{{User: "alice", Group: "team-a", Role: RoleReader, Scope: "/"}},
+			err:         "role assignment 0 must specify exactly one of user or group",
+		},
+		{
+			name:        "no principal",
+			assignments: []RoleAssignment{{Role: RoleReader, Scope: "/"}},
+			err:         "role assignment 0 must specify exactly one of user or group",
+		},
+		{
+			name:        "unknown role",
+			assignments: []RoleAssignment{{User: "alice", Role: "Admin", Scope: "/"}},
+			err:         `role assignment 0 has unknown role "Admin"`,
+		},
+		{
+			name:        "invalid scope",
+			assignments: []RoleAssignment{{User: "alice", Role: RoleReader, Scope: "planes/radius/local"}},
+			err:         `role assignment 0 has invalid scope "planes/radius/local", scope must begin with '/'`,
+		},
+		{
+			name:        "valid",
+			assignments: []RoleAssignment{{User: "alice", Role: RoleReader, Scope: "/"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewAuthorizer(Options{RoleAssignments: tc.assignments})
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAuthorizer_Authorize(t *testing.T) {
+	authorizer, err := NewAuthorizer(Options{
+		RoleAssignments: []RoleAssignment{
+			{User: "admin", Role: RoleOwner, Scope: "/"},
+			{User: "auditor", Role: RoleReader, Scope: "/planes/radius/local"},
+			{Group: "team-a", Role: RoleContributor, Scope: "/planes/radius/local/resourceGroups/team-a"},
+			{Group: "team-a", Role: RoleOwner, Scope: "/planes/radius/local/resourceGroups/team-a-sandbox/"},
+			{User: "deployer", Role: RoleContributor, Scope: "/planes/radius/local/resourceGroups/shared", ResourceTypes: []string{"Applications.Core/containers"}},
+		},
+	})
+	require.NoError(t, err)
+
+	const (
+		teamA      = "/planes/radius/local/resourceGroups/team-a"
+		container  = teamA + "/providers/Applications.Core/containers/frontend"
+		sharedCtr  = "/planes/radius/local/resourceGroups/shared/providers/Applications.Core/containers/frontend"
+		sharedEnv  = "/planes/radius/local/resourceGroups/shared/providers/Applications.Core/environments/default"
+		teamB      = "/planes/radius/local/resourceGroups/team-b/providers/Applications.Core/containers/frontend"
+		sandboxCtr = "/planes/radius/local/resourceGroups/team-a-sandbox/providers/Applications.Core/containers/frontend"
+	)
+
+	teamMember := &authentication.Principal{Name: "bob", Groups: []string{"team-a"}}
+
+	tests := []struct {
+		name      string
+		principal *authentication.Principal
+		method    string
+		path      string
+		expected  bool
+	}{
+		{"no principal", nil, http.MethodGet, container, false},
+		{"owner can manage planes", &authentication.Principal{Name: "admin"}, http.MethodPut, "/planes/radius/local", true},
+		{"owner can list planes", &authentication.Principal{Name: "admin"}, http.MethodGet, "/planes", true},
+		{"reader can read", &authentication.Principal{Name: "auditor"}, http.MethodGet, container, true},
+		{"reader can't write", &authentication.Principal{Name: "auditor"}, http.MethodPut, container, false},
+		{"reader can't invoke actions", &authentication.Principal{Name: "auditor"}, http.MethodPost, container + "/listSecrets", false},
+		{"reader outside scope", &authentication.Principal{Name: "auditor"}, http.MethodGet, "/planes/aws/aws", false},
+		{"group contributor can deploy", teamMember, http.MethodPut, container, true},
+		{"group contributor can delete", teamMember, http.MethodDelete, container, true},
+		{"group contributor can invoke actions", teamMember, http.MethodPost, container + "/listSecrets", true},
+		{"scope matching ignores case", teamMember, http.MethodPut, "/Planes/Radius/Local/ResourceGroups/Team-A/providers/Applications.Core/containers/frontend", true},
+		{"contributor can't delete the resource group", teamMember, http.MethodDelete, teamA, false},
+		{"contributor can read the resource group", teamMember, http.MethodGet, teamA, true},
+		{"contributor outside scope", teamMember, http.MethodPut, teamB, false},
+		{"scope prefix must match a whole segment", &authentication.Principal{Name: "carol", Groups: []string{"team-a"}}, http.MethodPut, "/planes/radius/local/resourceGroups/team-ab", false},
+		{"group owner can manage the resource group", teamMember, http.MethodPut, "/planes/radius/local/resourceGroups/team-a-sandbox", true},
+		{"group owner can deploy", teamMember, http.MethodPut, sandboxCtr, true},
+		{"resource type allowed", &authentication.Principal{Name: "deployer"}, http.MethodPut, sharedCtr, true},
+		{"resource type not allowed", &authentication.Principal{Name: "deployer"}, http.MethodPut, sharedEnv, false},
+		{"unassigned principal", &authentication.Principal{Name: "mallory"}, http.MethodGet, container, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, authorizer.Authorize(tc.principal, tc.method, tc.path))
+		})
+	}
+}
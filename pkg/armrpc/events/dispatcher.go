@@ -0,0 +1,418 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/secret/provider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	defaultMaxAttempts   = 5
+	defaultRetryInterval = 2 * time.Second
+	defaultTimeout       = 10 * time.Second
+	defaultCacheTTL      = 30 * time.Second
+
+	// shutdownTimeout is how long Shutdown waits for the deliveries in progress. It is shorter than the shutdown
+	// timeout of the host so that the services hosting the dispatcher can stop in time.
+	shutdownTimeout = 5 * time.Second
+
+	// maxStatusUpdateAttempts is the number of attempts made to save the delivery status when the subscription is
+	// updated concurrently.
+	maxStatusUpdateAttempts = 3
+)
+
+var _ Publisher = (*Dispatcher)(nil)
+
+// Dispatcher delivers events to the webhooks of the event subscriptions stored in the plane of the event.
+//
+// The subscriptions of a plane are cached for the cache TTL, so that publishing an event doesn't query the store when
+// the subscriptions are known. Changes to the subscriptions are picked up once the cache expires.
+type Dispatcher struct {
+	storageProvider dataprovider.DataStorageProvider
+	secretProvider  *provider.SecretProvider
+	client          *http.Client
+	policy          *AddressPolicy
+	maxAttempts     int
+	retryInterval   time.Duration
+	cacheTTL        time.Duration
+	deliveries      sync.WaitGroup
+
+	cacheLock sync.Mutex
+	cache     map[string]*cachedSubscriptions
+
+	// now returns the current time. It is replaced in tests.
+	now func() time.Time
+}
+
+// cachedSubscriptions are the subscriptions of a plane read from the store.
+type cachedSubscriptions struct {
+	subscriptions []*datamodel.EventSubscription
+	expiresAt     time.Time
+}
+
+// New creates a Dispatcher from the options. The secret provider is used to read the keys that sign the events. Events
+// are only delivered to the addresses allowed by the address policy of the options. It returns nil if event
+// notifications are not enabled.
+func New(options *Options, storageProvider dataprovider.DataStorageProvider, secretProvider *provider.SecretProvider) (*Dispatcher, error) {
+	if options == nil || !options.Enabled {
+		return nil, nil
+	}
+
+	if storageProvider == nil {
+		return nil, errors.New("event notifications require a storage provider")
+	}
+
+	timeout := options.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	policy, err := NewAddressPolicy(options)
+	if err != nil {
+		return nil, err
+	}
+
+	d := NewDispatcher(storageProvider, secretProvider, policy.NewClient(timeout), options.MaxAttempts, options.RetryInterval)
+	d.policy = policy
+	if options.CacheTTL > 0 {
+		d.cacheTTL = options.CacheTTL
+	}
+	return d, nil
+}
+
+// NewDispatcher creates a Dispatcher that delivers events with the given HTTP client, without an address policy.
+// Non-positive values of maxAttempts and retryInterval are replaced with the defaults.
+func NewDispatcher(storageProvider dataprovider.DataStorageProvider, secretProvider *provider.SecretProvider, client *http.Client, maxAttempts int, retryInterval time.Duration) *Dispatcher {
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+	if retryInterval <= 0 {
+		retryInterval = defaultRetryInterval
+	}
+
+	return &Dispatcher{
+		storageProvider: storageProvider,
+		secretProvider:  secretProvider,
+		client:          client,
+		maxAttempts:     maxAttempts,
+		retryInterval:   retryInterval,
+		cacheTTL:        defaultCacheTTL,
+		cache:           map[string]*cachedSubscriptions{},
+		now:             time.Now,
+	}
+}
+
+// Publish looks up the subscriptions matching the event and delivers it to each of them in the background. It is
+// safe to call on a nil Dispatcher, which drops the event.
+func (d *Dispatcher) Publish(ctx context.Context, event *Event) {
+	if d == nil || event == nil || event.Data == nil {
+		return
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	subscriptions, err := d.subscriptions(ctx, event)
+	if err != nil {
+		logger.Error(err, "failed to look up event subscriptions", "eventType", event.Type, "subject", event.Subject)
+		return
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		logger.Error(err, "failed to marshal event", "eventType", event.Type, "subject", event.Subject)
+		return
+	}
+
+	// Deliveries outlive the request or operation that caused the event.
+	ctx = context.WithoutCancel(ctx)
+	for _, subscription := range subscriptions {
+		d.deliveries.Add(1)
+		go func(subscription *datamodel.EventSubscription) {
+			defer d.deliveries.Done()
+			d.deliver(ctx, subscription, event, body)
+		}(subscription)
+	}
+}
+
+// AsPublisher returns the dispatcher as a Publisher. It returns a nil Publisher for a nil Dispatcher, so that the
+// "events are disabled" check of the Publisher consumers keeps working.
+func (d *Dispatcher) AsPublisher() Publisher {
+	if d == nil {
+		return nil
+	}
+	return d
+}
+
+// Wait blocks until the deliveries in progress have completed.
+func (d *Dispatcher) Wait() {
+	if d != nil {
+		d.deliveries.Wait()
+	}
+}
+
+// Shutdown waits for the deliveries in progress to complete, for at most the shutdown timeout. It must be called when
+// the service publishing events stops, so that events are not dropped when the process exits. It is safe to call on
+// a nil Dispatcher.
+func (d *Dispatcher) Shutdown(ctx context.Context) {
+	if d == nil {
+		return
+	}
+
+	done := make(chan struct{})
+	go func() {
+		d.deliveries.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(shutdownTimeout):
+		logger := ucplog.FromContextOrDiscard(ctx)
+		logger.Info(fmt.Sprintf("Event deliveries still in progress after %s were abandoned", shutdownTimeout))
+	}
+}
+
+// subscriptions returns the subscriptions in the plane of the event whose filter matches the event.
+func (d *Dispatcher) subscriptions(ctx context.Context, event *Event) ([]*datamodel.EventSubscription, error) {
+	all, err := d.planeSubscriptions(ctx, event.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions := []*datamodel.EventSubscription{}
+	for _, subscription := range all {
+		if subscription.Matches(event.Data.ResourceID, event.Data.ResourceType, event.Type) {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+
+	return subscriptions, nil
+}
+
+// planeSubscriptions returns all the subscriptions in the plane, from the cache if it has not expired.
+func (d *Dispatcher) planeSubscriptions(ctx context.Context, plane string) ([]*datamodel.EventSubscription, error) {
+	d.cacheLock.Lock()
+	cached, ok := d.cache[plane]
+	d.cacheLock.Unlock()
+	if ok && d.now().Before(cached.expiresAt) {
+		return cached.subscriptions, nil
+	}
+
+	client, err := d.storageProvider.GetStorageClient(ctx, datamodel.EventSubscriptionResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.Query(ctx, store.Query{RootScope: plane, ResourceType: datamodel.EventSubscriptionResourceType})
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions := []*datamodel.EventSubscription{}
+	for _, item := range result.Items {
+		subscription := &datamodel.EventSubscription{}
+		if err := item.As(subscription); err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	d.cacheLock.Lock()
+	d.cache[plane] = &cachedSubscriptions{subscriptions: subscriptions, expiresAt: d.now().Add(d.cacheTTL)}
+	d.cacheLock.Unlock()
+
+	return subscriptions, nil
+}
+
+// deliver posts the event to the webhook of the subscription, retrying with exponential backoff, and records the
+// outcome in the delivery status of the subscription.
+func (d *Dispatcher) deliver(ctx context.Context, subscription *datamodel.EventSubscription, event *Event, body []byte) {
+	ctx = ucplog.WrapLogContext(ctx, "subscription", subscription.ID, "eventId", event.ID, "eventType", event.Type)
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// An event that cannot be signed is not delivered, the webhook would reject it.
+	key, err := d.signingKey(ctx, subscription)
+	attempts := 0
+	if err == nil {
+		attempts, err = d.sendWithRetry(ctx, subscription, key, body)
+	}
+
+	if err != nil {
+		logger.Error(err, "failed to deliver event", "attempts", attempts)
+	}
+
+	if err := d.recordStatus(ctx, subscription.ID, event, attempts, err); err != nil {
+		logger.Error(err, "failed to record event delivery status")
+	}
+}
+
+// sendWithRetry posts the event body to the webhook, retrying with exponential backoff. It returns the number of
+// attempts made and the error of the last attempt.
+func (d *Dispatcher) sendWithRetry(ctx context.Context, subscription *datamodel.EventSubscription, key []byte, body []byte) (int, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	var err error
+	attempts := 0
+	delay := d.retryInterval
+	for attempts < d.maxAttempts {
+		attempts++
+
+		var retry bool
+		retry, err = d.send(ctx, subscription, key, body)
+		if err == nil || !retry || attempts == d.maxAttempts {
+			break
+		}
+
+		logger.Info(fmt.Sprintf("event delivery attempt %d failed, retrying in %s: %s", attempts, delay, err.Error()))
+		select {
+		case <-ctx.Done():
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+
+	return attempts, err
+}
+
+// signingKey returns the key used to sign the events delivered to the subscription, or nil if the events are not
+// signed.
+func (d *Dispatcher) signingKey(ctx context.Context, subscription *datamodel.EventSubscription) ([]byte, error) {
+	if subscription.Properties.SecretName == "" {
+		return nil, nil
+	}
+
+	if d.secretProvider == nil {
+		return nil, errors.New("the signing key of the subscription cannot be read: no secret provider is configured")
+	}
+
+	client, err := d.secretProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := secret.GetSecret[string](ctx, client, subscription.Properties.SecretName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the signing key of the subscription: %w", err)
+	}
+
+	return []byte(key), nil
+}
+
+// send posts the event body to the webhook, signing it with the key if one is given. It returns whether a failed
+// attempt can be retried.
+func (d *Dispatcher) send(ctx context.Context, subscription *datamodel.EventSubscription, key []byte, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Properties.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+
+	// The policy may have changed since the subscription was created.
+	if err := d.policy.CheckURL(req.URL); err != nil {
+		return false, err
+	}
+
+	req.Header.Set("Content-Type", ContentType)
+	if len(key) > 0 {
+		req.Header.Set(SignatureHeader, Sign(key, body))
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+
+	retry := resp.StatusCode >= 500 || resp.StatusCode == http.StatusRequestTimeout || resp.StatusCode == http.StatusTooManyRequests
+	return retry, fmt.Errorf("webhook responded with status code %d", resp.StatusCode)
+}
+
+// recordStatus saves the outcome of a delivery in the subscription. It does nothing if the subscription was deleted.
+func (d *Dispatcher) recordStatus(ctx context.Context, id string, event *Event, attempts int, deliveryErr error) error {
+	client, err := d.storageProvider.GetStorageClient(ctx, datamodel.EventSubscriptionResourceType)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < maxStatusUpdateAttempts; i++ {
+		obj, err := client.Get(ctx, id)
+		if errors.Is(err, &store.ErrNotFound{ID: id}) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		subscription := &datamodel.EventSubscription{}
+		if err := obj.As(subscription); err != nil {
+			return err
+		}
+
+		status := &datamodel.EventDeliveryStatus{
+			State:           datamodel.EventDeliveryStateSucceeded,
+			LastAttemptTime: time.Now().UTC(),
+			LastEventID:     event.ID,
+			LastEventType:   event.Type,
+			Attempts:        attempts,
+		}
+		if deliveryErr != nil {
+			status.State = datamodel.EventDeliveryStateFailed
+			status.Error = deliveryErr.Error()
+			status.ConsecutiveFailures = 1
+			if previous := subscription.Properties.DeliveryStatus; previous != nil {
+				status.ConsecutiveFailures = previous.ConsecutiveFailures + 1
+			}
+		}
+		subscription.Properties.DeliveryStatus = status
+		obj.Data = subscription
+
+		err = client.Save(ctx, obj, store.WithETag(obj.ETag))
+		if errors.Is(err, &store.ErrConcurrency{}) {
+			continue
+		}
+		return err
+	}
+
+	return &store.ErrConcurrency{}
+}
+
+// Sign returns the value of the signature header of the body signed with the secret, in the format "sha256=<hex>".
+func Sign(secret []byte, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,340 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/secret/provider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	testResourceID = "/planes/radius/local/resourceGroups/rg1/providers/Applications.Core/containers/frontend"
+	testSecret     = "s3cr3t"
+	testSecretName = "hook-secret"
+)
+
+type webhook struct {
+	mu       sync.Mutex
+	requests []*http.Request
+	bodies   [][]byte
+	statuses []int
+}
+
+func (w *webhook) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	w.requests = append(w.requests, r)
+	w.bodies = append(w.bodies, body)
+
+	status := http.StatusOK
+	if len(w.statuses) > 0 {
+		status = w.statuses[0]
+		w.statuses = w.statuses[1:]
+	}
+	rw.WriteHeader(status)
+}
+
+func newSubscription(name string, url string, filter datamodel.EventSubscriptionFilter) *datamodel.EventSubscription {
+	return &datamodel.EventSubscription{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/planes/radius/local/providers/System.Resources/eventSubscriptions/" + name,
+				Name: name,
+				Type: datamodel.EventSubscriptionResourceType,
+			},
+		},
+		Properties: datamodel.EventSubscriptionProperties{
+			URL:        url,
+			SecretName: testSecretName,
+			Filter:     filter,
+		},
+	}
+}
+
+func setup(t *testing.T, subscriptions ...*datamodel.EventSubscription) (*store.MockStorageClient, *dataprovider.MockDataStorageProvider) {
+	mockCtrl := gomock.NewController(t)
+	storageClient := store.NewMockStorageClient(mockCtrl)
+	storageProvider := dataprovider.NewMockDataStorageProvider(mockCtrl)
+	storageProvider.EXPECT().GetStorageClient(gomock.Any(), datamodel.EventSubscriptionResourceType).Return(storageClient, nil).AnyTimes()
+
+	items := []store.Object{}
+	for _, subscription := range subscriptions {
+		items = append(items, store.Object{Metadata: store.Metadata{ID: subscription.ID}, Data: subscription})
+	}
+	storageClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: "/planes/radius/local", ResourceType: datamodel.EventSubscriptionResourceType}).
+		Return(&store.ObjectQueryResult{Items: items}, nil)
+
+	return storageClient, storageProvider
+}
+
+func setupSecret(t *testing.T) *provider.SecretProvider {
+	mockCtrl := gomock.NewController(t)
+	secretClient := secret.NewMockClient(mockCtrl)
+	value, err := json.Marshal(testSecret)
+	require.NoError(t, err)
+	secretClient.EXPECT().Get(gomock.Any(), testSecretName).Return(value, nil).AnyTimes()
+
+	secretProvider := provider.NewSecretProvider(provider.SecretProviderOptions{})
+	secretProvider.SetClient(secretClient)
+	return secretProvider
+}
+
+func newTestEvent() *Event {
+	return NewEvent(EventTypeResourceCreated, resources.MustParse(testResourceID), EventData{ProvisioningState: v1.ProvisioningStateSucceeded})
+}
+
+func Test_New(t *testing.T) {
+	dispatcher, err := New(nil, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, dispatcher)
+
+	dispatcher, err = New(&Options{Enabled: false}, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, dispatcher)
+
+	_, err = New(&Options{Enabled: true}, nil, nil)
+	require.Error(t, err)
+
+	mockCtrl := gomock.NewController(t)
+	dispatcher, err = New(&Options{Enabled: true}, dataprovider.NewMockDataStorageProvider(mockCtrl), nil)
+	require.NoError(t, err)
+	require.Equal(t, defaultMaxAttempts, dispatcher.maxAttempts)
+	require.Equal(t, defaultRetryInterval, dispatcher.retryInterval)
+	require.Equal(t, defaultTimeout, dispatcher.client.Timeout)
+	require.Equal(t, defaultCacheTTL, dispatcher.cacheTTL)
+	require.NotNil(t, dispatcher.policy)
+
+	dispatcher, err = New(&Options{Enabled: true, CacheTTL: time.Minute}, dataprovider.NewMockDataStorageProvider(mockCtrl), nil)
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, dispatcher.cacheTTL)
+
+	_, err = New(&Options{Enabled: true, AllowedCIDRs: []string{"10.0.0.0"}}, dataprovider.NewMockDataStorageProvider(mockCtrl), nil)
+	require.Error(t, err)
+}
+
+func Test_NewEvent(t *testing.T) {
+	event := newTestEvent()
+	require.Equal(t, SpecVersion, event.SpecVersion)
+	require.NotEmpty(t, event.ID)
+	require.Equal(t, "/planes/radius/local", event.Source)
+	require.Equal(t, testResourceID, event.Subject)
+	require.Equal(t, testResourceID, event.Data.ResourceID)
+	require.Equal(t, "Applications.Core/containers", event.Data.ResourceType)
+	require.Equal(t, "Radius.Operation.Failed", OperationEventType(v1.ProvisioningStateFailed))
+}
+
+func Test_Dispatcher_Publish(t *testing.T) {
+	hook := &webhook{}
+	server := httptest.NewServer(hook)
+	defer server.Close()
+
+	matching := newSubscription("matching", server.URL, datamodel.EventSubscriptionFilter{Scope: "/planes/radius/local/resourceGroups/rg1"})
+	other := newSubscription("other", server.URL, datamodel.EventSubscriptionFilter{EventTypes: []string{EventTypeResourceDeleted}})
+	storageClient, storageProvider := setup(t, matching, other)
+
+	storageClient.EXPECT().Get(gomock.Any(), matching.ID).Return(&store.Object{Metadata: store.Metadata{ID: matching.ID, ETag: "etag"}, Data: matching}, nil)
+	storageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+		status := obj.Data.(*datamodel.EventSubscription).Properties.DeliveryStatus
+		require.Equal(t, datamodel.EventDeliveryStateSucceeded, status.State)
+		require.Equal(t, 1, status.Attempts)
+		require.Equal(t, EventTypeResourceCreated, status.LastEventType)
+		return nil
+	})
+
+	dispatcher := NewDispatcher(storageProvider, setupSecret(t), server.Client(), 3, time.Millisecond)
+	event := newTestEvent()
+	dispatcher.Publish(context.Background(), event)
+	dispatcher.Wait()
+
+	require.Len(t, hook.requests, 1)
+	require.Equal(t, ContentType, hook.requests[0].Header.Get("Content-Type"))
+	require.Equal(t, Sign([]byte(testSecret), hook.bodies[0]), hook.requests[0].Header.Get(SignatureHeader))
+
+	received := &Event{}
+	require.NoError(t, json.Unmarshal(hook.bodies[0], received))
+	require.Equal(t, event.ID, received.ID)
+	require.Equal(t, EventTypeResourceCreated, received.Type)
+	require.Equal(t, testResourceID, received.Data.ResourceID)
+}
+
+func Test_Dispatcher_Retry(t *testing.T) {
+	hook := &webhook{statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}}
+	server := httptest.NewServer(hook)
+	defer server.Close()
+
+	subscription := newSubscription("retry", server.URL, datamodel.EventSubscriptionFilter{})
+	storageClient, storageProvider := setup(t, subscription)
+
+	storageClient.EXPECT().Get(gomock.Any(), subscription.ID).Return(&store.Object{Metadata: store.Metadata{ID: subscription.ID, ETag: "etag"}, Data: subscription}, nil).Times(2)
+	gomock.InOrder(
+		storageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(&store.ErrConcurrency{}),
+		storageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			status := obj.Data.(*datamodel.EventSubscription).Properties.DeliveryStatus
+			require.Equal(t, datamodel.EventDeliveryStateSucceeded, status.State)
+			require.Equal(t, 3, status.Attempts)
+			return nil
+		}),
+	)
+
+	dispatcher := NewDispatcher(storageProvider, setupSecret(t), server.Client(), 3, time.Millisecond)
+	dispatcher.Publish(context.Background(), newTestEvent())
+	dispatcher.Wait()
+
+	require.Len(t, hook.requests, 3)
+}
+
+func Test_Dispatcher_Failure(t *testing.T) {
+	hook := &webhook{statuses: []int{http.StatusBadRequest}}
+	server := httptest.NewServer(hook)
+	defer server.Close()
+
+	subscription := newSubscription("failing", server.URL, datamodel.EventSubscriptionFilter{})
+	subscription.Properties.DeliveryStatus = &datamodel.EventDeliveryStatus{State: datamodel.EventDeliveryStateFailed, ConsecutiveFailures: 2}
+	storageClient, storageProvider := setup(t, subscription)
+
+	storageClient.EXPECT().Get(gomock.Any(), subscription.ID).Return(&store.Object{Metadata: store.Metadata{ID: subscription.ID, ETag: "etag"}, Data: subscription}, nil)
+	storageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+		status := obj.Data.(*datamodel.EventSubscription).Properties.DeliveryStatus
+		require.Equal(t, datamodel.EventDeliveryStateFailed, status.State)
+		require.Equal(t, 1, status.Attempts)
+		require.Equal(t, 3, status.ConsecutiveFailures)
+		require.Equal(t, "webhook responded with status code 400", status.Error)
+		return nil
+	})
+
+	dispatcher := NewDispatcher(storageProvider, setupSecret(t), server.Client(), 3, time.Millisecond)
+	dispatcher.Publish(context.Background(), newTestEvent())
+	dispatcher.Wait()
+
+	require.Len(t, hook.requests, 1)
+}
+
+func Test_Dispatcher_CachesSubscriptions(t *testing.T) {
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	// The subscription doesn't match the event, so nothing is delivered.
+	subscription := newSubscription("deleted-only", "https://hooks.contoso.com", datamodel.EventSubscriptionFilter{EventTypes: []string{EventTypeResourceDeleted}})
+	storageClient, storageProvider := setup(t, subscription)
+
+	dispatcher := NewDispatcher(storageProvider, nil, http.DefaultClient, 3, time.Millisecond)
+	dispatcher.now = func() time.Time { return now }
+
+	// The second event is matched against the cached subscriptions.
+	dispatcher.Publish(context.Background(), newTestEvent())
+	dispatcher.Publish(context.Background(), newTestEvent())
+
+	// The subscriptions are read again once the cache expires.
+	now = now.Add(defaultCacheTTL)
+	storageClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: "/planes/radius/local", ResourceType: datamodel.EventSubscriptionResourceType}).
+		Return(&store.ObjectQueryResult{}, nil)
+	dispatcher.Publish(context.Background(), newTestEvent())
+	dispatcher.Wait()
+}
+
+func Test_Dispatcher_PolicyDeniesWebhook(t *testing.T) {
+	hook := &webhook{}
+	server := httptest.NewServer(hook)
+	defer server.Close()
+
+	subscription := newSubscription("loopback", server.URL, datamodel.EventSubscriptionFilter{})
+	storageClient, storageProvider := setup(t, subscription)
+
+	storageClient.EXPECT().Get(gomock.Any(), subscription.ID).Return(&store.Object{Metadata: store.Metadata{ID: subscription.ID, ETag: "etag"}, Data: subscription}, nil)
+	storageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+		status := obj.Data.(*datamodel.EventSubscription).Properties.DeliveryStatus
+		require.Equal(t, datamodel.EventDeliveryStateFailed, status.State)
+		require.Equal(t, 1, status.Attempts)
+		require.Contains(t, status.Error, "is not allowed for webhooks")
+		return nil
+	})
+
+	dispatcher, err := New(&Options{Enabled: true, MaxAttempts: 3, RetryInterval: time.Millisecond}, storageProvider, setupSecret(t))
+	require.NoError(t, err)
+	dispatcher.Publish(context.Background(), newTestEvent())
+	dispatcher.Shutdown(context.Background())
+
+	require.Empty(t, hook.requests)
+}
+
+func Test_Dispatcher_Nil(t *testing.T) {
+	var dispatcher *Dispatcher
+	dispatcher.Publish(context.Background(), newTestEvent())
+	dispatcher.Wait()
+	dispatcher.Shutdown(context.Background())
+}
+
+func Test_Sign(t *testing.T) {
+	require.Equal(t, "sha256=5d5d139563c95b5967b9bd9a8c9b233a9dedb45072794cd232dc1b74832607d0", Sign([]byte("key"), []byte("")))
+}
+
+func Test_Dispatcher_SecretNotFound(t *testing.T) {
+	hook := &webhook{}
+	server := httptest.NewServer(hook)
+	defer server.Close()
+
+	subscription := newSubscription("nosecret", server.URL, datamodel.EventSubscriptionFilter{})
+	storageClient, storageProvider := setup(t, subscription)
+
+	storageClient.EXPECT().Get(gomock.Any(), subscription.ID).Return(&store.Object{Metadata: store.Metadata{ID: subscription.ID, ETag: "etag"}, Data: subscription}, nil)
+	storageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+		status := obj.Data.(*datamodel.EventSubscription).Properties.DeliveryStatus
+		require.Equal(t, datamodel.EventDeliveryStateFailed, status.State)
+		require.Equal(t, 0, status.Attempts)
+		return nil
+	})
+
+	mockCtrl := gomock.NewController(t)
+	secretClient := secret.NewMockClient(mockCtrl)
+	secretClient.EXPECT().Get(gomock.Any(), testSecretName).Return(nil, &secret.ErrNotFound{})
+	secretProvider := provider.NewSecretProvider(provider.SecretProviderOptions{})
+	secretProvider.SetClient(secretClient)
+
+	dispatcher := NewDispatcher(storageProvider, secretProvider, server.Client(), 3, time.Millisecond)
+	dispatcher.Publish(context.Background(), newTestEvent())
+	dispatcher.Wait()
+
+	require.Empty(t, hook.requests)
+}
+
+func Test_Dispatcher_AsPublisher(t *testing.T) {
+	var dispatcher *Dispatcher
+	require.Nil(t, dispatcher.AsPublisher())
+
+	dispatcher = NewDispatcher(nil, nil, http.DefaultClient, 0, 0)
+	require.Equal(t, dispatcher, dispatcher.AsPublisher())
+}
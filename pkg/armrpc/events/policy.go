@@ -0,0 +1,197 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// reservedNetworks are the special-purpose ranges that net.IP does not classify but that are used for internal
+// services: the shared address space of carrier-grade NAT, which some clouds use for node and pod addresses, and the
+// benchmarking range.
+var reservedNetworks = []*net.IPNet{
+	{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)},
+	{IP: net.IPv4(198, 18, 0, 0), Mask: net.CIDRMask(15, 32)},
+}
+
+// AddressPolicy restricts the addresses webhooks can be reached at, so that event subscriptions cannot be used to send
+// requests to the control plane itself, to the cloud metadata endpoints or to other internal services.
+//
+// Loopback, link-local, private, shared (100.64.0.0/10), benchmarking (198.18.0.0/15), unspecified and multicast
+// addresses are denied unless they are in an allowed range.
+// The denied ranges are denied even if they are also allowed. When allowed hosts are configured, the host of the
+// webhook URL must be one of them.
+type AddressPolicy struct {
+	allowedHosts []string
+	allowed      []*net.IPNet
+	denied       []*net.IPNet
+}
+
+// NewAddressPolicy creates the AddressPolicy configured by the options. It returns an error if a range is not a
+// valid CIDR.
+func NewAddressPolicy(options *Options) (*AddressPolicy, error) {
+	policy := &AddressPolicy{}
+	if options == nil {
+		return policy, nil
+	}
+
+	for _, host := range options.AllowedHosts {
+		policy.allowedHosts = append(policy.allowedHosts, strings.ToLower(host))
+	}
+
+	var err error
+	policy.allowed, err = parseCIDRs(options.AllowedCIDRs)
+	if err != nil {
+		return nil, err
+	}
+
+	policy.denied, err = parseCIDRs(options.DeniedCIDRs)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// CheckURL returns an error if the host of the URL is not allowed, or if it is an IP address that is not allowed. The
+// addresses a host name resolves to are checked when connecting. It is safe to call on a nil AddressPolicy, which
+// allows every URL.
+func (p *AddressPolicy) CheckURL(u *url.URL) error {
+	if p == nil {
+		return nil
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if len(p.allowedHosts) > 0 && !p.isAllowedHost(host) {
+		return fmt.Errorf("the host %q is not in the allowed webhook hosts", host)
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return p.CheckIP(ip)
+	}
+
+	// localhost never needs to be resolved to know it is a loopback address.
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return p.CheckIP(net.IPv4(127, 0, 0, 1))
+	}
+
+	return nil
+}
+
+// CheckIP returns an error if webhooks cannot be reached at the IP address. It is safe to call on a nil
+// AddressPolicy, which allows every address.
+func (p *AddressPolicy) CheckIP(ip net.IP) error {
+	if p == nil {
+		return nil
+	}
+
+	for _, cidr := range p.denied {
+		if cidr.Contains(ip) {
+			return fmt.Errorf("the address %s is in the denied webhook address range %s", ip, cidr)
+		}
+	}
+
+	for _, cidr := range p.allowed {
+		if cidr.Contains(ip) {
+			return nil
+		}
+	}
+
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() || ip.IsPrivate() || ip.IsUnspecified() {
+		return fmt.Errorf("the address %s is not allowed for webhooks: it is a loopback, link-local, private, unspecified or multicast address", ip)
+	}
+
+	for _, cidr := range reservedNetworks {
+		if cidr.Contains(ip) {
+			return fmt.Errorf("the address %s is not allowed for webhooks: it is in the reserved address range %s", ip, cidr)
+		}
+	}
+
+	return nil
+}
+
+// NewClient creates an HTTP client with the given timeout that only connects to the addresses allowed by the policy,
+// including when following redirects.
+func (p *AddressPolicy) NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		// Control is called with the resolved address, so host names that resolve to a denied address are rejected.
+		Control: func(network string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			ip := net.ParseIP(host)
+			if ip == nil {
+				return fmt.Errorf("the address %q is not an IP address", host)
+			}
+			return p.CheckIP(ip)
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	// A proxy resolves the host itself, which would bypass the checks of the dialer.
+	transport.Proxy = nil
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return fmt.Errorf("stopped after %d redirects", len(via))
+			}
+			return p.CheckURL(req.URL)
+		},
+	}
+}
+
+// isAllowedHost returns true if the host is one of the allowed hosts. An allowed host starting with "*." matches
+// its subdomains.
+func (p *AddressPolicy) isAllowedHost(host string) bool {
+	for _, allowed := range p.allowedHosts {
+		if suffix, ok := strings.CutPrefix(allowed, "*"); ok && strings.HasPrefix(suffix, ".") {
+			if strings.HasSuffix(host, suffix) {
+				return true
+			}
+		} else if host == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func parseCIDRs(values []string) ([]*net.IPNet, error) {
+	cidrs := []*net.IPNet{}
+	for _, value := range values {
+		_, cidr, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid webhook address range %q: %w", value, err)
+		}
+		cidrs = append(cidrs, cidr)
+	}
+	return cidrs, nil
+}
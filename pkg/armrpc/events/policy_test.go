@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AddressPolicy_CheckIP(t *testing.T) {
+	policy, err := NewAddressPolicy(&Options{
+		AllowedCIDRs: []string{"10.96.0.0/12"},
+		DeniedCIDRs:  []string{"10.96.0.1/32", "203.0.113.0/24"},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		ip      string
+		allowed bool
+	}{
+		{ip: "20.42.0.1", allowed: true},
+		{ip: "2001:4860:4860::8888", allowed: true},
+		{ip: "10.96.0.10", allowed: true},
+		{ip: "127.0.0.1", allowed: false},
+		{ip: "::1", allowed: false},
+		{ip: "169.254.169.254", allowed: false},
+		{ip: "fe80::1", allowed: false},
+		{ip: "10.0.0.1", allowed: false},
+		{ip: "172.16.0.1", allowed: false},
+		{ip: "192.168.1.1", allowed: false},
+		{ip: "fd00::1", allowed: false},
+		{ip: "0.0.0.0", allowed: false},
+		{ip: "224.0.0.1", allowed: false},
+		{ip: "100.64.0.1", allowed: false},
+		{ip: "100.127.255.254", allowed: false},
+		{ip: "100.128.0.1", allowed: true},
+		{ip: "198.18.0.1", allowed: false},
+		{ip: "198.19.255.254", allowed: false},
+		{ip: "198.20.0.1", allowed: true},
+		{ip: "::ffff:100.64.0.1", allowed: false},
+		{ip: "10.96.0.1", allowed: false},
+		{ip: "203.0.113.7", allowed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			err := policy.CheckIP(net.ParseIP(tt.ip))
+			if tt.allowed {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func Test_AddressPolicy_CheckURL(t *testing.T) {
+	var policy *AddressPolicy
+	require.NoError(t, policy.CheckURL(&url.URL{Scheme: "http", Host: "127.0.0.1"}))
+
+	policy, err := NewAddressPolicy(nil)
+	require.NoError(t, err)
+	require.NoError(t, policy.CheckURL(&url.URL{Scheme: "https", Host: "hooks.contoso.com"}))
+	require.Error(t, policy.CheckURL(&url.URL{Scheme: "http", Host: "localhost:8080"}))
+	require.Error(t, policy.CheckURL(&url.URL{Scheme: "http", Host: "ucp.localhost"}))
+	require.Error(t, policy.CheckURL(&url.URL{Scheme: "http", Host: "[::1]:8080"}))
+
+	_, err = NewAddressPolicy(&Options{DeniedCIDRs: []string{"not-a-cidr"}})
+	require.Error(t, err)
+}
+
+func Test_AddressPolicy_NewClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	// The address is checked when connecting, after the host name is resolved.
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	target := "http://localhost:" + u.Port()
+
+	policy, err := NewAddressPolicy(nil)
+	require.NoError(t, err)
+
+	_, err = policy.NewClient(time.Second).Get(target)
+	require.ErrorContains(t, err, "is not allowed for webhooks")
+
+	policy, err = NewAddressPolicy(&Options{AllowedCIDRs: []string{"127.0.0.0/8", "::1/128"}})
+	require.NoError(t, err)
+
+	resp, err := policy.NewClient(time.Second).Get(target)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
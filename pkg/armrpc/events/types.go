@@ -0,0 +1,137 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// SpecVersion is the CloudEvents specification version of the events.
+	SpecVersion = "1.0"
+	// ContentType is the content type of events delivered in the CloudEvents structured mode.
+	ContentType = "application/cloudevents+json"
+	// SignatureHeader is the header carrying the HMAC-SHA256 signature of the event body, in the format "sha256=<hex>".
+	SignatureHeader = "X-Radius-Signature"
+
+	// EventTypeResourceCreated is the type of the event emitted when a resource is created.
+	EventTypeResourceCreated = "Radius.Resource.Created"
+	// EventTypeResourceUpdated is the type of the event emitted when a resource is updated.
+	EventTypeResourceUpdated = "Radius.Resource.Updated"
+	// EventTypeResourceDeleted is the type of the event emitted when a resource is deleted.
+	EventTypeResourceDeleted = "Radius.Resource.Deleted"
+	// EventTypeOperationPrefix is the prefix of the types of the events emitted when an async operation changes state,
+	// for example Radius.Operation.Succeeded.
+	EventTypeOperationPrefix = "Radius.Operation."
+)
+
+// OperationEventType returns the type of the event emitted when an async operation enters the given state.
+func OperationEventType(state v1.ProvisioningState) string {
+	return EventTypeOperationPrefix + string(state)
+}
+
+// Event is a CloudEvents 1.0 event about a resource.
+type Event struct {
+	// SpecVersion is the CloudEvents specification version.
+	SpecVersion string `json:"specversion"`
+	// ID is the unique ID of the event.
+	ID string `json:"id"`
+	// Source is the plane the event originated from.
+	Source string `json:"source"`
+	// Type is the type of the event.
+	Type string `json:"type"`
+	// Subject is the ID of the resource the event is about.
+	Subject string `json:"subject"`
+	// Time is the time the event occurred.
+	Time time.Time `json:"time"`
+	// DataContentType is the content type of the data.
+	DataContentType string `json:"datacontenttype"`
+	// Data is the payload of the event.
+	Data *EventData `json:"data"`
+}
+
+// EventData is the payload of an event.
+type EventData struct {
+	// ResourceID is the ID of the resource.
+	ResourceID string `json:"resourceId"`
+	// ResourceType is the type of the resource.
+	ResourceType string `json:"resourceType"`
+	// ProvisioningState is the provisioning state of the resource or of the async operation.
+	ProvisioningState v1.ProvisioningState `json:"provisioningState,omitempty"`
+	// OperationID is the ID of the async operation.
+	OperationID string `json:"operationId,omitempty"`
+	// OperationType is the type of the async operation.
+	OperationType string `json:"operationType,omitempty"`
+	// CorrelationID is the correlation ID of the request that caused the event.
+	CorrelationID string `json:"correlationId,omitempty"`
+	// Error is the error of a failed async operation.
+	Error *v1.ErrorDetails `json:"error,omitempty"`
+}
+
+// NewEvent creates an event of the given type about the resource with the given ID.
+func NewEvent(eventType string, id resources.ID, data EventData) *Event {
+	data.ResourceID = id.String()
+	data.ResourceType = id.Type()
+
+	return &Event{
+		SpecVersion:     SpecVersion,
+		ID:              uuid.NewString(),
+		Source:          id.PlaneScope(),
+		Type:            eventType,
+		Subject:         id.String(),
+		Time:            time.Now().UTC(),
+		DataContentType: "application/json",
+		Data:            &data,
+	}
+}
+
+// Publisher publishes events to the subscriptions they match.
+type Publisher interface {
+	// Publish delivers the event to the matching subscriptions in the background. Delivery failures are recorded
+	// in the delivery status of the subscription and never returned to the caller.
+	Publish(ctx context.Context, event *Event)
+}
+
+// Options configures the delivery of events.
+type Options struct {
+	// Enabled enables event notifications.
+	Enabled bool `yaml:"enabled"`
+	// MaxAttempts is the number of attempts made to deliver an event. Defaults to 5.
+	MaxAttempts int `yaml:"maxAttempts,omitempty"`
+	// RetryInterval is the delay before the first retry. It doubles after every attempt. Defaults to 2s.
+	RetryInterval time.Duration `yaml:"retryInterval,omitempty"`
+	// Timeout is the timeout of a single delivery attempt. Defaults to 10s.
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+	// CacheTTL is how long the subscriptions of a plane are cached. New, updated and deleted subscriptions take
+	// effect after at most this duration. Defaults to 30s.
+	CacheTTL time.Duration `yaml:"cacheTTL,omitempty"`
+	// AllowedHosts restricts webhooks to these hosts when it is not empty. "*.example.com" allows the subdomains
+	// of example.com.
+	AllowedHosts []string `yaml:"allowedHosts,omitempty"`
+	// AllowedCIDRs are address ranges webhooks can be reached at even though they are loopback, link-local, private,
+	// shared (100.64.0.0/10), benchmarking (198.18.0.0/15), unspecified or multicast addresses, which are denied
+	// otherwise. For example the service range of the cluster.
+	AllowedCIDRs []string `yaml:"allowedCIDRs,omitempty"`
+	// DeniedCIDRs are address ranges webhooks can never be reached at, even if they are in AllowedCIDRs.
+	DeniedCIDRs []string `yaml:"deniedCIDRs,omitempty"`
+}
@@ -23,8 +23,11 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	sm "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/events"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/store"
 
@@ -67,6 +70,15 @@ type Options struct {
 
 	// StatusManager is the async operation status manager.
 	StatusManager sm.StatusManager
+
+	// EventPublisher publishes resource lifecycle events. May be nil if events are disabled.
+	EventPublisher events.Publisher
+
+	// Quotas is the resource quotas enforced when resources are created. May be nil if quotas are not configured.
+	Quotas *ratelimit.QuotaOptions
+
+	// SoftDelete configures the soft delete of resource groups and environments. May be nil if soft delete is disabled.
+	SoftDelete *softdelete.Options
 }
 
 // ResourceOptions represents the options and filters for resource.
@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/events"
+)
+
+// PublishResourceEvent publishes an event of the given type about the resource targeted by the request. The resource
+// can be nil if it was deleted. Events are not published if the controller has no event publisher.
+func (c *Operation[P, T]) PublishResourceEvent(ctx context.Context, eventType string, resource *T) {
+	publisher := c.Options().EventPublisher
+	if publisher == nil {
+		return
+	}
+
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	data := events.EventData{CorrelationID: serviceCtx.CorrelationID}
+	if resource != nil {
+		data.ProvisioningState = P(resource).ProvisioningState()
+	}
+
+	publisher.Publish(ctx, events.NewEvent(eventType, serviceCtx.ResourceID, data))
+}
+
+// ResourceEventType returns the type of the event emitted when a resource is written, based on whether the resource
+// existed before.
+func ResourceEventType[T any](oldResource *T) string {
+	if oldResource == nil {
+		return events.EventTypeResourceCreated
+	}
+	return events.EventTypeResourceUpdated
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+// ValidateLocks returns a Conflict response if a resource lock prevents the request. A lock prevents the request if
+// its scope is the resource or one of the scopes the resource is in, or if its scope is the application or environment
+// one of the given resources belongs to. Locks are not enforced if the controller has no data provider, and never
+// apply to locks themselves so that they can always be removed.
+func (c *Operation[P, T]) ValidateLocks(ctx context.Context, req *http.Request, models ...*T) (rest.Response, error) {
+	if c.DataProvider() == nil {
+		return nil, nil
+	}
+
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	linked := []string{}
+	for _, model := range models {
+		if model != nil {
+			linked = append(linked, linkedResourceIDs(model)...)
+		}
+	}
+
+	lock, err := FindLock(ctx, c.DataProvider(), req.Method, serviceCtx.ResourceID.String(), linked...)
+	if err != nil {
+		return nil, err
+	} else if lock == nil {
+		return nil, nil
+	}
+
+	action := "updated"
+	if req.Method == http.MethodDelete {
+		action = "deleted"
+	}
+	message := fmt.Sprintf("The resource %s cannot be %s because it is locked by %s with level %s. Remove the lock and try again.", serviceCtx.ResourceID.String(), action, lock.ID, lock.Properties.Level)
+	return rest.NewConflictResponse(message), nil
+}
+
+// FindLock returns the first lock that blocks the given HTTP method on the resource with the given ID, or nil if there
+// is none. A lock applies to the resource if its scope is the resource or one of the scopes it is in. Locks whose scope
+// is one of the linked resources, such as the application or environment of the resource, apply as well.
+func FindLock(ctx context.Context, provider dataprovider.DataStorageProvider, method string, id string, linked ...string) (*datamodel.Lock, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil || !parsed.IsUCPQualified() {
+		return nil, nil
+	}
+
+	// Locks can be removed even when their scope is locked.
+	if strings.EqualFold(parsed.Type(), datamodel.LockResourceType) {
+		return nil, nil
+	}
+
+	planes := []string{parsed.PlaneScope()}
+	for _, target := range linked {
+		if parsed, err := resources.Parse(target); err == nil && parsed.IsUCPQualified() && !containsFold(planes, parsed.PlaneScope()) {
+			planes = append(planes, parsed.PlaneScope())
+		}
+	}
+
+	client, err := provider.GetStorageClient(ctx, datamodel.LockResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	locks := []*datamodel.Lock{}
+	for _, plane := range planes {
+		result, err := client.Query(ctx, store.Query{RootScope: plane, ResourceType: datamodel.LockResourceType})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			lock := &datamodel.Lock{}
+			if err := item.As(lock); err != nil {
+				return nil, err
+			}
+			locks = append(locks, lock)
+		}
+	}
+
+	sort.Slice(locks, func(i, j int) bool {
+		return strings.ToLower(locks[i].ID) < strings.ToLower(locks[j].ID)
+	})
+
+	for _, lock := range locks {
+		if !lock.Blocks(method) {
+			continue
+		}
+
+		if lock.AppliesTo(id) {
+			return lock, nil
+		}
+
+		// Only locks of the linked resources themselves apply, not the locks of the scopes they are in.
+		if containsFold(linked, strings.TrimSuffix(lock.Properties.Scope, resources.SegmentSeparator)) {
+			return lock, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// linkedResourceIDs returns the IDs of the application and environment the resource belongs to.
+func linkedResourceIDs(resource any) []string {
+	ids := []string{}
+	if props := resourceMetadata(resource); props != nil {
+		for _, id := range []string{props.Application, props.Environment} {
+			if id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	return ids
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
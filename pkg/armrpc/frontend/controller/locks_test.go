@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	testPlaneScope       = "/planes/radius/local"
+	testGroupScope       = "/planes/radius/local/resourceGroups/rg1"
+	testContainerID      = "/planes/radius/local/resourceGroups/rg1/providers/Applications.Core/containers/frontend"
+	testApplicationID    = "/planes/radius/local/resourceGroups/rg1/providers/Applications.Core/applications/app"
+	testOtherGroupScope  = "/planes/radius/local/resourceGroups/rg2"
+	testOtherContainerID = "/planes/radius/local/resourceGroups/rg2/providers/Applications.Core/containers/backend"
+)
+
+func newLock(scope string, name string, level datamodel.LockLevel) *datamodel.Lock {
+	lock := &datamodel.Lock{
+		Properties: datamodel.LockProperties{
+			Level: level,
+			Scope: scope,
+		},
+	}
+	lock.ID = testPlaneScope + "/providers/System.Resources/locks/" + name
+	lock.Name = name
+	lock.Type = datamodel.LockResourceType
+	return lock
+}
+
+func setupLockStore(t *testing.T, locks ...*datamodel.Lock) dataprovider.DataStorageProvider {
+	mctrl := gomock.NewController(t)
+	client := store.NewMockStorageClient(mctrl)
+	provider := dataprovider.NewMockDataStorageProvider(mctrl)
+	provider.EXPECT().GetStorageClient(gomock.Any(), datamodel.LockResourceType).Return(client, nil).AnyTimes()
+	client.EXPECT().Query(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+		require.Equal(t, datamodel.LockResourceType, query.ResourceType)
+
+		result := &store.ObjectQueryResult{}
+		for _, lock := range locks {
+			if resources.MustParse(lock.ID).RootScope() == query.RootScope {
+				result.Items = append(result.Items, store.Object{Metadata: store.Metadata{ID: lock.ID}, Data: lock})
+			}
+		}
+		return result, nil
+	}).AnyTimes()
+
+	return provider
+}
+
+func Test_FindLock(t *testing.T) {
+	tests := []struct {
+		name     string
+		locks    []*datamodel.Lock
+		method   string
+		id       string
+		linked   []string
+		expected string
+	}{
+		{
+			name:   "no locks",
+			method: http.MethodDelete,
+			id:     testContainerID,
+		},
+		{
+			name:     "plane lock blocks delete",
+			locks:    []*datamodel.Lock{newLock(testPlaneScope, "plane", datamodel.LockLevelCanNotDelete)},
+			method:   http.MethodDelete,
+			id:       testContainerID,
+			expected: "plane",
+		},
+		{
+			name:   "can not delete lock allows update",
+			locks:  []*datamodel.Lock{newLock(testGroupScope, "group", datamodel.LockLevelCanNotDelete)},
+			method: http.MethodPut,
+			id:     testContainerID,
+		},
+		{
+			name:     "read only lock blocks update",
+			locks:    []*datamodel.Lock{newLock(testGroupScope, "group", datamodel.LockLevelReadOnly)},
+			method:   http.MethodPut,
+			id:       testContainerID,
+			expected: "group",
+		},
+		{
+			name:     "group lock blocks deleting the group",
+			locks:    []*datamodel.Lock{newLock(testGroupScope, "group", datamodel.LockLevelCanNotDelete)},
+			method:   http.MethodDelete,
+			id:       testGroupScope,
+			expected: "group",
+		},
+		{
+			name:   "lock of another group does not apply",
+			locks:  []*datamodel.Lock{newLock(testOtherGroupScope, "other", datamodel.LockLevelReadOnly)},
+			method: http.MethodDelete,
+			id:     testContainerID,
+		},
+		{
+			name:   "application lock does not apply to resources in the scope of the application",
+			locks:  []*datamodel.Lock{newLock(testApplicationID, "app", datamodel.LockLevelCanNotDelete)},
+			method: http.MethodDelete,
+			id:     testContainerID,
+		},
+		{
+			name:     "resource lock applies to the resource",
+			locks:    []*datamodel.Lock{newLock(testApplicationID, "app", datamodel.LockLevelCanNotDelete)},
+			method:   http.MethodDelete,
+			id:       testApplicationID,
+			expected: "app",
+		},
+		{
+			name:     "application lock applies to resources of the application",
+			locks:    []*datamodel.Lock{newLock(testApplicationID, "app", datamodel.LockLevelCanNotDelete)},
+			method:   http.MethodDelete,
+			id:       testOtherContainerID,
+			linked:   []string{testApplicationID},
+			expected: "app",
+		},
+		{
+			name:   "lock of the scope of a linked resource does not apply",
+			locks:  []*datamodel.Lock{newLock(testGroupScope, "group", datamodel.LockLevelCanNotDelete)},
+			method: http.MethodDelete,
+			id:     testOtherContainerID,
+			linked: []string{testApplicationID},
+		},
+		{
+			name:   "locks can always be removed",
+			locks:  []*datamodel.Lock{newLock(testPlaneScope, "plane", datamodel.LockLevelReadOnly)},
+			method: http.MethodDelete,
+			id:     testPlaneScope + "/providers/System.Resources/locks/group",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := setupLockStore(t, tt.locks...)
+
+			lock, err := FindLock(context.Background(), provider, tt.method, tt.id, tt.linked...)
+			require.NoError(t, err)
+
+			if tt.expected == "" {
+				require.Nil(t, lock)
+			} else {
+				require.NotNil(t, lock)
+				require.Equal(t, tt.expected, lock.Name)
+			}
+		})
+	}
+}
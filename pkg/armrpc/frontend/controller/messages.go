@@ -19,4 +19,11 @@ package controller
 const (
 	// InProgressStateMessageFormat represents the message when resource is in progress state.
 	InProgressStateMessageFormat = "The target resource is in progress state: %s."
+
+	// SoftDeletedMessageFormat represents the message when a soft-deleted resource is updated.
+	SoftDeletedMessageFormat = "The target resource %s is soft deleted and must be restored before it is updated."
+
+	// SoftDeletedParentMessageFormat represents the message when a resource is created in a soft-deleted resource group
+	// or environment.
+	SoftDeletedParentMessageFormat = "The target resource %s cannot be created because %s is soft deleted and must be restored first."
 )
@@ -146,6 +146,17 @@ func (c *Operation[P, T]) PrepareResource(ctx context.Context, req *http.Request
 		if !state.IsTerminal() {
 			return rest.NewConflictResponse(fmt.Sprintf(InProgressStateMessageFormat, state)), nil
 		}
+
+		if newResource != nil && P(oldResource).GetBaseResource().DeletedTime != nil {
+			return rest.NewConflictResponse(fmt.Sprintf(SoftDeletedMessageFormat, serviceCtx.ResourceID.String())), nil
+		}
+	} else if newResource != nil && c.Options().SoftDelete.IsEnabled() {
+		parentID, err := deletedParent(ctx, c.StorageClient(), serviceCtx.ResourceID, resourceMetadata(newResource))
+		if err != nil {
+			return nil, err
+		} else if parentID != "" {
+			return rest.NewConflictResponse(fmt.Sprintf(SoftDeletedParentMessageFormat, serviceCtx.ResourceID.String(), parentID)), nil
+		}
 	}
 
 	if newResource != nil {
@@ -163,8 +174,9 @@ func (c *Operation[P, T]) PrepareResource(ctx context.Context, req *http.Request
 	return nil, nil
 }
 
-// PrepareAsyncOperation saves the initial state and queue the async operation.
-func (c *Operation[P, T]) PrepareAsyncOperation(ctx context.Context, newResource *T, initialState v1.ProvisioningState, asyncTimeout time.Duration, etag *string) (rest.Response, error) {
+// PrepareAsyncOperation saves the initial state and queue the async operation. The resource event of the given type
+// is published when the async operation succeeds, eventType can be empty to publish no resource event.
+func (c *Operation[P, T]) PrepareAsyncOperation(ctx context.Context, newResource *T, initialState v1.ProvisioningState, asyncTimeout time.Duration, etag *string, eventType string) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
 	P(newResource).SetProvisioningState(initialState)
@@ -176,8 +188,9 @@ func (c *Operation[P, T]) PrepareAsyncOperation(ctx context.Context, newResource
 	}
 
 	options := sm.QueueOperationOptions{
-		OperationTimeout: asyncTimeout,
-		RetryAfter:       v1.DefaultRetryAfterDuration,
+		OperationTimeout:  asyncTimeout,
+		RetryAfter:        v1.DefaultRetryAfterDuration,
+		ResourceEventType: eventType,
 	}
 	if c.resourceOptions.AsyncOperationRetryAfter != 0 {
 		options.RetryAfter = c.resourceOptions.AsyncOperationRetryAfter
@@ -0,0 +1,393 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
+	"github.com/radius-project/radius/pkg/rp/environments"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// systemResourceTypePrefix is the prefix of the internal resource types of UCP, which are not counted by quotas.
+	systemResourceTypePrefix = "System."
+
+	// QuotaUsageResourceType is the resource type of the stored resource counts of the scopes limited by quotas.
+	QuotaUsageResourceType = "System.Resources/quotaUsages"
+
+	// quotaRecountInterval is the age after which the resources of a scope are counted again.
+	quotaRecountInterval = 10 * time.Minute
+
+	// quotaReservationTTL is how long the creation of a resource is counted by the usage of its scope even if the
+	// resource is not stored.
+	quotaReservationTTL = 2 * time.Minute
+
+	// maxQuotaAttempts is the number of times the resource count of a scope is updated before giving up because of
+	// concurrent updates.
+	maxQuotaAttempts = 5
+)
+
+// quotaUsage is the stored count of the resources of a scope limited by a quota.
+type quotaUsage struct {
+	// Count is the number of resources in the scope, including the resources being created.
+	Count int `json:"count"`
+	// ResourceTypes is the list of resource types created in the scope. They are queried when the resources of the
+	// scope are counted.
+	ResourceTypes []string `json:"resourceTypes"`
+	// CountedAt is the time the resources of the scope were last counted.
+	CountedAt time.Time `json:"countedAt"`
+	// Reservations are the resources created in the last quotaReservationTTL. They are counted with the stored
+	// resources of the scope because their creation can still be in progress.
+	Reservations []quotaReservation `json:"reservations,omitempty"`
+}
+
+// quotaReservation is a resource counted by the usage of a scope when it was created.
+type quotaReservation struct {
+	// ID is the ID of the resource.
+	ID string `json:"id"`
+	// Time is the time the resource was counted.
+	Time time.Time `json:"time"`
+}
+
+// ValidateQuota returns a QuotaExceeded response if creating the resource would exceed the resource quota of its
+// resource group or environment. Quotas are only enforced when a resource is created, and not if the controller has no
+// data provider or quotas are not configured.
+func (c *Operation[P, T]) ValidateQuota(ctx context.Context, newResource *T, oldResource *T) (rest.Response, error) {
+	quotas := c.Options().Quotas
+	if oldResource != nil || quotas == nil || c.DataProvider() == nil {
+		return nil, nil
+	}
+
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	message, err := ReserveQuota(ctx, c.DataProvider(), quotas, serviceCtx.ResourceID, resourceMetadata(newResource))
+	if err != nil {
+		return nil, err
+	} else if message == "" {
+		return nil, nil
+	}
+
+	return rest.NewQuotaExceededResponse(message), nil
+}
+
+// ReserveQuota counts the resource with the given ID towards the resource quotas of its resource group and environment.
+// It returns a message describing the exceeded quota if creating the resource would exceed a quota, or an empty string
+// if it would not. properties is the application and environment of the new resource and may be nil.
+//
+// The resources of a scope are counted by a usage object stored in the scope. Each create increments it with an ETag
+// check, so concurrent creates can't exceed a quota together and a create costs one read and one write. Deleted
+// resources and failed creates are not subtracted. Instead the resources of the scope are counted again when the usage
+// reaches the quota, when a resource type is created in the scope for the first time, and when the usage is older
+// than quotaRecountInterval. A recount also counts the resources created in the last quotaReservationTTL, so that
+// the creates in progress are not lost. The first create of a scope saves the usage without an ETag check, so creates
+// that run concurrently with it can exceed the quota by their number.
+//
+// Resources are counted with a query per resource type created in the scope, so that a quota counts the resources
+// of every type on stores that keep a collection per resource type. On those stores, resources created before quotas
+// were enabled are only counted once a resource of the same type is created in the scope.
+func ReserveQuota(ctx context.Context, provider dataprovider.DataStorageProvider, quotas *ratelimit.QuotaOptions, id resources.ID, properties *rpv1.BasicResourceProperties) (string, error) {
+	if quotas == nil || !id.IsUCPQualified() || isSystemResourceType(id.Type()) {
+		return "", nil
+	}
+
+	if max := quotas.MaxResourcesPerResourceGroup; max > 0 && id.FindScope(resources_radius.ScopeResourceGroups) != "" {
+		list := func(ctx context.Context, client store.StorageClient, resourceType string) ([]store.Object, error) {
+			result, err := client.Query(ctx, store.Query{RootScope: id.RootScope(), ResourceType: resourceType})
+			if err != nil {
+				return nil, err
+			}
+			return result.Items, nil
+		}
+
+		usageID := id.RootScope() + "/providers/" + QuotaUsageResourceType + "/resourceGroup"
+		reserved, err := reserveQuota(ctx, provider, usageID, id, max, list)
+		if err != nil {
+			return "", err
+		}
+
+		if !reserved {
+			return fmt.Sprintf("The resource %s cannot be created because the resource group %s has reached its quota of %d resources. Delete unused resources or ask an administrator to raise the quota.", id.String(), id.RootScope(), max), nil
+		}
+	}
+
+	if max := quotas.MaxResourcesPerEnvironment; max > 0 && properties != nil && !strings.EqualFold(id.Type(), environments.ResourceType) {
+		applicationClient, err := provider.GetStorageClient(ctx, environments.ApplicationResourceType)
+		if err != nil {
+			return "", err
+		}
+
+		environmentID, err := environmentOf(ctx, applicationClient, properties)
+		if err != nil {
+			return "", err
+		}
+
+		environment, err := resources.ParseResource(environmentID)
+		if environmentID != "" && err == nil {
+			list := func(ctx context.Context, client store.StorageClient, resourceType string) ([]store.Object, error) {
+				return listEnvironmentResources(ctx, provider, client, environment, resourceType)
+			}
+
+			usageID := environment.RootScope() + "/providers/" + QuotaUsageResourceType + "/environment-" + environment.Name()
+			reserved, err := reserveQuota(ctx, provider, usageID, id, max, list)
+			if err != nil {
+				return "", err
+			}
+
+			if !reserved {
+				return fmt.Sprintf("The resource %s cannot be created because the environment %s has reached its quota of %d resources. Delete unused resources or ask an administrator to raise the quota.", id.String(), environmentID, max), nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// reserveQuota increments the usage with the given ID if it is below max and returns true, or returns false if the
+// quota is reached. list returns the resources of the scope of the usage. It is called with an empty resource type to
+// find the resources of every type stored with the new resource.
+func reserveQuota(ctx context.Context, provider dataprovider.DataStorageProvider, usageID string, id resources.ID, max int, list func(ctx context.Context, client store.StorageClient, resourceType string) ([]store.Object, error)) (bool, error) {
+	client, err := provider.GetStorageClient(ctx, QuotaUsageResourceType)
+	if err != nil {
+		return false, err
+	}
+
+	for attempt := 0; attempt < maxQuotaAttempts; attempt++ {
+		usage := quotaUsage{}
+		etag := ""
+		obj, err := client.Get(ctx, usageID)
+		if errors.Is(err, &store.ErrNotFound{}) {
+			// The usage is created below.
+		} else if err != nil {
+			return false, err
+		} else {
+			if err := obj.As(&usage); err != nil {
+				return false, err
+			}
+			etag = obj.ETag
+		}
+
+		now := time.Now().UTC()
+		reservations := []quotaReservation{}
+		for _, reservation := range usage.Reservations {
+			if now.Sub(reservation.Time) < quotaReservationTTL && !strings.EqualFold(reservation.ID, id.String()) {
+				reservations = append(reservations, reservation)
+			}
+		}
+		usage.Reservations = reservations
+
+		if etag == "" || usage.Count >= max || !containsResourceType(usage.ResourceTypes, id.Type()) || now.Sub(usage.CountedAt) > quotaRecountInterval {
+			usage.Count, usage.ResourceTypes, err = countResources(ctx, provider, id, append(usage.ResourceTypes, id.Type()), usage.Reservations, list)
+			if err != nil {
+				return false, err
+			}
+			usage.CountedAt = now
+		}
+
+		if usage.Count >= max {
+			return false, nil
+		}
+
+		usage.Count++
+		usage.Reservations = append(usage.Reservations, quotaReservation{ID: id.String(), Time: now})
+		options := []store.SaveOptions{}
+		if etag != "" {
+			options = append(options, store.WithETag(etag))
+		}
+
+		err = client.Save(ctx, &store.Object{Metadata: store.Metadata{ID: usageID}, Data: usage}, options...)
+		if errors.Is(err, &store.ErrConcurrency{}) {
+			continue
+		} else if err != nil {
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	return false, fmt.Errorf("failed to update the quota usage %s because of concurrent updates", usageID)
+}
+
+// countResources returns the number of resources of the scope counted by quotas, including the reserved resources,
+// and the resource types found in the scope. The resource with the given ID is not counted.
+func countResources(ctx context.Context, provider dataprovider.DataStorageProvider, id resources.ID, resourceTypes []string, reservations []quotaReservation, list func(ctx context.Context, client store.StorageClient, resourceType string) ([]store.Object, error)) (int, []string, error) {
+	seen := map[string]bool{}
+	types := []string{}
+	addType := func(resourceType string) {
+		if !containsResourceType(types, resourceType) {
+			types = append(types, resourceType)
+		}
+	}
+	add := func(items []store.Object) {
+		for _, item := range items {
+			if !countsTowardsQuota(&item, id) {
+				continue
+			}
+
+			seen[strings.ToLower(item.ID)] = true
+			addType(resources.MustParse(item.ID).Type())
+		}
+	}
+
+	for _, resourceType := range resourceTypes {
+		addType(resourceType)
+	}
+	for _, reservation := range reservations {
+		seen[strings.ToLower(reservation.ID)] = true
+	}
+
+	// Stores that keep every resource type in one collection return the resources of every type here.
+	client, err := provider.GetStorageClient(ctx, id.Type())
+	if err != nil {
+		return 0, nil, err
+	}
+	items, err := list(ctx, client, "")
+	if err != nil {
+		return 0, nil, err
+	}
+	add(items)
+
+	for i := 0; i < len(types); i++ {
+		client, err := provider.GetStorageClient(ctx, types[i])
+		if err != nil {
+			return 0, nil, err
+		}
+
+		items, err := list(ctx, client, types[i])
+		if err != nil {
+			return 0, nil, err
+		}
+		add(items)
+	}
+
+	return len(seen), types, nil
+}
+
+// environmentOf returns the ID of the environment of a resource, looking up the environment of its application if
+// the resource does not reference an environment directly.
+func environmentOf(ctx context.Context, client store.StorageClient, properties *rpv1.BasicResourceProperties) (string, error) {
+	if properties.Environment != "" || properties.Application == "" {
+		return properties.Environment, nil
+	}
+
+	obj, err := client.Get(ctx, properties.Application)
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	_, environment, err := environments.LinkedScopes(obj)
+	return environment, err
+}
+
+// listEnvironmentResources returns the resources of the given type in the environment, including the resources of its
+// applications. All the resources stored with client are returned if resourceType is empty.
+func listEnvironmentResources(ctx context.Context, provider dataprovider.DataStorageProvider, client store.StorageClient, environment resources.ID, resourceType string) ([]store.Object, error) {
+	if resourceType == "" {
+		return environments.Resources(ctx, client, environment.String())
+	}
+
+	query := func(client store.StorageClient, resourceType string, property string, id string) ([]store.Object, error) {
+		result, err := client.Query(ctx, store.Query{
+			RootScope:      environment.PlaneScope(),
+			ScopeRecursive: true,
+			ResourceType:   resourceType,
+			Filters:        []store.QueryFilter{{Field: "properties." + property, Value: id}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return result.Items, nil
+	}
+
+	items, err := query(client, resourceType, "environment", environment.String())
+	if err != nil {
+		return nil, err
+	}
+
+	applicationClient, err := provider.GetStorageClient(ctx, environments.ApplicationResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	applications, err := query(applicationClient, environments.ApplicationResourceType, "environment", environment.String())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, application := range applications {
+		applicationResources, err := query(client, resourceType, "application", application.ID)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, applicationResources...)
+	}
+
+	return items, nil
+}
+
+// resourceMetadata returns the application and environment of the resource, or nil if the resource does not have them.
+func resourceMetadata(resource any) *rpv1.BasicResourceProperties {
+	metadata, ok := resource.(interface {
+		ResourceMetadata() *rpv1.BasicResourceProperties
+	})
+	if !ok {
+		return nil
+	}
+
+	return metadata.ResourceMetadata()
+}
+
+// countsTowardsQuota returns true if the stored object is a resource counted by quotas. The resource being created and
+// soft-deleted resources are not counted.
+func countsTowardsQuota(obj *store.Object, id resources.ID) bool {
+	if strings.EqualFold(obj.ID, id.String()) || softdelete.IsDeleted(obj) {
+		return false
+	}
+
+	parsed, err := resources.ParseResource(obj.ID)
+	if err != nil {
+		return false
+	}
+
+	return !isSystemResourceType(parsed.Type())
+}
+
+func containsResourceType(resourceTypes []string, resourceType string) bool {
+	for _, t := range resourceTypes {
+		if strings.EqualFold(t, resourceType) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSystemResourceType(resourceType string) bool {
+	return strings.HasPrefix(strings.ToLower(resourceType), strings.ToLower(systemResourceTypePrefix))
+}
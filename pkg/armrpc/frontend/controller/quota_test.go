@@ -0,0 +1,403 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	testEnvironmentID      = "/planes/radius/local/resourceGroups/rg1/providers/Applications.Core/environments/env"
+	testOtherEnvironmentID = "/planes/radius/local/resourceGroups/rg1/providers/Applications.Core/environments/other"
+	testOtherApplicationID = "/planes/radius/local/resourceGroups/rg2/providers/Applications.Core/applications/other"
+)
+
+func newQuotaObject(id string, properties map[string]any) store.Object {
+	return store.Object{
+		Metadata: store.Metadata{ID: id},
+		Data:     map[string]any{"id": id, "properties": properties},
+	}
+}
+
+// quotaObjects is the content of the store used by the quota tests:
+//
+//   - rg1: the environment "env", the application "app" in "env", a container of "app" and a tracked resource record.
+//   - rg2: a container in "env" and the application "other" in the environment "other".
+var quotaObjects = []store.Object{
+	newQuotaObject(testEnvironmentID, map[string]any{}),
+	newQuotaObject(testApplicationID, map[string]any{"environment": testEnvironmentID}),
+	newQuotaObject(testContainerID, map[string]any{"application": testApplicationID}),
+	newQuotaObject(testGroupScope+"/providers/System.Resources/resources/tracked", map[string]any{}),
+	newQuotaObject(testOtherContainerID, map[string]any{"environment": testEnvironmentID}),
+	newQuotaObject(testOtherApplicationID, map[string]any{"environment": testOtherEnvironmentID}),
+}
+
+// quotaStore is a store with one collection per resource type, or with one collection for every resource type, which
+// saves objects with optimistic concurrency.
+type quotaStore struct {
+	t                 *testing.T
+	collectionPerType bool
+
+	mu      sync.Mutex
+	objects map[string]store.Object
+	version int
+}
+
+func newQuotaStore(t *testing.T, collectionPerType bool, objects ...store.Object) *quotaStore {
+	s := &quotaStore{t: t, collectionPerType: collectionPerType, objects: map[string]store.Object{}}
+	for _, obj := range objects {
+		s.objects[strings.ToLower(obj.ID)] = obj
+	}
+	return s
+}
+
+func (s *quotaStore) provider() dataprovider.DataStorageProvider {
+	mctrl := gomock.NewController(s.t)
+	provider := dataprovider.NewMockDataStorageProvider(mctrl)
+	provider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, resourceType string) (store.StorageClient, error) {
+		collection := ""
+		if s.collectionPerType {
+			collection = resourceType
+		}
+		return s.client(mctrl, collection), nil
+	}).AnyTimes()
+	return provider
+}
+
+func (s *quotaStore) inCollection(id string, collection string) bool {
+	return collection == "" || strings.EqualFold(resources.MustParse(id).Type(), collection)
+}
+
+func (s *quotaStore) client(mctrl *gomock.Controller, collection string) store.StorageClient {
+	client := store.NewMockStorageClient(mctrl)
+	client.EXPECT().Query(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		result := &store.ObjectQueryResult{}
+		for _, obj := range s.objects {
+			parsed := resources.MustParse(obj.ID)
+			if !s.inCollection(obj.ID, collection) || (query.ResourceType != "" && !strings.EqualFold(parsed.Type(), query.ResourceType)) {
+				continue
+			}
+
+			scope := parsed.RootScope()
+			if !strings.EqualFold(scope, query.RootScope) && !(query.ScopeRecursive && strings.HasPrefix(strings.ToLower(scope), strings.ToLower(query.RootScope))) {
+				continue
+			}
+
+			match, err := obj.MatchesFilters(query.Filters)
+			require.NoError(s.t, err)
+			if match {
+				result.Items = append(result.Items, obj)
+			}
+		}
+		return result, nil
+	}).AnyTimes()
+	client.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		obj, ok := s.objects[strings.ToLower(id)]
+		if !ok || !s.inCollection(id, collection) {
+			return nil, &store.ErrNotFound{ID: id}
+		}
+		return &obj, nil
+	}).AnyTimes()
+	client.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		config := store.NewSaveConfig(options...)
+		existing, ok := s.objects[strings.ToLower(obj.ID)]
+		if config.ETag != "" && (!ok || existing.ETag != config.ETag) {
+			return &store.ErrConcurrency{}
+		}
+
+		s.version++
+		obj.ETag = fmt.Sprintf("%d", s.version)
+		s.objects[strings.ToLower(obj.ID)] = *obj
+		return nil
+	}).AnyTimes()
+	return client
+}
+
+// age moves the times of the usage with the given ID back by d.
+func (s *quotaStore) age(id string, d time.Duration) {
+	usage := s.usage(id)
+	usage.CountedAt = usage.CountedAt.Add(-d)
+	for i := range usage.Reservations {
+		usage.Reservations[i].Time = usage.Reservations[i].Time.Add(-d)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj := s.objects[strings.ToLower(id)]
+	obj.Data = usage
+	s.objects[strings.ToLower(id)] = obj
+}
+
+func (s *quotaStore) usage(id string) quotaUsage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	usage := quotaUsage{}
+	obj, ok := s.objects[strings.ToLower(id)]
+	require.True(s.t, ok)
+	require.NoError(s.t, obj.As(&usage))
+	return usage
+}
+
+func Test_ReserveQuota(t *testing.T) {
+	tests := []struct {
+		name       string
+		quotas     *ratelimit.QuotaOptions
+		id         string
+		properties *rpv1.BasicResourceProperties
+		exceeded   string
+	}{
+		{
+			name:   "no quotas",
+			quotas: nil,
+			id:     testGroupScope + "/providers/Applications.Core/containers/new",
+		},
+		{
+			name:   "resource group below quota",
+			quotas: &ratelimit.QuotaOptions{MaxResourcesPerResourceGroup: 4},
+			id:     testGroupScope + "/providers/Applications.Core/containers/new",
+		},
+		{
+			name:     "resource group at quota",
+			quotas:   &ratelimit.QuotaOptions{MaxResourcesPerResourceGroup: 3},
+			id:       testGroupScope + "/providers/Applications.Core/containers/new",
+			exceeded: "the resource group /planes/radius/local/resourceGroups/rg1 has reached its quota of 3 resources",
+		},
+		{
+			name:   "resource group quota does not count the resource itself",
+			quotas: &ratelimit.QuotaOptions{MaxResourcesPerResourceGroup: 3},
+			id:     testContainerID,
+		},
+		{
+			name:   "system resources are not limited",
+			quotas: &ratelimit.QuotaOptions{MaxResourcesPerResourceGroup: 1},
+			id:     testGroupScope + "/providers/System.Resources/locks/lock",
+		},
+		{
+			name:       "environment below quota",
+			quotas:     &ratelimit.QuotaOptions{MaxResourcesPerEnvironment: 4},
+			id:         testOtherGroupScope + "/providers/Applications.Core/containers/new",
+			properties: &rpv1.BasicResourceProperties{Environment: testEnvironmentID},
+		},
+		{
+			name:       "environment at quota",
+			quotas:     &ratelimit.QuotaOptions{MaxResourcesPerEnvironment: 3},
+			id:         testOtherGroupScope + "/providers/Applications.Core/containers/new",
+			properties: &rpv1.BasicResourceProperties{Environment: testEnvironmentID},
+			exceeded:   "the environment " + testEnvironmentID + " has reached its quota of 3 resources",
+		},
+		{
+			name:       "environment of the application at quota",
+			quotas:     &ratelimit.QuotaOptions{MaxResourcesPerEnvironment: 3},
+			id:         testOtherGroupScope + "/providers/Applications.Core/containers/new",
+			properties: &rpv1.BasicResourceProperties{Application: testApplicationID},
+			exceeded:   "the environment " + testEnvironmentID + " has reached its quota of 3 resources",
+		},
+		{
+			name:       "other environment below quota",
+			quotas:     &ratelimit.QuotaOptions{MaxResourcesPerEnvironment: 2},
+			id:         testOtherGroupScope + "/providers/Applications.Core/containers/new",
+			properties: &rpv1.BasicResourceProperties{Application: testOtherApplicationID},
+		},
+		{
+			name:       "environments are not limited by the environment quota",
+			quotas:     &ratelimit.QuotaOptions{MaxResourcesPerEnvironment: 1},
+			id:         testOtherEnvironmentID,
+			properties: &rpv1.BasicResourceProperties{},
+		},
+		{
+			name:       "missing application",
+			quotas:     &ratelimit.QuotaOptions{MaxResourcesPerEnvironment: 1},
+			id:         testOtherGroupScope + "/providers/Applications.Core/containers/new",
+			properties: &rpv1.BasicResourceProperties{Application: testOtherGroupScope + "/providers/Applications.Core/applications/missing"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := newQuotaStore(t, false, quotaObjects...).provider()
+
+			message, err := ReserveQuota(context.Background(), provider, tt.quotas, resources.MustParse(tt.id), tt.properties)
+			require.NoError(t, err)
+			if tt.exceeded == "" {
+				require.Empty(t, message)
+			} else {
+				require.Contains(t, message, tt.exceeded)
+			}
+		})
+	}
+}
+
+func Test_ReserveQuota_Usage(t *testing.T) {
+	ctx := context.Background()
+	quotas := &ratelimit.QuotaOptions{MaxResourcesPerResourceGroup: 5}
+	usageID := testGroupScope + "/providers/" + QuotaUsageResourceType + "/resourceGroup"
+	s := newQuotaStore(t, false, quotaObjects...)
+	provider := s.provider()
+
+	// The first create counts the resources of the resource group: the environment, the application and the container.
+	message, err := ReserveQuota(ctx, provider, quotas, resources.MustParse(testGroupScope+"/providers/Applications.Core/containers/a"), nil)
+	require.NoError(t, err)
+	require.Empty(t, message)
+	usage := s.usage(usageID)
+	require.Equal(t, 4, usage.Count)
+	require.ElementsMatch(t, []string{"Applications.Core/containers", "Applications.Core/environments", "Applications.Core/applications"}, usage.ResourceTypes)
+
+	// The next create increments the count without counting the resources again.
+	message, err = ReserveQuota(ctx, provider, quotas, resources.MustParse(testGroupScope+"/providers/Applications.Core/containers/b"), nil)
+	require.NoError(t, err)
+	require.Empty(t, message)
+	require.Equal(t, 5, s.usage(usageID).Count)
+
+	// The resources are counted again when the quota is reached. Creates "a" and "b" were not saved yet, but they
+	// are still counted because their creation can be in progress.
+	message, err = ReserveQuota(ctx, provider, quotas, resources.MustParse(testGroupScope+"/providers/Applications.Core/containers/c"), nil)
+	require.NoError(t, err)
+	require.Contains(t, message, "has reached its quota of 5 resources")
+	require.Equal(t, 5, s.usage(usageID).Count)
+
+	// Once the reservations of "a" and "b" expire, the resources are counted again without them.
+	s.age(usageID, time.Hour)
+	message, err = ReserveQuota(ctx, provider, quotas, resources.MustParse(testGroupScope+"/providers/Applications.Core/containers/c"), nil)
+	require.NoError(t, err)
+	require.Empty(t, message)
+	usage = s.usage(usageID)
+	require.Equal(t, 4, usage.Count)
+	require.Len(t, usage.Reservations, 1)
+}
+
+func Test_ReserveQuota_CollectionPerType(t *testing.T) {
+	ctx := context.Background()
+	usageID := testGroupScope + "/providers/" + QuotaUsageResourceType + "/resourceGroup"
+
+	// The usage is at the quota, so the resources of every type created in the resource group are counted again,
+	// even though each type is stored in its own collection.
+	usage := quotaUsage{
+		Count:         3,
+		ResourceTypes: []string{"Applications.Core/environments", "Applications.Core/applications", "Applications.Core/containers"},
+		CountedAt:     time.Now().UTC(),
+	}
+	objects := append([]store.Object{{Metadata: store.Metadata{ID: usageID, ETag: "0"}, Data: usage}}, quotaObjects...)
+
+	provider := newQuotaStore(t, true, objects...).provider()
+	message, err := ReserveQuota(ctx, provider, &ratelimit.QuotaOptions{MaxResourcesPerResourceGroup: 3}, resources.MustParse(testGroupScope+"/providers/Applications.Core/containers/new"), nil)
+	require.NoError(t, err)
+	require.Contains(t, message, "has reached its quota of 3 resources")
+
+	provider = newQuotaStore(t, true, objects...).provider()
+	message, err = ReserveQuota(ctx, provider, &ratelimit.QuotaOptions{MaxResourcesPerResourceGroup: 4}, resources.MustParse(testGroupScope+"/providers/Applications.Core/containers/new"), nil)
+	require.NoError(t, err)
+	require.Empty(t, message)
+}
+
+func Test_ReserveQuota_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	const max = 10
+	quotas := &ratelimit.QuotaOptions{MaxResourcesPerResourceGroup: max}
+	usageID := testGroupScope + "/providers/" + QuotaUsageResourceType + "/resourceGroup"
+	s := newQuotaStore(t, false, quotaObjects...)
+	provider := s.provider()
+
+	// Create the usage first: the first create of a resource group counts its resources without an ETag check.
+	message, err := ReserveQuota(ctx, provider, quotas, resources.MustParse(testGroupScope+"/providers/Applications.Core/containers/first"), nil)
+	require.NoError(t, err)
+	require.Empty(t, message)
+	require.Equal(t, 4, s.usage(usageID).Count)
+
+	// Concurrent creates are serialized by the ETag check of the usage, so they never exceed the quota together. None
+	// of the resources are saved, so the creates that find the quota reached count the reserved resources.
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	reserved, exceeded := 0, 0
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			id := resources.MustParse(fmt.Sprintf("%s/providers/Applications.Core/containers/c%d", testGroupScope, i))
+			message, err := ReserveQuota(ctx, provider, quotas, id, nil)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				require.True(t, strings.Contains(err.Error(), "concurrent updates"), err.Error())
+			} else if message == "" {
+				reserved++
+			} else {
+				exceeded++
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	require.LessOrEqual(t, reserved, max-4)
+	require.Equal(t, 4+reserved, s.usage(usageID).Count)
+	if reserved+exceeded == 20 {
+		require.Equal(t, max-4, reserved)
+	}
+}
+
+func Test_ReserveQuota_SoftDeleted(t *testing.T) {
+	deleted := func(id string, properties map[string]any) store.Object {
+		obj := newQuotaObject(id, properties)
+		obj.Data.(map[string]any)["deletedTime"] = time.Now().UTC().Format(time.RFC3339Nano)
+		return obj
+	}
+
+	// Soft-deleted resources are kept until they are purged, but they don't count towards quotas.
+	objects := append([]store.Object{
+		deleted(testGroupScope+"/providers/Applications.Core/containers/deleted", map[string]any{"environment": testEnvironmentID}),
+		deleted(testGroupScope+"/providers/Applications.Core/applications/deleted", map[string]any{"environment": testEnvironmentID}),
+	}, quotaObjects...)
+
+	t.Run("resource group", func(t *testing.T) {
+		provider := newQuotaStore(t, false, objects...).provider()
+		message, err := ReserveQuota(context.Background(), provider, &ratelimit.QuotaOptions{MaxResourcesPerResourceGroup: 4}, resources.MustParse(testGroupScope+"/providers/Applications.Core/containers/new"), nil)
+		require.NoError(t, err)
+		require.Empty(t, message)
+	})
+
+	t.Run("environment", func(t *testing.T) {
+		provider := newQuotaStore(t, false, objects...).provider()
+		message, err := ReserveQuota(context.Background(), provider, &ratelimit.QuotaOptions{MaxResourcesPerEnvironment: 4}, resources.MustParse(testOtherGroupScope+"/providers/Applications.Core/containers/new"), &rpv1.BasicResourceProperties{Environment: testEnvironmentID})
+		require.NoError(t, err)
+		require.Empty(t, message)
+	})
+}
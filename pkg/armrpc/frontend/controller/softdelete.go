@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
+	"github.com/radius-project/radius/pkg/rp/environments"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+// deletedParent returns the ID of the soft-deleted resource group or environment that contains the resource with the
+// given ID, or an empty string if neither is soft deleted. properties is the application and environment of the
+// resource and may be nil. Parents that are not stored are treated as not deleted.
+func deletedParent(ctx context.Context, client store.StorageClient, id resources.ID, properties *rpv1.BasicResourceProperties) (string, error) {
+	parents := []string{}
+	if id.IsResource() && id.FindScope(resources_radius.ScopeResourceGroups) != "" {
+		parents = append(parents, id.RootScope())
+	}
+
+	if properties != nil && !strings.EqualFold(id.Type(), environments.ResourceType) {
+		environmentID, err := environmentOf(ctx, client, properties)
+		if err != nil {
+			return "", err
+		} else if environmentID != "" {
+			parents = append(parents, environmentID)
+		}
+	}
+
+	for _, parent := range parents {
+		obj, err := client.Get(ctx, parent)
+		if errors.Is(err, &store.ErrNotFound{}) {
+			continue
+		} else if err != nil {
+			return "", err
+		}
+
+		if softdelete.IsDeleted(obj) {
+			return parent, nil
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+func Test_DeletedParent(t *testing.T) {
+	deleted := map[string]any{"deletedTime": time.Now().UTC().Format(time.RFC3339Nano)}
+
+	tests := []struct {
+		name       string
+		objects    map[string]map[string]any
+		id         string
+		properties *rpv1.BasicResourceProperties
+		expected   string
+	}{
+		{
+			name:       "parents not deleted",
+			objects:    map[string]map[string]any{testGroupScope: {}, testEnvironmentID: {}},
+			id:         testContainerID,
+			properties: &rpv1.BasicResourceProperties{Environment: testEnvironmentID},
+		},
+		{
+			name:    "parents not stored",
+			objects: map[string]map[string]any{},
+			id:      testContainerID,
+		},
+		{
+			name:     "resource group deleted",
+			objects:  map[string]map[string]any{testGroupScope: deleted},
+			id:       testContainerID,
+			expected: testGroupScope,
+		},
+		{
+			name:       "environment deleted",
+			objects:    map[string]map[string]any{testGroupScope: {}, testEnvironmentID: deleted},
+			id:         testContainerID,
+			properties: &rpv1.BasicResourceProperties{Environment: testEnvironmentID},
+			expected:   testEnvironmentID,
+		},
+		{
+			name: "environment of application deleted",
+			objects: map[string]map[string]any{
+				testGroupScope:    {},
+				testApplicationID: {"properties": map[string]any{"environment": testEnvironmentID}},
+				testEnvironmentID: deleted,
+			},
+			id:         testContainerID,
+			properties: &rpv1.BasicResourceProperties{Application: testApplicationID},
+			expected:   testEnvironmentID,
+		},
+		{
+			name:    "resource group is not its own parent",
+			objects: map[string]map[string]any{testGroupScope: deleted},
+			id:      testGroupScope,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			client := store.NewMockStorageClient(mctrl)
+			client.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+				for objectID, data := range tt.objects {
+					if strings.EqualFold(objectID, id) {
+						return &store.Object{Metadata: store.Metadata{ID: objectID}, Data: data}, nil
+					}
+				}
+				return nil, &store.ErrNotFound{ID: id}
+			}).AnyTimes()
+
+			parent, err := deletedParent(context.Background(), client, resources.MustParse(tt.id), tt.properties)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, parent)
+		})
+	}
+}
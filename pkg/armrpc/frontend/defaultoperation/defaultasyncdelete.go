@@ -23,6 +23,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
 )
 
 // DefaultAsyncDelete is the controller implementation to delete async resource.
@@ -41,7 +42,8 @@ func NewDefaultAsyncDelete[P interface {
 	return &DefaultAsyncDelete[P, T]{ctrl.NewOperation[P](opts, resourceOpts)}, nil
 }
 
-// Run executes asynchronous delete operation by validating the request, executing custom delete filters, and starting async job, and returns an async response.
+// Run executes asynchronous delete operation by validating the request, rejecting it if the resource is locked, executing custom delete filters,
+// and starting async job, and returns an async response.
 func (e *DefaultAsyncDelete[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	old, etag, err := e.GetResource(ctx, serviceCtx.ResourceID)
@@ -57,13 +59,24 @@ func (e *DefaultAsyncDelete[P, T]) Run(ctx context.Context, w http.ResponseWrite
 		return r, err
 	}
 
+	if r, err := e.ValidateLocks(ctx, req, old); r != nil || err != nil {
+		return r, err
+	}
+
 	for _, filter := range e.DeleteFilters() {
 		if resp, err := filter(ctx, old, e.Options()); resp != nil || err != nil {
 			return resp, err
 		}
 	}
 
-	if r, err := e.PrepareAsyncOperation(ctx, old, v1.ProvisioningStateAccepted, e.AsyncOperationTimeout(), &etag); r != nil || err != nil {
+	// The backend keeps soft-deleted resources and only tears down their output resources, so the resource is no
+	// longer marked deleted unless the request is a teardown.
+	if base := P(old).GetBaseResource(); !softdelete.IsTeardown(req, base.DeletedTime) {
+		base.DeletedTime = nil
+		base.DeletedBy = ""
+	}
+
+	if r, err := e.PrepareAsyncOperation(ctx, old, v1.ProvisioningStateAccepted, e.AsyncOperationTimeout(), &etag, ""); r != nil || err != nil {
 		return r, err
 	}
 
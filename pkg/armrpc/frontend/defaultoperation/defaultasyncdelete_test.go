@@ -29,6 +29,7 @@ import (
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
 	"github.com/radius-project/radius/pkg/ucp/store"
 
 	"github.com/golang/mock/gomock"
@@ -139,3 +140,76 @@ func TestDefaultAsyncDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultAsyncDelete_SoftDeleted(t *testing.T) {
+	cases := []struct {
+		desc     string
+		header   string
+		teardown bool
+	}{
+		{"teardown-keeps-resource-deleted", "true", true},
+		{"delete-removes-deletion-time", "false", false},
+		{"delete-without-header-removes-deletion-time", "", false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.desc, func(t *testing.T) {
+			teardownTest, mds, msm := setupTest(t)
+			defer teardownTest(t)
+
+			w := httptest.NewRecorder()
+
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodDelete, resourceTestHeaderFile, nil)
+			require.NoError(t, err)
+			if tt.header != "" {
+				req.Header.Set(softdelete.HeaderSoftDelete, tt.header)
+			}
+
+			ctx := rpctest.NewARMRequestContext(req)
+			_, appDataModel, _ := loadTestResurce()
+			deletedTime := time.Now().UTC()
+			appDataModel.DeletedTime = &deletedTime
+			appDataModel.InternalMetadata.AsyncProvisioningState = v1.ProvisioningStateSucceeded
+
+			mds.EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				Return(&store.Object{Metadata: store.Metadata{ID: appDataModel.ID}, Data: appDataModel}, nil)
+
+			msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+			var saved *TestResourceDataModel
+			mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+					saved = obj.Data.(*TestResourceDataModel)
+					return nil
+				})
+
+			opts := ctrl.Options{
+				StorageClient: mds,
+				StatusManager: msm,
+			}
+
+			resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+				RequestConverter:  testResourceDataModelFromVersioned,
+				ResponseConverter: testResourceDataModelToVersioned,
+			}
+
+			ctl, err := NewDefaultAsyncDelete(opts, resourceOpts)
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+
+			err = resp.Apply(ctx, w, req)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusAccepted, w.Result().StatusCode)
+
+			require.NotNil(t, saved)
+			if tt.teardown {
+				require.NotNil(t, saved.DeletedTime)
+			} else {
+				require.Nil(t, saved.DeletedTime)
+			}
+		})
+	}
+}
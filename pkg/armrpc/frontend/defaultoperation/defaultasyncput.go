@@ -42,7 +42,8 @@ func NewDefaultAsyncPut[P interface {
 }
 
 // Run executes asynchronous create or update operation by validating new resource metadata, ensuring if it is new resource
-// or updated resource, running custom update filters, and queuing async operation and returns an async response.
+// or updated resource, rejecting the request if the resource is locked or a new resource exceeds a quota, running custom update filters,
+// queuing async operation which publishes a resource event when it succeeds, and returns an async response.
 func (e *DefaultAsyncPut[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	newResource, err := e.GetResourceFromRequest(ctx, req)
@@ -59,13 +60,21 @@ func (e *DefaultAsyncPut[P, T]) Run(ctx context.Context, w http.ResponseWriter,
 		return r, err
 	}
 
+	if r, err := e.ValidateLocks(ctx, req, newResource, old); r != nil || err != nil {
+		return r, err
+	}
+
+	if r, err := e.ValidateQuota(ctx, newResource, old); r != nil || err != nil {
+		return r, err
+	}
+
 	for _, filter := range e.UpdateFilters() {
 		if resp, err := filter(ctx, newResource, old, e.Options()); resp != nil || err != nil {
 			return resp, err
 		}
 	}
 
-	if r, err := e.PrepareAsyncOperation(ctx, newResource, v1.ProvisioningStateAccepted, e.AsyncOperationTimeout(), &etag); r != nil || err != nil {
+	if r, err := e.PrepareAsyncOperation(ctx, newResource, v1.ProvisioningStateAccepted, e.AsyncOperationTimeout(), &etag, ctrl.ResourceEventType(old)); r != nil || err != nil {
 		return r, err
 	}
 
@@ -27,6 +27,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/events"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/ucp/store"
@@ -105,8 +106,9 @@ func TestDefaultAsyncPut_Create(t *testing.T) {
 
 				if tt.saveErr == nil {
 					expectedOptions := statusmanager.QueueOperationOptions{
-						OperationTimeout: asyncOperationTimeout,
-						RetryAfter:       asyncOperationRetryAfter,
+						OperationTimeout:  asyncOperationTimeout,
+						RetryAfter:        asyncOperationRetryAfter,
+						ResourceEventType: events.EventTypeResourceCreated,
 					}
 					msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), expectedOptions).
 						Return(tt.qErr).
@@ -22,8 +22,10 @@ import (
 	"net/http"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/events"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
 	"github.com/radius-project/radius/pkg/ucp/store"
 )
 
@@ -43,8 +45,9 @@ func NewDefaultSyncDelete[P interface {
 	return &DefaultSyncDelete[P, T]{ctrl.NewOperation[P](opts, resourceOpts)}, nil
 }
 
-// Run executes synchronous deletion operation. It retrieves the resource from the store, runs custom delete filters,
-// and then deletes the resource from the data store. If the resource is not found, a No Content response is returned.
+// Run executes synchronous deletion operation. It retrieves the resource from the store, rejects the request if the resource
+// is locked, runs custom delete filters, and then deletes the resource from the data store. If the resource is not found,
+// a No Content response is returned.
 // If an error occurs during the delete, an error is returned.
 func (e *DefaultSyncDelete[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
@@ -58,10 +61,19 @@ func (e *DefaultSyncDelete[P, T]) Run(ctx context.Context, w http.ResponseWriter
 		return rest.NewNoContentResponse(), nil
 	}
 
+	// Resources that are deleted synchronously are not torn down, a soft-deleted resource is kept as is until it is purged.
+	if softdelete.IsTeardown(req, P(old).GetBaseResource().DeletedTime) {
+		return rest.NewOKResponse(nil), nil
+	}
+
 	if r, err := e.PrepareResource(ctx, req, nil, old, etag); r != nil || err != nil {
 		return r, err
 	}
 
+	if r, err := e.ValidateLocks(ctx, req, old); r != nil || err != nil {
+		return r, err
+	}
+
 	for _, filter := range e.DeleteFilters() {
 		if resp, err := filter(ctx, old, e.Options()); resp != nil || err != nil {
 			return resp, err
@@ -75,5 +87,7 @@ func (e *DefaultSyncDelete[P, T]) Run(ctx context.Context, w http.ResponseWriter
 		return nil, err
 	}
 
+	e.PublishResourceEvent(ctx, events.EventTypeResourceDeleted, nil)
+
 	return rest.NewOKResponse(nil), nil
 }
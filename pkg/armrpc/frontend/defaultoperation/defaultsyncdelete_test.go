@@ -22,10 +22,16 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/events"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/store"
 
 	"github.com/golang/mock/gomock"
@@ -77,9 +83,11 @@ func TestDefaultSyncDelete(t *testing.T) {
 					Times(1)
 			}
 
+			publisher := &testPublisher{}
 			opts := ctrl.Options{
-				StorageClient: mds,
-				StatusManager: msm,
+				StorageClient:  mds,
+				StatusManager:  msm,
+				EventPublisher: publisher,
 			}
 
 			resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
@@ -107,6 +115,14 @@ func TestDefaultSyncDelete(t *testing.T) {
 			result := w.Result()
 			require.Equal(t, tt.code, result.StatusCode)
 
+			if tt.code == http.StatusOK {
+				require.Len(t, publisher.events, 1)
+				require.Equal(t, events.EventTypeResourceDeleted, publisher.events[0].Type)
+				require.Equal(t, v1.ARMRequestContextFromContext(ctx).ResourceID.String(), publisher.events[0].Subject)
+			} else {
+				require.Empty(t, publisher.events)
+			}
+
 			// If happy path, expect that the returned object has Accepted state
 			if tt.code == http.StatusAccepted {
 				actualOutput := &TestResource{}
@@ -115,3 +131,100 @@ func TestDefaultSyncDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultSyncDelete_Locked(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	mds := store.NewMockStorageClient(mctrl)
+	lockClient := store.NewMockStorageClient(mctrl)
+	provider := dataprovider.NewMockDataStorageProvider(mctrl)
+
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodDelete, "resource_planescope_requestheaders.json", nil)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+	_, appDataModel, _ := loadTestResurce()
+
+	mds.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(&store.Object{
+			Metadata: store.Metadata{ID: appDataModel.ID},
+			Data:     appDataModel,
+		}, nil).
+		Times(1)
+
+	lock := &datamodel.Lock{Properties: datamodel.LockProperties{Level: datamodel.LockLevelCanNotDelete, Scope: "/planes/radius/local"}}
+	lock.ID = "/planes/radius/local/providers/System.Resources/locks/production"
+	provider.EXPECT().GetStorageClient(gomock.Any(), datamodel.LockResourceType).Return(lockClient, nil).Times(1)
+	lockClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: "/planes/radius/local", ResourceType: datamodel.LockResourceType}).
+		Return(&store.ObjectQueryResult{Items: []store.Object{{Metadata: store.Metadata{ID: lock.ID}, Data: lock}}}, nil).
+		Times(1)
+
+	opts := ctrl.Options{
+		StorageClient: mds,
+		DataProvider:  provider,
+	}
+
+	resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+		RequestConverter:  testResourceDataModelFromVersioned,
+		ResponseConverter: testResourceDataModelToVersioned,
+	}
+
+	ctl, err := NewDefaultSyncDelete(opts, resourceOpts)
+	require.NoError(t, err)
+
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+
+	err = resp.Apply(ctx, w, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusConflict, w.Result().StatusCode)
+
+	body := &v1.ErrorResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), body))
+	require.Equal(t, v1.CodeConflict, body.Error.Code)
+	require.Contains(t, body.Error.Message, "locked by /planes/radius/local/providers/System.Resources/locks/production")
+}
+
+func TestDefaultSyncDelete_Teardown(t *testing.T) {
+	teardownTest, mds, msm := setupTest(t)
+	defer teardownTest(t)
+
+	w := httptest.NewRecorder()
+
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodDelete, resourceTestHeaderFile, nil)
+	require.NoError(t, err)
+	req.Header.Set(softdelete.HeaderSoftDelete, "true")
+
+	ctx := rpctest.NewARMRequestContext(req)
+	_, appDataModel, _ := loadTestResurce()
+	deletedTime := time.Now().UTC()
+	appDataModel.DeletedTime = &deletedTime
+
+	mds.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(&store.Object{Metadata: store.Metadata{ID: appDataModel.ID}, Data: appDataModel}, nil)
+
+	// The soft-deleted resource is kept until it is purged.
+	mds.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(0)
+
+	opts := ctrl.Options{
+		StorageClient: mds,
+		StatusManager: msm,
+	}
+
+	resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+		RequestConverter:  testResourceDataModelFromVersioned,
+		ResponseConverter: testResourceDataModelToVersioned,
+	}
+
+	ctl, err := NewDefaultSyncDelete(opts, resourceOpts)
+	require.NoError(t, err)
+
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+
+	err = resp.Apply(ctx, w, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+}
@@ -42,7 +42,8 @@ func NewDefaultSyncPut[P interface {
 }
 
 // Run executes synchronous create or update operation by validating new resource metadata, ensuring if it is new resource or updated resource,
-// running custom update filters, and upserting resource metadata and returns an resource as a response.
+// rejecting the request if the resource is locked or a new resource exceeds a quota, running custom update filters, upserting resource metadata
+// and publishing a resource event, and returns an resource as a response.
 func (e *DefaultSyncPut[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	newResource, err := e.GetResourceFromRequest(ctx, req)
@@ -58,6 +59,14 @@ func (e *DefaultSyncPut[P, T]) Run(ctx context.Context, w http.ResponseWriter, r
 		return r, err
 	}
 
+	if r, err := e.ValidateLocks(ctx, req, newResource, old); r != nil || err != nil {
+		return r, err
+	}
+
+	if r, err := e.ValidateQuota(ctx, newResource, old); r != nil || err != nil {
+		return r, err
+	}
+
 	for _, filter := range e.UpdateFilters() {
 		if resp, err := filter(ctx, newResource, old, e.Options()); resp != nil || err != nil {
 			return resp, err
@@ -70,5 +79,7 @@ func (e *DefaultSyncPut[P, T]) Run(ctx context.Context, w http.ResponseWriter, r
 		return nil, err
 	}
 
+	e.PublishResourceEvent(ctx, ctrl.ResourceEventType(old), newResource)
+
 	return e.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
 }
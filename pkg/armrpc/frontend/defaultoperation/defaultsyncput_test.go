@@ -25,8 +25,12 @@ import (
 	"testing"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/events"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/test/testutil"
 
@@ -81,9 +85,11 @@ func TestDefaultSyncPut_Create(t *testing.T) {
 					Times(1)
 			}
 
+			publisher := &testPublisher{}
 			opts := ctrl.Options{
-				StorageClient: mds,
-				StatusManager: msm,
+				StorageClient:  mds,
+				StatusManager:  msm,
+				EventPublisher: publisher,
 			}
 
 			resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
@@ -106,6 +112,10 @@ func TestDefaultSyncPut_Create(t *testing.T) {
 
 				_ = resp.Apply(ctx, w, req)
 				require.Equal(t, tt.rCode, w.Result().StatusCode)
+
+				require.Len(t, publisher.events, 1)
+				require.Equal(t, events.EventTypeResourceCreated, publisher.events[0].Type)
+				require.Equal(t, v1.ProvisioningStateSucceeded, publisher.events[0].Data.ProvisioningState)
 			}
 		})
 	}
@@ -239,3 +249,59 @@ func TestDefaultSyncPut_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultSyncPut_QuotaExceeded(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	mds := store.NewMockStorageClient(mctrl)
+	provider := dataprovider.NewMockDataStorageProvider(mctrl)
+
+	reqModel, _, _ := loadTestResurce()
+
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPut, "resource_resourcegroup_requestheaders.json", reqModel)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	// The resource and the quota usage of the resource group are not found.
+	mds.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, &store.ErrNotFound{}).Times(2)
+
+	existingID := "/planes/radius/local/resourceGroups/rg1/providers/Applications.Core/environments/existing"
+	provider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(mds, nil).AnyTimes()
+	mds.EXPECT().
+		Query(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+			if query.ResourceType == datamodel.LockResourceType {
+				return &store.ObjectQueryResult{}, nil
+			}
+
+			require.Equal(t, "/planes/radius/local/resourceGroups/rg1", query.RootScope)
+			return &store.ObjectQueryResult{Items: []store.Object{{Metadata: store.Metadata{ID: existingID}, Data: map[string]any{"id": existingID}}}}, nil
+		}).
+		AnyTimes()
+
+	opts := ctrl.Options{
+		StorageClient: mds,
+		DataProvider:  provider,
+		Quotas:        &ratelimit.QuotaOptions{MaxResourcesPerResourceGroup: 1},
+	}
+
+	resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+		RequestConverter:  testResourceDataModelFromVersioned,
+		ResponseConverter: testResourceDataModelToVersioned,
+	}
+
+	ctl, err := NewDefaultSyncPut(opts, resourceOpts)
+	require.NoError(t, err)
+
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+
+	err = resp.Apply(ctx, w, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusConflict, w.Result().StatusCode)
+
+	body := &v1.ErrorResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), body))
+	require.Equal(t, v1.CodeQuotaExceeded, body.Error.Code)
+	require.Contains(t, body.Error.Message, "has reached its quota of 1 resources")
+}
@@ -23,6 +23,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
 	"github.com/radius-project/radius/pkg/ucp/store"
 )
 
@@ -43,8 +44,10 @@ func NewListResources[P interface {
 	return &ListResources[P, T]{ctrl.NewOperation[P](opts, ctrlOpts), ctrlOpts.ListRecursiveQuery}, nil
 }
 
-// Run queries the resource data store with a given type and scope and returns the paginated resource list. An internal error
-// is returned if the query fails.
+// Run queries the resource data store with a given type and scope and returns the paginated resource list. Soft-deleted
+// resources are omitted unless the request includes them, and the resources are filtered by the tag given in the request
+// if any. A BadRequest response is returned if the request has a tag value without a tag name, and an internal error is
+// returned if the query fails.
 func (e *ListResources[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
@@ -54,6 +57,12 @@ func (e *ListResources[P, T]) Run(ctx context.Context, w http.ResponseWriter, re
 		ScopeRecursive: e.listRecursiveQuery,
 	}
 
+	if serviceCtx.TagName == "" && serviceCtx.TagValue != "" {
+		return rest.NewBadRequestResponse(v1.ErrTagValueWithoutTagName.Error()), nil
+	} else if serviceCtx.TagName != "" {
+		query.TagFilters = []store.TagFilter{{Name: serviceCtx.TagName, Value: serviceCtx.TagValue}}
+	}
+
 	result, err := e.StorageClient().Query(ctx, query, store.WithPaginationToken(serviceCtx.SkipToken), store.WithMaxQueryItemCount(serviceCtx.Top))
 	if err != nil {
 		return nil, err
@@ -68,7 +77,7 @@ func (e *ListResources[P, T]) createPaginationResponse(ctx context.Context, req
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
 	items := []any{}
-	for _, item := range result.Items {
+	for _, item := range softdelete.Filter(req, result.Items) {
 		resource := new(T)
 		if err := item.As(resource); err != nil {
 			return nil, err
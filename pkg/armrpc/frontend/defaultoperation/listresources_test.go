@@ -184,3 +184,137 @@ func TestListResourcesRun(t *testing.T) {
 		})
 	}
 }
+
+func TestListResourcesRun_SoftDeleted(t *testing.T) {
+	cases := []struct {
+		desc           string
+		includeDeleted string
+		expected       []string
+	}{
+		{"omits-deleted-resources", "", []string{"active"}},
+		{"includes-deleted-resources", "true", []string{"active", "deleted"}},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.desc, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			mStorageClient := store.NewMockStorageClient(mctrl)
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodGet, resourceTestHeaderFile, nil)
+			require.NoError(t, err)
+			if tt.includeDeleted != "" {
+				q := req.URL.Query()
+				q.Add("includeDeleted", tt.includeDeleted)
+				req.URL.RawQuery = q.Encode()
+			}
+			ctx := rpctest.NewARMRequestContext(req)
+
+			mStorageClient.
+				EXPECT().
+				Query(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&store.ObjectQueryResult{
+					Items: []store.Object{
+						{Metadata: store.Metadata{ID: "active"}, Data: map[string]any{"name": "active"}},
+						{Metadata: store.Metadata{ID: "deleted"}, Data: map[string]any{"name": "deleted", "deletedTime": "2023-10-01T00:00:00Z"}},
+					},
+				}, nil)
+
+			ctl, err := NewListResources(ctrl.Options{StorageClient: mStorageClient}, ctrl.ResourceOptions[testDataModel]{
+				ResponseConverter: resourceToVersioned,
+			})
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+			actualOutput := &testResourceList{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), actualOutput))
+			names := []string{}
+			for _, item := range actualOutput.Value {
+				names = append(names, item.Name)
+			}
+			require.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestListResourcesRun_TagFilter(t *testing.T) {
+	cases := []struct {
+		desc       string
+		tagName    string
+		tagValue   string
+		tagFilters []store.TagFilter
+	}{
+		{"no-tag-filter", "", "", nil},
+		{"tag-name-filter", "team", "", []store.TagFilter{{Name: "team"}}},
+		{"tag-name-and-value-filter", "team", "payments", []store.TagFilter{{Name: "team", Value: "payments"}}},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.desc, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			mStorageClient := store.NewMockStorageClient(mctrl)
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodGet, resourceTestHeaderFile, nil)
+			require.NoError(t, err)
+			q := req.URL.Query()
+			if tt.tagName != "" {
+				q.Add(v1.TagNameParameterName, tt.tagName)
+			}
+			if tt.tagValue != "" {
+				q.Add(v1.TagValueParameterName, tt.tagValue)
+			}
+			req.URL.RawQuery = q.Encode()
+			ctx := rpctest.NewARMRequestContext(req)
+			serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+			expectedQuery := store.Query{
+				RootScope:    serviceCtx.ResourceID.RootScope(),
+				ResourceType: serviceCtx.ResourceID.Type(),
+				TagFilters:   tt.tagFilters,
+			}
+			mStorageClient.
+				EXPECT().
+				Query(gomock.Any(), expectedQuery, gomock.Any()).
+				Return(&store.ObjectQueryResult{}, nil)
+
+			ctl, err := NewListResources(ctrl.Options{StorageClient: mStorageClient}, ctrl.ResourceOptions[testDataModel]{
+				ResponseConverter: resourceToVersioned,
+			})
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, http.StatusOK, w.Result().StatusCode)
+		})
+	}
+}
+
+func TestListResourcesRun_TagValueWithoutTagName(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	mStorageClient := store.NewMockStorageClient(mctrl)
+
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodGet, resourceTestHeaderFile, nil)
+	require.NoError(t, err)
+	q := req.URL.Query()
+	q.Add(v1.TagValueParameterName, "payments")
+	req.URL.RawQuery = q.Encode()
+	ctx := rpctest.NewARMRequestContext(req)
+
+	ctl, err := NewListResources(ctrl.Options{StorageClient: mStorageClient}, ctrl.ResourceOptions[testDataModel]{
+		ResponseConverter: resourceToVersioned,
+	})
+	require.NoError(t, err)
+
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	require.Contains(t, w.Body.String(), v1.ErrTagValueWithoutTagName.Error())
+}
@@ -26,6 +26,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/events"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/to"
@@ -241,3 +242,11 @@ func getAsyncLocationPath(sCtx *v1.ARMRequestContext, location string, resourceT
 
 	return dest.String()
 }
+
+type testPublisher struct {
+	events []*events.Event
+}
+
+func (p *testPublisher) Publish(ctx context.Context, event *events.Event) {
+	p.events = append(p.events, event)
+}
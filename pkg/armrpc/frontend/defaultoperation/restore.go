@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
+)
+
+// Restore is the controller implementation to restore a soft-deleted resource group or environment.
+type Restore[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any] struct {
+	ctrl.Operation[P, T]
+	children softdelete.ChildrenFunc
+}
+
+// NewRestore creates a new Restore. The children function returns the resources that are deleted and restored with
+// the resource.
+func NewRestore[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any](opts ctrl.Options, resourceOpts ctrl.ResourceOptions[T], children softdelete.ChildrenFunc) (ctrl.Controller, error) {
+	return &Restore[P, T]{ctrl.NewOperation[P](opts, resourceOpts), children}, nil
+}
+
+// Run restores the resource and the children that were deleted with it. Children that were deleted before the
+// resource stay deleted. A Conflict response is returned if the resource is not deleted.
+func (e *Restore[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	old, etag, err := e.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if old == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	if r, err := e.PrepareResource(ctx, req, nil, old, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	if P(old).GetBaseResource().DeletedTime == nil {
+		return rest.NewConflictResponse("the resource " + serviceCtx.ResourceID.String() + " is not deleted"), nil
+	}
+
+	children, err := e.children(ctx, e.StorageClient(), serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := softdelete.Restore(ctx, e.StorageClient(), children, serviceCtx.ResourceID.String()); err != nil {
+		return nil, err
+	}
+
+	P(old).GetBaseResource().DeletedTime = nil
+	newEtag, err := e.SaveResource(ctx, serviceCtx.ResourceID.String(), old, etag)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.ConstructSyncResponse(ctx, req.Method, newEtag, old)
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
+	"github.com/radius-project/radius/pkg/ucp/store"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestore(t *testing.T) {
+	deletedTime := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	earlierTime := deletedTime.Add(-time.Hour)
+
+	restoreCases := []struct {
+		desc     string
+		notFound bool
+		deleted  bool
+		code     int
+	}{
+		{"restore-deleted-resource", false, true, http.StatusOK},
+		{"restore-non-existing-resource", true, false, http.StatusNotFound},
+		{"restore-resource-not-deleted", false, false, http.StatusConflict},
+	}
+
+	for _, tt := range restoreCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			teardownTest, mds, msm := setupTest(t)
+			defer teardownTest(t)
+
+			w := httptest.NewRecorder()
+
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPost, resourceTestHeaderFile, nil)
+			require.NoError(t, err)
+
+			ctx := rpctest.NewARMRequestContext(req)
+			_, appDataModel, _ := loadTestResurce()
+			if tt.deleted {
+				appDataModel.DeletedTime = &deletedTime
+			}
+
+			var getErr error
+			if tt.notFound {
+				getErr = &store.ErrNotFound{}
+			}
+			mds.EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				Return(&store.Object{
+					Metadata: store.Metadata{ID: appDataModel.ID},
+					Data:     appDataModel,
+				}, getErr).
+				Times(1)
+
+			var savedResource *TestResourceDataModel
+			saved := map[string]*store.Object{}
+			if tt.code == http.StatusOK {
+				mds.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+						if resource, ok := obj.Data.(*TestResourceDataModel); ok {
+							savedResource = resource
+						} else {
+							saved[obj.ID] = obj
+						}
+						return nil
+					}).
+					Times(2)
+			}
+
+			opts := ctrl.Options{
+				StorageClient: mds,
+				StatusManager: msm,
+				SoftDelete:    &softdelete.Options{Enabled: true},
+			}
+
+			resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+				RequestConverter:  testResourceDataModelFromVersioned,
+				ResponseConverter: testResourceDataModelToVersioned,
+			}
+
+			// The first child was deleted with the resource by an earlier attempt that failed, the second was deleted
+			// before it.
+			restoredID := testChildID
+			keptID := testChildID + "-kept"
+			resourceID := v1.ARMRequestContextFromContext(ctx).ResourceID.String()
+			ctl, err := NewRestore(opts, resourceOpts, testChildren(
+				store.Object{
+					Metadata: store.Metadata{ID: restoredID},
+					Data:     map[string]any{"id": restoredID, "deletedTime": earlierTime.Format(time.RFC3339Nano), "deletedBy": resourceID},
+				},
+				store.Object{
+					Metadata: store.Metadata{ID: keptID},
+					Data:     map[string]any{"id": keptID, "deletedTime": earlierTime.Format(time.RFC3339Nano)},
+				},
+			))
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+
+			err = resp.Apply(ctx, w, req)
+			require.NoError(t, err)
+
+			result := w.Result()
+			require.Equal(t, tt.code, result.StatusCode)
+
+			if tt.code == http.StatusOK {
+				require.NotNil(t, savedResource)
+				require.Nil(t, savedResource.DeletedTime)
+
+				require.False(t, softdelete.IsDeleted(saved[restoredID]))
+				require.NotContains(t, saved, keptID)
+			}
+		})
+	}
+}
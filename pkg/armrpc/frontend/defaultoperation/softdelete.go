@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/events"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// SoftDelete is the controller implementation to delete a resource group or environment synchronously. When soft delete
+// is enabled, the resource and its children are marked deleted instead of being removed from the store, and they are
+// purged by a background job once the retention period has passed.
+type SoftDelete[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any] struct {
+	ctrl.Operation[P, T]
+	hardDelete *DefaultSyncDelete[P, T]
+	children   softdelete.ChildrenFunc
+	deleter    softdelete.Deleter
+}
+
+// NewSoftDelete creates a new SoftDelete. The children function returns the resources that are deleted and restored
+// with the resource, and the deleter tears down their output resources unless the teardown is deferred until purge.
+func NewSoftDelete[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any](opts ctrl.Options, resourceOpts ctrl.ResourceOptions[T], children softdelete.ChildrenFunc, deleter softdelete.Deleter) (ctrl.Controller, error) {
+	return &SoftDelete[P, T]{
+		Operation:  ctrl.NewOperation[P](opts, resourceOpts),
+		hardDelete: &DefaultSyncDelete[P, T]{ctrl.NewOperation[P](opts, resourceOpts)},
+		children:   children,
+		deleter:    deleter,
+	}, nil
+}
+
+// Run deletes the resource permanently if soft delete is disabled or if the request disables it with the
+// Radius-Soft-Delete header. Otherwise it marks the children of the resource and then the resource itself deleted, and
+// tears down the output resources of the children unless the teardown is deferred until purge. A request that requires
+// soft delete while it is disabled is rejected with a Bad Request response.
+func (e *SoftDelete[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	enabled := e.Options().SoftDelete.IsEnabled()
+	if header := req.Header.Get(softdelete.HeaderSoftDelete); header != "" {
+		required, err := strconv.ParseBool(header)
+		if err != nil {
+			return rest.NewBadRequestResponse("the value of the " + softdelete.HeaderSoftDelete + " header must be true or false"), nil
+		}
+
+		if required && !enabled {
+			return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+				Error: v1.ErrorDetails{
+					Code:    v1.CodeSoftDeleteNotEnabled,
+					Message: "soft delete is not enabled",
+					Target:  serviceCtx.ResourceID.String(),
+				},
+			}), nil
+		}
+		enabled = required
+	}
+
+	if !enabled {
+		return e.hardDelete.Run(ctx, w, req)
+	}
+
+	old, etag, err := e.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if old == nil || P(old).GetBaseResource().DeletedTime != nil {
+		return rest.NewNoContentResponse(), nil
+	}
+
+	if r, err := e.PrepareResource(ctx, req, nil, old, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	if r, err := e.ValidateLocks(ctx, req, old); r != nil || err != nil {
+		return r, err
+	}
+
+	for _, filter := range e.DeleteFilters() {
+		if resp, err := filter(ctx, old, e.Options()); resp != nil || err != nil {
+			return resp, err
+		}
+	}
+
+	children, err := e.children(ctx, e.StorageClient(), serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The children record the resource that deleted them, so that the deletion can be retried and the children
+	// marked by a failed attempt are restored with the resource.
+	deletedTime := time.Now().UTC()
+	if err := softdelete.MarkDeleted(ctx, e.StorageClient(), children, serviceCtx.ResourceID.String(), deletedTime); err != nil {
+		return nil, err
+	}
+
+	P(old).GetBaseResource().DeletedTime = &deletedTime
+	if _, err := e.SaveResource(ctx, serviceCtx.ResourceID.String(), old, etag); err != nil {
+		return nil, err
+	}
+
+	e.PublishResourceEvent(ctx, events.EventTypeResourceDeleted, nil)
+
+	// The resource is already deleted at this point, output resources that can't be torn down now are deleted when
+	// the resource is purged.
+	if e.Options().SoftDelete.TeardownOnDelete() && e.deleter != nil {
+		if err := softdelete.Teardown(ctx, e.deleter, children); err != nil {
+			ucplog.FromContextOrDiscard(ctx).Error(err, "failed to tear down the output resources of soft-deleted resources", "resourceId", serviceCtx.ResourceID.String())
+		}
+	}
+
+	return rest.NewOKResponseWithHeaders(nil, map[string]string{softdelete.HeaderSoftDelete: "true"}), nil
+}
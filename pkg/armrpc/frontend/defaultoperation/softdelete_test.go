@@ -0,0 +1,206 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/events"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const testChildID = "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/containers/test-container"
+
+// testChildren returns a ChildrenFunc that returns the given children.
+func testChildren(children ...store.Object) softdelete.ChildrenFunc {
+	return func(ctx context.Context, client store.StorageClient, id resources.ID) ([]store.Object, error) {
+		return children, nil
+	}
+}
+
+// testDeleter records the resources torn down by the controller.
+type testDeleter struct {
+	teardowns []string
+}
+
+func (d *testDeleter) Delete(ctx context.Context, id string, apiVersion string) error {
+	return nil
+}
+
+func (d *testDeleter) Teardown(ctx context.Context, id string, apiVersion string) error {
+	d.teardowns = append(d.teardowns, id)
+	return nil
+}
+
+func TestSoftDelete(t *testing.T) {
+	deleteCases := []struct {
+		desc       string
+		enabled    bool
+		header     string
+		deleted    bool
+		hardDelete bool
+		softDelete bool
+		code       int
+		errorCode  string
+		deferred   bool
+	}{
+		{"disabled-deletes-permanently", false, "", false, true, false, http.StatusOK, "", false},
+		{"header-false-deletes-permanently", true, "false", false, true, false, http.StatusOK, "", false},
+		{"header-true-requires-soft-delete", false, "true", false, false, false, http.StatusBadRequest, v1.CodeSoftDeleteNotEnabled, false},
+		{"invalid-header", true, "maybe", false, false, false, http.StatusBadRequest, v1.CodeInvalid, false},
+		{"enabled-soft-deletes", true, "", false, false, true, http.StatusOK, "", false},
+		{"header-true-soft-deletes", true, "true", false, false, true, http.StatusOK, "", false},
+		{"already-deleted", true, "", true, false, false, http.StatusNoContent, "", false},
+		{"enabled-defers-teardown", true, "", false, false, true, http.StatusOK, "", true},
+	}
+
+	for _, tt := range deleteCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			teardownTest, mds, msm := setupTest(t)
+			defer teardownTest(t)
+
+			w := httptest.NewRecorder()
+
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodDelete, resourceTestHeaderFile, nil)
+			require.NoError(t, err)
+			if tt.header != "" {
+				req.Header.Set(softdelete.HeaderSoftDelete, tt.header)
+			}
+
+			ctx := rpctest.NewARMRequestContext(req)
+			_, appDataModel, _ := loadTestResurce()
+			if tt.deleted {
+				deletedTime := time.Now().UTC()
+				appDataModel.DeletedTime = &deletedTime
+			}
+
+			if tt.hardDelete || tt.softDelete || tt.deleted {
+				mds.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(&store.Object{
+						Metadata: store.Metadata{ID: appDataModel.ID},
+						Data:     appDataModel,
+					}, nil).
+					Times(1)
+			}
+
+			if tt.hardDelete {
+				mds.EXPECT().
+					Delete(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			}
+
+			var savedResource *TestResourceDataModel
+			saved := map[string]*store.Object{}
+			if tt.softDelete {
+				mds.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+						if resource, ok := obj.Data.(*TestResourceDataModel); ok {
+							savedResource = resource
+						} else {
+							saved[obj.ID] = obj
+						}
+						return nil
+					}).
+					Times(2)
+			}
+
+			publisher := &testPublisher{}
+			opts := ctrl.Options{
+				StorageClient:  mds,
+				StatusManager:  msm,
+				EventPublisher: publisher,
+				SoftDelete:     &softdelete.Options{Enabled: tt.enabled, DeferTeardown: tt.deferred},
+			}
+
+			resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+				RequestConverter:  testResourceDataModelFromVersioned,
+				ResponseConverter: testResourceDataModelToVersioned,
+			}
+
+			child := store.Object{
+				Metadata: store.Metadata{ID: testChildID},
+				Data:     map[string]any{"id": testChildID, "updatedApiVersion": "2023-10-01-preview"},
+			}
+			deleter := &testDeleter{}
+			ctl, err := NewSoftDelete(opts, resourceOpts, testChildren(child), deleter)
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+
+			err = resp.Apply(ctx, w, req)
+			require.NoError(t, err)
+
+			result := w.Result()
+			require.Equal(t, tt.code, result.StatusCode)
+
+			if tt.errorCode != "" {
+				actual := v1.ErrorResponse{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &actual))
+				require.Equal(t, tt.errorCode, actual.Error.Code)
+			}
+
+			if tt.code == http.StatusOK {
+				require.Len(t, publisher.events, 1)
+				require.Equal(t, events.EventTypeResourceDeleted, publisher.events[0].Type)
+			} else {
+				require.Empty(t, publisher.events)
+			}
+
+			// The response confirms the soft deletion, so that clients don't clean up the children themselves.
+			if tt.softDelete {
+				require.Equal(t, "true", result.Header.Get(softdelete.HeaderSoftDelete))
+			} else {
+				require.Empty(t, result.Header.Get(softdelete.HeaderSoftDelete))
+			}
+
+			if tt.softDelete {
+				require.NotNil(t, savedResource)
+				require.NotNil(t, savedResource.DeletedTime)
+
+				childTime, err := softdelete.DeletedTime(saved[testChildID])
+				require.NoError(t, err)
+				require.True(t, savedResource.DeletedTime.Equal(*childTime))
+				require.Equal(t, v1.ARMRequestContextFromContext(ctx).ResourceID.String(), saved[testChildID].Data.(map[string]any)["deletedBy"])
+			}
+
+			// The output resources of the children are torn down when they are soft deleted, unless the teardown is
+			// deferred until purge.
+			if tt.softDelete && !tt.deferred {
+				require.Equal(t, []string{testChildID}, deleter.teardowns)
+			} else {
+				require.Empty(t, deleter.teardowns)
+			}
+		})
+	}
+}
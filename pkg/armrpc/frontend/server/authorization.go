@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// NewOIDCAuth creates the bearer token validator and the authorizer configured in the server options. The validator is nil
+// unless AuthType is OIDC, and the authorizer is nil unless role assignments are configured.
+func NewOIDCAuth(options *hostoptions.ServerOptions) (*authentication.OIDCValidator, *authorization.Authorizer, error) {
+	if options == nil || options.AuthType != hostoptions.OIDCAuthType {
+		if options != nil && options.Authorization != nil {
+			return nil, nil, errors.New("authorization requires the OIDC authentication type")
+		}
+		return nil, nil, nil
+	}
+
+	if options.OIDC == nil {
+		return nil, nil, errors.New("oidc options are required for the OIDC authentication type")
+	}
+
+	validator, err := authentication.NewOIDCValidator(*options.OIDC, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if options.Authorization == nil {
+		return validator, nil, nil
+	}
+
+	authorizer, err := authorization.NewAuthorizer(*options.Authorization)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return validator, authorizer, nil
+}
+
+// AuthorizationMiddleware enforces the role assignments of the authorizer for requests to resources, using the principal
+// stored in the request context by authentication. Requests that do not target a plane or subscription, such as the
+// health, version and discovery endpoints, are not authorized.
+func AuthorizationMiddleware(authorizer *authorization.Authorizer, pathBase string) func(http.Handler) http.Handler {
+	pathBase = strings.ToLower(pathBase)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path := r.URL.Path
+			if pathBase != "" && strings.HasPrefix(strings.ToLower(path), pathBase) {
+				path = path[len(pathBase):]
+			}
+
+			if !isResourcePath(path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			principal := authentication.PrincipalFromContext(r.Context())
+			if principal == nil {
+				_ = rest.NewClientAuthenticationFailedARMResponse().Apply(r.Context(), w, r)
+				return
+			}
+
+			if !authorizer.Authorize(principal, r.Method, path) {
+				logger := ucplog.FromContextOrDiscard(r.Context())
+				logger.Info(fmt.Sprintf("principal %q is not authorized to perform %s on %q", principal.Name, r.Method, path))
+
+				message := fmt.Sprintf("The client %q does not have authorization to perform %s on %q.", principal.Name, r.Method, path)
+				_ = rest.NewForbiddenResponse(message).Apply(r.Context(), w, r)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func isResourcePath(path string) bool {
+	path = strings.ToLower(path)
+	return path == "/planes" || strings.HasPrefix(path, "/planes/") ||
+		path == "/subscriptions" || strings.HasPrefix(path, "/subscriptions/")
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOIDCAuth(t *testing.T) {
+	oidc := &authentication.OIDCOptions{Issuer: "https://issuer.example.com", Audience: "radius"}
+	authz := &authorization.Options{
+		RoleAssignments: []authorization.RoleAssignment{{User: "alice", Role: authorization.RoleOwner, Scope: "/"}},
+	}
+
+	t.Run("not configured", func(t *testing.T) {
+		validator, authorizer, err := NewOIDCAuth(nil)
+		require.NoError(t, err)
+		require.Nil(t, validator)
+		require.Nil(t, authorizer)
+
+		validator, authorizer, err = NewOIDCAuth(&hostoptions.ServerOptions{AuthType: hostoptions.ClientCertificateAuthType})
+		require.NoError(t, err)
+		require.Nil(t, validator)
+		require.Nil(t, authorizer)
+	})
+
+	t.Run("authentication only", func(t *testing.T) {
+		validator, authorizer, err := NewOIDCAuth(&hostoptions.ServerOptions{AuthType: hostoptions.OIDCAuthType, OIDC: oidc})
+		require.NoError(t, err)
+		require.NotNil(t, validator)
+		require.Nil(t, authorizer)
+	})
+
+	t.Run("authentication and authorization", func(t *testing.T) {
+		validator, authorizer, err := NewOIDCAuth(&hostoptions.ServerOptions{AuthType: hostoptions.OIDCAuthType, OIDC: oidc, Authorization: authz})
+		require.NoError(t, err)
+		require.NotNil(t, validator)
+		require.NotNil(t, authorizer)
+	})
+
+	t.Run("missing oidc options", func(t *testing.T) {
+		_, _, err := NewOIDCAuth(&hostoptions.ServerOptions{AuthType: hostoptions.OIDCAuthType})
+		require.EqualError(t, err, "oidc options are required for the OIDC authentication type")
+	})
+
+	t.Run("authorization without oidc", func(t *testing.T) {
+		_, _, err := NewOIDCAuth(&hostoptions.ServerOptions{Authorization: authz})
+		require.EqualError(t, err, "authorization requires the OIDC authentication type")
+	})
+}
+
+func TestAuthorizationMiddleware(t *testing.T) {
+	authorizer, err := authorization.NewAuthorizer(authorization.Options{
+		RoleAssignments: []authorization.RoleAssignment{
+			{Group: "team-a", Role: authorization.RoleContributor, Scope: "/planes/radius/local/resourceGroups/team-a"},
+		},
+	})
+	require.NoError(t, err)
+
+	const pathBase = "/apis/api.ucp.dev/v1alpha3"
+	handler := AuthorizationMiddleware(authorizer, pathBase)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	teamMember := &authentication.Principal{Name: "bob", Groups: []string{"team-a"}}
+
+	tests := []struct {
+		name         string
+		principal    *authentication.Principal
+		method       string
+		path         string
+		expectedCode int
+		expectedErr  string
+	}{
+		{
+			name:         "allowed",
+			principal:    teamMember,
+			method:       http.MethodPut,
+			path:         pathBase + "/planes/radius/local/resourceGroups/team-a/providers/Applications.Core/containers/frontend",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "forbidden",
+			principal:    teamMember,
+			method:       http.MethodPut,
+			path:         pathBase + "/planes/radius/local/resourceGroups/team-b/providers/Applications.Core/containers/frontend",
+			expectedCode: http.StatusForbidden,
+			expectedErr:  v1.CodeAuthorizationFailed,
+		},
+		{
+			name:         "unauthenticated",
+			method:       http.MethodGet,
+			path:         pathBase + "/planes/radius/local/resourceGroups/team-a",
+			expectedCode: http.StatusUnauthorized,
+			expectedErr:  v1.CodeInvalidAuthenticationInfo,
+		},
+		{
+			name:         "non-resource path",
+			method:       http.MethodGet,
+			path:         pathBase,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "openapi document",
+			method:       http.MethodGet,
+			path:         "/openapi/v2",
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			if tc.principal != nil {
+				req = req.WithContext(authentication.WithPrincipal(req.Context(), tc.principal))
+			}
+
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code)
+			if tc.expectedErr != "" {
+				require.Contains(t, w.Body.String(), tc.expectedErr)
+			}
+		})
+	}
+}
@@ -21,7 +21,10 @@ import (
 	"net"
 	"net/http"
 
+	"github.com/radius-project/radius/pkg/armrpc/audit"
 	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
 	"github.com/radius-project/radius/pkg/armrpc/servicecontext"
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/validator"
@@ -45,6 +48,15 @@ type Options struct {
 	EnableArmAuth bool
 	Configure     func(chi.Router) error
 	ArmCertMgr    *authentication.ArmCertManager
+
+	// OIDCValidator validates the bearer token of requests when set.
+	OIDCValidator *authentication.OIDCValidator
+	// Authorizer enforces role assignments for authenticated requests when set.
+	Authorizer *authorization.Authorizer
+	// Auditor records audit records of mutating requests when set.
+	Auditor *audit.Auditor
+	// Limiter limits the rate of mutating requests when set.
+	Limiter *ratelimit.Limiter
 }
 
 // New creates a frontend server that can listen on the provided address and serve requests - it creates an HTTP server with a router,
@@ -63,7 +75,21 @@ func New(ctx context.Context, options Options) (*http.Server, error) {
 	if options.EnableArmAuth {
 		r.Use(authentication.ClientCertValidator(options.ArmCertMgr))
 	}
+	if options.OIDCValidator != nil {
+		r.Use(authentication.BearerTokenValidator(options.OIDCValidator))
+	}
 	r.Use(servicecontext.ARMRequestCtx(options.PathBase, options.Location))
+	// Throttled requests are rejected before they are audited or authorized.
+	if options.Limiter != nil {
+		r.Use(options.Limiter.Middleware)
+	}
+	// Auditing runs before authorization so that denied requests are recorded.
+	if options.Auditor != nil {
+		r.Use(options.Auditor.Middleware)
+	}
+	if options.Authorizer != nil {
+		r.Use(AuthorizationMiddleware(options.Authorizer, options.PathBase))
+	}
 
 	r.Get(versionEndpoint, version.ReportVersionHandler)
 	r.Get(healthzEndpoint, version.ReportVersionHandler)
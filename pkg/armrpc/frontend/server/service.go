@@ -22,11 +22,16 @@ import (
 	"net/http"
 
 	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
 	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
+	"github.com/radius-project/radius/pkg/armrpc/events"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
 	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	qprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
+	sprovider "github.com/radius-project/radius/pkg/ucp/secret/provider"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	controller_runtime "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -48,12 +53,27 @@ type Service struct {
 	// ARMCertManager is the certificate manager of client cert authentication.
 	ARMCertManager *authentication.ArmCertManager
 
+	// OIDCValidator is the bearer token validator of OIDC authentication.
+	OIDCValidator *authentication.OIDCValidator
+
+	// Authorizer enforces the role assignments of authenticated requests.
+	Authorizer *authorization.Authorizer
+
+	// Auditor records audit records of mutating requests.
+	Auditor *audit.Auditor
+
+	// Limiter limits the rate of mutating requests.
+	Limiter *ratelimit.Limiter
+
+	// EventPublisher publishes resource lifecycle events to webhook subscriptions.
+	EventPublisher *events.Dispatcher
+
 	// KubeClient is the Kubernetes controller runtime client.
 	KubeClient controller_runtime.Client
 }
 
-// Init initializes web service - it initializes the StorageProvider, QueueProvider, OperationStatusManager, KubeClient and ARMCertManager
-// with the given context and returns an error if any of the initialization fails.
+// Init initializes web service - it initializes the StorageProvider, QueueProvider, EventPublisher, OperationStatusManager, KubeClient,
+// ARMCertManager, OIDCValidator, Authorizer, Auditor and Limiter with the given context and returns an error if any of the initialization fails.
 func (s *Service) Init(ctx context.Context) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -63,7 +83,11 @@ func (s *Service) Init(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	s.OperationStatusManager = manager.New(s.StorageProvider, reqQueueClient, s.Options.Config.Env.RoleLocation)
+	s.EventPublisher, err = events.New(s.Options.Config.Events, s.StorageProvider, sprovider.NewSecretProvider(s.Options.Config.SecretProvider))
+	if err != nil {
+		return err
+	}
+	s.OperationStatusManager = manager.NewWithPublisher(s.StorageProvider, reqQueueClient, s.Options.Config.Env.RoleLocation, s.EventPublisher.AsPublisher())
 	s.KubeClient, err = kubeutil.NewRuntimeClient(s.Options.K8sConfig)
 	if err != nil {
 		return err
@@ -77,6 +101,22 @@ func (s *Service) Init(ctx context.Context) error {
 		}
 	}
 
+	// Initialize bearer token authentication and role based authorization
+	s.OIDCValidator, s.Authorizer, err = NewOIDCAuth(s.Options.Config.Server)
+	if err != nil {
+		return err
+	}
+
+	s.Auditor, err = audit.New(s.Options.Config.Server.Audit, s.StorageProvider)
+	if err != nil {
+		return err
+	}
+
+	s.Limiter, err = ratelimit.New(s.Options.Config.Server.RateLimit)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -100,6 +140,10 @@ func (s *Service) Start(ctx context.Context, opt Options) error {
 
 	logger.Info(fmt.Sprintf("listening on: '%s'...", address))
 	err = server.ListenAndServe()
+
+	// Deliver the events of the last requests before the process exits.
+	s.EventPublisher.Shutdown(ctx)
+
 	if err == http.ErrServerClosed {
 		// We expect this, safe to ignore.
 		logger.Info("Server stopped...")
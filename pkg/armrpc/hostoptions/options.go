@@ -33,6 +33,7 @@ type AuthentificationType string
 const (
 	ClientCertificateAuthType AuthentificationType = "ClientCertificate"
 	AADPoPAuthType            AuthentificationType = "PoP"
+	OIDCAuthType              AuthentificationType = "OIDC"
 )
 
 // EnvironmentOptions represents the environment.
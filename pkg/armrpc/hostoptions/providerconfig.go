@@ -17,6 +17,12 @@ limitations under the License.
 package hostoptions
 
 import (
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
+	"github.com/radius-project/radius/pkg/armrpc/events"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
 	metricsprovider "github.com/radius-project/radius/pkg/metrics/provider"
 	profilerprovider "github.com/radius-project/radius/pkg/profiler/provider"
 	"github.com/radius-project/radius/pkg/trace"
@@ -44,6 +50,9 @@ type ProviderConfig struct {
 	Bicep            BicepOptions                             `yaml:"bicep,omitempty"`
 	Terraform        TerraformOptions                         `yaml:"terraform,omitempty"`
 
+	// Events configures the delivery of resource lifecycle events to webhook subscriptions.
+	Events *events.Options `yaml:"events,omitempty"`
+
 	// FeatureFlags includes the list of feature flags.
 	FeatureFlags []string `yaml:"featureFlags"`
 }
@@ -59,6 +68,18 @@ type ServerOptions struct {
 	ArmMetadataEndpoint string `yaml:"armMetadataEndpoint,omitempty"`
 	// EnableAuth when set the arm client authetication will be performed
 	EnableArmAuth bool `yaml:"enableArmAuth,omitempty"`
+	// OIDC configures bearer token authentication when AuthType is OIDC.
+	OIDC *authentication.OIDCOptions `yaml:"oidc,omitempty"`
+	// Authorization configures role-based authorization of authenticated requests. It requires AuthType to be OIDC.
+	Authorization *authorization.Options `yaml:"authorization,omitempty"`
+	// Audit configures the audit log of mutating requests.
+	Audit *audit.Options `yaml:"audit,omitempty"`
+	// RateLimit configures the rate limits of mutating requests per caller and per resource group.
+	RateLimit *ratelimit.Options `yaml:"rateLimit,omitempty"`
+	// Quotas configures the maximum number of resources per resource group and per environment.
+	Quotas *ratelimit.QuotaOptions `yaml:"quotas,omitempty"`
+	// SoftDelete configures the soft delete of resource groups and environments.
+	SoftDelete *softdelete.Options `yaml:"softDelete,omitempty"`
 }
 
 // WorkerServerOptions includes the worker server options.
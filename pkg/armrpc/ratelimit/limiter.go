@@ -0,0 +1,242 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// anonymousCaller is the key of the bucket shared by requests without a caller identity.
+	anonymousCaller = "anonymous"
+
+	// defaultIdleTimeout is the minimum time a bucket is kept after its last request.
+	defaultIdleTimeout = 10 * time.Minute
+)
+
+// Limiter limits the rate of mutating requests per caller and per resource group using token buckets.
+type Limiter struct {
+	perCaller        *buckets
+	perResourceGroup *buckets
+
+	// trustForwardedCaller identifies unauthenticated callers by the ARM client headers.
+	trustForwardedCaller bool
+
+	// now returns the current time. It is replaced in tests.
+	now func() time.Time
+}
+
+// New creates a Limiter from the options. It returns nil if rate limiting is not enabled.
+func New(options *Options) (*Limiter, error) {
+	if options == nil || !options.Enabled {
+		return nil, nil
+	}
+
+	if options.PerCaller == nil && options.PerResourceGroup == nil {
+		return nil, errors.New("rate limiting requires perCaller or perResourceGroup limits")
+	}
+
+	limiter := &Limiter{trustForwardedCaller: options.TrustForwardedCaller, now: time.Now}
+	var err error
+	if limiter.perCaller, err = newBuckets("perCaller", options.PerCaller); err != nil {
+		return nil, err
+	}
+	if limiter.perResourceGroup, err = newBuckets("perResourceGroup", options.PerResourceGroup); err != nil {
+		return nil, err
+	}
+
+	return limiter, nil
+}
+
+// Allow reports whether a request of the caller targeting the resource group can be made now. If not, it returns the
+// duration after which the request can be retried. Either key can be empty to skip the corresponding limit. No tokens
+// are consumed if the request is not allowed.
+func (l *Limiter) Allow(caller string, resourceGroup string) (bool, time.Duration) {
+	now := l.now()
+
+	reservations := []*rate.Reservation{}
+	if l.perCaller != nil && caller != "" {
+		reservations = append(reservations, l.perCaller.reserve(caller, now))
+	}
+	if l.perResourceGroup != nil && resourceGroup != "" {
+		reservations = append(reservations, l.perResourceGroup.reserve(resourceGroup, now))
+	}
+
+	var retryAfter time.Duration
+	for _, r := range reservations {
+		if delay := r.DelayFrom(now); delay > retryAfter {
+			retryAfter = delay
+		}
+	}
+
+	if retryAfter == 0 {
+		return true, 0
+	}
+
+	for _, r := range reservations {
+		r.CancelAt(now)
+	}
+	return false, retryAfter
+}
+
+// Middleware responds with 429 Too Many Requests and a Retry-After header when a PUT, PATCH, DELETE or POST request
+// exceeds the rate limits. Read requests, including the polling of asynchronous operations, are not limited. It must be
+// registered after the ARM request context middleware.
+func (l *Limiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !v1.IsMutatingHTTPMethod(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := r.Context()
+		rpcCtx := v1.ARMRequestContextFromContext(ctx)
+
+		caller := Caller(ctx, rpcCtx, l.trustForwardedCaller)
+		resourceGroup := strings.ToLower(rpcCtx.ResourceID.ResourceGroupScope())
+		if ok, retryAfter := l.Allow(caller, resourceGroup); !ok {
+			logger := ucplog.FromContextOrDiscard(ctx)
+			logger.Info("request is throttled", "caller", caller, "resourceGroup", resourceGroup, "retryAfter", retryAfter.String())
+
+			message := fmt.Sprintf("The request rate limit for %s is exceeded. Retry the request after %s.", throttledScope(caller, resourceGroup), retryAfter.Round(time.Second))
+			resp := rest.NewTooManyRequestsResponse(message, retryAfter)
+			if err := resp.Apply(ctx, w, r); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Caller returns the identity of the caller of the request, which is the principal authenticated by the server.
+//
+// Requests proxied by UCP to a resource provider are not authenticated by the resource provider. When trustForwarded
+// is true they are identified by the principal name that UCP forwards in the X-Ms-Client-Principal-Name header. The
+// header is set by the client, so it must only be trusted when the server can only be reached through UCP. Requests
+// without an identity share the anonymous caller.
+func Caller(ctx context.Context, rpcCtx *v1.ARMRequestContext, trustForwarded bool) string {
+	if principal := authentication.PrincipalFromContext(ctx); principal != nil && principal.Name != "" {
+		return principal.Name
+	}
+
+	if trustForwarded && rpcCtx != nil {
+		if rpcCtx.ClientPrincipalName != "" {
+			return rpcCtx.ClientPrincipalName
+		}
+		if rpcCtx.ClientObjectID != "" {
+			return rpcCtx.ClientObjectID
+		}
+	}
+
+	return anonymousCaller
+}
+
+func throttledScope(caller string, resourceGroup string) string {
+	if resourceGroup == "" {
+		return fmt.Sprintf("caller %q", caller)
+	}
+	return fmt.Sprintf("caller %q or resource group %q", caller, resourceGroup)
+}
+
+// buckets is a set of token buckets with the same limits, keyed by caller or resource group.
+type buckets struct {
+	limit       rate.Limit
+	burst       int
+	idleTimeout time.Duration
+
+	mu        sync.Mutex
+	entries   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newBuckets(name string, options *BucketOptions) (*buckets, error) {
+	if options == nil {
+		return nil, nil
+	}
+
+	if options.RequestsPerSecond <= 0 {
+		return nil, fmt.Errorf("%s.requestsPerSecond must be greater than zero", name)
+	}
+	if options.Burst < 1 {
+		return nil, fmt.Errorf("%s.burst must be at least 1", name)
+	}
+
+	// A bucket can only be dropped once it is full again, otherwise dropping it would reset the limit.
+	idleTimeout := time.Duration(float64(options.Burst) / options.RequestsPerSecond * float64(time.Second))
+	if idleTimeout < defaultIdleTimeout {
+		idleTimeout = defaultIdleTimeout
+	}
+
+	return &buckets{
+		limit:       rate.Limit(options.RequestsPerSecond),
+		burst:       options.Burst,
+		idleTimeout: idleTimeout,
+		entries:     map[string]*bucket{},
+	}, nil
+}
+
+// reserve reserves a token of the bucket with the given key, creating the bucket if needed.
+func (b *buckets) reserve(key string, now time.Time) *rate.Reservation {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.sweep(now)
+
+	key = strings.ToLower(key)
+	entry, ok := b.entries[key]
+	if !ok {
+		entry = &bucket{limiter: rate.NewLimiter(b.limit, b.burst)}
+		b.entries[key] = entry
+	}
+	entry.lastSeen = now
+
+	return entry.limiter.ReserveN(now, 1)
+}
+
+// sweep drops the buckets which have been idle for longer than the idle timeout.
+func (b *buckets) sweep(now time.Time) {
+	if now.Sub(b.lastSweep) < b.idleTimeout {
+		return
+	}
+
+	for key, entry := range b.entries {
+		if now.Sub(entry.lastSeen) >= b.idleTimeout {
+			delete(b.entries, key)
+		}
+	}
+	b.lastSweep = now
+}
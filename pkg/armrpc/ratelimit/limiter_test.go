@@ -0,0 +1,206 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const testResourceID = "/planes/radius/local/resourceGroups/rg1/providers/Applications.Core/applications/app"
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func newTestLimiter(t *testing.T, options *Options) (*Limiter, *testClock) {
+	limiter, err := New(options)
+	require.NoError(t, err)
+	require.NotNil(t, limiter)
+
+	clock := &testClock{now: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)}
+	limiter.now = clock.Now
+	return limiter, clock
+}
+
+func newRequest(t *testing.T, method string, id string, principal string) *http.Request {
+	parsed, err := resources.Parse(id)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(method, id+"?api-version=2023-10-01-preview", nil)
+	ctx := v1.WithARMRequestContext(req.Context(), &v1.ARMRequestContext{ResourceID: parsed})
+	if principal != "" {
+		ctx = authentication.WithPrincipal(ctx, &authentication.Principal{Name: principal})
+	}
+	return req.WithContext(ctx)
+}
+
+func Test_New(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		limiter, err := New(nil)
+		require.NoError(t, err)
+		require.Nil(t, limiter)
+
+		limiter, err = New(&Options{Enabled: false, PerCaller: &BucketOptions{RequestsPerSecond: 1, Burst: 1}})
+		require.NoError(t, err)
+		require.Nil(t, limiter)
+	})
+
+	t.Run("no limits", func(t *testing.T) {
+		_, err := New(&Options{Enabled: true})
+		require.Error(t, err)
+	})
+
+	t.Run("invalid rate", func(t *testing.T) {
+		_, err := New(&Options{Enabled: true, PerCaller: &BucketOptions{RequestsPerSecond: 0, Burst: 1}})
+		require.EqualError(t, err, "perCaller.requestsPerSecond must be greater than zero")
+	})
+
+	t.Run("invalid burst", func(t *testing.T) {
+		_, err := New(&Options{Enabled: true, PerResourceGroup: &BucketOptions{RequestsPerSecond: 1, Burst: 0}})
+		require.EqualError(t, err, "perResourceGroup.burst must be at least 1")
+	})
+}
+
+func Test_Limiter_Allow_PerCaller(t *testing.T) {
+	limiter, clock := newTestLimiter(t, &Options{Enabled: true, PerCaller: &BucketOptions{RequestsPerSecond: 1, Burst: 2}})
+
+	ok, _ := limiter.Allow("alice", "")
+	require.True(t, ok)
+	ok, _ = limiter.Allow("Alice", "")
+	require.True(t, ok)
+
+	ok, retryAfter := limiter.Allow("alice", "")
+	require.False(t, ok)
+	require.Equal(t, time.Second, retryAfter)
+
+	// Other callers have their own bucket.
+	ok, _ = limiter.Allow("bob", "")
+	require.True(t, ok)
+
+	clock.now = clock.now.Add(time.Second)
+	ok, _ = limiter.Allow("alice", "")
+	require.True(t, ok)
+}
+
+func Test_Limiter_Allow_PerResourceGroup(t *testing.T) {
+	limiter, _ := newTestLimiter(t, &Options{
+		Enabled:          true,
+		PerCaller:        &BucketOptions{RequestsPerSecond: 1, Burst: 10},
+		PerResourceGroup: &BucketOptions{RequestsPerSecond: 0.5, Burst: 1},
+	})
+
+	ok, _ := limiter.Allow("alice", "/planes/radius/local/resourcegroups/rg1")
+	require.True(t, ok)
+
+	ok, retryAfter := limiter.Allow("bob", "/planes/radius/local/resourcegroups/rg1")
+	require.False(t, ok)
+	require.Equal(t, 2*time.Second, retryAfter)
+
+	// The throttled request did not consume a token of the caller.
+	for i := 0; i < 10; i++ {
+		ok, _ = limiter.Allow("bob", "")
+		require.True(t, ok, "request %d", i)
+	}
+	ok, _ = limiter.Allow("bob", "")
+	require.False(t, ok)
+}
+
+func Test_Limiter_Sweep(t *testing.T) {
+	limiter, clock := newTestLimiter(t, &Options{Enabled: true, PerCaller: &BucketOptions{RequestsPerSecond: 1, Burst: 1}})
+
+	limiter.Allow("alice", "")
+	limiter.Allow("bob", "")
+	require.Len(t, limiter.perCaller.entries, 2)
+
+	clock.now = clock.now.Add(defaultIdleTimeout)
+	limiter.Allow("bob", "")
+	require.Len(t, limiter.perCaller.entries, 1)
+	require.Contains(t, limiter.perCaller.entries, "bob")
+}
+
+func Test_Limiter_Middleware(t *testing.T) {
+	limiter, _ := newTestLimiter(t, &Options{Enabled: true, PerCaller: &BucketOptions{RequestsPerSecond: 0.2, Burst: 1}})
+
+	called := 0
+	handler := limiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called++
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newRequest(t, http.MethodPut, testResourceID, "alice"))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newRequest(t, http.MethodDelete, testResourceID, "alice"))
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "5", w.Header().Get("Retry-After"))
+
+	body := v1.ErrorResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, v1.CodeTooManyRequests, body.Error.Code)
+	require.Contains(t, body.Error.Message, `caller "alice"`)
+
+	// Reads are not limited.
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newRequest(t, http.MethodGet, testResourceID, "alice"))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// Requests without an identity share the anonymous caller.
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newRequest(t, http.MethodPut, testResourceID, ""))
+	require.Equal(t, http.StatusOK, w.Code)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newRequest(t, http.MethodPut, testResourceID, ""))
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+
+	require.Equal(t, 3, called)
+}
+
+func Test_Caller(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(t, anonymousCaller, Caller(ctx, nil, true))
+	require.Equal(t, anonymousCaller, Caller(ctx, &v1.ARMRequestContext{}, true))
+
+	// The client headers are not trusted by default, so that a caller can't get a new bucket by changing them.
+	require.Equal(t, anonymousCaller, Caller(ctx, &v1.ARMRequestContext{ClientPrincipalName: "alice", ClientObjectID: "id"}, false))
+	require.Equal(t, anonymousCaller, Caller(ctx, &v1.ARMRequestContext{ClientObjectID: "id"}, false))
+
+	// Requests proxied by UCP carry the principal name forwarded by UCP.
+	require.Equal(t, "alice", Caller(ctx, &v1.ARMRequestContext{ClientPrincipalName: "alice", ClientObjectID: "id"}, true))
+	require.Equal(t, "id", Caller(ctx, &v1.ARMRequestContext{ClientObjectID: "id"}, true))
+
+	// Requests authenticated by the server use the principal of the token.
+	ctx = authentication.WithPrincipal(ctx, &authentication.Principal{Name: "bob"})
+	require.Equal(t, "bob", Caller(ctx, &v1.ARMRequestContext{ClientPrincipalName: "alice"}, false))
+	require.Equal(t, "bob", Caller(ctx, &v1.ARMRequestContext{ClientPrincipalName: "alice"}, true))
+}
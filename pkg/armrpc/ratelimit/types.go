@@ -0,0 +1,48 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+// Options represents the request rate limit options of a frontend server.
+type Options struct {
+	// Enabled enables rate limiting of mutating requests.
+	Enabled bool `yaml:"enabled"`
+	// PerCaller limits the rate of requests of each caller.
+	PerCaller *BucketOptions `yaml:"perCaller,omitempty"`
+	// PerResourceGroup limits the rate of requests targeting each resource group.
+	PerResourceGroup *BucketOptions `yaml:"perResourceGroup,omitempty"`
+	// TrustForwardedCaller identifies unauthenticated callers by the X-Ms-Client-Principal-Name and
+	// X-Ms-Client-Object-Id headers forwarded by UCP. These headers are set by the client, so this must only be enabled
+	// when the server can only be reached through UCP. Otherwise unauthenticated callers share one bucket.
+	TrustForwardedCaller bool `yaml:"trustForwardedCaller,omitempty"`
+}
+
+// BucketOptions represents the options of a token bucket.
+type BucketOptions struct {
+	// RequestsPerSecond is the rate at which tokens are added to the bucket.
+	RequestsPerSecond float64 `yaml:"requestsPerSecond"`
+	// Burst is the size of the bucket, which is the number of requests that can be made at once.
+	Burst int `yaml:"burst"`
+}
+
+// QuotaOptions represents the resource quotas enforced when resources are created. A zero value disables the quota.
+type QuotaOptions struct {
+	// MaxResourcesPerResourceGroup is the maximum number of resources in a resource group.
+	MaxResourcesPerResourceGroup int `yaml:"maxResourcesPerResourceGroup,omitempty"`
+	// MaxResourcesPerEnvironment is the maximum number of resources in an environment, including the resources of its
+	// applications.
+	MaxResourcesPerEnvironment int `yaml:"maxResourcesPerEnvironment,omitempty"`
+}
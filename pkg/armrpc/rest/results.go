@@ -23,6 +23,7 @@ import (
 	"net/http"
 	"net/textproto"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -714,6 +715,131 @@ func (r *ClientAuthenticationFailed) Apply(ctx context.Context, w http.ResponseW
 	return nil
 }
 
+// ForbiddenResponse represents an HTTP 403 with an ARM error payload.
+type ForbiddenResponse struct {
+	Body v1.ErrorResponse
+}
+
+// NewForbiddenResponse creates a ForbiddenResponse with CodeAuthorizationFailed code and the given message.
+func NewForbiddenResponse(message string) Response {
+	return &ForbiddenResponse{
+		Body: v1.ErrorResponse{
+			Error: v1.ErrorDetails{
+				Code:    v1.CodeAuthorizationFailed,
+				Message: message,
+			},
+		},
+	}
+}
+
+// Apply renders 403 Forbidden HTTP response into http.ResponseWriter by setting Content-Type and serializing response.
+func (r *ForbiddenResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("responding with status code: %d", http.StatusForbidden), logging.LogHTTPStatusCode, http.StatusForbidden)
+
+	bytes, err := json.MarshalIndent(r.Body, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling %T: %w", r.Body, err)
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("error writing marshaled %T bytes to output: %s", r.Body, err)
+	}
+
+	return nil
+}
+
+// TooManyRequestsResponse represents an HTTP 429 with an ARM error payload and a Retry-After header.
+type TooManyRequestsResponse struct {
+	Body v1.ErrorResponse
+	// RetryAfter is the duration the client should wait before retrying the request.
+	RetryAfter time.Duration
+}
+
+// NewTooManyRequestsResponse creates a TooManyRequestsResponse with CodeTooManyRequests code, the given message and
+// the duration the client should wait before retrying.
+func NewTooManyRequestsResponse(message string, retryAfter time.Duration) Response {
+	return &TooManyRequestsResponse{
+		Body: v1.ErrorResponse{
+			Error: v1.ErrorDetails{
+				Code:    v1.CodeTooManyRequests,
+				Message: message,
+			},
+		},
+		RetryAfter: retryAfter,
+	}
+}
+
+// Apply renders 429 Too Many Requests HTTP response into http.ResponseWriter by setting Content-Type, Retry-After and
+// serializing response. Retry-After is rounded up to whole seconds and is at least one second.
+func (r *TooManyRequestsResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("responding with status code: %d", http.StatusTooManyRequests), logging.LogHTTPStatusCode, http.StatusTooManyRequests)
+
+	bytes, err := json.MarshalIndent(r.Body, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling %T: %w", r.Body, err)
+	}
+
+	retryAfter := int64((r.RetryAfter + time.Second - 1) / time.Second)
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.Header().Add("Retry-After", strconv.FormatInt(retryAfter, 10))
+	w.WriteHeader(http.StatusTooManyRequests)
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("error writing marshaled %T bytes to output: %s", r.Body, err)
+	}
+
+	return nil
+}
+
+// QuotaExceededResponse represents an HTTP 409 with an ARM error payload.
+//
+// This is used when creating a resource would exceed a resource quota. Unlike throttling, retrying the request does
+// not succeed until resources are deleted or the quota is raised, so no Retry-After header is set.
+type QuotaExceededResponse struct {
+	Body v1.ErrorResponse
+}
+
+// NewQuotaExceededResponse creates a QuotaExceededResponse with CodeQuotaExceeded code and the given message.
+func NewQuotaExceededResponse(message string) Response {
+	return &QuotaExceededResponse{
+		Body: v1.ErrorResponse{
+			Error: v1.ErrorDetails{
+				Code:    v1.CodeQuotaExceeded,
+				Message: message,
+			},
+		},
+	}
+}
+
+// Apply renders 409 Conflict HTTP response into http.ResponseWriter by setting Content-Type and serializing response.
+func (r *QuotaExceededResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("responding with status code: %d", http.StatusConflict), logging.LogHTTPStatusCode, http.StatusConflict)
+
+	bytes, err := json.MarshalIndent(r.Body, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling %T: %w", r.Body, err)
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusConflict)
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("error writing marshaled %T bytes to output: %s", r.Body, err)
+	}
+
+	return nil
+}
+
 // AsyncOperationResultResponse
 type AsyncOperationResultResponse struct {
 	Headers map[string]string
@@ -147,6 +147,57 @@ func Test_OKResponse_WithBody(t *testing.T) {
 	require.Equal(t, payload, body)
 }
 
+func Test_TooManyRequestsResponse(t *testing.T) {
+	tests := []struct {
+		retryAfter time.Duration
+		expected   string
+	}{
+		{retryAfter: 0, expected: "1"},
+		{retryAfter: 200 * time.Millisecond, expected: "1"},
+		{retryAfter: 2 * time.Second, expected: "2"},
+		{retryAfter: 2100 * time.Millisecond, expected: "3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			response := NewTooManyRequestsResponse("slow down", tt.retryAfter)
+
+			req := httptest.NewRequest("PUT", "http://example.com", nil)
+			w := httptest.NewRecorder()
+
+			err := response.Apply(context.TODO(), w, req)
+			require.NoError(t, err)
+
+			require.Equal(t, http.StatusTooManyRequests, w.Code)
+			require.Equal(t, tt.expected, w.Header().Get("Retry-After"))
+
+			body := v1.ErrorResponse{}
+			err = json.Unmarshal(w.Body.Bytes(), &body)
+			require.NoError(t, err)
+			require.Equal(t, v1.CodeTooManyRequests, body.Error.Code)
+			require.Equal(t, "slow down", body.Error.Message)
+		})
+	}
+}
+
+func Test_QuotaExceededResponse(t *testing.T) {
+	response := NewQuotaExceededResponse("too many resources")
+
+	req := httptest.NewRequest("PUT", "http://example.com", nil)
+	w := httptest.NewRecorder()
+
+	err := response.Apply(context.TODO(), w, req)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusConflict, w.Code)
+	require.Empty(t, w.Header().Get("Retry-After"))
+
+	body := v1.ErrorResponse{}
+	err = json.Unmarshal(w.Body.Bytes(), &body)
+	require.NoError(t, err)
+	require.Equal(t, v1.CodeQuotaExceeded, body.Error.Code)
+}
+
 func TestGetAsyncLocationPath(t *testing.T) {
 	operationID := uuid.New()
 
@@ -0,0 +1,359 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package softdelete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/rp/environments"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/hosting"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// purgeScope is the root scope of the query for the soft-deleted resources of all planes.
+	purgeScope = "/planes"
+
+	// trackedResourceType is the type of the UCP records that track the resources of a resource group.
+	trackedResourceType = "System.Resources/resources"
+
+	// systemResourceTypePrefix is the prefix of the UCP resource types that are not managed by a resource provider.
+	systemResourceTypePrefix = "System."
+)
+
+// Deleter deletes a resource through the API of its resource provider, so that the resource provider also deletes
+// the output resources of the resource.
+type Deleter interface {
+	// Delete permanently deletes the resource. It returns nil if the resource does not exist or if its deletion
+	// is already in progress.
+	Delete(ctx context.Context, id string, apiVersion string) error
+
+	// Teardown deletes the output resources of a soft-deleted resource and keeps the resource, so that it can be
+	// restored. It returns nil if the resource does not exist or if its deletion is already in progress.
+	Teardown(ctx context.Context, id string, apiVersion string) error
+}
+
+// NewDeleter creates a Deleter that sends permanent delete requests to the Radius API of the connection.
+func NewDeleter(connection sdk.Connection) Deleter {
+	return &httpDeleter{connection: connection}
+}
+
+type httpDeleter struct {
+	connection sdk.Connection
+}
+
+// Delete sends a DELETE request for the resource that disables soft delete.
+func (d *httpDeleter) Delete(ctx context.Context, id string, apiVersion string) error {
+	return d.send(ctx, id, apiVersion, false)
+}
+
+// Teardown sends a DELETE request for the resource that requires soft delete.
+func (d *httpDeleter) Teardown(ctx context.Context, id string, apiVersion string) error {
+	return d.send(ctx, id, apiVersion, true)
+}
+
+func (d *httpDeleter) send(ctx context.Context, id string, apiVersion string, softDelete bool) error {
+	address := strings.TrimSuffix(d.connection.Endpoint(), "/") + id + "?api-version=" + url.QueryEscape(apiVersion)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, address, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set(HeaderSoftDelete, strconv.FormatBool(softDelete))
+
+	resp, err := d.connection.Client().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// 409 Conflict is returned while a delete operation of the resource is in progress.
+	if resp.StatusCode < 300 || resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusConflict {
+		return nil
+	}
+
+	return fmt.Errorf("failed to delete resource %q: the server responded with status code %d", id, resp.StatusCode)
+}
+
+var _ hosting.Service = (*PurgeService)(nil)
+
+// PurgeService periodically purges the soft-deleted resource groups or environments whose retention period has
+// passed. The children of a purged resource are deleted through the API, so that their output resources are deleted
+// as well.
+type PurgeService struct {
+	resourceType    string
+	children        ChildrenFunc
+	storageProvider dataprovider.DataStorageProvider
+	deleter         Deleter
+	retention       time.Duration
+	interval        time.Duration
+
+	// now returns the current time. It is replaced in tests.
+	now func() time.Time
+}
+
+// NewPurgeService creates a PurgeService for the soft-deleted resources of the given type.
+func NewPurgeService(options *Options, resourceType string, children ChildrenFunc, storageProvider dataprovider.DataStorageProvider, deleter Deleter) *PurgeService {
+	return &PurgeService{
+		resourceType:    resourceType,
+		children:        children,
+		storageProvider: storageProvider,
+		deleter:         deleter,
+		retention:       options.Retention(),
+		interval:        options.Interval(),
+		now:             time.Now,
+	}
+}
+
+// Name returns the name of the service.
+func (s *PurgeService) Name() string {
+	return fmt.Sprintf("soft delete purge of %s", s.resourceType)
+}
+
+// Run purges the expired resources immediately and then at every interval until the context is cancelled.
+func (s *PurgeService) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.PurgeAll(ctx); err != nil {
+			logger.Error(err, "failed to purge soft-deleted resources", "resourceType", s.resourceType)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeAll makes progress on the purge of every soft-deleted resource whose retention period has passed. The purge
+// of a resource can take several runs, because the children are deleted in order and deletions can be asynchronous.
+// The failure to purge one resource is logged and does not prevent the purge of the others.
+func (s *PurgeService) PurgeAll(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	client, err := s.storageProvider.GetStorageClient(ctx, s.resourceType)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.Query(ctx, store.Query{RootScope: purgeScope, ScopeRecursive: true, ResourceType: s.resourceType})
+	if err != nil {
+		return err
+	}
+
+	for i := range result.Items {
+		item := &result.Items[i]
+		deletedTime, err := DeletedTime(item)
+		if err != nil || deletedTime == nil || s.now().Sub(*deletedTime) < s.retention {
+			continue
+		}
+
+		if err := s.purge(ctx, client, item); err != nil {
+			logger.Error(err, "failed to purge soft-deleted resource", "resourceId", item.ID)
+		}
+	}
+
+	return nil
+}
+
+// purge deletes the children of the resource through the API, starting with the resources that are neither
+// applications nor environments. The records of the resource are deleted from the store once no child remains.
+func (s *PurgeService) purge(ctx context.Context, client store.StorageClient, obj *store.Object) error {
+	id, err := resources.Parse(obj.ID)
+	if err != nil {
+		return err
+	}
+
+	children, err := s.children(ctx, client, id)
+	if err != nil {
+		return err
+	}
+
+	targets, err := deletionTargets(children)
+	if err != nil {
+		return err
+	}
+
+	if len(targets) > 0 {
+		for _, target := range targets {
+			if err := s.deleter.Delete(ctx, target.id, target.apiVersion); err != nil {
+				return err
+			}
+
+			if target.tracked != nil {
+				if err := deleteRecord(ctx, client, target.tracked.ID); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	for _, child := range children {
+		if err := deleteRecord(ctx, client, child.ID); err != nil {
+			return err
+		}
+	}
+
+	return client.Delete(ctx, obj.ID, store.WithETag(obj.ETag))
+}
+
+// target is a resource to delete through the API.
+type target struct {
+	id         string
+	apiVersion string
+	tier       int
+
+	// tracked is the UCP record that tracks the resource, if the resource itself is not among the children.
+	tracked *store.Object
+}
+
+// trackedResource is the stored representation of the UCP records that track the resources of a resource group.
+type trackedResource struct {
+	Properties struct {
+		ID         string `json:"id"`
+		APIVersion string `json:"apiVersion"`
+	} `json:"properties"`
+}
+
+// resourceVersions is the stored representation of the api-versions of a resource.
+type resourceVersions struct {
+	CreatedAPIVersion string `json:"createdApiVersion"`
+	UpdatedAPIVersion string `json:"updatedApiVersion"`
+}
+
+// deletionTargets returns the children to delete in the next step of a purge: the resources that are neither
+// applications nor environments first, then the applications, and then the environments.
+func deletionTargets(children []store.Object) ([]target, error) {
+	candidates := map[string]target{}
+	for i := range children {
+		child := &children[i]
+		if isSystemResource(child.ID) {
+			continue
+		}
+
+		apiVersion, err := apiVersionOf(child)
+		if err != nil {
+			return nil, err
+		}
+		candidates[strings.ToLower(child.ID)] = target{id: child.ID, apiVersion: apiVersion, tier: tierOf(child.ID)}
+	}
+
+	for i := range children {
+		child := &children[i]
+		if !strings.EqualFold(typeOf(child.ID), trackedResourceType) {
+			continue
+		}
+
+		tracked := trackedResource{}
+		if err := child.As(&tracked); err != nil {
+			return nil, err
+		}
+
+		key := strings.ToLower(tracked.Properties.ID)
+		if _, ok := candidates[key]; ok || key == "" {
+			continue
+		}
+		candidates[key] = target{id: tracked.Properties.ID, apiVersion: tracked.Properties.APIVersion, tier: tierOf(tracked.Properties.ID), tracked: child}
+	}
+
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	lowest := -1
+	for _, candidate := range candidates {
+		if lowest < 0 || candidate.tier < lowest {
+			lowest = candidate.tier
+		}
+	}
+
+	targets := []target{}
+	for _, candidate := range candidates {
+		if candidate.tier == lowest {
+			targets = append(targets, candidate)
+		}
+	}
+
+	return targets, nil
+}
+
+// apiVersionOf returns the api-version the stored resource was last written with.
+func apiVersionOf(obj *store.Object) (string, error) {
+	versions := resourceVersions{}
+	if err := obj.As(&versions); err != nil {
+		return "", err
+	}
+
+	if versions.UpdatedAPIVersion != "" {
+		return versions.UpdatedAPIVersion, nil
+	}
+	return versions.CreatedAPIVersion, nil
+}
+
+// isSystemResource returns true if the resource ID is a UCP resource that is not managed by a resource provider.
+func isSystemResource(id string) bool {
+	return strings.HasPrefix(strings.ToLower(typeOf(id)), strings.ToLower(systemResourceTypePrefix))
+}
+
+// tierOf returns the order in which the resource is deleted during a purge.
+func tierOf(id string) int {
+	switch strings.ToLower(typeOf(id)) {
+	case strings.ToLower(environments.ResourceType):
+		return 2
+	case strings.ToLower(environments.ApplicationResourceType):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// typeOf returns the resource type of the resource ID, or an empty string if the ID is invalid.
+func typeOf(id string) string {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return ""
+	}
+
+	return parsed.Type()
+}
+
+// deleteRecord deletes the record from the store. Records that no longer exist are ignored.
+func deleteRecord(ctx context.Context, client store.StorageClient, id string) error {
+	err := client.Delete(ctx, id)
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return nil
+	}
+
+	return err
+}
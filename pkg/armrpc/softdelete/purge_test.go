@@ -0,0 +1,203 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package softdelete
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+const testTrackedID = "/planes/radius/local/resourceGroups/test-rg/providers/System.Resources/resources/tracked"
+
+type deletion struct {
+	id         string
+	apiVersion string
+}
+
+type testDeleter struct {
+	deletions []deletion
+	teardowns []deletion
+}
+
+func (d *testDeleter) Delete(ctx context.Context, id string, apiVersion string) error {
+	d.deletions = append(d.deletions, deletion{id: id, apiVersion: apiVersion})
+	return nil
+}
+
+func (d *testDeleter) Teardown(ctx context.Context, id string, apiVersion string) error {
+	d.teardowns = append(d.teardowns, deletion{id: id, apiVersion: apiVersion})
+	return nil
+}
+
+func Test_PurgeAll(t *testing.T) {
+	now := time.Date(2023, 10, 8, 0, 0, 0, 0, time.UTC)
+	expired := now.Add(-8 * 24 * time.Hour)
+	retained := now.Add(-time.Hour)
+
+	cases := []struct {
+		desc             string
+		children         []store.Object
+		expectedDeleted  []deletion
+		expectedRecords  []string
+		purgedResourceID string
+	}{
+		{
+			desc: "deletes-resources-before-applications",
+			children: []store.Object{
+				object(testApplicationID, map[string]any{"updatedApiVersion": "2023-10-01-preview"}),
+				object(testRedisID, map[string]any{"createdApiVersion": "2023-10-01-preview"}),
+			},
+			expectedDeleted: []deletion{{id: testRedisID, apiVersion: "2023-10-01-preview"}},
+		},
+		{
+			desc: "deletes-applications-before-environments",
+			children: []store.Object{
+				object(testEnvironmentID, map[string]any{"updatedApiVersion": "2023-10-01-preview"}),
+				object(testApplicationID, map[string]any{"updatedApiVersion": "2023-10-01-preview"}),
+			},
+			expectedDeleted: []deletion{{id: testApplicationID, apiVersion: "2023-10-01-preview"}},
+		},
+		{
+			desc: "deletes-tracked-resources",
+			children: []store.Object{
+				object(testTrackedID, map[string]any{"properties": map[string]any{"id": testContainerID, "apiVersion": "2023-10-01-preview"}}),
+			},
+			expectedDeleted: []deletion{{id: testContainerID, apiVersion: "2023-10-01-preview"}},
+			expectedRecords: []string{testTrackedID},
+		},
+		{
+			desc: "skips-tracked-resources-of-stored-children",
+			children: []store.Object{
+				object(testTrackedID, map[string]any{"properties": map[string]any{"id": testRedisID, "apiVersion": "2023-10-01-preview"}}),
+				object(testRedisID, map[string]any{"updatedApiVersion": "2023-10-01-preview"}),
+			},
+			expectedDeleted: []deletion{{id: testRedisID, apiVersion: "2023-10-01-preview"}},
+		},
+		{
+			desc:             "deletes-resource-group-without-children",
+			expectedRecords:  []string{},
+			purgedResourceID: testResourceGroupID,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.desc, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := store.NewMockStorageClient(ctrl)
+			storageProvider := dataprovider.NewMockDataStorageProvider(ctrl)
+			storageProvider.EXPECT().GetStorageClient(gomock.Any(), "System.Resources/resourceGroups").Return(client, nil)
+
+			client.EXPECT().
+				Query(gomock.Any(), store.Query{RootScope: "/planes", ScopeRecursive: true, ResourceType: "System.Resources/resourceGroups"}).
+				Return(&store.ObjectQueryResult{
+					Items: []store.Object{
+						object(testResourceGroupID, deletedAt(expired)),
+						object("/planes/radius/local/resourceGroups/retained-rg", deletedAt(retained)),
+						object("/planes/radius/local/resourceGroups/active-rg", map[string]any{}),
+					},
+				}, nil)
+
+			records := []string{}
+			client.EXPECT().
+				Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, id string, options ...store.DeleteOptions) error {
+					records = append(records, id)
+					return nil
+				}).
+				AnyTimes()
+
+			children := func(ctx context.Context, client store.StorageClient, id resources.ID) ([]store.Object, error) {
+				require.Equal(t, testResourceGroupID, id.String())
+				return tt.children, nil
+			}
+
+			deleter := &testDeleter{}
+			service := NewPurgeService(&Options{Enabled: true}, "System.Resources/resourceGroups", children, storageProvider, deleter)
+			service.now = func() time.Time { return now }
+
+			err := service.PurgeAll(context.Background())
+			require.NoError(t, err)
+
+			sort.Slice(deleter.deletions, func(i, j int) bool { return deleter.deletions[i].id < deleter.deletions[j].id })
+			require.Equal(t, tt.expectedDeleted, deleter.deletions)
+
+			expectedRecords := tt.expectedRecords
+			if tt.purgedResourceID != "" {
+				expectedRecords = append(expectedRecords, tt.purgedResourceID)
+			}
+			if expectedRecords == nil {
+				expectedRecords = []string{}
+			}
+			require.Equal(t, expectedRecords, records)
+		})
+	}
+}
+
+func Test_Deleter(t *testing.T) {
+	cases := []struct {
+		desc     string
+		status   int
+		teardown bool
+		wantErr  bool
+	}{
+		{"accepted", http.StatusAccepted, false, false},
+		{"not-found", http.StatusNotFound, false, false},
+		{"in-progress", http.StatusConflict, false, false},
+		{"failure", http.StatusInternalServerError, false, true},
+		{"teardown", http.StatusAccepted, true, false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.desc, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodDelete, r.Method)
+				require.Equal(t, "/apis/api.ucp.dev/v1alpha3"+testApplicationID, r.URL.Path)
+				require.Equal(t, "2023-10-01-preview", r.URL.Query().Get("api-version"))
+				require.Equal(t, strconv.FormatBool(tt.teardown), r.Header.Get(HeaderSoftDelete))
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			connection, err := sdk.NewDirectConnection(server.URL + "/apis/api.ucp.dev/v1alpha3")
+			require.NoError(t, err)
+
+			deleter := NewDeleter(connection)
+			if tt.teardown {
+				err = deleter.Teardown(context.Background(), testApplicationID, "2023-10-01-preview")
+			} else {
+				err = deleter.Delete(context.Background(), testApplicationID, "2023-10-01-preview")
+			}
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package softdelete
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/rp/environments"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// deletedTimeField is the JSON field of the deletion time in the stored resources.
+	deletedTimeField = "deletedTime"
+	// deletedByField is the JSON field of the ID of the resource whose deletion soft deleted the stored resources.
+	deletedByField = "deletedBy"
+)
+
+// ChildrenFunc returns the stored resources contained in the resource group or environment with the given ID,
+// including the resources that are already soft deleted.
+type ChildrenFunc func(ctx context.Context, client store.StorageClient, id resources.ID) ([]store.Object, error)
+
+// DeletedTime returns the time the object was soft deleted, or nil if it is not deleted.
+func DeletedTime(obj *store.Object) (*time.Time, error) {
+	metadata := v1.InternalMetadata{}
+	if err := obj.As(&metadata); err != nil {
+		return nil, err
+	}
+
+	return metadata.DeletedTime, nil
+}
+
+// IsDeleted returns true if the object is soft deleted. Objects that can't be read are treated as not deleted.
+func IsDeleted(obj *store.Object) bool {
+	deletedTime, err := DeletedTime(obj)
+	return err == nil && deletedTime != nil
+}
+
+// IncludeDeleted returns true if the list request asks for soft-deleted resources to be included.
+func IncludeDeleted(req *http.Request) bool {
+	include, _ := strconv.ParseBool(req.URL.Query().Get(QueryIncludeDeleted))
+	return include
+}
+
+// IsTeardown returns true if the delete request tears down a soft-deleted resource: the request requires soft delete and
+// the resource, with the given deletion time, is already soft deleted. The output resources of the resource are
+// deleted and the resource is kept, so that it can be restored.
+func IsTeardown(req *http.Request, deletedTime *time.Time) bool {
+	required, _ := strconv.ParseBool(req.Header.Get(HeaderSoftDelete))
+	return required && deletedTime != nil
+}
+
+// Filter returns the items of a list request without the soft-deleted items, unless the request includes them.
+func Filter(req *http.Request, items []store.Object) []store.Object {
+	if IncludeDeleted(req) {
+		return items
+	}
+
+	filtered := []store.Object{}
+	for i := range items {
+		if !IsDeleted(&items[i]) {
+			filtered = append(filtered, items[i])
+		}
+	}
+
+	return filtered
+}
+
+// MarkDeleted marks the objects soft deleted at the given time by the deletion of the resource group or environment
+// with the given ID. Objects that are already soft deleted are skipped: objects deleted earlier on their own or with
+// another resource keep their deletion, so that they are not restored together with this resource, and objects marked
+// by a previous attempt to delete this resource stay marked, so that a failed deletion can be retried.
+func MarkDeleted(ctx context.Context, client store.StorageClient, objs []store.Object, deletedBy string, deletedTime time.Time) error {
+	for i := range objs {
+		current, err := DeletedTime(&objs[i])
+		if err != nil {
+			return err
+		} else if current != nil {
+			continue
+		}
+
+		if err := setDeleted(ctx, client, &objs[i], deletedBy, &deletedTime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Restore restores the objects that were soft deleted by the deletion of the resource group or environment with the
+// given ID, including the objects marked by failed attempts to delete it.
+func Restore(ctx context.Context, client store.StorageClient, objs []store.Object, deletedBy string) error {
+	for i := range objs {
+		metadata := v1.InternalMetadata{}
+		if err := objs[i].As(&metadata); err != nil {
+			return err
+		} else if metadata.DeletedTime == nil || !strings.EqualFold(metadata.DeletedBy, deletedBy) {
+			continue
+		}
+
+		if err := setDeleted(ctx, client, &objs[i], "", nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Teardown tears down the output resources of the soft-deleted children of a resource group or environment through
+// the API of their resource providers. Applications, environments and the resources that are not managed by a
+// resource provider have no output resources of their own and are skipped.
+func Teardown(ctx context.Context, deleter Deleter, children []store.Object) error {
+	for i := range children {
+		child := &children[i]
+		if tierOf(child.ID) != 0 || isSystemResource(child.ID) {
+			continue
+		}
+
+		apiVersion, err := apiVersionOf(child)
+		if err != nil {
+			return err
+		}
+
+		if err := deleter.Teardown(ctx, child.ID, apiVersion); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setDeleted saves the object as deleted by the given resource at the given time, or as not deleted if the time is nil.
+// The object is updated as a map so that resources of any type can be marked.
+func setDeleted(ctx context.Context, client store.StorageClient, obj *store.Object, deletedBy string, deletedTime *time.Time) error {
+	data := map[string]any{}
+	if err := obj.As(&data); err != nil {
+		return err
+	}
+
+	if deletedTime == nil {
+		delete(data, deletedTimeField)
+		delete(data, deletedByField)
+	} else {
+		data[deletedTimeField] = deletedTime.UTC().Format(time.RFC3339Nano)
+		data[deletedByField] = deletedBy
+	}
+
+	return client.Save(ctx, &store.Object{Metadata: obj.Metadata, Data: data}, store.WithETag(obj.ETag))
+}
+
+// ResourceGroupChildren returns the resources stored in the scope of a resource group.
+func ResourceGroupChildren(ctx context.Context, client store.StorageClient, id resources.ID) ([]store.Object, error) {
+	result, err := client.Query(ctx, store.Query{RootScope: id.String(), ScopeRecursive: true})
+	if err != nil {
+		return nil, err
+	}
+
+	children := []store.Object{}
+	for _, item := range result.Items {
+		if !strings.EqualFold(item.ID, id.String()) {
+			children = append(children, item)
+		}
+	}
+
+	return children, nil
+}
+
+// EnvironmentChildren returns the applications of an environment and the resources of the environment and of its
+// applications.
+func EnvironmentChildren(ctx context.Context, client store.StorageClient, id resources.ID) ([]store.Object, error) {
+	return environments.Resources(ctx, client, id.String())
+}
@@ -0,0 +1,274 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package softdelete
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testResourceGroupID = "/planes/radius/local/resourceGroups/test-rg"
+	testEnvironmentID   = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/test-env"
+	testApplicationID   = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/test-app"
+	testContainerID     = "/planes/radius/local/resourceGroups/other-rg/providers/Applications.Core/containers/test-container"
+	testRedisID         = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/test-redis"
+	testOtherAppID      = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/other-app"
+)
+
+func object(id string, data map[string]any) store.Object {
+	data["id"] = id
+	return store.Object{Metadata: store.Metadata{ID: id, ETag: "etag-" + id}, Data: data}
+}
+
+func deletedAt(t time.Time) map[string]any {
+	return map[string]any{"deletedTime": t.Format(time.RFC3339Nano)}
+}
+
+func Test_IncludeDeleted(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/resourcegroups?includeDeleted=true", nil)
+	require.True(t, IncludeDeleted(req))
+
+	req = httptest.NewRequest(http.MethodGet, "/resourcegroups?includeDeleted=invalid", nil)
+	require.False(t, IncludeDeleted(req))
+
+	req = httptest.NewRequest(http.MethodGet, "/resourcegroups", nil)
+	require.False(t, IncludeDeleted(req))
+}
+
+func Test_Filter(t *testing.T) {
+	items := []store.Object{
+		object(testEnvironmentID, map[string]any{}),
+		object(testApplicationID, deletedAt(time.Now())),
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/environments", nil)
+	filtered := Filter(req, items)
+	require.Len(t, filtered, 1)
+	require.Equal(t, testEnvironmentID, filtered[0].ID)
+
+	req = httptest.NewRequest(http.MethodGet, "/environments?includeDeleted=true", nil)
+	require.Len(t, Filter(req, items), 2)
+}
+
+func Test_MarkDeleted_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := store.NewMockStorageClient(ctrl)
+
+	deletedTime := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	earlierTime := deletedTime.Add(-time.Hour)
+
+	saved := map[string]*store.Object{}
+	client.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			saved[obj.ID] = obj
+			return nil
+		}).
+		AnyTimes()
+
+	children := []store.Object{
+		object(testApplicationID, map[string]any{"name": "test-app"}),
+		object(testContainerID, deletedAt(earlierTime)),
+	}
+
+	err := MarkDeleted(context.Background(), client, children, testResourceGroupID, deletedTime)
+	require.NoError(t, err)
+
+	// The container was deleted earlier and keeps its deletion time.
+	require.Len(t, saved, 1)
+	actual, err := DeletedTime(saved[testApplicationID])
+	require.NoError(t, err)
+	require.True(t, deletedTime.Equal(*actual))
+	require.Equal(t, "test-app", saved[testApplicationID].Data.(map[string]any)["name"])
+	require.Equal(t, testResourceGroupID, saved[testApplicationID].Data.(map[string]any)["deletedBy"])
+
+	children = []store.Object{*saved[testApplicationID], children[1]}
+	saved = map[string]*store.Object{}
+
+	err = Restore(context.Background(), client, children, testResourceGroupID)
+	require.NoError(t, err)
+
+	require.Len(t, saved, 1)
+	require.False(t, IsDeleted(saved[testApplicationID]))
+	require.NotContains(t, saved[testApplicationID].Data.(map[string]any), "deletedBy")
+}
+
+func Test_MarkDeleted_Retry_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := store.NewMockStorageClient(ctrl)
+
+	firstTime := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	retryTime := firstTime.Add(time.Minute)
+
+	// The first attempt fails after marking the application.
+	saved := map[string]*store.Object{}
+	save := func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+		saved[obj.ID] = obj
+		return nil
+	}
+	client.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(save)
+	client.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(&store.ErrConcurrency{})
+
+	children := []store.Object{
+		object(testApplicationID, map[string]any{}),
+		object(testRedisID, map[string]any{}),
+		object(testContainerID, deletedAt(firstTime.Add(-time.Hour))),
+	}
+
+	err := MarkDeleted(context.Background(), client, children, testResourceGroupID, firstTime)
+	require.ErrorIs(t, err, &store.ErrConcurrency{})
+	require.Len(t, saved, 1)
+
+	// The retry marks the remaining children with its own time and skips the application marked by the first attempt.
+	client.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(save).AnyTimes()
+
+	children = []store.Object{*saved[testApplicationID], children[1], children[2]}
+	err = MarkDeleted(context.Background(), client, children, testResourceGroupID, retryTime)
+	require.NoError(t, err)
+	require.Len(t, saved, 2)
+
+	actual, err := DeletedTime(saved[testApplicationID])
+	require.NoError(t, err)
+	require.True(t, firstTime.Equal(*actual))
+	actual, err = DeletedTime(saved[testRedisID])
+	require.NoError(t, err)
+	require.True(t, retryTime.Equal(*actual))
+
+	// Both children are restored even though they were marked at different times, the container deleted earlier
+	// on its own stays deleted.
+	children = []store.Object{*saved[testApplicationID], *saved[testRedisID], children[2]}
+	saved = map[string]*store.Object{}
+
+	err = Restore(context.Background(), client, children, testResourceGroupID)
+	require.NoError(t, err)
+
+	require.Len(t, saved, 2)
+	require.False(t, IsDeleted(saved[testApplicationID]))
+	require.False(t, IsDeleted(saved[testRedisID]))
+}
+
+func Test_IsTeardown(t *testing.T) {
+	deletedTime := time.Now()
+
+	req := httptest.NewRequest(http.MethodDelete, "/containers/test-container", nil)
+	req.Header.Set(HeaderSoftDelete, "true")
+	require.True(t, IsTeardown(req, &deletedTime))
+	require.False(t, IsTeardown(req, nil))
+
+	req.Header.Set(HeaderSoftDelete, "false")
+	require.False(t, IsTeardown(req, &deletedTime))
+
+	req.Header.Del(HeaderSoftDelete)
+	require.False(t, IsTeardown(req, &deletedTime))
+}
+
+func Test_Teardown(t *testing.T) {
+	children := []store.Object{
+		object(testEnvironmentID, map[string]any{"updatedApiVersion": "2023-10-01-preview"}),
+		object(testApplicationID, map[string]any{"updatedApiVersion": "2023-10-01-preview"}),
+		object(testContainerID, map[string]any{"createdApiVersion": "2023-10-01-preview"}),
+		object(testTrackedID, map[string]any{"properties": map[string]any{"id": testRedisID}}),
+	}
+
+	deleter := &testDeleter{}
+	err := Teardown(context.Background(), deleter, children)
+	require.NoError(t, err)
+
+	// Only the resources with output resources of their own are torn down, nothing is deleted.
+	require.Equal(t, []deletion{{id: testContainerID, apiVersion: "2023-10-01-preview"}}, deleter.teardowns)
+	require.Empty(t, deleter.deletions)
+}
+
+func Test_Options(t *testing.T) {
+	var options *Options
+	require.False(t, options.TeardownOnDelete())
+
+	options = &Options{Enabled: true}
+	require.True(t, options.TeardownOnDelete())
+
+	options.DeferTeardown = true
+	require.False(t, options.TeardownOnDelete())
+}
+
+func Test_ResourceGroupChildren(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := store.NewMockStorageClient(ctrl)
+
+	client.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: testResourceGroupID, ScopeRecursive: true}).
+		Return(&store.ObjectQueryResult{
+			Items: []store.Object{
+				object(testResourceGroupID, map[string]any{}),
+				object(testEnvironmentID, map[string]any{}),
+				object(testApplicationID, map[string]any{}),
+			},
+		}, nil)
+
+	children, err := ResourceGroupChildren(context.Background(), client, resources.MustParse(testResourceGroupID))
+	require.NoError(t, err)
+	require.Equal(t, []string{testEnvironmentID, testApplicationID}, ids(children))
+}
+
+func Test_EnvironmentChildren(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := store.NewMockStorageClient(ctrl)
+
+	objects := []store.Object{
+		object(testEnvironmentID, map[string]any{}),
+		object(testApplicationID, map[string]any{"properties": map[string]any{"environment": testEnvironmentID}}),
+		object(testOtherAppID, map[string]any{"properties": map[string]any{"environment": "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/other-env"}}),
+		object(testContainerID, map[string]any{"properties": map[string]any{"application": testApplicationID}}),
+		object(testRedisID, map[string]any{"properties": map[string]any{"environment": testEnvironmentID}}),
+	}
+
+	client.EXPECT().
+		Query(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+			require.Equal(t, "/planes/radius/local", query.RootScope)
+			require.True(t, query.ScopeRecursive)
+
+			result := &store.ObjectQueryResult{}
+			for _, obj := range objects {
+				if match, err := obj.MatchesFilters(query.Filters); err == nil && match {
+					result.Items = append(result.Items, obj)
+				}
+			}
+			return result, nil
+		}).
+		Times(2)
+
+	children, err := EnvironmentChildren(context.Background(), client, resources.MustParse(testEnvironmentID))
+	require.NoError(t, err)
+	require.Equal(t, []string{testApplicationID, testRedisID, testContainerID}, ids(children))
+}
+
+func ids(objs []store.Object) []string {
+	result := []string{}
+	for _, obj := range objs {
+		result = append(result, obj.ID)
+	}
+	return result
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package softdelete
+
+import (
+	"time"
+)
+
+const (
+	// HeaderSoftDelete is the request header controlling the deletion of a resource group or environment. The value
+	// "true" requires the resource to be soft deleted and fails with the SoftDeleteNotEnabled error code if soft delete is not
+	// enabled. The value "false" deletes the resource permanently, even if soft delete is enabled. The response to a
+	// delete request that soft deleted the resource carries the header with the value "true".
+	HeaderSoftDelete = "Radius-Soft-Delete"
+
+	// QueryIncludeDeleted is the query parameter of list requests that includes soft-deleted resources in the list.
+	QueryIncludeDeleted = "includeDeleted"
+
+	defaultRetentionPeriod = 7 * 24 * time.Hour
+	defaultPurgeInterval   = time.Hour
+)
+
+// Options configures the soft deletion of resource groups and environments.
+type Options struct {
+	// Enabled enables soft delete. Deleted resource groups and environments, and the resources they contain, are
+	// hidden from lists and can be restored until they are purged.
+	Enabled bool `yaml:"enabled"`
+	// RetentionPeriod is how long soft-deleted resources are kept before they are purged. Defaults to 168h (7 days).
+	RetentionPeriod time.Duration `yaml:"retentionPeriod,omitempty"`
+	// PurgeInterval is the interval between two runs of the purge job. Defaults to 1h.
+	PurgeInterval time.Duration `yaml:"purgeInterval,omitempty"`
+	// DeferTeardown keeps the output resources of soft-deleted resources, such as the Kubernetes objects of
+	// containers, until the resources are purged. By default the output resources are torn down when the resource
+	// group or environment is soft deleted, and restored resources must be redeployed to recreate them.
+	DeferTeardown bool `yaml:"deferTeardown,omitempty"`
+}
+
+// IsEnabled returns true if soft delete is enabled. It is safe to call on nil Options.
+func (o *Options) IsEnabled() bool {
+	return o != nil && o.Enabled
+}
+
+// TeardownOnDelete returns true if the output resources of soft-deleted resources are torn down when they are soft
+// deleted rather than when they are purged.
+func (o *Options) TeardownOnDelete() bool {
+	return o.IsEnabled() && !o.DeferTeardown
+}
+
+// Retention returns the retention period, or the default if it is not set.
+func (o *Options) Retention() time.Duration {
+	if o == nil || o.RetentionPeriod <= 0 {
+		return defaultRetentionPeriod
+	}
+	return o.RetentionPeriod
+}
+
+// Interval returns the purge interval, or the default if it is not set.
+func (o *Options) Interval() time.Duration {
+	if o == nil || o.PurgeInterval <= 0 {
+		return defaultPurgeInterval
+	}
+	return o.PurgeInterval
+}
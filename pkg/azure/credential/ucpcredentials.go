@@ -19,6 +19,7 @@ package credential
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
 	"go.uber.org/atomic"
@@ -34,6 +36,9 @@ import (
 const (
 	// DefaultExpireDuration is the default expiry duration.
 	DefaultExpireDuration = time.Second * time.Duration(30)
+
+	// federatedTokenFileEnv is the environment variable set by the Azure workload identity webhook.
+	federatedTokenFileEnv = "AZURE_FEDERATED_TOKEN_FILE"
 )
 
 var _ azcore.TokenCredential = (*UCPCredential)(nil)
@@ -49,7 +54,7 @@ type UCPCredentialOptions struct {
 	ClientOptions *azcore.ClientOptions
 }
 
-// UCPCredential authenticates service principal using UCP credential APIs.
+// UCPCredential authenticates service principal, workload identity or managed identity using UCP credential APIs.
 type UCPCredential struct {
 	options    UCPCredentialOptions
 	credential *sdk_cred.AzureCredential
@@ -102,28 +107,16 @@ func (c *UCPCredential) refreshCredentials(ctx context.Context) error {
 		return err
 	}
 
-	if s.ClientID == "" || s.ClientSecret == "" || s.TenantID == "" {
-		return errors.New("invalid azure service principal credential info")
-	}
-
-	// Do not instantiate new client unless the secret is rotated.
-	if c.credential != nil && c.credential.ClientSecret == s.ClientSecret &&
-		c.credential.ClientID == s.ClientID && c.credential.TenantID == s.TenantID {
+	// Do not instantiate new client unless the credential is changed.
+	if c.credential != nil && *c.credential == *s {
 		c.refreshExpiry()
 		return nil
 	}
 
-	logger.Info("Retreived Azure Credential - ClientID: " + s.ClientID)
+	logger.Info("Retreived Azure Credential - Kind: " + s.GetKind() + ", ClientID: " + s.ClientID)
 
-	// Rotate credentials by creating new ClientSecretCredential.
-	var opt *azidentity.ClientSecretCredentialOptions
-	if c.options.ClientOptions != nil {
-		opt = &azidentity.ClientSecretCredentialOptions{
-			ClientOptions: *c.options.ClientOptions,
-		}
-	}
-
-	azCred, err := azidentity.NewClientSecretCredential(s.TenantID, s.ClientID, s.ClientSecret, opt)
+	// Rotate credentials by creating new token credential.
+	azCred, err := NewTokenCredential(s, c.options.ClientOptions)
 	if err != nil {
 		return err
 	}
@@ -135,7 +128,53 @@ func (c *UCPCredential) refreshCredentials(ctx context.Context) error {
 	return nil
 }
 
-// GetToken attempts to refresh the Azure service principal credential if it is expired and then returns an
+// NewTokenCredential creates the Azure SDK token credential for the kind of the given UCP Azure credential.
+// clientOptions is optional.
+func NewTokenCredential(s *sdk_cred.AzureCredential, clientOptions *azcore.ClientOptions) (azcore.TokenCredential, error) {
+	var options azcore.ClientOptions
+	if clientOptions != nil {
+		options = *clientOptions
+	}
+
+	switch s.GetKind() {
+	case datamodel.AzureCredentialKind:
+		if s.ClientID == "" || s.ClientSecret == "" || s.TenantID == "" {
+			return nil, errors.New("invalid azure service principal credential info")
+		}
+		return azidentity.NewClientSecretCredential(s.TenantID, s.ClientID, s.ClientSecret, &azidentity.ClientSecretCredentialOptions{
+			ClientOptions: options,
+		})
+
+	case datamodel.AzureWorkloadIdentityCredentialKind:
+		if s.ClientID == "" || s.TenantID == "" {
+			return nil, errors.New("invalid azure workload identity credential info")
+		}
+		// An empty token file defaults to AZURE_FEDERATED_TOKEN_FILE set by the workload identity webhook.
+		tokenFile, err := sdk_cred.ResolveTokenFile(s.TokenFile, federatedTokenFileEnv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid azure workload identity credential info: %w", err)
+		}
+		return azidentity.NewWorkloadIdentityCredential(&azidentity.WorkloadIdentityCredentialOptions{
+			ClientOptions: options,
+			ClientID:      s.ClientID,
+			TenantID:      s.TenantID,
+			TokenFilePath: tokenFile,
+		})
+
+	case datamodel.AzureManagedIdentityCredentialKind:
+		opt := &azidentity.ManagedIdentityCredentialOptions{ClientOptions: options}
+		// Use the system-assigned identity unless the client id of a user-assigned identity is given.
+		if s.ClientID != "" {
+			opt.ID = azidentity.ClientID(s.ClientID)
+		}
+		return azidentity.NewManagedIdentityCredential(opt)
+
+	default:
+		return nil, fmt.Errorf("unsupported azure credential kind %q", s.GetKind())
+	}
+}
+
+// GetToken attempts to refresh the Azure credential if it is expired and then returns an
 // access token if the credential is ready. This method is called automatically by Azure SDK clients.
 func (c *UCPCredential) GetToken(ctx context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -143,7 +182,7 @@ func (c *UCPCredential) GetToken(ctx context.Context, opts policy.TokenRequestOp
 	if c.isExpired() {
 		err := c.refreshCredentials(ctx)
 		if err != nil {
-			logger.Error(err, "failed to refresh Azure service principal credential.")
+			logger.Error(err, "failed to refresh Azure credential.")
 		}
 	}
 
@@ -152,7 +191,7 @@ func (c *UCPCredential) GetToken(ctx context.Context, opts policy.TokenRequestOp
 	c.tokenCredMu.RUnlock()
 
 	if credentialAuth == nil {
-		return azcore.AccessToken{}, errors.New("azure service principal credential is not ready")
+		return azcore.AccessToken{}, errors.New("azure credential is not ready")
 	}
 
 	return credentialAuth.GetToken(ctx, opts)
@@ -21,9 +21,11 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/stretchr/testify/require"
 
 	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 )
 
 type mockProvider struct {
@@ -98,3 +100,74 @@ func TestRefreshCredentials(t *testing.T) {
 		require.Equal(t, old, c.tokenCred)
 	})
 }
+
+func TestNewTokenCredential(t *testing.T) {
+	t.Setenv(federatedTokenFileEnv, "/var/run/secrets/azure/tokens/azure-identity-token")
+	t.Setenv(sdk_cred.AllowedTokenFilesEnv, "/etc/radius/azure-token")
+
+	tests := []struct {
+		name       string
+		credential *sdk_cred.AzureCredential
+		expected   any
+		err        string
+	}{
+		{
+			name:       "service principal",
+			credential: &sdk_cred.AzureCredential{ClientID: "fakeid", TenantID: "faketenant", ClientSecret: "fakeSecret"},
+			expected:   &azidentity.ClientSecretCredential{},
+		},
+		{
+			name:       "service principal without secret",
+			credential: &sdk_cred.AzureCredential{Kind: datamodel.AzureCredentialKind, ClientID: "fakeid", TenantID: "faketenant"},
+			err:        "invalid azure service principal credential info",
+		},
+		{
+			name:       "workload identity",
+			credential: &sdk_cred.AzureCredential{Kind: datamodel.AzureWorkloadIdentityCredentialKind, ClientID: "fakeid", TenantID: "faketenant", TokenFile: "/var/run/secrets/azure/tokens/azure-identity-token"},
+			expected:   &azidentity.WorkloadIdentityCredential{},
+		},
+		{
+			name:       "workload identity with the token file of the webhook",
+			credential: &sdk_cred.AzureCredential{Kind: datamodel.AzureWorkloadIdentityCredentialKind, ClientID: "fakeid", TenantID: "faketenant"},
+			expected:   &azidentity.WorkloadIdentityCredential{},
+		},
+		{
+			name:       "workload identity with an allowed token file",
+			credential: &sdk_cred.AzureCredential{Kind: datamodel.AzureWorkloadIdentityCredentialKind, ClientID: "fakeid", TenantID: "faketenant", TokenFile: "/etc/radius/azure-token"},
+			expected:   &azidentity.WorkloadIdentityCredential{},
+		},
+		{
+			name:       "workload identity with a token file that is not allowed",
+			credential: &sdk_cred.AzureCredential{Kind: datamodel.AzureWorkloadIdentityCredentialKind, ClientID: "fakeid", TenantID: "faketenant", TokenFile: "/etc/shadow"},
+			err:        "invalid azure workload identity credential info: the token file \"/etc/shadow\" is not allowed: it must be the token file set in AZURE_FEDERATED_TOKEN_FILE or be listed in RADIUS_ALLOWED_TOKEN_FILES",
+		},
+		{
+			name:       "workload identity without tenant",
+			credential: &sdk_cred.AzureCredential{Kind: datamodel.AzureWorkloadIdentityCredentialKind, ClientID: "fakeid"},
+			err:        "invalid azure workload identity credential info",
+		},
+		{
+			name:       "managed identity",
+			credential: &sdk_cred.AzureCredential{Kind: datamodel.AzureManagedIdentityCredentialKind, ClientID: "fakeid"},
+			expected:   &azidentity.ManagedIdentityCredential{},
+		},
+		{
+			name:       "unsupported kind",
+			credential: &sdk_cred.AzureCredential{Kind: "Certificate"},
+			err:        "unsupported azure credential kind \"Certificate\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cred, err := NewTokenCredential(tt.credential, nil)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.IsType(t, tt.expected, cred)
+		})
+	}
+}
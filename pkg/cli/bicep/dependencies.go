@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bicep
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	// moduleReference matches the path of a module declaration, eg: module storage 'storage.bicep' = {
+	moduleReference = regexp.MustCompile(`(?m)^\s*module\s+\w+\s+'([^']+)'`)
+
+	// importReference matches the path of a compile-time import, eg: import { config } from 'shared.bicep'
+	importReference = regexp.MustCompile(`(?m)^\s*import\s+.*?\bfrom\s+'([^']+)'`)
+
+	// loadReference matches the path of a file loaded with one of the load*() functions, eg: loadTextContent('script.sh')
+	loadReference = regexp.MustCompile(`\bload(?:TextContent|JsonContent|YamlContent|FileAsBase64)\(\s*'([^']+)'`)
+
+	// registryReference matches module paths that refer to a registry or template spec rather than a local file,
+	// eg: br:myregistry.azurecr.io/storage:v1 or br/public:avm/res/storage:0.1.0.
+	registryReference = regexp.MustCompile(`^[a-zA-Z]+(/[a-zA-Z0-9-]+)?:`)
+)
+
+// Dependencies returns the local files a template depends on, including the template itself: modules, compile-time
+// imports and files loaded with the load*() functions, found recursively. Modules in registries are not included.
+// The paths are absolute and sorted. ARM-JSON templates have no dependencies besides themselves.
+func Dependencies(filePath string) ([]string, error) {
+	absolute, err := filepath.Abs(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	found := map[string]bool{}
+	err = findDependencies(absolute, found)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []string{}
+	for path := range found {
+		results = append(results, path)
+	}
+	sort.Strings(results)
+	return results, nil
+}
+
+func findDependencies(filePath string, found map[string]bool) error {
+	if found[filePath] {
+		return nil
+	}
+	found[filePath] = true
+
+	if !strings.EqualFold(filepath.Ext(filePath), ".bicep") {
+		return nil
+	}
+
+	b, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("could not read file %q: %w", filePath, err)
+	}
+	content := string(b)
+
+	directory := filepath.Dir(filePath)
+	for _, expression := range []*regexp.Regexp{moduleReference, importReference, loadReference} {
+		for _, match := range expression.FindAllStringSubmatch(content, -1) {
+			reference := match[1]
+			if registryReference.MatchString(reference) {
+				continue
+			}
+
+			err := findDependencies(filepath.Join(directory, filepath.FromSlash(reference)), found)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
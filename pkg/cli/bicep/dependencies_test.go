@@ -0,0 +1,54 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bicep
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Dependencies(t *testing.T) {
+	directory, err := filepath.Abs("testdata/dependencies")
+	require.NoError(t, err)
+
+	dependencies, err := Dependencies("testdata/dependencies/app.bicep")
+	require.NoError(t, err)
+
+	expected := []string{
+		filepath.Join(directory, "app.bicep"),
+		filepath.Join(directory, "modules", "storage.bicep"),
+		filepath.Join(directory, "scripts", "init.sh"),
+		filepath.Join(directory, "shared.bicep"),
+	}
+	require.Equal(t, expected, dependencies)
+}
+
+func Test_Dependencies_JSON(t *testing.T) {
+	dependencies, err := Dependencies("testdata/test-noenv.json")
+	require.NoError(t, err)
+
+	expected, err := filepath.Abs("testdata/test-noenv.json")
+	require.NoError(t, err)
+	require.Equal(t, []string{expected}, dependencies)
+}
+
+func Test_Dependencies_MissingModule(t *testing.T) {
+	_, err := Dependencies("testdata/does-not-exist.bicep")
+	require.Error(t, err)
+}
@@ -0,0 +1,609 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bicep
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// UnknownValue is a placeholder for a value in a template that can only be determined during deployment, for example
+// the output of a reference() to a property computed by the resource provider.
+type UnknownValue struct {
+	// Expression is the template expression that could not be evaluated.
+	Expression string
+
+	// Secure is true if the value is a secure parameter. Secure parameters are never evaluated so that their values
+	// are not displayed.
+	Secure bool
+}
+
+// String returns a description of the unknown value suitable for display.
+func (u UnknownValue) String() string {
+	if u.Secure {
+		return "(secure value)"
+	}
+	return "(known after deployment)"
+}
+
+// MarshalJSON marshals the unknown value as its display string.
+func (u UnknownValue) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(u.String())), nil
+}
+
+// IsUnknown returns true if the value is, or contains, a value that can only be determined during deployment.
+func IsUnknown(value any) bool {
+	switch v := value.(type) {
+	case UnknownValue:
+		return true
+	case map[string]any:
+		for _, item := range v {
+			if IsUnknown(item) {
+				return true
+			}
+		}
+	case []any:
+		for _, item := range v {
+			if IsUnknown(item) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// evaluator evaluates the subset of ARM template expressions that can be resolved without deploying the template:
+// parameters, variables, the id and name of resources declared in the template, and string functions. Everything
+// else evaluates to an UnknownValue.
+type evaluator struct {
+	scope      string
+	template   map[string]any
+	parameters map[string]map[string]any
+	resources  map[string]*TemplateResource
+
+	// resolving is used to detect cycles between variables and resource names.
+	resolving map[string]bool
+}
+
+// evaluateValue evaluates every expression found in the value, walking maps and slices.
+func (e *evaluator) evaluateValue(value any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		if !isExpression(v) {
+			// A leading '[[' escapes a literal '['.
+			if strings.HasPrefix(v, "[[") {
+				return v[1:], nil
+			}
+			return v, nil
+		}
+		return e.evaluateExpression(v[1 : len(v)-1])
+	case map[string]any:
+		result := map[string]any{}
+		for key, item := range v {
+			evaluated, err := e.evaluateValue(item)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = evaluated
+		}
+		return result, nil
+	case []any:
+		result := []any{}
+		for _, item := range v {
+			evaluated, err := e.evaluateValue(item)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, evaluated)
+		}
+		return result, nil
+	default:
+		return value, nil
+	}
+}
+
+func isExpression(value string) bool {
+	return strings.HasPrefix(value, "[") && !strings.HasPrefix(value, "[[") && strings.HasSuffix(value, "]")
+}
+
+// evaluateExpression evaluates a single template expression, without the surrounding brackets.
+func (e *evaluator) evaluateExpression(expression string) (any, error) {
+	p := &expressionParser{input: expression}
+	node, err := p.parse()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template expression %q: %w", expression, err)
+	}
+
+	value, err := e.evaluateNode(node)
+	if err != nil {
+		return nil, err
+	}
+
+	if unknown, ok := value.(UnknownValue); ok {
+		unknown.Expression = "[" + expression + "]"
+		return unknown, nil
+	}
+	return value, nil
+}
+
+func (e *evaluator) evaluateNode(node expressionNode) (any, error) {
+	switch n := node.(type) {
+	case literalNode:
+		return n.value, nil
+	case propertyNode:
+		target, err := e.evaluateNode(n.target)
+		if err != nil {
+			return nil, err
+		}
+		key, err := e.evaluateNode(n.property)
+		if err != nil {
+			return nil, err
+		}
+		return accessProperty(target, key), nil
+	case callNode:
+		args := []any{}
+		for _, arg := range n.args {
+			value, err := e.evaluateNode(arg)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, value)
+		}
+		return e.call(n.name, args)
+	default:
+		return nil, fmt.Errorf("unsupported expression node %T", node)
+	}
+}
+
+func accessProperty(target any, key any) any {
+	switch t := target.(type) {
+	case map[string]any:
+		name, ok := key.(string)
+		if !ok {
+			return UnknownValue{}
+		}
+		for k, v := range t {
+			if strings.EqualFold(k, name) {
+				return v
+			}
+		}
+		return UnknownValue{}
+	case []any:
+		index, ok := key.(float64)
+		if !ok || int(index) < 0 || int(index) >= len(t) {
+			return UnknownValue{}
+		}
+		return t[int(index)]
+	default:
+		return UnknownValue{}
+	}
+}
+
+func (e *evaluator) call(name string, args []any) (any, error) {
+	name = strings.ToLower(name)
+
+	// reference() can return a partially known object, so it is evaluated before checking the arguments.
+	if name == "reference" {
+		return e.reference(args), nil
+	}
+
+	for _, arg := range args {
+		if IsUnknown(arg) {
+			return UnknownValue{}, nil
+		}
+	}
+
+	switch name {
+	case "parameters":
+		return e.parameter(args)
+	case "variables":
+		return e.variable(args)
+	case "resourceid":
+		return e.resourceID(args), nil
+	case "concat":
+		return concat(args), nil
+	case "format":
+		return format(args), nil
+	case "tolower":
+		if s, ok := singleString(args); ok {
+			return strings.ToLower(s), nil
+		}
+	case "toupper":
+		if s, ok := singleString(args); ok {
+			return strings.ToUpper(s), nil
+		}
+	case "string":
+		if len(args) == 1 {
+			if s, ok := args[0].(string); ok {
+				return s, nil
+			}
+			return fmt.Sprint(args[0]), nil
+		}
+	case "if":
+		if len(args) == 3 {
+			if condition, ok := args[0].(bool); ok {
+				if condition {
+					return args[1], nil
+				}
+				return args[2], nil
+			}
+		}
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+
+	return UnknownValue{}, nil
+}
+
+func (e *evaluator) parameter(args []any) (any, error) {
+	name, ok := singleString(args)
+	if !ok {
+		return UnknownValue{}, nil
+	}
+
+	definitions, _ := e.template["parameters"].(map[string]any)
+	for key, definition := range definitions {
+		definitionMap, _ := definition.(map[string]any)
+		parameterType, _ := definitionMap["type"].(string)
+		if strings.EqualFold(key, name) && (strings.EqualFold(parameterType, "securestring") || strings.EqualFold(parameterType, "secureobject")) {
+			return UnknownValue{Secure: true}, nil
+		}
+	}
+
+	for key, value := range e.parameters {
+		if strings.EqualFold(key, name) {
+			return value["value"], nil
+		}
+	}
+
+	for key, definition := range definitions {
+		if !strings.EqualFold(key, name) {
+			continue
+		}
+
+		definitionMap, _ := definition.(map[string]any)
+		if defaultValue, ok := definitionMap["defaultValue"]; ok {
+			return e.resolve("parameters/"+key, defaultValue)
+		}
+		return UnknownValue{}, nil
+	}
+
+	return nil, fmt.Errorf("the template parameter %q is not defined", name)
+}
+
+func (e *evaluator) variable(args []any) (any, error) {
+	name, ok := singleString(args)
+	if !ok {
+		return UnknownValue{}, nil
+	}
+
+	variables, _ := e.template["variables"].(map[string]any)
+	for key, value := range variables {
+		if strings.EqualFold(key, name) {
+			return e.resolve("variables/"+key, value)
+		}
+	}
+
+	return nil, fmt.Errorf("the template variable %q is not defined", name)
+}
+
+// resolve evaluates a value that may itself refer to other values, guarding against cycles.
+func (e *evaluator) resolve(key string, value any) (any, error) {
+	if e.resolving[key] {
+		return nil, fmt.Errorf("the template contains a circular reference involving %q", key)
+	}
+
+	e.resolving[key] = true
+	defer delete(e.resolving, key)
+	return e.evaluateValue(value)
+}
+
+// reference returns the id, name and type of a resource declared in the template. The other properties of the
+// resource are only known after deployment.
+func (e *evaluator) reference(args []any) any {
+	if len(args) == 0 {
+		return UnknownValue{}
+	}
+
+	symbolicName, ok := args[0].(string)
+	if !ok {
+		return UnknownValue{}
+	}
+
+	resource, ok := e.resources[strings.ToLower(symbolicName)]
+	if !ok {
+		return UnknownValue{}
+	}
+
+	err := e.resolveName(resource)
+	if err != nil || resource.Unresolved {
+		return UnknownValue{}
+	}
+
+	return map[string]any{
+		"id":         resource.ID,
+		"name":       resource.Name,
+		"type":       resource.Type,
+		"properties": UnknownValue{},
+	}
+}
+
+func (e *evaluator) resourceID(args []any) any {
+	segments := []string{}
+	for _, arg := range args {
+		s, ok := arg.(string)
+		if !ok {
+			return UnknownValue{}
+		}
+		segments = append(segments, s)
+	}
+
+	// resourceId([subscriptionId], [resourceGroupName], resourceType, name1, [name2, ...]) - only the form
+	// without a scope is supported because Radius resources are always deployed to the scope of the deployment.
+	if len(segments) < 2 || !strings.Contains(segments[0], "/") {
+		return UnknownValue{}
+	}
+
+	typeSegments := strings.Split(segments[0], "/")
+	names := segments[1:]
+	if len(typeSegments)-1 != len(names) {
+		return UnknownValue{}
+	}
+
+	id := e.scope + "/providers/" + typeSegments[0]
+	for i, name := range names {
+		id += "/" + typeSegments[i+1] + "/" + name
+	}
+	return id
+}
+
+func concat(args []any) any {
+	if len(args) > 0 {
+		if _, ok := args[0].([]any); ok {
+			result := []any{}
+			for _, arg := range args {
+				items, ok := arg.([]any)
+				if !ok {
+					return UnknownValue{}
+				}
+				result = append(result, items...)
+			}
+			return result
+		}
+	}
+
+	result := strings.Builder{}
+	for _, arg := range args {
+		result.WriteString(toString(arg))
+	}
+	return result.String()
+}
+
+func format(args []any) any {
+	if len(args) == 0 {
+		return UnknownValue{}
+	}
+
+	formatString, ok := args[0].(string)
+	if !ok {
+		return UnknownValue{}
+	}
+
+	result := formatString
+	for i, arg := range args[1:] {
+		result = strings.ReplaceAll(result, "{"+strconv.Itoa(i)+"}", toString(arg))
+	}
+	return result
+}
+
+func toString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func singleString(args []any) (string, bool) {
+	if len(args) != 1 {
+		return "", false
+	}
+	s, ok := args[0].(string)
+	return s, ok
+}
+
+type expressionNode any
+
+type literalNode struct {
+	value any
+}
+
+type callNode struct {
+	name string
+	args []expressionNode
+}
+
+type propertyNode struct {
+	target   expressionNode
+	property expressionNode
+}
+
+// expressionParser is a recursive descent parser for ARM template expressions.
+type expressionParser struct {
+	input string
+	pos   int
+}
+
+func (p *expressionParser) parse() (expressionNode, error) {
+	node, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipWhitespace()
+	if p.pos != len(p.input) {
+		return nil, fmt.Errorf("unexpected character %q at position %d", p.input[p.pos], p.pos)
+	}
+	return node, nil
+}
+
+func (p *expressionParser) parseExpression() (expressionNode, error) {
+	node, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		p.skipWhitespace()
+		if p.consume('.') {
+			name := p.parseIdentifier()
+			if name == "" {
+				return nil, fmt.Errorf("expected property name at position %d", p.pos)
+			}
+			node = propertyNode{target: node, property: literalNode{value: name}}
+		} else if p.consume('[') {
+			index, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			p.skipWhitespace()
+			if !p.consume(']') {
+				return nil, fmt.Errorf("expected ']' at position %d", p.pos)
+			}
+			node = propertyNode{target: node, property: index}
+		} else {
+			return node, nil
+		}
+	}
+}
+
+func (p *expressionParser) parsePrimary() (expressionNode, error) {
+	p.skipWhitespace()
+	if p.pos >= len(p.input) {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+
+	c := rune(p.input[p.pos])
+	switch {
+	case c == '\'':
+		return p.parseString()
+	case c == '-' || unicode.IsDigit(c):
+		return p.parseNumber()
+	case unicode.IsLetter(c) || c == '_':
+		name := p.parseIdentifier()
+		p.skipWhitespace()
+		if !p.consume('(') {
+			return callNode{name: name}, nil
+		}
+
+		args := []expressionNode{}
+		p.skipWhitespace()
+		if p.consume(')') {
+			return callNode{name: name, args: args}, nil
+		}
+		for {
+			arg, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+
+			p.skipWhitespace()
+			if p.consume(')') {
+				return callNode{name: name, args: args}, nil
+			}
+			if !p.consume(',') {
+				return nil, fmt.Errorf("expected ',' or ')' at position %d", p.pos)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unexpected character %q at position %d", c, p.pos)
+	}
+}
+
+func (p *expressionParser) parseString() (expressionNode, error) {
+	// Skip the opening quote. Quotes inside a string are escaped by doubling them.
+	p.pos++
+	result := strings.Builder{}
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		p.pos++
+		if c != '\'' {
+			result.WriteByte(c)
+			continue
+		}
+
+		if p.pos < len(p.input) && p.input[p.pos] == '\'' {
+			result.WriteByte('\'')
+			p.pos++
+			continue
+		}
+		return literalNode{value: result.String()}, nil
+	}
+
+	return nil, fmt.Errorf("unterminated string literal")
+}
+
+func (p *expressionParser) parseNumber() (expressionNode, error) {
+	start := p.pos
+	p.pos++
+	for p.pos < len(p.input) && (unicode.IsDigit(rune(p.input[p.pos])) || p.input[p.pos] == '.') {
+		p.pos++
+	}
+
+	value, err := strconv.ParseFloat(p.input[start:p.pos], 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number %q", p.input[start:p.pos])
+	}
+	return literalNode{value: value}, nil
+}
+
+func (p *expressionParser) parseIdentifier() string {
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := rune(p.input[p.pos])
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '_' {
+			break
+		}
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *expressionParser) consume(c byte) bool {
+	if p.pos < len(p.input) && p.input[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *expressionParser) skipWhitespace() {
+	for p.pos < len(p.input) && unicode.IsSpace(rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
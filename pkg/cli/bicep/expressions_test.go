@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bicep
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EvaluateValue(t *testing.T) {
+	e := &evaluator{
+		scope: "/planes/radius/local/resourceGroups/test-group",
+		template: map[string]any{
+			"parameters": map[string]any{
+				"name":     map[string]any{"type": "string"},
+				"replicas": map[string]any{"type": "int", "defaultValue": float64(3)},
+				"password": map[string]any{"type": "secureString"},
+				"settings": map[string]any{"type": "secureObject", "defaultValue": map[string]any{"key": "secret"}},
+			},
+			"variables": map[string]any{
+				"greeting": "[format('Hello, {0}!', parameters('name'))]",
+				"loop":     "[variables('loop')]",
+			},
+		},
+		parameters: map[string]map[string]any{"name": {"value": "world"}, "password": {"value": "hunter2"}},
+		resources:  map[string]*TemplateResource{},
+		resolving:  map[string]bool{},
+	}
+
+	testcases := []struct {
+		name     string
+		input    any
+		expected any
+	}{
+		{name: "literal", input: "hello", expected: "hello"},
+		{name: "escaped bracket", input: "[[hello]", expected: "[hello]"},
+		{name: "parameter", input: "[parameters('name')]", expected: "world"},
+		{name: "parameter default value", input: "[parameters('replicas')]", expected: float64(3)},
+		{name: "variable", input: "[variables('greeting')]", expected: "Hello, world!"},
+		{name: "concat", input: "[concat('a', '-', parameters('name'))]", expected: "a-world"},
+		{name: "escaped quote", input: "[concat('it''s ', parameters('name'))]", expected: "it's world"},
+		{name: "toLower", input: "[toLower('ABC')]", expected: "abc"},
+		{name: "string of number", input: "[string(parameters('replicas'))]", expected: "3"},
+		{name: "if", input: "[if(true(), 'yes', 'no')]", expected: "yes"},
+		{name: "resourceId", input: "[resourceId('Applications.Core/containers', 'frontend')]", expected: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend"},
+		{name: "property access", input: "[createObject('a', 'b').a]", expected: UnknownValue{Expression: "[createObject('a', 'b').a]"}},
+		{name: "unsupported function", input: "[utcNow()]", expected: UnknownValue{Expression: "[utcNow()]"}},
+		{name: "secure parameter", input: "[parameters('password')]", expected: UnknownValue{Expression: "[parameters('password')]", Secure: true}},
+		{name: "secure parameter default value", input: "[parameters('Settings')]", expected: UnknownValue{Expression: "[parameters('Settings')]", Secure: true}},
+		{name: "secure parameter in function", input: "[concat('pass-', parameters('password'))]", expected: UnknownValue{Expression: "[concat('pass-', parameters('password'))]"}},
+		{name: "nested values", input: map[string]any{"list": []any{"[parameters('name')]", float64(1)}}, expected: map[string]any{"list": []any{"world", float64(1)}}},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := e.evaluateValue(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+
+	t.Run("undefined parameter", func(t *testing.T) {
+		_, err := e.evaluateValue("[parameters('missing')]")
+		require.Error(t, err)
+	})
+
+	t.Run("circular variable", func(t *testing.T) {
+		_, err := e.evaluateValue("[variables('loop')]")
+		require.Error(t, err)
+	})
+
+	t.Run("invalid expression", func(t *testing.T) {
+		_, err := e.evaluateValue("[concat('a', ]")
+		require.Error(t, err)
+	})
+}
+
+func Test_UnknownValue_String(t *testing.T) {
+	require.Equal(t, "(known after deployment)", UnknownValue{}.String())
+	require.Equal(t, "(secure value)", UnknownValue{Expression: "[parameters('password')]", Secure: true}.String())
+}
+
+func Test_IsUnknown(t *testing.T) {
+	require.False(t, IsUnknown("value"))
+	require.True(t, IsUnknown(UnknownValue{}))
+	require.True(t, IsUnknown(map[string]any{"a": []any{UnknownValue{}}}))
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bicep
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// RadiusExtensionName is the name of the Bicep extension that provides the Radius resource types.
+	RadiusExtensionName = "Radius"
+
+	// radiusNamespacePrefix is the prefix of the namespaces of the Radius resource types.
+	radiusNamespacePrefix = "applications."
+)
+
+// TemplateResource is a Radius resource declared in a compiled template, with the template expressions in its
+// body evaluated as far as possible without deploying the template.
+type TemplateResource struct {
+	// SymbolicName is the symbolic name of the resource in the template.
+	SymbolicName string
+
+	// Type is the fully-qualified resource type, without the API version. eg: Applications.Core/containers.
+	Type string
+
+	// APIVersion is the API version of the resource type used in the template.
+	APIVersion string
+
+	// Name is the name of the resource.
+	Name string
+
+	// ID is the resource ID that the resource will have once deployed.
+	ID string
+
+	// Body is the evaluated body of the resource, including the name, location, tags and properties. Values that
+	// can only be determined during deployment are represented by UnknownValue.
+	Body map[string]any
+
+	// Unresolved is true when the name of the resource can only be determined during deployment.
+	Unresolved bool
+}
+
+// RadiusResources returns the Radius resources declared in a compiled template, sorted by type and name. Resources
+// marked as existing and resources with a false condition are not included since they will not be deployed.
+// The parameters are the deployment parameters, and the scope is the scope the template is deployed to, eg:
+// /planes/radius/local/resourceGroups/default.
+func RadiusResources(template map[string]any, parameters map[string]map[string]any, scope string) ([]TemplateResource, error) {
+	e := &evaluator{
+		scope:      scope,
+		template:   template,
+		parameters: parameters,
+		resources:  map[string]*TemplateResource{},
+		resolving:  map[string]bool{},
+	}
+
+	declarations := map[string]map[string]any{}
+	switch resources := template["resources"].(type) {
+	case map[string]any:
+		// Symbolic name templates (languageVersion 2.0), which are used by Bicep extensibility.
+		for symbolicName, resource := range resources {
+			declaration, ok := resource.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("the template resource %q is invalid", symbolicName)
+			}
+			declarations[symbolicName] = declaration
+		}
+	case []any:
+		for i, resource := range resources {
+			declaration, ok := resource.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("the template resource at index %d is invalid", i)
+			}
+			declarations[fmt.Sprintf("resources[%d]", i)] = declaration
+		}
+	}
+
+	for symbolicName, declaration := range declarations {
+		if !isRadiusResource(template, declaration) {
+			continue
+		}
+
+		resourceType, _ := declaration["type"].(string)
+		resourceType, apiVersion, _ := strings.Cut(resourceType, "@")
+		if apiVersion == "" {
+			apiVersion, _ = declaration["apiVersion"].(string)
+		}
+
+		e.resources[strings.ToLower(symbolicName)] = &TemplateResource{
+			SymbolicName: symbolicName,
+			Type:         resourceType,
+			APIVersion:   apiVersion,
+		}
+	}
+
+	results := []TemplateResource{}
+	for key, resource := range e.resources {
+		declaration := declarations[resource.SymbolicName]
+
+		if existing, _ := declaration["existing"].(bool); existing {
+			continue
+		}
+
+		if condition, ok := declaration["condition"]; ok {
+			evaluated, err := e.evaluateValue(condition)
+			if err != nil {
+				return nil, err
+			}
+			if value, ok := evaluated.(bool); ok && !value {
+				continue
+			}
+		}
+
+		err := e.resolveName(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := e.evaluateValue(resourceBody(declaration))
+		if err != nil {
+			return nil, err
+		}
+		resource.Body, _ = body.(map[string]any)
+
+		results = append(results, *e.resources[key])
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if !strings.EqualFold(results[i].Type, results[j].Type) {
+			return strings.ToLower(results[i].Type) < strings.ToLower(results[j].Type)
+		}
+		if results[i].Name != results[j].Name {
+			return results[i].Name < results[j].Name
+		}
+		return results[i].SymbolicName < results[j].SymbolicName
+	})
+
+	return results, nil
+}
+
+// resolveName evaluates the name and ID of a resource declared in the template.
+func (e *evaluator) resolveName(resource *TemplateResource) error {
+	if resource.Name != "" || resource.Unresolved {
+		return nil
+	}
+
+	var declaration map[string]any
+	switch resources := e.template["resources"].(type) {
+	case map[string]any:
+		declaration, _ = resources[resource.SymbolicName].(map[string]any)
+	case []any:
+		var index int
+		if _, err := fmt.Sscanf(resource.SymbolicName, "resources[%d]", &index); err == nil && index < len(resources) {
+			declaration, _ = resources[index].(map[string]any)
+		}
+	}
+
+	name, err := e.resolve("resources/"+resource.SymbolicName, resourceBody(declaration)["name"])
+	if err != nil {
+		return err
+	}
+
+	if s, ok := name.(string); ok && s != "" {
+		resource.Name = s
+		resource.ID = e.scope + "/providers/" + resource.Type + "/" + s
+	} else {
+		resource.Unresolved = true
+	}
+
+	return nil
+}
+
+// resourceBody returns the body of a resource declaration. Resources provided by extensions nest the body
+// under "properties", while other resources declare it inline.
+func resourceBody(declaration map[string]any) map[string]any {
+	if hasExtension(declaration) {
+		body, _ := declaration["properties"].(map[string]any)
+		if body == nil {
+			return map[string]any{}
+		}
+		return body
+	}
+
+	body := map[string]any{}
+	for _, key := range []string{"name", "location", "tags", "properties"} {
+		if value, ok := declaration[key]; ok {
+			body[key] = value
+		}
+	}
+	return body
+}
+
+func hasExtension(declaration map[string]any) bool {
+	return extensionAlias(declaration) != ""
+}
+
+func extensionAlias(declaration map[string]any) string {
+	for _, key := range []string{"import", "extension"} {
+		if alias, ok := declaration[key].(string); ok {
+			return alias
+		}
+	}
+	return ""
+}
+
+// isRadiusResource returns true if the resource is provided by the Radius extension, or has a Radius resource type.
+func isRadiusResource(template map[string]any, declaration map[string]any) bool {
+	if alias := extensionAlias(declaration); alias != "" {
+		for _, key := range []string{"imports", "extensions"} {
+			extensions, _ := template[key].(map[string]any)
+			extension, _ := extensions[alias].(map[string]any)
+			for _, nameKey := range []string{"provider", "name"} {
+				if name, _ := extension[nameKey].(string); strings.EqualFold(name, RadiusExtensionName) {
+					return true
+				}
+			}
+		}
+	}
+
+	resourceType, _ := declaration["type"].(string)
+	return strings.HasPrefix(strings.ToLower(resourceType), radiusNamespacePrefix)
+}
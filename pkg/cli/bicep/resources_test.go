@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bicep
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RadiusResources(t *testing.T) {
+	template, err := ReadARMJSON("testdata/test-radius-resources.json")
+	require.NoError(t, err)
+
+	scope := "/planes/radius/local/resourceGroups/test-group"
+	parameters := map[string]map[string]any{
+		"environment": {"value": scope + "/providers/Applications.Core/environments/default"},
+	}
+
+	resources, err := RadiusResources(template, parameters, scope)
+	require.NoError(t, err)
+
+	expected := []TemplateResource{
+		{
+			SymbolicName: "app",
+			Type:         "Applications.Core/applications",
+			APIVersion:   "2023-10-01-preview",
+			Name:         "demo-app",
+			ID:           scope + "/providers/Applications.Core/applications/demo-app",
+			Body: map[string]any{
+				"name": "demo-app",
+				"properties": map[string]any{
+					"environment": scope + "/providers/Applications.Core/environments/default",
+				},
+			},
+		},
+		{
+			SymbolicName: "frontend",
+			Type:         "Applications.Core/containers",
+			APIVersion:   "2023-10-01-preview",
+			Name:         "demo-frontend",
+			ID:           scope + "/providers/Applications.Core/containers/demo-frontend",
+			Body: map[string]any{
+				"name": "demo-frontend",
+				"properties": map[string]any{
+					"application": scope + "/providers/Applications.Core/applications/demo-app",
+					"container": map[string]any{
+						"image": "nginx:latest",
+						"env": map[string]any{
+							"BACKEND": UnknownValue{Expression: "[reference('backend').properties.url]"},
+						},
+					},
+				},
+			},
+		},
+	}
+	require.Equal(t, expected, resources)
+
+	t.Run("condition true", func(t *testing.T) {
+		parameters["deployCache"] = map[string]any{"value": true}
+		resources, err := RadiusResources(template, parameters, scope)
+		require.NoError(t, err)
+		require.Len(t, resources, 3)
+		require.Equal(t, "cache", resources[2].Name)
+	})
+
+	t.Run("unresolved name", func(t *testing.T) {
+		template := map[string]any{
+			"resources": map[string]any{
+				"app": map[string]any{
+					"type":       "Applications.Core/applications@2023-10-01-preview",
+					"name":       "[utcNow()]",
+					"properties": map[string]any{},
+				},
+			},
+		}
+
+		resources, err := RadiusResources(template, nil, scope)
+		require.NoError(t, err)
+		require.Len(t, resources, 1)
+		require.True(t, resources[0].Unresolved)
+		require.Empty(t, resources[0].ID)
+	})
+}
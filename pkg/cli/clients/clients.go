@@ -95,14 +95,73 @@ type DiagnosticsClient interface {
 	Expose(ctx context.Context, options ExposeOptions) (failed chan error, stop chan struct{}, signals chan os.Signal, err error)
 	Logs(ctx context.Context, options LogsOptions) ([]LogStream, error)
 	GetPublicEndpoint(ctx context.Context, options EndpointOptions) (*string, error)
+	GetResourceHealth(ctx context.Context, options ResourceHealthOptions) (*ResourceHealth, error)
 }
 
 type ApplicationStatus struct {
 	Name          string
 	ResourceCount int
+	Health        string
+	Resources     []ResourceHealth
 	Gateways      []GatewayStatus
 }
 
+const (
+	// HealthStateHealthy is the health state of a resource whose output resources are all healthy.
+	HealthStateHealthy = "Healthy"
+
+	// HealthStateUnknown is the health state of a resource whose health could not be determined.
+	HealthStateUnknown = "Unknown"
+
+	// HealthStateDegraded is the health state of a resource that is working, but not at full capacity,
+	// for example a deployment with only some of its replicas ready.
+	HealthStateDegraded = "Degraded"
+
+	// HealthStateUnhealthy is the health state of a resource that is not working.
+	HealthStateUnhealthy = "Unhealthy"
+)
+
+// WorstHealthState returns the least healthy of the given health states. HealthStateHealthy is returned if no
+// states are given.
+func WorstHealthState(states ...string) string {
+	severity := map[string]int{
+		HealthStateHealthy:   0,
+		HealthStateUnknown:   1,
+		HealthStateDegraded:  2,
+		HealthStateUnhealthy: 3,
+	}
+
+	worst := HealthStateHealthy
+	for _, state := range states {
+		if severity[state] > severity[worst] {
+			worst = state
+		}
+	}
+
+	return worst
+}
+
+// ResourceHealthOptions describes the resource to compute the health of.
+type ResourceHealthOptions struct {
+	Resource generated.GenericResource
+}
+
+// ResourceHealth is the health of a Radius resource, rolled up from the health of its output resources.
+type ResourceHealth struct {
+	Name            string
+	Type            string
+	State           string
+	Message         string
+	OutputResources []OutputResourceHealth
+}
+
+// OutputResourceHealth is the health of a single output resource of a Radius resource.
+type OutputResourceHealth struct {
+	ID      string
+	State   string
+	Message string
+}
+
 type GatewayStatus struct {
 	Name     string
 	Endpoint string
@@ -137,13 +196,21 @@ type LogStream struct {
 
 // ApplicationsManagementClient is used to interface with management features like listing resources by app, show details of a resource.
 type ApplicationsManagementClient interface {
-	ListAllResourcesByType(ctx context.Context, resourceType string) ([]generated.GenericResource, error)
-	ListAllResourcesOfTypeInApplication(ctx context.Context, applicationName string, resourceType string) ([]generated.GenericResource, error)
+	ListAllResourcesByType(ctx context.Context, resourceType string, options *generated.GenericResourcesClientListByRootScopeOptions) ([]generated.GenericResource, error)
+	ListAllResourcesOfTypeInApplication(ctx context.Context, applicationName string, resourceType string, options *generated.GenericResourcesClientListByRootScopeOptions) ([]generated.GenericResource, error)
 	ListAllResourcesByApplication(ctx context.Context, applicationName string) ([]generated.GenericResource, error)
 	ListAllResourcesOfTypeInEnvironment(ctx context.Context, environmentName string, resourceType string) ([]generated.GenericResource, error)
 	ListAllResourcesByEnvironment(ctx context.Context, environmentName string) ([]generated.GenericResource, error)
 	ShowResource(ctx context.Context, resourceType string, resourceName string) (generated.GenericResource, error)
+	// ShowResourceWithETag retrieves the resource and the ETag of its current version.
+	ShowResourceWithETag(ctx context.Context, resourceType string, resourceName string) (generated.GenericResource, string, error)
 	DeleteResource(ctx context.Context, resourceType string, resourceName string) (bool, error)
+	// CreateOrUpdateResource creates or updates the resource and waits for the operation to complete. When etag is not
+	// empty, the request fails with a precondition failed error if the resource was modified since the ETag was read.
+	CreateOrUpdateResource(ctx context.Context, resourceType string, resourceName string, resource generated.GenericResource, etag string) (generated.GenericResource, error)
+	// CreateResource creates the resource and waits for the operation to complete. The request fails with a
+	// precondition failed error if the resource already exists.
+	CreateResource(ctx context.Context, resourceType string, resourceName string, resource generated.GenericResource) (generated.GenericResource, error)
 	ListApplications(ctx context.Context) ([]corerp.ApplicationResource, error)
 	ShowApplication(ctx context.Context, applicationName string) (corerp.ApplicationResource, error)
 	GetGraph(ctx context.Context, applicationName string) (corerp.ApplicationGraphResponse, error)
@@ -158,16 +225,46 @@ type ApplicationsManagementClient interface {
 	CreateEnvironment(ctx context.Context, envName string, location string, envProperties *corerp.EnvironmentProperties) error
 
 	// ListEnvironmentsInResourceGroup lists all environments in the configured scope (assumes configured scope is a resource group)
-	ListEnvironmentsInResourceGroup(ctx context.Context) ([]corerp.EnvironmentResource, error)
+	ListEnvironmentsInResourceGroup(ctx context.Context, options *corerp.EnvironmentsClientListByScopeOptions) ([]corerp.EnvironmentResource, error)
 
 	// ListEnvironmentsAll lists all environments across resource groups.
 	ListEnvironmentsAll(ctx context.Context) ([]corerp.EnvironmentResource, error)
 	GetEnvDetails(ctx context.Context, envName string) (corerp.EnvironmentResource, error)
 	DeleteEnv(ctx context.Context, envName string) (bool, error)
+
+	// RestoreEnv restores the soft deleted environment with the given name.
+	RestoreEnv(ctx context.Context, envName string) (corerp.EnvironmentResource, error)
 	CreateUCPGroup(ctx context.Context, planeType string, planeName string, resourceGroupName string, resourceGroup ucp_v20231001preview.ResourceGroupResource) error
 	DeleteUCPGroup(ctx context.Context, planeType string, planeName string, resourceGroupName string) (bool, error)
 	ShowUCPGroup(ctx context.Context, planeType string, planeName string, resourceGroupName string) (ucp_v20231001preview.ResourceGroupResource, error)
-	ListUCPGroup(ctx context.Context, planeType string, planeName string) ([]ucp_v20231001preview.ResourceGroupResource, error)
+	ListUCPGroup(ctx context.Context, planeType string, planeName string, options *ucp_v20231001preview.ResourceGroupsClientListOptions) ([]ucp_v20231001preview.ResourceGroupResource, error)
+
+	// RestoreUCPGroup restores the soft deleted resource group with the given name in the plane with the given type and name.
+	RestoreUCPGroup(ctx context.Context, planeType string, planeName string, resourceGroupName string) (ucp_v20231001preview.ResourceGroupResource, error)
+
+	// CreateUCPPlane creates or updates the plane with the given type and name.
+	CreateUCPPlane(ctx context.Context, planeType string, planeName string, plane ucp_v20231001preview.PlaneResource) error
+
+	// ShowUCPPlane retrieves the plane with the given type and name.
+	ShowUCPPlane(ctx context.Context, planeType string, planeName string) (ucp_v20231001preview.PlaneResource, error)
+
+	// ListUCPPlanes lists the planes of the given type, or all planes if the type is empty.
+	ListUCPPlanes(ctx context.Context, planeType string) ([]ucp_v20231001preview.PlaneResource, error)
+
+	// DeleteUCPPlane deletes the plane with the given type and name.
+	DeleteUCPPlane(ctx context.Context, planeType string, planeName string) (bool, error)
+
+	// ListAuditRecords lists the audit records of the plane with the given type and name that match the options, newest first.
+	ListAuditRecords(ctx context.Context, planeType string, planeName string, options *ucp_v20231001preview.AuditRecordsClientListOptions) ([]ucp_v20231001preview.AuditRecordResource, error)
+
+	// CreateOrUpdateLock creates or updates the lock with the given name in the plane with the given type and name.
+	CreateOrUpdateLock(ctx context.Context, planeType string, planeName string, lockName string, lock ucp_v20231001preview.LockResource) error
+
+	// ListLocks lists the locks of the plane with the given type and name.
+	ListLocks(ctx context.Context, planeType string, planeName string) ([]ucp_v20231001preview.LockResource, error)
+
+	// DeleteLock deletes the lock with the given name in the plane with the given type and name.
+	DeleteLock(ctx context.Context, planeType string, planeName string, lockName string) (bool, error)
 
 	// ShowRecipe shows recipe details including list of all parameters for a given recipe registered to an environment
 	ShowRecipe(ctx context.Context, environmentName string, recipe corerp.RecipeGetMetadata) (corerp.RecipeGetMetadataResponse, error)
@@ -59,3 +59,16 @@ func Is404Error(err error) bool {
 
 	return false
 }
+
+// IsPreconditionFailedError returns true if the error is a ResponseError with a StatusCode of 412, which is returned
+// when the ETag in the If-Match header does not match the current version of the resource.
+func IsPreconditionFailedError(err error) bool {
+	responseError := &azcore.ResponseError{}
+	return errors.As(err, &responseError) && (responseError.StatusCode == http.StatusPreconditionFailed || responseError.ErrorCode == v1.CodePreconditionFailed)
+}
+
+// isSoftDeleteNotEnabledError returns true if the error is a ResponseError with an ErrorCode of "SoftDeleteNotEnabled".
+func isSoftDeleteNotEnabledError(err error) bool {
+	responseError := &azcore.ResponseError{}
+	return errors.As(err, &responseError) && responseError.ErrorCode == v1.CodeSoftDeleteNotEnabled
+}
@@ -19,12 +19,16 @@ package clients
 import (
 	"context"
 	"net/http"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
 	"github.com/radius-project/radius/pkg/azure/clientv2"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
@@ -70,8 +74,8 @@ var (
 //
 
 // ListAllResourcesByType retrieves a list of all resources of a given type from the root
-// scope, and returns them in a slice of GenericResource objects, or an error if one occurs.
-func (amc *UCPApplicationsManagementClient) ListAllResourcesByType(ctx context.Context, resourceType string) ([]generated.GenericResource, error) {
+// scope that match the options, and returns them in a slice of GenericResource objects, or an error if one occurs.
+func (amc *UCPApplicationsManagementClient) ListAllResourcesByType(ctx context.Context, resourceType string, options *generated.GenericResourcesClientListByRootScopeOptions) ([]generated.GenericResource, error) {
 	results := []generated.GenericResource{}
 
 	client, err := generated.NewGenericResourcesClient(amc.RootScope, resourceType, &aztoken.AnonymousCredential{}, amc.ClientOptions)
@@ -79,7 +83,11 @@ func (amc *UCPApplicationsManagementClient) ListAllResourcesByType(ctx context.C
 		return results, err
 	}
 
-	pager := client.NewListByRootScopePager(&generated.GenericResourcesClientListByRootScopeOptions{})
+	if options == nil {
+		options = &generated.GenericResourcesClientListByRootScopeOptions{}
+	}
+
+	pager := client.NewListByRootScopePager(options)
 	for pager.More() {
 		nextPage, err := pager.NextPage(ctx)
 		if err != nil {
@@ -97,11 +105,11 @@ func (amc *UCPApplicationsManagementClient) ListAllResourcesByType(ctx context.C
 // ListAllResourceOfTypeInApplication lists the resources of a particular type in an application
 //
 
-// ListAllResourcesOfTypeInApplication takes in a context, an application name and a
-// resource type and returns a slice of GenericResources and an error if one occurs.
-func (amc *UCPApplicationsManagementClient) ListAllResourcesOfTypeInApplication(ctx context.Context, applicationName string, resourceType string) ([]generated.GenericResource, error) {
+// ListAllResourcesOfTypeInApplication takes in a context, an application name, a resource type and
+// list options and returns a slice of GenericResources and an error if one occurs.
+func (amc *UCPApplicationsManagementClient) ListAllResourcesOfTypeInApplication(ctx context.Context, applicationName string, resourceType string, options *generated.GenericResourcesClientListByRootScopeOptions) ([]generated.GenericResource, error) {
 	results := []generated.GenericResource{}
-	resourceList, err := amc.ListAllResourcesByType(ctx, resourceType)
+	resourceList, err := amc.ListAllResourcesByType(ctx, resourceType, options)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +130,7 @@ func (amc *UCPApplicationsManagementClient) ListAllResourcesOfTypeInApplication(
 func (amc *UCPApplicationsManagementClient) ListAllResourcesByApplication(ctx context.Context, applicationName string) ([]generated.GenericResource, error) {
 	results := []generated.GenericResource{}
 	for _, resourceType := range ResourceTypesList {
-		resourceList, err := amc.ListAllResourcesOfTypeInApplication(ctx, applicationName, resourceType)
+		resourceList, err := amc.ListAllResourcesOfTypeInApplication(ctx, applicationName, resourceType, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -157,7 +165,7 @@ func (amc *UCPApplicationsManagementClient) ListAllResourcesByEnvironment(ctx co
 // resource type and returns a slice of GenericResources and an error if one occurs.
 func (amc *UCPApplicationsManagementClient) ListAllResourcesOfTypeInEnvironment(ctx context.Context, environmentName string, resourceType string) ([]generated.GenericResource, error) {
 	results := []generated.GenericResource{}
-	resourceList, err := amc.ListAllResourcesByType(ctx, resourceType)
+	resourceList, err := amc.ListAllResourcesByType(ctx, resourceType, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -186,6 +194,25 @@ func (amc *UCPApplicationsManagementClient) ShowResource(ctx context.Context, re
 	return getResponse.GenericResource, nil
 }
 
+// ShowResourceWithETag retrieves the resource with the given name and type, returning the resource and the ETag of its
+// current version, or an error if one occurs.
+func (amc *UCPApplicationsManagementClient) ShowResourceWithETag(ctx context.Context, resourceType string, resourceName string) (generated.GenericResource, string, error) {
+	client, err := generated.NewGenericResourcesClient(amc.RootScope, resourceType, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return generated.GenericResource{}, "", err
+	}
+
+	var respFromCtx *http.Response
+	ctxWithResp := runtime.WithCaptureResponse(ctx, &respFromCtx)
+
+	getResponse, err := client.Get(ctxWithResp, resourceName, &generated.GenericResourcesClientGetOptions{})
+	if err != nil {
+		return generated.GenericResource{}, "", err
+	}
+
+	return getResponse.GenericResource, respFromCtx.Header.Get("ETag"), nil
+}
+
 // DeleteResource creates a new client, sends a delete request to the resource, polls until the request is completed,
 // and returns a boolean indicating whether the resource was successfully deleted or not, and an error if one occurred.
 func (amc *UCPApplicationsManagementClient) DeleteResource(ctx context.Context, resourceType string, resourceName string) (bool, error) {
@@ -210,6 +237,52 @@ func (amc *UCPApplicationsManagementClient) DeleteResource(ctx context.Context,
 	return respFromCtx.StatusCode != 204, nil
 }
 
+// CreateOrUpdateResource creates a new client, sends a create or update request for the resource, polls until the
+// request is completed, and returns the resource or an error if one occurred. When etag is not empty, it is sent in
+// the If-Match header so that the request fails if the resource was modified since the ETag was read.
+func (amc *UCPApplicationsManagementClient) CreateOrUpdateResource(ctx context.Context, resourceType string, resourceName string, resource generated.GenericResource, etag string) (generated.GenericResource, error) {
+	header := http.Header{}
+	if etag != "" {
+		header.Set("If-Match", etag)
+	}
+
+	return amc.createOrUpdateResource(ctx, resourceType, resourceName, resource, header)
+}
+
+// CreateResource creates a new client, sends a create request for the resource, polls until the request is
+// completed, and returns the resource or an error if one occurred. The If-None-Match header is set to "*" so that
+// the request fails if the resource already exists.
+func (amc *UCPApplicationsManagementClient) CreateResource(ctx context.Context, resourceType string, resourceName string, resource generated.GenericResource) (generated.GenericResource, error) {
+	return amc.createOrUpdateResource(ctx, resourceType, resourceName, resource, http.Header{"If-None-Match": []string{"*"}})
+}
+
+// createOrUpdateResource sends a create or update request for the resource with the given conditional headers and
+// polls until the request is completed.
+func (amc *UCPApplicationsManagementClient) createOrUpdateResource(ctx context.Context, resourceType string, resourceName string, resource generated.GenericResource, header http.Header) (generated.GenericResource, error) {
+	client, err := generated.NewGenericResourcesClient(amc.RootScope, resourceType, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	// Only the initial request is conditional, polling uses the original context.
+	requestCtx := ctx
+	if len(header) > 0 {
+		requestCtx = runtime.WithHTTPHeader(ctx, header)
+	}
+
+	poller, err := client.BeginCreateOrUpdate(requestCtx, resourceName, resource, &generated.GenericResourcesClientBeginCreateOrUpdateOptions{})
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	response, err := poller.PollUntilDone(ctx, nil)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	return response.GenericResource, nil
+}
+
 // ListApplications() retrieves a list of ApplicationResource objects from the Azure API
 // and returns them in a slice, or an error if one occurs.
 func (amc *UCPApplicationsManagementClient) ListApplications(ctx context.Context) ([]corerpv20231001.ApplicationResource, error) {
@@ -444,7 +517,7 @@ func isResourceInEnvironment(ctx context.Context, resource generated.GenericReso
 // ListEnvironmentsInResourceGroup creates a list of environment resources by paging through the list of environments in
 // the resource group and appending each environment to the list. It returns the list of environment resources or an error
 // if one occurs.
-func (amc *UCPApplicationsManagementClient) ListEnvironmentsInResourceGroup(ctx context.Context) ([]corerpv20231001.EnvironmentResource, error) {
+func (amc *UCPApplicationsManagementClient) ListEnvironmentsInResourceGroup(ctx context.Context, options *corerpv20231001.EnvironmentsClientListByScopeOptions) ([]corerpv20231001.EnvironmentResource, error) {
 	envResourceList := []corerpv20231001.EnvironmentResource{}
 
 	envClient, err := corerpv20231001.NewEnvironmentsClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
@@ -452,7 +525,11 @@ func (amc *UCPApplicationsManagementClient) ListEnvironmentsInResourceGroup(ctx
 		return envResourceList, err
 	}
 
-	pager := envClient.NewListByScopePager(&corerpv20231001.EnvironmentsClientListByScopeOptions{})
+	if options == nil {
+		options = &corerpv20231001.EnvironmentsClientListByScopeOptions{}
+	}
+
+	pager := envClient.NewListByScopePager(options)
 	for pager.More() {
 		nextPage, err := pager.NextPage(ctx)
 		if err != nil {
@@ -520,9 +597,31 @@ func (amc *UCPApplicationsManagementClient) GetEnvDetails(ctx context.Context, e
 
 }
 
-// DeleteEnv function checks if there are any applications associated with the given environment, deletes them if found,
-// and then deletes the environment itself. It returns a boolean and an error if one occurs.
+// DeleteEnv function first requests a soft deletion of the given environment. If the server does not confirm the soft
+// deletion it checks if there are any applications associated with the given environment, deletes them if found, and then
+// deletes the environment itself unless the server already deleted it. It returns a boolean and an error if one occurs.
 func (amc *UCPApplicationsManagementClient) DeleteEnv(ctx context.Context, envName string) (bool, error) {
+	envClient, err := corerpv20231001.NewEnvironmentsClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return false, err
+	}
+
+	var respFromCtx *http.Response
+	ctxWithResp := runtime.WithCaptureResponse(ctx, &respFromCtx)
+	ctxWithResp = runtime.WithHTTPHeader(ctxWithResp, http.Header{softdelete.HeaderSoftDelete: []string{"true"}})
+
+	_, err = envClient.Delete(ctxWithResp, envName, nil)
+	if err == nil && isSoftDeleted(respFromCtx) {
+		return true, nil
+	} else if err != nil && !isSoftDeleteNotEnabledError(err) {
+		return false, err
+	}
+
+	// The environment is not soft deleted, so its applications are deleted here. Either soft delete is not enabled,
+	// or the server does not support soft delete, ignored the header and already deleted the environment permanently.
+	alreadyDeleted := err == nil
+	deleted := alreadyDeleted && respFromCtx.StatusCode != 204
+
 	applicationsWithEnv, err := amc.ListApplicationsByEnv(ctx, envName)
 	if err != nil {
 		return false, err
@@ -535,13 +634,12 @@ func (amc *UCPApplicationsManagementClient) DeleteEnv(ctx context.Context, envNa
 		}
 	}
 
-	envClient, err := corerpv20231001.NewEnvironmentsClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
-	if err != nil {
-		return false, err
+	if alreadyDeleted {
+		return deleted, nil
 	}
 
-	var respFromCtx *http.Response
-	ctxWithResp := runtime.WithCaptureResponse(ctx, &respFromCtx)
+	ctxWithResp = runtime.WithCaptureResponse(ctx, &respFromCtx)
+	ctxWithResp = runtime.WithHTTPHeader(ctxWithResp, http.Header{softdelete.HeaderSoftDelete: []string{"false"}})
 
 	_, err = envClient.Delete(ctxWithResp, envName, nil)
 	if err != nil {
@@ -551,6 +649,28 @@ func (amc *UCPApplicationsManagementClient) DeleteEnv(ctx context.Context, envNa
 	return respFromCtx.StatusCode != 204, nil
 }
 
+// isSoftDeleted returns true if the response of a delete request confirms that the resource was soft deleted.
+func isSoftDeleted(resp *http.Response) bool {
+	softDeleted, _ := strconv.ParseBool(resp.Header.Get(softdelete.HeaderSoftDelete))
+	return softDeleted
+}
+
+// RestoreEnv restores the soft deleted environment with the given name and returns the restored environment resource or
+// an error if one occurs.
+func (amc *UCPApplicationsManagementClient) RestoreEnv(ctx context.Context, envName string) (corerpv20231001.EnvironmentResource, error) {
+	envClient, err := corerpv20231001.NewEnvironmentsClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return corerpv20231001.EnvironmentResource{}, err
+	}
+
+	resp, err := envClient.Restore(ctx, envName, nil)
+	if err != nil {
+		return corerpv20231001.EnvironmentResource{}, err
+	}
+
+	return resp.EnvironmentResource, nil
+}
+
 // CreateUCPGroup creates a new resource group in the specified plane type and plane name using the provided resource
 // group resource and returns an error if one occurs.
 func (amc *UCPApplicationsManagementClient) CreateUCPGroup(ctx context.Context, planeType string, planeName string, resourceGroupName string, resourceGroup ucpv20231001.ResourceGroupResource) error {
@@ -608,15 +728,14 @@ func (amc *UCPApplicationsManagementClient) ShowUCPGroup(ctx context.Context, pl
 
 // ListUCPGroup is a function that retrieves a list of resource groups from the UCP API and returns them as a slice of
 // ResourceGroupResource objects. It may return an error if there is an issue with the API request.
-func (amc *UCPApplicationsManagementClient) ListUCPGroup(ctx context.Context, planeType string, planeName string) ([]ucpv20231001.ResourceGroupResource, error) {
-	var resourceGroupOptions *ucpv20231001.ResourceGroupsClientListOptions
+func (amc *UCPApplicationsManagementClient) ListUCPGroup(ctx context.Context, planeType string, planeName string, options *ucpv20231001.ResourceGroupsClientListOptions) ([]ucpv20231001.ResourceGroupResource, error) {
 	resourceGroupResources := []ucpv20231001.ResourceGroupResource{}
 	resourcegroupClient, err := ucpv20231001.NewResourceGroupsClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
 	if err != nil {
 		return resourceGroupResources, err
 	}
 
-	pager := resourcegroupClient.NewListPager(planeType, planeName, resourceGroupOptions)
+	pager := resourcegroupClient.NewListPager(planeType, planeName, options)
 
 	for pager.More() {
 		resp, err := pager.NextPage(ctx)
@@ -634,6 +753,215 @@ func (amc *UCPApplicationsManagementClient) ListUCPGroup(ctx context.Context, pl
 	return resourceGroupResources, nil
 }
 
+// RestoreUCPGroup restores the soft deleted resource group with the given plane type, plane name and resource group name,
+// and returns the restored resource group resource or an error if one occurs.
+func (amc *UCPApplicationsManagementClient) RestoreUCPGroup(ctx context.Context, planeType string, planeName string, resourceGroupName string) (ucpv20231001.ResourceGroupResource, error) {
+	resourcegroupClient, err := ucpv20231001.NewResourceGroupsClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return ucpv20231001.ResourceGroupResource{}, err
+	}
+
+	resp, err := resourcegroupClient.Restore(ctx, planeType, planeName, resourceGroupName, nil)
+	if err != nil {
+		return ucpv20231001.ResourceGroupResource{}, err
+	}
+
+	return resp.ResourceGroupResource, nil
+}
+
+// CreateUCPPlane creates or updates a UCP plane with the given plane type and plane name, polls until the request
+// is completed, and returns an error if one occurs.
+func (amc *UCPApplicationsManagementClient) CreateUCPPlane(ctx context.Context, planeType string, planeName string, plane ucpv20231001.PlaneResource) error {
+	client, err := ucpv20231001.NewPlanesClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return err
+	}
+
+	poller, err := client.BeginCreateOrUpdate(ctx, planeType, planeName, plane, &ucpv20231001.PlanesClientBeginCreateOrUpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	_, err = poller.PollUntilDone(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ShowUCPPlane retrieves a UCP plane using the given plane type and plane name, and returns the plane resource or an
+// error if one occurs.
+func (amc *UCPApplicationsManagementClient) ShowUCPPlane(ctx context.Context, planeType string, planeName string) (ucpv20231001.PlaneResource, error) {
+	client, err := ucpv20231001.NewPlanesClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return ucpv20231001.PlaneResource{}, err
+	}
+
+	resp, err := client.Get(ctx, planeType, planeName, &ucpv20231001.PlanesClientGetOptions{})
+	if err != nil {
+		return ucpv20231001.PlaneResource{}, err
+	}
+
+	return resp.PlaneResource, nil
+}
+
+// ListUCPPlanes retrieves the UCP planes of the given plane type, or all planes if the plane type is empty, and returns
+// them as a slice of PlaneResource objects. It returns an error if there is an issue with the API request.
+func (amc *UCPApplicationsManagementClient) ListUCPPlanes(ctx context.Context, planeType string) ([]ucpv20231001.PlaneResource, error) {
+	planes := []ucpv20231001.PlaneResource{}
+	client, err := ucpv20231001.NewPlanesClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return planes, err
+	}
+
+	if planeType == "" {
+		pager := client.NewListPlanesPager(&ucpv20231001.PlanesClientListPlanesOptions{})
+		for pager.More() {
+			resp, err := pager.NextPage(ctx)
+			if err != nil {
+				return planes, err
+			}
+
+			for _, plane := range resp.Value {
+				planes = append(planes, *plane)
+			}
+		}
+
+		return planes, nil
+	}
+
+	pager := client.NewListByTypePager(planeType, &ucpv20231001.PlanesClientListByTypeOptions{})
+	for pager.More() {
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return planes, err
+		}
+
+		for _, plane := range resp.Value {
+			planes = append(planes, *plane)
+		}
+	}
+
+	return planes, nil
+}
+
+// DeleteUCPPlane deletes a UCP plane using the given plane type and plane name, polls until the request is completed,
+// and returns a boolean indicating whether the plane was deleted and an error if one occurs.
+func (amc *UCPApplicationsManagementClient) DeleteUCPPlane(ctx context.Context, planeType string, planeName string) (bool, error) {
+	client, err := ucpv20231001.NewPlanesClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return false, err
+	}
+
+	var respFromCtx *http.Response
+	ctxWithResp := runtime.WithCaptureResponse(ctx, &respFromCtx)
+
+	poller, err := client.BeginDelete(ctxWithResp, planeType, planeName, &ucpv20231001.PlanesClientBeginDeleteOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	_, err = poller.PollUntilDone(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+
+	return respFromCtx.StatusCode != 204, nil
+}
+
+// ListAuditRecords lists the audit records of the plane with the given type and name that match the options, newest first.
+func (amc *UCPApplicationsManagementClient) ListAuditRecords(ctx context.Context, planeType string, planeName string, options *ucpv20231001.AuditRecordsClientListOptions) ([]ucpv20231001.AuditRecordResource, error) {
+	records := []ucpv20231001.AuditRecordResource{}
+	client, err := ucpv20231001.NewAuditRecordsClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return records, err
+	}
+
+	pager := client.NewListPager(planeType, planeName, options)
+	for pager.More() {
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return records, err
+		}
+
+		for _, record := range resp.Value {
+			records = append(records, *record)
+		}
+	}
+
+	// The records are ordered within each page, so order them across pages.
+	sort.SliceStable(records, func(i, j int) bool {
+		return timeOf(records[i].Properties).After(timeOf(records[j].Properties))
+	})
+
+	return records, nil
+}
+
+func timeOf(properties *ucpv20231001.AuditRecordProperties) time.Time {
+	if properties == nil || properties.Timestamp == nil {
+		return time.Time{}
+	}
+	return *properties.Timestamp
+}
+
+// CreateOrUpdateLock creates or updates the lock with the given name in the plane with the given type and name.
+func (amc *UCPApplicationsManagementClient) CreateOrUpdateLock(ctx context.Context, planeType string, planeName string, lockName string, lock ucpv20231001.LockResource) error {
+	client, err := ucpv20231001.NewLocksClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.CreateOrUpdate(ctx, planeType, planeName, lockName, lock, &ucpv20231001.LocksClientCreateOrUpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListLocks lists the locks of the plane with the given type and name.
+func (amc *UCPApplicationsManagementClient) ListLocks(ctx context.Context, planeType string, planeName string) ([]ucpv20231001.LockResource, error) {
+	locks := []ucpv20231001.LockResource{}
+	client, err := ucpv20231001.NewLocksClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return locks, err
+	}
+
+	pager := client.NewListPager(planeType, planeName, &ucpv20231001.LocksClientListOptions{})
+	for pager.More() {
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return locks, err
+		}
+
+		for _, lock := range resp.Value {
+			locks = append(locks, *lock)
+		}
+	}
+
+	return locks, nil
+}
+
+// DeleteLock deletes the lock with the given name in the plane with the given type and name. It returns false if
+// the lock did not exist.
+func (amc *UCPApplicationsManagementClient) DeleteLock(ctx context.Context, planeType string, planeName string, lockName string) (bool, error) {
+	client, err := ucpv20231001.NewLocksClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return false, err
+	}
+
+	var respFromCtx *http.Response
+	ctxWithResp := runtime.WithCaptureResponse(ctx, &respFromCtx)
+
+	_, err = client.Delete(ctxWithResp, planeType, planeName, lockName, &ucpv20231001.LocksClientDeleteOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	return respFromCtx.StatusCode != 204, nil
+}
+
 // ShowRecipe creates a new EnvironmentsClient, gets the recipe metadata from the
 // environment, and returns the EnvironmentRecipeProperties or an error if one occurs.
 func (amc *UCPApplicationsManagementClient) ShowRecipe(ctx context.Context, environmentName string, recipeName corerpv20231001.RecipeGetMetadata) (corerpv20231001.RecipeGetMetadataResponse, error) {
@@ -79,6 +79,50 @@ func (mr *MockApplicationsManagementClientMockRecorder) CreateOrUpdateApplicatio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateApplication", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CreateOrUpdateApplication), arg0, arg1, arg2)
 }
 
+// CreateOrUpdateLock mocks base method.
+func (m *MockApplicationsManagementClient) CreateOrUpdateLock(arg0 context.Context, arg1, arg2, arg3 string, arg4 v20231001preview0.LockResource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateLock", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdateLock indicates an expected call of CreateOrUpdateLock.
+func (mr *MockApplicationsManagementClientMockRecorder) CreateOrUpdateLock(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateLock", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CreateOrUpdateLock), arg0, arg1, arg2, arg3, arg4)
+}
+
+// CreateOrUpdateResource mocks base method.
+func (m *MockApplicationsManagementClient) CreateOrUpdateResource(arg0 context.Context, arg1, arg2 string, arg3 generated.GenericResource, arg4 string) (generated.GenericResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateResource", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(generated.GenericResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdateResource indicates an expected call of CreateOrUpdateResource.
+func (mr *MockApplicationsManagementClientMockRecorder) CreateOrUpdateResource(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateResource", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CreateOrUpdateResource), arg0, arg1, arg2, arg3, arg4)
+}
+
+// CreateResource mocks base method.
+func (m *MockApplicationsManagementClient) CreateResource(arg0 context.Context, arg1, arg2 string, arg3 generated.GenericResource) (generated.GenericResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResource", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(generated.GenericResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateResource indicates an expected call of CreateResource.
+func (mr *MockApplicationsManagementClientMockRecorder) CreateResource(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResource", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CreateResource), arg0, arg1, arg2, arg3)
+}
+
 // CreateUCPGroup mocks base method.
 func (m *MockApplicationsManagementClient) CreateUCPGroup(arg0 context.Context, arg1, arg2, arg3 string, arg4 v20231001preview0.ResourceGroupResource) error {
 	m.ctrl.T.Helper()
@@ -93,6 +137,20 @@ func (mr *MockApplicationsManagementClientMockRecorder) CreateUCPGroup(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUCPGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CreateUCPGroup), arg0, arg1, arg2, arg3, arg4)
 }
 
+// CreateUCPPlane mocks base method.
+func (m *MockApplicationsManagementClient) CreateUCPPlane(arg0 context.Context, arg1, arg2 string, arg3 v20231001preview0.PlaneResource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUCPPlane", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUCPPlane indicates an expected call of CreateUCPPlane.
+func (mr *MockApplicationsManagementClientMockRecorder) CreateUCPPlane(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUCPPlane", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CreateUCPPlane), arg0, arg1, arg2, arg3)
+}
+
 // DeleteApplication mocks base method.
 func (m *MockApplicationsManagementClient) DeleteApplication(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +181,21 @@ func (mr *MockApplicationsManagementClientMockRecorder) DeleteEnv(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnv", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeleteEnv), arg0, arg1)
 }
 
+// DeleteLock mocks base method.
+func (m *MockApplicationsManagementClient) DeleteLock(arg0 context.Context, arg1, arg2, arg3 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLock", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLock indicates an expected call of DeleteLock.
+func (mr *MockApplicationsManagementClientMockRecorder) DeleteLock(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLock", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeleteLock), arg0, arg1, arg2, arg3)
+}
+
 // DeleteResource mocks base method.
 func (m *MockApplicationsManagementClient) DeleteResource(arg0 context.Context, arg1, arg2 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -153,6 +226,21 @@ func (mr *MockApplicationsManagementClientMockRecorder) DeleteUCPGroup(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUCPGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeleteUCPGroup), arg0, arg1, arg2, arg3)
 }
 
+// DeleteUCPPlane mocks base method.
+func (m *MockApplicationsManagementClient) DeleteUCPPlane(arg0 context.Context, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUCPPlane", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUCPPlane indicates an expected call of DeleteUCPPlane.
+func (mr *MockApplicationsManagementClientMockRecorder) DeleteUCPPlane(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUCPPlane", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeleteUCPPlane), arg0, arg1, arg2)
+}
+
 // GetEnvDetails mocks base method.
 func (m *MockApplicationsManagementClient) GetEnvDetails(arg0 context.Context, arg1 string) (v20231001preview.EnvironmentResource, error) {
 	m.ctrl.T.Helper()
@@ -214,33 +302,33 @@ func (mr *MockApplicationsManagementClientMockRecorder) ListAllResourcesByEnviro
 }
 
 // ListAllResourcesByType mocks base method.
-func (m *MockApplicationsManagementClient) ListAllResourcesByType(arg0 context.Context, arg1 string) ([]generated.GenericResource, error) {
+func (m *MockApplicationsManagementClient) ListAllResourcesByType(arg0 context.Context, arg1 string, arg2 *generated.GenericResourcesClientListByRootScopeOptions) ([]generated.GenericResource, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAllResourcesByType", arg0, arg1)
+	ret := m.ctrl.Call(m, "ListAllResourcesByType", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]generated.GenericResource)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAllResourcesByType indicates an expected call of ListAllResourcesByType.
-func (mr *MockApplicationsManagementClientMockRecorder) ListAllResourcesByType(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockApplicationsManagementClientMockRecorder) ListAllResourcesByType(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllResourcesByType", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListAllResourcesByType), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllResourcesByType", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListAllResourcesByType), arg0, arg1, arg2)
 }
 
 // ListAllResourcesOfTypeInApplication mocks base method.
-func (m *MockApplicationsManagementClient) ListAllResourcesOfTypeInApplication(arg0 context.Context, arg1, arg2 string) ([]generated.GenericResource, error) {
+func (m *MockApplicationsManagementClient) ListAllResourcesOfTypeInApplication(arg0 context.Context, arg1, arg2 string, arg3 *generated.GenericResourcesClientListByRootScopeOptions) ([]generated.GenericResource, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAllResourcesOfTypeInApplication", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "ListAllResourcesOfTypeInApplication", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].([]generated.GenericResource)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAllResourcesOfTypeInApplication indicates an expected call of ListAllResourcesOfTypeInApplication.
-func (mr *MockApplicationsManagementClientMockRecorder) ListAllResourcesOfTypeInApplication(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockApplicationsManagementClientMockRecorder) ListAllResourcesOfTypeInApplication(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllResourcesOfTypeInApplication", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListAllResourcesOfTypeInApplication), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllResourcesOfTypeInApplication", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListAllResourcesOfTypeInApplication), arg0, arg1, arg2, arg3)
 }
 
 // ListAllResourcesOfTypeInEnvironment mocks base method.
@@ -273,6 +361,21 @@ func (mr *MockApplicationsManagementClientMockRecorder) ListApplications(arg0 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplications", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListApplications), arg0)
 }
 
+// ListAuditRecords mocks base method.
+func (m *MockApplicationsManagementClient) ListAuditRecords(arg0 context.Context, arg1, arg2 string, arg3 *v20231001preview0.AuditRecordsClientListOptions) ([]v20231001preview0.AuditRecordResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditRecords", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]v20231001preview0.AuditRecordResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditRecords indicates an expected call of ListAuditRecords.
+func (mr *MockApplicationsManagementClientMockRecorder) ListAuditRecords(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditRecords", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListAuditRecords), arg0, arg1, arg2, arg3)
+}
+
 // ListEnvironmentsAll mocks base method.
 func (m *MockApplicationsManagementClient) ListEnvironmentsAll(arg0 context.Context) ([]v20231001preview.EnvironmentResource, error) {
 	m.ctrl.T.Helper()
@@ -289,33 +392,93 @@ func (mr *MockApplicationsManagementClientMockRecorder) ListEnvironmentsAll(arg0
 }
 
 // ListEnvironmentsInResourceGroup mocks base method.
-func (m *MockApplicationsManagementClient) ListEnvironmentsInResourceGroup(arg0 context.Context) ([]v20231001preview.EnvironmentResource, error) {
+func (m *MockApplicationsManagementClient) ListEnvironmentsInResourceGroup(arg0 context.Context, arg1 *v20231001preview.EnvironmentsClientListByScopeOptions) ([]v20231001preview.EnvironmentResource, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEnvironmentsInResourceGroup", arg0)
+	ret := m.ctrl.Call(m, "ListEnvironmentsInResourceGroup", arg0, arg1)
 	ret0, _ := ret[0].([]v20231001preview.EnvironmentResource)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListEnvironmentsInResourceGroup indicates an expected call of ListEnvironmentsInResourceGroup.
-func (mr *MockApplicationsManagementClientMockRecorder) ListEnvironmentsInResourceGroup(arg0 interface{}) *gomock.Call {
+func (mr *MockApplicationsManagementClientMockRecorder) ListEnvironmentsInResourceGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironmentsInResourceGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListEnvironmentsInResourceGroup), arg0, arg1)
+}
+
+// ListLocks mocks base method.
+func (m *MockApplicationsManagementClient) ListLocks(arg0 context.Context, arg1, arg2 string) ([]v20231001preview0.LockResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLocks", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]v20231001preview0.LockResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLocks indicates an expected call of ListLocks.
+func (mr *MockApplicationsManagementClientMockRecorder) ListLocks(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironmentsInResourceGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListEnvironmentsInResourceGroup), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLocks", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListLocks), arg0, arg1, arg2)
 }
 
 // ListUCPGroup mocks base method.
-func (m *MockApplicationsManagementClient) ListUCPGroup(arg0 context.Context, arg1, arg2 string) ([]v20231001preview0.ResourceGroupResource, error) {
+func (m *MockApplicationsManagementClient) ListUCPGroup(arg0 context.Context, arg1, arg2 string, arg3 *v20231001preview0.ResourceGroupsClientListOptions) ([]v20231001preview0.ResourceGroupResource, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUCPGroup", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "ListUCPGroup", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].([]v20231001preview0.ResourceGroupResource)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListUCPGroup indicates an expected call of ListUCPGroup.
-func (mr *MockApplicationsManagementClientMockRecorder) ListUCPGroup(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockApplicationsManagementClientMockRecorder) ListUCPGroup(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUCPGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListUCPGroup), arg0, arg1, arg2, arg3)
+}
+
+// ListUCPPlanes mocks base method.
+func (m *MockApplicationsManagementClient) ListUCPPlanes(arg0 context.Context, arg1 string) ([]v20231001preview0.PlaneResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUCPPlanes", arg0, arg1)
+	ret0, _ := ret[0].([]v20231001preview0.PlaneResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUCPPlanes indicates an expected call of ListUCPPlanes.
+func (mr *MockApplicationsManagementClientMockRecorder) ListUCPPlanes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUCPPlanes", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListUCPPlanes), arg0, arg1)
+}
+
+// RestoreEnv mocks base method.
+func (m *MockApplicationsManagementClient) RestoreEnv(arg0 context.Context, arg1 string) (v20231001preview.EnvironmentResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreEnv", arg0, arg1)
+	ret0, _ := ret[0].(v20231001preview.EnvironmentResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreEnv indicates an expected call of RestoreEnv.
+func (mr *MockApplicationsManagementClientMockRecorder) RestoreEnv(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreEnv", reflect.TypeOf((*MockApplicationsManagementClient)(nil).RestoreEnv), arg0, arg1)
+}
+
+// RestoreUCPGroup mocks base method.
+func (m *MockApplicationsManagementClient) RestoreUCPGroup(arg0 context.Context, arg1, arg2, arg3 string) (v20231001preview0.ResourceGroupResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreUCPGroup", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(v20231001preview0.ResourceGroupResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreUCPGroup indicates an expected call of RestoreUCPGroup.
+func (mr *MockApplicationsManagementClientMockRecorder) RestoreUCPGroup(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUCPGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListUCPGroup), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUCPGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).RestoreUCPGroup), arg0, arg1, arg2, arg3)
 }
 
 // ShowApplication mocks base method.
@@ -363,6 +526,22 @@ func (mr *MockApplicationsManagementClientMockRecorder) ShowResource(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowResource", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ShowResource), arg0, arg1, arg2)
 }
 
+// ShowResourceWithETag mocks base method.
+func (m *MockApplicationsManagementClient) ShowResourceWithETag(arg0 context.Context, arg1, arg2 string) (generated.GenericResource, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowResourceWithETag", arg0, arg1, arg2)
+	ret0, _ := ret[0].(generated.GenericResource)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ShowResourceWithETag indicates an expected call of ShowResourceWithETag.
+func (mr *MockApplicationsManagementClientMockRecorder) ShowResourceWithETag(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowResourceWithETag", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ShowResourceWithETag), arg0, arg1, arg2)
+}
+
 // ShowUCPGroup mocks base method.
 func (m *MockApplicationsManagementClient) ShowUCPGroup(arg0 context.Context, arg1, arg2, arg3 string) (v20231001preview0.ResourceGroupResource, error) {
 	m.ctrl.T.Helper()
@@ -377,3 +556,18 @@ func (mr *MockApplicationsManagementClientMockRecorder) ShowUCPGroup(arg0, arg1,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowUCPGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ShowUCPGroup), arg0, arg1, arg2, arg3)
 }
+
+// ShowUCPPlane mocks base method.
+func (m *MockApplicationsManagementClient) ShowUCPPlane(arg0 context.Context, arg1, arg2 string) (v20231001preview0.PlaneResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowUCPPlane", arg0, arg1, arg2)
+	ret0, _ := ret[0].(v20231001preview0.PlaneResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowUCPPlane indicates an expected call of ShowUCPPlane.
+func (mr *MockApplicationsManagementClientMockRecorder) ShowUCPPlane(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowUCPPlane", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ShowUCPPlane), arg0, arg1, arg2)
+}
@@ -67,6 +67,21 @@ func (mr *MockDiagnosticsClientMockRecorder) GetPublicEndpoint(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicEndpoint", reflect.TypeOf((*MockDiagnosticsClient)(nil).GetPublicEndpoint), arg0, arg1)
 }
 
+// GetResourceHealth mocks base method.
+func (m *MockDiagnosticsClient) GetResourceHealth(arg0 context.Context, arg1 ResourceHealthOptions) (*ResourceHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceHealth", arg0, arg1)
+	ret0, _ := ret[0].(*ResourceHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceHealth indicates an expected call of GetResourceHealth.
+func (mr *MockDiagnosticsClientMockRecorder) GetResourceHealth(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceHealth", reflect.TypeOf((*MockDiagnosticsClient)(nil).GetResourceHealth), arg0, arg1)
+}
+
 // Logs mocks base method.
 func (m *MockDiagnosticsClient) Logs(arg0 context.Context, arg1 LogsOptions) ([]LogStream, error) {
 	m.ctrl.T.Helper()
@@ -89,7 +89,10 @@ type GenericResourcesClientGetOptions struct {
 // GenericResourcesClientListByRootScopeOptions contains the optional parameters for the GenericResourcesClient.ListByRootScope
 // method.
 type GenericResourcesClientListByRootScopeOptions struct {
-	// placeholder for future optional parameters
+	// List only the resources that have a tag with this name.
+	TagName *string
+	// List only the resources whose tag named by tagName has this value. Requires tagName.
+	TagValue *string
 }
 
 // GenericResourcesClientListSecretsOptions contains the optional parameters for the GenericResourcesClient.ListSecrets method.
@@ -27,6 +27,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/config"
+	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/spf13/cobra"
@@ -320,8 +321,25 @@ func RequireAzureSubscriptionId(cmd *cobra.Command) (string, error) {
 	return subscriptionId, err
 }
 
+// RequireOutput reads the output format from the command flags. An error is returned if the format is not supported,
+// or if the expression of a jsonpath or go-template format cannot be parsed.
 func RequireOutput(cmd *cobra.Command) (string, error) {
-	return cmd.Flags().GetString("output")
+	format, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return "", err
+	}
+
+	// Some commands treat an empty format as the default format.
+	if format == "" {
+		return format, nil
+	}
+
+	_, err = output.NewFormatter(format)
+	if err != nil {
+		return "", clierrors.Message("Invalid output format %q: %s. Supported formats are %s.", format, err.Error(), strings.Join(output.SupportedFormats(), ", "))
+	}
+
+	return format, nil
 }
 
 // RequireWorkspace is used by commands that require an existing workspace either set as the default,
@@ -7,6 +7,8 @@ package connections
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
@@ -18,6 +20,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
 )
 
 // NewCommand creates an instance of the command and runner for the `rad app connections` command.
@@ -34,7 +37,16 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 rad app connections
 
 # Show connections for specified application
-rad app connections my-application`,
+rad app connections my-application
+
+# Export the connections as a Graphviz DOT diagram
+rad app connections my-application --output dot | dot -Tsvg > my-application.svg
+
+# Export the connections as a Mermaid diagram, grouping output resources by provider
+rad app connections my-application --output mermaid --group-by-provider
+
+# Export the connections as JSON without output resources
+rad app connections my-application --output json --hide-output-resources`,
 		RunE: framework.RunCommand(runner),
 	}
 
@@ -42,6 +54,9 @@ rad app connections my-application`,
 	commonflags.AddResourceGroupFlag(cmd)
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
+	cmd.Flags().StringP("output", "o", formatText, fmt.Sprintf("output format (supported formats are %s)", strings.Join(supportedFormats(), ", ")))
+	cmd.Flags().Bool("hide-output-resources", false, "Hide the output resources (eg: Kubernetes or cloud resources) of each resource")
+	cmd.Flags().Bool("group-by-provider", false, "Group output resources by the provider that hosts them. Applies to the dot and mermaid formats")
 
 	return cmd, runner
 }
@@ -52,9 +67,12 @@ type Runner struct {
 	ConnectionFactory connections.Factory
 	Output            output.Interface
 
-	ApplicationName string
-	EnvironmentName string
-	Workspace       *workspaces.Workspace
+	ApplicationName     string
+	EnvironmentName     string
+	Format              string
+	GroupByProvider     bool
+	HideOutputResources bool
+	Workspace           *workspaces.Workspace
 }
 
 // NewRunner creates a new instance of the `rad app connections` runner.
@@ -84,6 +102,27 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// The connections command supports diagram formats in addition to json, so the
+	// format is validated here rather than by cli.RequireOutput.
+	r.Format, err = cmd.Flags().GetString("output")
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(supportedFormats(), r.Format) {
+		return clierrors.Message("Unsupported output format %q. Supported formats are %s.", r.Format, strings.Join(supportedFormats(), ", "))
+	}
+
+	r.HideOutputResources, err = cmd.Flags().GetBool("hide-output-resources")
+	if err != nil {
+		return err
+	}
+
+	r.GroupByProvider, err = cmd.Flags().GetBool("group-by-provider")
+	if err != nil {
+		return err
+	}
+
 	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(cmd.Context(), *r.Workspace)
 	if err != nil {
 		return err
@@ -119,8 +158,28 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 	graph := applicationGraphResponse.Resources
-	display := display(graph, r.ApplicationName)
-	r.Output.LogInfo(display)
+	options := diagramOptions{HideOutputResources: r.HideOutputResources, GroupByProvider: r.GroupByProvider}
+
+	switch r.Format {
+	case formatDOT:
+		r.Output.LogInfo(displayDOT(graph, r.ApplicationName, options))
+	case formatMermaid:
+		r.Output.LogInfo(displayMermaid(graph, options))
+	case output.FormatJson:
+		return r.Output.WriteFormatted(output.FormatJson, filterGraph(graph, options), output.FormatterOptions{})
+	default:
+		r.Output.LogInfo(display(filterGraph(graph, options), r.ApplicationName))
+	}
 
 	return nil
 }
+
+const (
+	formatText    = "text"
+	formatDOT     = "dot"
+	formatMermaid = "mermaid"
+)
+
+func supportedFormats() []string {
+	return []string{formatText, formatDOT, formatMermaid, output.FormatJson}
+}
@@ -97,6 +97,36 @@ func Test_Validate(t *testing.T) {
 					Times(1)
 			},
 		},
+		{
+			Name:          "Connections command with diagram options",
+			Input:         []string{"test-app", "--output", "mermaid", "--hide-output-resources", "--group-by-provider"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					ShowApplication(gomock.Any(), "test-app").
+					Return(application, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "mermaid", runner.Format)
+				require.True(t, runner.HideOutputResources)
+				require.True(t, runner.GroupByProvider)
+			},
+		},
+		{
+			Name:          "Connections command with unsupported output format",
+			Input:         []string{"test-app", "--output", "table"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 		{
 			Name:          "Connections command with incorrect args",
 			Input:         []string{"foo", "bar"},
@@ -182,6 +212,7 @@ func Test_Run(t *testing.T) {
 		// Populated by Validate()
 		ApplicationName: "test-app",
 		EnvironmentName: "test-env",
+		Format:          "text",
 	}
 
 	err := runner.Run(context.Background())
@@ -211,3 +242,68 @@ Resources:
 
 	require.Equal(t, expected, outputSink.Writes)
 }
+
+func Test_Run_JSON(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graph := corerpv20231001preview.ApplicationGraphResponse{
+		Resources: []*corerpv20231001preview.ApplicationGraphResource{
+			{
+				ID:                to.Ptr(containerResourceID),
+				Name:              to.Ptr(containerResourceName),
+				Type:              to.Ptr(containerResourceType),
+				ProvisioningState: to.Ptr(provisioningStateSuccess),
+				OutputResources: []*corerpv20231001preview.ApplicationGraphOutputResource{
+					{
+						ID:   to.Ptr("/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/demo"),
+						Type: to.Ptr("apps/Deployment"),
+						Name: to.Ptr("demo"),
+					},
+				},
+				Connections: []*corerpv20231001preview.ApplicationGraphConnection{},
+			},
+		},
+	}
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		GetGraph(gomock.Any(), "test-app").
+		Return(graph, nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Workspace:         &workspaces.Workspace{},
+		Output:            outputSink,
+
+		// Populated by Validate()
+		ApplicationName:     "test-app",
+		EnvironmentName:     "test-env",
+		Format:              "json",
+		HideOutputResources: true,
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	expected := []any{
+		output.FormattedOutput{
+			Format: "json",
+			Obj: []*corerpv20231001preview.ApplicationGraphResource{
+				{
+					ID:                to.Ptr(containerResourceID),
+					Name:              to.Ptr(containerResourceName),
+					Type:              to.Ptr(containerResourceType),
+					ProvisioningState: to.Ptr(provisioningStateSuccess),
+					OutputResources:   []*corerpv20231001preview.ApplicationGraphOutputResource{},
+					Connections:       []*corerpv20231001preview.ApplicationGraphConnection{},
+				},
+			},
+			Options: output.FormatterOptions{},
+		},
+	}
+
+	require.Equal(t, expected, outputSink.Writes)
+}
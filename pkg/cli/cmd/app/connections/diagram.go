@@ -0,0 +1,310 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connections
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// diagramOptions controls which parts of the application graph are rendered in a diagram.
+type diagramOptions struct {
+	// HideOutputResources excludes the output resources of each resource from the diagram.
+	HideOutputResources bool
+
+	// GroupByProvider groups the output resources by the provider that hosts them, eg: kubernetes, azure or aws.
+	GroupByProvider bool
+}
+
+// diagramNode is a node of the diagram. A node is either a resource of the application, a resource referenced by
+// a connection, or an output resource.
+type diagramNode struct {
+	ID       string
+	Name     string
+	Type     string
+	Output   bool
+	Provider string
+}
+
+// diagramEdge is an edge of the diagram. Edges either represent a connection between two resources or the
+// relationship between a resource and one of its output resources.
+type diagramEdge struct {
+	From   string
+	To     string
+	Output bool
+}
+
+// diagram is the format-agnostic representation of the application graph that is rendered as DOT or Mermaid.
+type diagram struct {
+	Nodes []diagramNode
+	Edges []diagramEdge
+}
+
+// buildDiagram builds the diagram for the application graph. The nodes and edges are sorted so that the output is stable.
+func buildDiagram(applicationResources []*v20231001preview.ApplicationGraphResource, options diagramOptions) diagram {
+	sortResources(applicationResources)
+
+	result := diagram{}
+	seen := map[string]bool{}
+	for _, resource := range applicationResources {
+		result.Nodes = append(result.Nodes, diagramNode{ID: *resource.ID, Name: *resource.Name, Type: *resource.Type})
+		seen[strings.ToLower(*resource.ID)] = true
+	}
+
+	// Connections are reported on both ends, so the edges need to be de-duplicated.
+	edges := map[diagramEdge]bool{}
+	external := map[string]diagramNode{}
+	for _, resource := range applicationResources {
+		for _, connection := range resource.Connections {
+			if connection.ID == nil || connection.Direction == nil {
+				continue
+			}
+
+			edge := diagramEdge{From: *resource.ID, To: *connection.ID}
+			if *connection.Direction == v20231001preview.DirectionInbound {
+				edge = diagramEdge{From: *connection.ID, To: *resource.ID}
+			}
+			edges[edge] = true
+
+			if !seen[strings.ToLower(*connection.ID)] {
+				external[*connection.ID] = makeExternalNode(*connection.ID)
+			}
+		}
+	}
+
+	// Resources outside of the application (eg: shared resources in the environment) are still shown
+	// so that the connections to them are visible.
+	externalIDs := []string{}
+	for id := range external {
+		externalIDs = append(externalIDs, id)
+	}
+	sort.Strings(externalIDs)
+	for _, id := range externalIDs {
+		result.Nodes = append(result.Nodes, external[id])
+		seen[strings.ToLower(id)] = true
+	}
+
+	connectionEdges := []diagramEdge{}
+	for edge := range edges {
+		connectionEdges = append(connectionEdges, edge)
+	}
+	sort.Slice(connectionEdges, func(i, j int) bool {
+		if connectionEdges[i].From != connectionEdges[j].From {
+			return connectionEdges[i].From < connectionEdges[j].From
+		}
+		return connectionEdges[i].To < connectionEdges[j].To
+	})
+	result.Edges = append(result.Edges, connectionEdges...)
+
+	if options.HideOutputResources {
+		return result
+	}
+
+	for _, resource := range applicationResources {
+		for _, outputResource := range resource.OutputResources {
+			if outputResource.ID == nil {
+				continue
+			}
+
+			if !seen[strings.ToLower(*outputResource.ID)] {
+				result.Nodes = append(result.Nodes, diagramNode{
+					ID:       *outputResource.ID,
+					Name:     valueOrEmpty(outputResource.Name),
+					Type:     valueOrEmpty(outputResource.Type),
+					Output:   true,
+					Provider: providerFromID(*outputResource.ID),
+				})
+				seen[strings.ToLower(*outputResource.ID)] = true
+			}
+
+			result.Edges = append(result.Edges, diagramEdge{From: *resource.ID, To: *outputResource.ID, Output: true})
+		}
+	}
+
+	return result
+}
+
+// displayDOT renders the application graph as a Graphviz DOT digraph.
+func displayDOT(applicationResources []*v20231001preview.ApplicationGraphResource, applicationName string, options diagramOptions) string {
+	d := buildDiagram(applicationResources, options)
+
+	output := &strings.Builder{}
+	output.WriteString(fmt.Sprintf("digraph %s {\n", dotQuote(applicationName)))
+	output.WriteString("  rankdir=LR;\n")
+	output.WriteString("  node [shape=box];\n")
+
+	writeNode := func(indent string, node diagramNode) {
+		attributes := fmt.Sprintf("label=%s", dotQuote(node.Name+"\n"+node.Type))
+		if node.Output {
+			attributes += ", shape=ellipse"
+		}
+		output.WriteString(fmt.Sprintf("%s%s [%s];\n", indent, dotQuote(node.ID), attributes))
+	}
+
+	if len(d.Nodes) > 0 {
+		output.WriteString("\n")
+	}
+
+	groups, providers := groupNodes(d.Nodes, options)
+	for _, node := range groups[""] {
+		writeNode("  ", node)
+	}
+	for _, provider := range providers {
+		output.WriteString(fmt.Sprintf("\n  subgraph %s {\n", dotQuote("cluster_"+provider)))
+		output.WriteString(fmt.Sprintf("    label=%s;\n", dotQuote(provider)))
+		for _, node := range groups[provider] {
+			writeNode("    ", node)
+		}
+		output.WriteString("  }\n")
+	}
+
+	if len(d.Edges) > 0 {
+		output.WriteString("\n")
+	}
+	for _, edge := range d.Edges {
+		if edge.Output {
+			output.WriteString(fmt.Sprintf("  %s -> %s [style=dashed];\n", dotQuote(edge.From), dotQuote(edge.To)))
+		} else {
+			output.WriteString(fmt.Sprintf("  %s -> %s;\n", dotQuote(edge.From), dotQuote(edge.To)))
+		}
+	}
+
+	output.WriteString("}\n")
+	return output.String()
+}
+
+// displayMermaid renders the application graph as a Mermaid flowchart.
+func displayMermaid(applicationResources []*v20231001preview.ApplicationGraphResource, options diagramOptions) string {
+	d := buildDiagram(applicationResources, options)
+
+	// Mermaid node ids cannot contain the characters used in resource ids, so nodes are numbered instead.
+	ids := map[string]string{}
+	for i, node := range d.Nodes {
+		ids[strings.ToLower(node.ID)] = fmt.Sprintf("n%d", i)
+	}
+
+	output := &strings.Builder{}
+	output.WriteString("graph LR\n")
+
+	writeNode := func(indent string, node diagramNode) {
+		label := mermaidQuote(node.Name + "<br/>" + node.Type)
+		if node.Output {
+			output.WriteString(fmt.Sprintf("%s%s([%s])\n", indent, ids[strings.ToLower(node.ID)], label))
+		} else {
+			output.WriteString(fmt.Sprintf("%s%s[%s]\n", indent, ids[strings.ToLower(node.ID)], label))
+		}
+	}
+
+	groups, providers := groupNodes(d.Nodes, options)
+	for _, node := range groups[""] {
+		writeNode("  ", node)
+	}
+	for _, provider := range providers {
+		output.WriteString(fmt.Sprintf("  subgraph provider_%s [%s]\n", mermaidID(provider), mermaidQuote(provider)))
+		for _, node := range groups[provider] {
+			writeNode("    ", node)
+		}
+		output.WriteString("  end\n")
+	}
+
+	for _, edge := range d.Edges {
+		if edge.Output {
+			output.WriteString(fmt.Sprintf("  %s -.-> %s\n", ids[strings.ToLower(edge.From)], ids[strings.ToLower(edge.To)]))
+		} else {
+			output.WriteString(fmt.Sprintf("  %s --> %s\n", ids[strings.ToLower(edge.From)], ids[strings.ToLower(edge.To)]))
+		}
+	}
+
+	return output.String()
+}
+
+// filterGraph returns a copy of the application graph with the output resources removed if requested.
+func filterGraph(applicationResources []*v20231001preview.ApplicationGraphResource, options diagramOptions) []*v20231001preview.ApplicationGraphResource {
+	sortResources(applicationResources)
+	if !options.HideOutputResources {
+		return applicationResources
+	}
+
+	filtered := []*v20231001preview.ApplicationGraphResource{}
+	for _, resource := range applicationResources {
+		copied := *resource
+		copied.OutputResources = []*v20231001preview.ApplicationGraphOutputResource{}
+		filtered = append(filtered, &copied)
+	}
+	return filtered
+}
+
+// groupNodes groups the output resource nodes by provider when requested. Nodes that are not grouped are returned
+// with the empty provider name. The provider names are returned in sorted order.
+func groupNodes(nodes []diagramNode, options diagramOptions) (map[string][]diagramNode, []string) {
+	groups := map[string][]diagramNode{}
+	providers := []string{}
+	for _, node := range nodes {
+		provider := ""
+		if options.GroupByProvider && node.Output && node.Provider != "" {
+			provider = node.Provider
+		}
+
+		if _, ok := groups[provider]; !ok && provider != "" {
+			providers = append(providers, provider)
+		}
+		groups[provider] = append(groups[provider], node)
+	}
+
+	sort.Strings(providers)
+	return groups, providers
+}
+
+func makeExternalNode(id string) diagramNode {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return diagramNode{ID: id, Name: id}
+	}
+
+	return diagramNode{ID: id, Name: parsed.Name(), Type: parsed.Type()}
+}
+
+func valueOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+func mermaidQuote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, "#quot;") + `"`
+}
+
+func mermaidID(s string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, s)
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connections
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+const kubernetesDeploymentResourceID = "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/webapp"
+
+func makeDiagramGraph() []*v20231001preview.ApplicationGraphResource {
+	return []*v20231001preview.ApplicationGraphResource{
+		{
+			ID:                to.Ptr(redisResourceID),
+			Name:              to.Ptr(redisResourceName),
+			Type:              to.Ptr(redisResourceType),
+			ProvisioningState: to.Ptr(provisioningStateSuccess),
+			OutputResources: []*v20231001preview.ApplicationGraphOutputResource{
+				{
+					ID:   to.Ptr(awsMemoryDBResourceID),
+					Name: to.Ptr("redis-aqbjixghynqgg"),
+					Type: to.Ptr("AWS.MemoryDB/Cluster"),
+				},
+			},
+			Connections: []*v20231001preview.ApplicationGraphConnection{
+				{
+					ID:        to.Ptr(containerResourceID),
+					Direction: &directionInbound,
+				},
+			},
+		},
+		{
+			ID:                to.Ptr(containerResourceID),
+			Name:              to.Ptr(containerResourceName),
+			Type:              to.Ptr(containerResourceType),
+			ProvisioningState: to.Ptr(provisioningStateSuccess),
+			OutputResources: []*v20231001preview.ApplicationGraphOutputResource{
+				{
+					ID:   to.Ptr(kubernetesDeploymentResourceID),
+					Name: to.Ptr("webapp"),
+					Type: to.Ptr("apps/Deployment"),
+				},
+			},
+			Connections: []*v20231001preview.ApplicationGraphConnection{
+				{
+					ID:        to.Ptr(redisResourceID),
+					Direction: &directionOutbound,
+				},
+				{
+					ID:        to.Ptr(azureRedisCacheResourceID),
+					Direction: &directionOutbound,
+				},
+			},
+		},
+	}
+}
+
+func Test_displayDOT(t *testing.T) {
+	t.Run("empty graph", func(t *testing.T) {
+		expected := `digraph "cool-app" {
+  rankdir=LR;
+  node [shape=box];
+}
+`
+		require.Equal(t, expected, displayDOT([]*v20231001preview.ApplicationGraphResource{}, "cool-app", diagramOptions{}))
+	})
+
+	t.Run("with output resources", func(t *testing.T) {
+		expected := `digraph "test-app" {
+  rankdir=LR;
+  node [shape=box];
+
+  "` + containerResourceID + `" [label="webapp\nApplications.Core/containers"];
+  "` + redisResourceID + `" [label="redis\nApplications.Datastores/redisCaches"];
+  "` + azureRedisCacheResourceID + `" [label="redis\nMicrosoft.Cache/Redis"];
+  "` + kubernetesDeploymentResourceID + `" [label="webapp\napps/Deployment", shape=ellipse];
+  "` + awsMemoryDBResourceID + `" [label="redis-aqbjixghynqgg\nAWS.MemoryDB/Cluster", shape=ellipse];
+
+  "` + containerResourceID + `" -> "` + azureRedisCacheResourceID + `";
+  "` + containerResourceID + `" -> "` + redisResourceID + `";
+  "` + containerResourceID + `" -> "` + kubernetesDeploymentResourceID + `" [style=dashed];
+  "` + redisResourceID + `" -> "` + awsMemoryDBResourceID + `" [style=dashed];
+}
+`
+		require.Equal(t, expected, displayDOT(makeDiagramGraph(), "test-app", diagramOptions{}))
+	})
+
+	t.Run("hide output resources", func(t *testing.T) {
+		expected := `digraph "test-app" {
+  rankdir=LR;
+  node [shape=box];
+
+  "` + containerResourceID + `" [label="webapp\nApplications.Core/containers"];
+  "` + redisResourceID + `" [label="redis\nApplications.Datastores/redisCaches"];
+  "` + azureRedisCacheResourceID + `" [label="redis\nMicrosoft.Cache/Redis"];
+
+  "` + containerResourceID + `" -> "` + azureRedisCacheResourceID + `";
+  "` + containerResourceID + `" -> "` + redisResourceID + `";
+}
+`
+		require.Equal(t, expected, displayDOT(makeDiagramGraph(), "test-app", diagramOptions{HideOutputResources: true}))
+	})
+
+	t.Run("group by provider", func(t *testing.T) {
+		expected := `digraph "test-app" {
+  rankdir=LR;
+  node [shape=box];
+
+  "` + containerResourceID + `" [label="webapp\nApplications.Core/containers"];
+  "` + redisResourceID + `" [label="redis\nApplications.Datastores/redisCaches"];
+  "` + azureRedisCacheResourceID + `" [label="redis\nMicrosoft.Cache/Redis"];
+
+  subgraph "cluster_aws" {
+    label="aws";
+    "` + awsMemoryDBResourceID + `" [label="redis-aqbjixghynqgg\nAWS.MemoryDB/Cluster", shape=ellipse];
+  }
+
+  subgraph "cluster_kubernetes" {
+    label="kubernetes";
+    "` + kubernetesDeploymentResourceID + `" [label="webapp\napps/Deployment", shape=ellipse];
+  }
+
+  "` + containerResourceID + `" -> "` + azureRedisCacheResourceID + `";
+  "` + containerResourceID + `" -> "` + redisResourceID + `";
+  "` + containerResourceID + `" -> "` + kubernetesDeploymentResourceID + `" [style=dashed];
+  "` + redisResourceID + `" -> "` + awsMemoryDBResourceID + `" [style=dashed];
+}
+`
+		require.Equal(t, expected, displayDOT(makeDiagramGraph(), "test-app", diagramOptions{GroupByProvider: true}))
+	})
+
+	t.Run("escapes quotes", func(t *testing.T) {
+		expected := `digraph "my \"app\"" {
+  rankdir=LR;
+  node [shape=box];
+}
+`
+		require.Equal(t, expected, displayDOT([]*v20231001preview.ApplicationGraphResource{}, `my "app"`, diagramOptions{}))
+	})
+}
+
+func Test_displayMermaid(t *testing.T) {
+	t.Run("empty graph", func(t *testing.T) {
+		require.Equal(t, "graph LR\n", displayMermaid([]*v20231001preview.ApplicationGraphResource{}, diagramOptions{}))
+	})
+
+	t.Run("with output resources", func(t *testing.T) {
+		expected := `graph LR
+  n0["webapp<br/>Applications.Core/containers"]
+  n1["redis<br/>Applications.Datastores/redisCaches"]
+  n2["redis<br/>Microsoft.Cache/Redis"]
+  n3(["webapp<br/>apps/Deployment"])
+  n4(["redis-aqbjixghynqgg<br/>AWS.MemoryDB/Cluster"])
+  n0 --> n2
+  n0 --> n1
+  n0 -.-> n3
+  n1 -.-> n4
+`
+		require.Equal(t, expected, displayMermaid(makeDiagramGraph(), diagramOptions{}))
+	})
+
+	t.Run("hide output resources", func(t *testing.T) {
+		expected := `graph LR
+  n0["webapp<br/>Applications.Core/containers"]
+  n1["redis<br/>Applications.Datastores/redisCaches"]
+  n2["redis<br/>Microsoft.Cache/Redis"]
+  n0 --> n2
+  n0 --> n1
+`
+		require.Equal(t, expected, displayMermaid(makeDiagramGraph(), diagramOptions{HideOutputResources: true}))
+	})
+
+	t.Run("group by provider", func(t *testing.T) {
+		expected := `graph LR
+  n0["webapp<br/>Applications.Core/containers"]
+  n1["redis<br/>Applications.Datastores/redisCaches"]
+  n2["redis<br/>Microsoft.Cache/Redis"]
+  subgraph provider_aws ["aws"]
+    n4(["redis-aqbjixghynqgg<br/>AWS.MemoryDB/Cluster"])
+  end
+  subgraph provider_kubernetes ["kubernetes"]
+    n3(["webapp<br/>apps/Deployment"])
+  end
+  n0 --> n2
+  n0 --> n1
+  n0 -.-> n3
+  n1 -.-> n4
+`
+		require.Equal(t, expected, displayMermaid(makeDiagramGraph(), diagramOptions{GroupByProvider: true}))
+	})
+}
+
+func Test_filterGraph(t *testing.T) {
+	graph := makeDiagramGraph()
+
+	filtered := filterGraph(graph, diagramOptions{HideOutputResources: true})
+	require.Len(t, filtered, 2)
+	require.Equal(t, containerResourceID, *filtered[0].ID)
+	require.Empty(t, filtered[0].OutputResources)
+	require.Empty(t, filtered[1].OutputResources)
+
+	// The original graph is not modified.
+	require.Len(t, graph[0].OutputResources, 1)
+
+	unfiltered := filterGraph(graph, diagramOptions{})
+	require.Len(t, unfiltered[0].OutputResources, 1)
+}
@@ -27,25 +27,7 @@ import (
 
 // display builds the formatted output for the application graph as text.
 func display(applicationResources []*v20231001preview.ApplicationGraphResource, applicationName string) string {
-	// Sort by type (containers first), and then by other types, name and then by id.
-	containerType := "Applications.Core/containers"
-	sort.Slice(applicationResources, func(i, j int) bool {
-		if strings.EqualFold(*applicationResources[i].Type, containerType) !=
-			strings.EqualFold(*applicationResources[j].Type, containerType) {
-
-			return strings.EqualFold(*applicationResources[i].Type, containerType)
-		}
-
-		if *applicationResources[i].Type != *applicationResources[j].Type {
-			return *applicationResources[i].Type < *applicationResources[j].Type
-		}
-
-		if *applicationResources[i].Name != *applicationResources[j].Name {
-			return *applicationResources[i].Name < *applicationResources[j].Name
-		}
-		return *applicationResources[i].ID < *applicationResources[j].ID
-
-	})
+	sortResources(applicationResources)
 
 	output := &strings.Builder{}
 	output.WriteString(fmt.Sprintf("Displaying application: %s\n\n", applicationName))
@@ -103,6 +85,28 @@ func display(applicationResources []*v20231001preview.ApplicationGraphResource,
 	return output.String()
 }
 
+// sortResources sorts the resources by type (containers first), and then by other types, name and then by id.
+func sortResources(applicationResources []*v20231001preview.ApplicationGraphResource) {
+	containerType := "Applications.Core/containers"
+	sort.Slice(applicationResources, func(i, j int) bool {
+		if strings.EqualFold(*applicationResources[i].Type, containerType) !=
+			strings.EqualFold(*applicationResources[j].Type, containerType) {
+
+			return strings.EqualFold(*applicationResources[i].Type, containerType)
+		}
+
+		if *applicationResources[i].Type != *applicationResources[j].Type {
+			return *applicationResources[i].Type < *applicationResources[j].Type
+		}
+
+		if *applicationResources[i].Name != *applicationResources[j].Name {
+			return *applicationResources[i].Name < *applicationResources[j].Name
+		}
+		return *applicationResources[i].ID < *applicationResources[j].ID
+
+	})
+}
+
 func makeHyperlink(resource *v20231001preview.ApplicationGraphOutputResource) string {
 	// Just azure for now.
 	provider := providerFromID(*resource.ID)
@@ -0,0 +1,137 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/history"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the `rad app history` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Show the deployment history of a Radius Application",
+		Long: `Show the deployment history of a Radius Application. Shows the history of the user's default application (if configured) by default.
+
+Each deployment made with 'rad deploy', 'rad run' or 'rad app rollback' from this machine is recorded as a revision of the application. A revision can be redeployed with 'rad app rollback'.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `
+# Show the deployment history of the current application
+rad app history
+
+# Show the deployment history of the specified application
+rad app history my-app
+
+# Show the deployment history of the specified application in a specified resource group
+rad app history my-app --group my-group
+`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad app history` command.
+type Runner struct {
+	ConfigHolder *framework.ConfigHolder
+	History      history.Interface
+	Output       output.Interface
+	Workspace    *workspaces.Workspace
+
+	ApplicationName string
+	Format          string
+}
+
+// NewRunner creates an instance of the runner for the `rad app history` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder: factory.GetConfigHolder(),
+		History:      factory.GetHistory(),
+		Output:       factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad app history` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.ApplicationName, err = cli.RequireApplicationArgs(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad app history` command.
+func (r *Runner) Run(ctx context.Context) error {
+	revisions, err := r.History.List(ctx, history.Key{
+		Workspace:   r.Workspace.Name,
+		Scope:       r.Workspace.Scope,
+		Application: r.ApplicationName,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(revisions) == 0 {
+		r.Output.LogInfo("No deployment history found for application %q.", r.ApplicationName)
+		return nil
+	}
+
+	// The compiled template and parameters are only needed for rollback, and are too large to be useful here.
+	for i := range revisions {
+		revisions[i].Template = nil
+		revisions[i].Parameters = nil
+		revisions[i].Providers = nil
+	}
+
+	return r.Output.WriteFormatted(r.Format, revisions, historyFormat())
+}
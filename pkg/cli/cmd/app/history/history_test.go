@@ -0,0 +1,151 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/history"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "History Command with flag",
+			Input:         []string{"-a", "test-app"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "History Command with positional arg",
+			Input:         []string{"test-app"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "History Command with output format",
+			Input:         []string{"test-app", "-o", "json"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "History Command without application",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "History Command with too many args",
+			Input:         []string{"foo", "bar"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Name:  "test-workspace",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+	key := history.Key{Workspace: "test-workspace", Scope: workspace.Scope, Application: "test-app"}
+
+	t.Run("Success: revisions found", func(t *testing.T) {
+		store := &history.FileStore{Directory: t.TempDir()}
+		for i := 0; i < 2; i++ {
+			_, err := store.Record(context.Background(), key, history.Revision{
+				Timestamp:    time.Date(2023, 10, 1, 12, i, 0, 0, time.UTC),
+				Status:       history.StatusSucceeded,
+				TemplateFile: "app.bicep",
+				Template:     map[string]any{"resources": map[string]any{}},
+			})
+			require.NoError(t, err)
+		}
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			History:         store,
+			Output:          outputSink,
+			Workspace:       workspace,
+			ApplicationName: "test-app",
+			Format:          "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, outputSink.Writes, 1)
+		formatted := outputSink.Writes[0].(output.FormattedOutput)
+		require.Equal(t, historyFormat(), formatted.Options)
+
+		revisions := formatted.Obj.([]history.Revision)
+		require.Len(t, revisions, 2)
+		require.Equal(t, 2, revisions[0].Revision)
+		require.Equal(t, 1, revisions[1].Revision)
+		require.Nil(t, revisions[0].Template)
+	})
+
+	t.Run("Success: no revisions", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			History:         &history.FileStore{Directory: t.TempDir()},
+			Output:          outputSink,
+			Workspace:       workspace,
+			ApplicationName: "test-app",
+			Format:          "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "No deployment history found for application %q.",
+				Params: []any{"test-app"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
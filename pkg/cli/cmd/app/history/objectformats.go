@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/output"
+)
+
+const (
+	// shortHashLength is the number of characters of the template hash shown in the table output.
+	shortHashLength = 12
+
+	// timestampLayout is the layout of the deployment time shown in the table output.
+	timestampLayout = "2006-01-02 15:04:05 MST"
+)
+
+// historyFormat returns the columns and headings for a table to display the deployment history of an application.
+func historyFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "REVISION",
+				JSONPath: "{ .Revision }",
+			},
+			{
+				Heading:     "DEPLOYED",
+				JSONPath:    "{ .Timestamp }",
+				Transformer: &timestampTransformer{},
+			},
+			{
+				Heading:  "STATUS",
+				JSONPath: "{ .Status }",
+			},
+			{
+				Heading:  "USER",
+				JSONPath: "{ .User }",
+			},
+			{
+				Heading:  "TEMPLATE",
+				JSONPath: "{ .TemplateFile }",
+			},
+			{
+				Heading:     "HASH",
+				JSONPath:    "{ .TemplateHash }",
+				Transformer: &shortHashTransformer{},
+			},
+		},
+	}
+}
+
+// shortHashTransformer is a transformer that shortens a template hash for display.
+type shortHashTransformer struct {
+}
+
+// Transform returns the first characters of the hash.
+func (t *shortHashTransformer) Transform(input string) string {
+	if len(input) <= shortHashLength {
+		return input
+	}
+
+	return input[:shortHashLength]
+}
+
+// timestampTransformer is a transformer that formats the JSON encoding of a deployment time for display.
+type timestampTransformer struct {
+}
+
+// Transform returns the deployment time in a human readable format.
+func (t *timestampTransformer) Transform(input string) string {
+	if unquoted, err := strconv.Unquote(input); err == nil {
+		input = unquoted
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, input)
+	if err != nil {
+		return input
+	}
+
+	return timestamp.UTC().Format(timestampLayout)
+}
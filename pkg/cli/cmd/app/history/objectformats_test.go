@@ -0,0 +1,46 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/history"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_HistoryFormat(t *testing.T) {
+	obj := history.Revision{
+		Revision:     3,
+		Timestamp:    time.Date(2023, 10, 1, 12, 30, 0, 0, time.UTC),
+		User:         "test-user",
+		Status:       history.StatusSucceeded,
+		TemplateFile: "app.bicep",
+		TemplateHash: "0123456789abcdef0123456789abcdef",
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, historyFormat())
+	require.NoError(t, err)
+
+	expected := "REVISION  DEPLOYED                 STATUS     USER       TEMPLATE   HASH\n" +
+		"3         2023-10-01 12:30:00 UTC  Succeeded  test-user  app.bicep  0123456789ab\n"
+	require.Equal(t, expected, buffer.String())
+}
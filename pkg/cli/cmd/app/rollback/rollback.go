@@ -0,0 +1,241 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/deploy"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/history"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	rollbackConfirmation  = "Are you sure you want to roll back application '%v' to revision %v?"
+	secureParameterPrompt = "Enter the value of the secure parameter '%v':"
+)
+
+// NewCommand creates an instance of the `rad app rollback` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "rollback <revision>",
+		Short: "Roll back a Radius Application to a previous revision",
+		Long: `Roll back a Radius Application to a previous revision. Rolls back the user's default application (if configured) by default.
+
+Rolling back redeploys the compiled template and parameters recorded for the revision, and records a new revision. Use 'rad app history' to list the revisions of an application.
+
+The values of secure parameters are not recorded. You will be prompted for them when rolling back.`,
+		Args: cobra.ExactArgs(1),
+		Example: `
+# Roll back the current application to revision 3
+rad app rollback 3
+
+# Roll back the specified application to revision 3
+rad app rollback 3 --application my-app
+
+# Roll back the specified application to revision 3 and bypass the confirmation prompt
+rad app rollback 3 --application my-app --yes
+`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	commonflags.AddConfirmationFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad app rollback` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Deploy            deploy.Interface
+	History           history.Interface
+	InputPrompter     prompt.Interface
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	ApplicationName string
+	Revision        int
+	Confirm         bool
+}
+
+// NewRunner creates an instance of the runner for the `rad app rollback` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Deploy:            factory.GetDeploy(),
+		History:           factory.GetHistory(),
+		InputPrompter:     factory.GetPrompter(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad app rollback` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.ApplicationName, err = cli.RequireApplication(cmd, *workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Revision, err = strconv.Atoi(args[0])
+	if err != nil || r.Revision < 1 {
+		return clierrors.Message("The revision %q is invalid. Specify a revision number from `rad app history`.", args[0])
+	}
+
+	r.Confirm, err = cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad app rollback` command.
+func (r *Runner) Run(ctx context.Context) error {
+	key := history.Key{
+		Workspace:   r.Workspace.Name,
+		Scope:       r.Workspace.Scope,
+		Application: r.ApplicationName,
+	}
+
+	revision, err := r.History.Get(ctx, key, r.Revision)
+	if errors.Is(err, history.ErrNotFound) {
+		return clierrors.Message("The revision %d of application %q was not found. Use `rad app history` to list the revisions of the application.", r.Revision, r.ApplicationName)
+	} else if err != nil {
+		return err
+	}
+
+	if revision.Status != history.StatusSucceeded {
+		return clierrors.Message("The revision %d of application %q did not deploy successfully and cannot be rolled back to.", r.Revision, r.ApplicationName)
+	}
+
+	if revision.Template == nil || revision.Providers == nil || revision.Providers.Radius == nil {
+		return clierrors.Message("The revision %d of application %q does not contain a deployable template.", r.Revision, r.ApplicationName)
+	}
+
+	if !r.Confirm {
+		confirmed, err := prompt.YesOrNoPrompt(fmt.Sprintf(rollbackConfirmation, r.ApplicationName, r.Revision), prompt.ConfirmNo, r.InputPrompter)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	parameters, err := r.promptSecureParameters(revision)
+	if err != nil {
+		return err
+	}
+
+	progressText := fmt.Sprintf(
+		"Rolling back application '%v' to revision %v in environment '%v' from workspace '%v'...\n\n"+
+			"Deployment In Progress... ", r.ApplicationName, r.Revision, revision.Environment, r.Workspace.Name)
+
+	result, err := r.Deploy.DeployWithProgress(ctx, deploy.Options{
+		ConnectionFactory: r.ConnectionFactory,
+		Workspace:         *r.Workspace,
+		Template:          revision.Template,
+		Parameters:        parameters,
+		ProgressText:      progressText,
+		CompletionText:    "Rollback Complete",
+		Providers:         revision.Providers,
+	})
+
+	recorded, recordErr := history.RecordDeployment(ctx, r.History, history.DeploymentOptions{
+		Workspace:       r.Workspace.Name,
+		Scope:           r.Workspace.Scope,
+		Environment:     revision.Environment,
+		ApplicationName: r.ApplicationName,
+		TemplateFile:    revision.TemplateFile,
+		Template:        revision.Template,
+		Parameters:      parameters,
+		Providers:       revision.Providers,
+		Result:          result,
+		Error:           err,
+		RollbackOf:      revision.Revision,
+	})
+	if recordErr != nil {
+		r.Output.LogInfo("Failed to record the deployment history: %v", recordErr)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if recordErr == nil && len(recorded) > 0 {
+		r.Output.LogInfo("")
+		r.Output.LogInfo("Rolled back application %q to revision %d as revision %d.", r.ApplicationName, r.Revision, recorded[0].Revision)
+	}
+
+	return nil
+}
+
+// promptSecureParameters returns the parameters of the revision, with the values of the secure parameters that were
+// not recorded provided by the user.
+func (r *Runner) promptSecureParameters(revision history.Revision) (clients.DeploymentParameters, error) {
+	if len(revision.SecureParameters) == 0 {
+		return revision.Parameters, nil
+	}
+
+	parameters := clients.DeploymentParameters{}
+	for name, value := range revision.Parameters {
+		parameters[name] = value
+	}
+
+	for _, name := range revision.SecureParameters {
+		value, err := r.InputPrompter.GetTextInput(fmt.Sprintf(secureParameterPrompt, name), prompt.TextInputOptions{EchoMode: textinput.EchoPassword})
+		if err != nil {
+			return nil, err
+		}
+		parameters[name] = map[string]any{"value": value}
+	}
+
+	return parameters, nil
+}
@@ -0,0 +1,319 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollback
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/deploy"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/history"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Rollback Command with revision",
+			Input:         []string{"3", "-a", "test-app"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, 3, r.Revision)
+				require.Equal(t, "test-app", r.ApplicationName)
+				require.False(t, r.Confirm)
+			},
+		},
+		{
+			Name:          "Rollback Command with confirmation",
+			Input:         []string{"3", "-a", "test-app", "--yes"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.True(t, runner.(*Runner).Confirm)
+			},
+		},
+		{
+			Name:          "Rollback Command with invalid revision",
+			Input:         []string{"latest", "-a", "test-app"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Rollback Command with zero revision",
+			Input:         []string{"0", "-a", "test-app"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Rollback Command without revision",
+			Input:         []string{"-a", "test-app"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Rollback Command without application",
+			Input:         []string{"3"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Name:  "test-workspace",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+	key := history.Key{Workspace: "test-workspace", Scope: workspace.Scope, Application: "test-app"}
+	template := map[string]any{"resources": map[string]any{}}
+	parameters := clients.DeploymentParameters{"image": map[string]any{"value": "nginx:1.0"}}
+	providers := &clients.Providers{
+		Radius: &clients.RadiusProvider{
+			EnvironmentID: workspace.Scope + "/providers/applications.core/environments/test-env",
+			ApplicationID: workspace.Scope + "/providers/applications.core/applications/test-app",
+		},
+	}
+
+	setup := func(t *testing.T, status string) *history.FileStore {
+		store := &history.FileStore{Directory: t.TempDir()}
+		_, err := store.Record(context.Background(), key, history.Revision{
+			Status:       status,
+			TemplateFile: "app.bicep",
+			Environment:  "test-env",
+			Template:     template,
+			Parameters:   parameters,
+			Providers:    providers,
+		})
+		require.NoError(t, err)
+		return store
+	}
+
+	t.Run("Success: rollback", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := setup(t, history.StatusSucceeded)
+
+		deployMock := deploy.NewMockInterface(ctrl)
+		deployMock.EXPECT().
+			DeployWithProgress(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, options deploy.Options) (clients.DeploymentResult, error) {
+				require.Equal(t, template, options.Template)
+				require.Equal(t, parameters, options.Parameters)
+				require.Equal(t, providers, options.Providers)
+				return clients.DeploymentResult{}, nil
+			}).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{},
+			Deploy:            deployMock,
+			History:           store,
+			Output:            outputSink,
+			Workspace:         workspace,
+			ApplicationName:   "test-app",
+			Revision:          1,
+			Confirm:           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		revisions, err := store.List(context.Background(), key)
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+		require.Equal(t, 2, revisions[0].Revision)
+		require.Equal(t, 1, revisions[0].RollbackOf)
+		require.Equal(t, history.StatusSucceeded, revisions[0].Status)
+		require.Equal(t, "app.bicep", revisions[0].TemplateFile)
+
+		require.Contains(t, outputSink.Writes, output.LogOutput{
+			Format: "Rolled back application %q to revision %d as revision %d.",
+			Params: []any{"test-app", 1, 2},
+		})
+	})
+
+	t.Run("Success: rollback prompts for secure parameters", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := &history.FileStore{Directory: t.TempDir()}
+		_, err := store.Record(context.Background(), key, history.Revision{
+			Status:           history.StatusSucceeded,
+			TemplateFile:     "app.bicep",
+			Environment:      "test-env",
+			Template:         template,
+			Parameters:       parameters,
+			SecureParameters: []string{"password"},
+			Providers:        providers,
+		})
+		require.NoError(t, err)
+
+		promptMock := prompt.NewMockInterface(ctrl)
+		promptMock.EXPECT().
+			GetTextInput("Enter the value of the secure parameter 'password':", gomock.Any()).
+			Return("hunter2", nil).
+			Times(1)
+
+		deployMock := deploy.NewMockInterface(ctrl)
+		deployMock.EXPECT().
+			DeployWithProgress(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, options deploy.Options) (clients.DeploymentResult, error) {
+				expected := clients.DeploymentParameters{
+					"image":    map[string]any{"value": "nginx:1.0"},
+					"password": map[string]any{"value": "hunter2"},
+				}
+				require.Equal(t, expected, options.Parameters)
+				return clients.DeploymentResult{}, nil
+			}).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{},
+			Deploy:            deployMock,
+			History:           store,
+			InputPrompter:     promptMock,
+			Output:            &output.MockOutput{},
+			Workspace:         workspace,
+			ApplicationName:   "test-app",
+			Revision:          1,
+			Confirm:           true,
+		}
+
+		err = runner.Run(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("Success: rollback cancelled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := setup(t, history.StatusSucceeded)
+
+		promptMock := prompt.NewMockInterface(ctrl)
+		promptMock.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, "Are you sure you want to roll back application 'test-app' to revision 1?").
+			Return(prompt.ConfirmNo, nil).
+			Times(1)
+
+		runner := &Runner{
+			Deploy:          deploy.NewMockInterface(ctrl),
+			History:         store,
+			InputPrompter:   promptMock,
+			Output:          &output.MockOutput{},
+			Workspace:       workspace,
+			ApplicationName: "test-app",
+			Revision:        1,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		revisions, err := store.List(context.Background(), key)
+		require.NoError(t, err)
+		require.Len(t, revisions, 1)
+	})
+
+	t.Run("Error: failed deployment is recorded", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := setup(t, history.StatusSucceeded)
+
+		deployMock := deploy.NewMockInterface(ctrl)
+		deployMock.EXPECT().
+			DeployWithProgress(gomock.Any(), gomock.Any()).
+			Return(clients.DeploymentResult{}, errors.New("deployment failed")).
+			Times(1)
+
+		runner := &Runner{
+			Deploy:          deployMock,
+			History:         store,
+			Output:          &output.MockOutput{},
+			Workspace:       workspace,
+			ApplicationName: "test-app",
+			Revision:        1,
+			Confirm:         true,
+		}
+
+		err := runner.Run(context.Background())
+		require.EqualError(t, err, "deployment failed")
+
+		revisions, err := store.List(context.Background(), key)
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+		require.Equal(t, history.StatusFailed, revisions[0].Status)
+		require.Equal(t, "deployment failed", revisions[0].Error)
+	})
+
+	t.Run("Error: revision not found", func(t *testing.T) {
+		runner := &Runner{
+			History:         setup(t, history.StatusSucceeded),
+			Output:          &output.MockOutput{},
+			Workspace:       workspace,
+			ApplicationName: "test-app",
+			Revision:        5,
+			Confirm:         true,
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The revision %d of application %q was not found. Use `rad app history` to list the revisions of the application.", 5, "test-app"), err)
+	})
+
+	t.Run("Error: failed revision", func(t *testing.T) {
+		runner := &Runner{
+			History:         setup(t, history.StatusFailed),
+			Output:          &output.MockOutput{},
+			Workspace:       workspace,
+			ApplicationName: "test-app",
+			Revision:        1,
+			Confirm:         true,
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The revision %d of application %q did not deploy successfully and cannot be rolled back to.", 1, "test-app"), err)
+	})
+}
@@ -18,7 +18,7 @@ package status
 
 import "github.com/radius-project/radius/pkg/cli/output"
 
-// statusFormat sets up the columns and headings for a table to display application names and resource counts.
+// statusFormat sets up the columns and headings for a table to display application names, resource counts and health.
 func statusFormat() output.FormatterOptions {
 	return output.FormatterOptions{
 		Columns: []output.Column{
@@ -30,6 +30,35 @@ func statusFormat() output.FormatterOptions {
 				Heading:  "RESOURCES",
 				JSONPath: "{ .ResourceCount }",
 			},
+			{
+				Heading:  "HEALTH",
+				JSONPath: "{ .Health }",
+			},
+		},
+	}
+}
+
+// resourceHealthFormat returns the columns and headings for a table to display the health of the resources
+// of an application.
+func resourceHealthFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "HEALTH",
+				JSONPath: "{ .State }",
+			},
+			{
+				Heading:  "MESSAGE",
+				JSONPath: "{ .Message }",
+			},
 		},
 	}
 }
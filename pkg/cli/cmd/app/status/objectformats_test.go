@@ -29,13 +29,31 @@ func Test_GetApplicationStatusTableFormat(t *testing.T) {
 	obj := clients.ApplicationStatus{
 		Name:          "test",
 		ResourceCount: 3,
+		Health:        clients.HealthStateDegraded,
 	}
 
 	buffer := &bytes.Buffer{}
 	err := output.Write(output.FormatTable, obj, buffer, statusFormat())
 	require.NoError(t, err)
 
-	expected := "APPLICATION  RESOURCES\ntest         3\n"
+	expected := "APPLICATION  RESOURCES  HEALTH\ntest         3          Degraded\n"
+	require.Equal(t, expected, buffer.String())
+}
+
+func Test_GetResourceHealthTableFormat(t *testing.T) {
+	obj := clients.ResourceHealth{
+		Name:    "test",
+		Type:    "Applications.Core/containers",
+		State:   clients.HealthStateDegraded,
+		Message: "Deployment test: 1/2 replicas ready",
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, resourceHealthFormat())
+	require.NoError(t, err)
+
+	expected := "RESOURCE  TYPE                          HEALTH    MESSAGE\n" +
+		"test      Applications.Core/containers  Degraded  Deployment test: 1/2 replicas ready\n"
 	require.Equal(t, expected, buffer.String())
 }
 
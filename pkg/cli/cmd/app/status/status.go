@@ -18,6 +18,8 @@ package status
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
@@ -31,6 +33,14 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	watchFlag    = "watch"
+	intervalFlag = "interval"
+
+	// defaultInterval is the default interval between refreshes when watching the status of an application.
+	defaultInterval = 5 * time.Second
+)
+
 // NewCommand creates an instance of the `rad app status` command and runner.
 func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	runner := NewRunner(factory)
@@ -38,8 +48,10 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	cmd := &cobra.Command{
 		Use:   "status",
 		Short: "Show Radius Application status",
-		Long:  `Show Radius Application status, such as public endpoints and resource count. Shows details for the user's default application (if configured) by default.`,
-		Args:  cobra.MaximumNArgs(1),
+		Long: `Show Radius Application status, such as public endpoints, resource count and health. Shows details for the user's default application (if configured) by default.
+
+The health of each resource is computed from its provisioning state and the state of its output resources, such as the readiness and restarts of Kubernetes deployments and the status of gateway HTTP proxies. The health of the application is the health of its least healthy resource.`,
+		Args: cobra.MaximumNArgs(1),
 		Example: `
 # Show status of current application
 rad app status
@@ -49,6 +61,9 @@ rad app status my-app
 
 # Show status of specified application in a specified resource group
 rad app status my-app --group my-group
+
+# Watch the status of the current application, refreshing every 10 seconds
+rad app status --watch --interval 10s
 `,
 		RunE: framework.RunCommand(runner),
 	}
@@ -57,6 +72,8 @@ rad app status my-app --group my-group
 	commonflags.AddResourceGroupFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().Bool(watchFlag, false, "Refresh the status until interrupted")
+	cmd.Flags().Duration(intervalFlag, defaultInterval, "The interval between refreshes when watching")
 
 	return cmd, runner
 }
@@ -70,6 +87,8 @@ type Runner struct {
 
 	ApplicationName string
 	Format          string
+	Watch           bool
+	Interval        time.Duration
 }
 
 // NewRunner creates an instance of the runner for the `rad app status` command.
@@ -112,13 +131,27 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 
 	r.Format = format
 
+	r.Watch, err = cmd.Flags().GetBool(watchFlag)
+	if err != nil {
+		return err
+	}
+
+	r.Interval, err = cmd.Flags().GetDuration(intervalFlag)
+	if err != nil {
+		return err
+	}
+	if r.Interval <= 0 {
+		return clierrors.Message("The interval %q must be greater than zero.", r.Interval)
+	}
+
 	return nil
 }
 
 // Run runs the `rad app status` command.
 //
 
-// Run() retrieves the application status and its associated gateways from the given workspace and returns it in the specified format.
+// Run() retrieves the application status, health and gateways from the given workspace and returns it in the specified
+// format. When watching, the status is refreshed at the configured interval until the context is cancelled.
 // It returns an error if the application is not found or if there is an error while retrieving the application status.
 func (r *Runner) Run(ctx context.Context) error {
 	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
@@ -126,39 +159,83 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	diagnosticsClient, err := r.ConnectionFactory.CreateDiagnosticsClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	if !r.Watch {
+		applicationStatus, err := r.getStatus(ctx, client, diagnosticsClient)
+		if err != nil {
+			return err
+		}
+
+		return r.writeStatus(applicationStatus)
+	}
+
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+
+	for {
+		applicationStatus, err := r.getStatus(ctx, client, diagnosticsClient)
+		if ctx.Err() != nil {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		// The banners would make the other formats unparseable, so they are only printed for the table format.
+		if r.isTableFormat() {
+			r.Output.LogInfo("Status of application %q at %s (refreshing every %s, press CTRL+C to stop):", r.ApplicationName, time.Now().Format(time.TimeOnly), r.Interval)
+			r.Output.LogInfo("")
+		}
+		err = r.writeStatus(applicationStatus)
+		if err != nil {
+			return err
+		}
+		if r.isTableFormat() {
+			r.Output.LogInfo("")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// getStatus retrieves the resources, health and gateways of the application.
+func (r *Runner) getStatus(ctx context.Context, client clients.ApplicationsManagementClient, diagnosticsClient clients.DiagnosticsClient) (clients.ApplicationStatus, error) {
 	application, err := client.ShowApplication(ctx, r.ApplicationName)
 	if clients.Is404Error(err) {
-		return clierrors.Message("The application %q was not found or has been deleted.", r.ApplicationName)
+		return clients.ApplicationStatus{}, clierrors.Message("The application %q was not found or has been deleted.", r.ApplicationName)
 	} else if err != nil {
-		return err
+		return clients.ApplicationStatus{}, err
 	}
 
 	resourceList, err := client.ListAllResourcesByApplication(ctx, r.ApplicationName)
 	if err != nil {
-		return err
+		return clients.ApplicationStatus{}, err
 	}
 
 	applicationStatus := clients.ApplicationStatus{
 		Name:          *application.Name,
 		ResourceCount: len(resourceList),
-	}
-
-	diagnosticsClient, err := r.ConnectionFactory.CreateDiagnosticsClient(ctx, *r.Workspace)
-	if err != nil {
-		return err
+		Health:        clients.HealthStateHealthy,
 	}
 
 	for _, resource := range resourceList {
 		resourceID, err := resources.ParseResource(*resource.ID)
 		if err != nil {
-			return err
+			return clients.ApplicationStatus{}, err
 		}
 
 		publicEndpoint, err := diagnosticsClient.GetPublicEndpoint(ctx, clients.EndpointOptions{
 			ResourceID: resourceID,
 		})
 		if err != nil {
-			return err
+			return clients.ApplicationStatus{}, err
 		}
 
 		if publicEndpoint != nil {
@@ -167,14 +244,44 @@ func (r *Runner) Run(ctx context.Context) error {
 				Endpoint: *publicEndpoint,
 			})
 		}
+
+		health, err := diagnosticsClient.GetResourceHealth(ctx, clients.ResourceHealthOptions{
+			Resource: resource,
+		})
+		if err != nil {
+			return clients.ApplicationStatus{}, err
+		}
+
+		applicationStatus.Resources = append(applicationStatus.Resources, *health)
+		applicationStatus.Health = clients.WorstHealthState(applicationStatus.Health, health.State)
 	}
 
-	err = r.Output.WriteFormatted(r.Format, applicationStatus, statusFormat())
+	return applicationStatus, nil
+}
+
+// writeStatus writes the application status. The table format shows the health of the resources and the gateways
+// in separate tables.
+func (r *Runner) writeStatus(applicationStatus clients.ApplicationStatus) error {
+	err := r.Output.WriteFormatted(r.Format, applicationStatus, statusFormat())
 	if err != nil {
 		return err
 	}
 
-	if r.Format == output.FormatTable && len(applicationStatus.Gateways) > 0 {
+	if !r.isTableFormat() {
+		return nil
+	}
+
+	if len(applicationStatus.Resources) > 0 {
+		// Print newline for readability
+		r.Output.LogInfo("")
+
+		err = r.Output.WriteFormatted(r.Format, applicationStatus.Resources, resourceHealthFormat())
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(applicationStatus.Gateways) > 0 {
 		// Print newline for readability
 		r.Output.LogInfo("")
 
@@ -186,3 +293,7 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	return nil
 }
+
+func (r *Runner) isTableFormat() bool {
+	return strings.EqualFold(r.Format, output.FormatTable)
+}
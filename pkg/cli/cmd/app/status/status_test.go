@@ -19,6 +19,7 @@ package status
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/radius-project/radius/pkg/cli/clients"
@@ -83,6 +84,29 @@ func Test_Validate(t *testing.T) {
 				Config:         radcli.LoadEmptyConfig(t),
 			},
 		},
+		{
+			Name:          "Status Command with watch",
+			Input:         []string{"test-app", "--watch", "--interval", "10s"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadConfigWithWorkspace(t),
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.True(t, r.Watch)
+				require.Equal(t, 10*time.Second, r.Interval)
+			},
+		},
+		{
+			Name:          "Status Command with invalid interval",
+			Input:         []string{"test-app", "--watch", "--interval", "0s"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadConfigWithWorkspace(t),
+			},
+		},
 		{
 			Name:          "Status Command with incorrect args",
 			Input:         []string{"foo", "bar"},
@@ -147,6 +171,19 @@ func Test_Run(t *testing.T) {
 			Return(to.Ptr("http://some-url.example.com"), nil).
 			Times(1)
 
+		health := map[string]clients.ResourceHealth{
+			"test-container": {Name: "test-container", State: clients.HealthStateDegraded, Message: "Deployment test-container: 1/2 replicas ready"},
+			"test-route":     {Name: "test-route", State: clients.HealthStateHealthy},
+			"test-gateway":   {Name: "test-gateway", State: clients.HealthStateHealthy},
+		}
+		diagnosticsClient.EXPECT().
+			GetResourceHealth(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, options clients.ResourceHealthOptions) (*clients.ResourceHealth, error) {
+				result := health[*options.Resource.Name]
+				return &result, nil
+			}).
+			Times(3)
+
 		workspace := &workspaces.Workspace{
 			Connection: map[string]any{
 				"kind":    "kubernetes",
@@ -173,6 +210,12 @@ func Test_Run(t *testing.T) {
 		applicationStatus := clients.ApplicationStatus{
 			Name:          "test-app",
 			ResourceCount: 3,
+			Health:        clients.HealthStateDegraded,
+			Resources: []clients.ResourceHealth{
+				health["test-container"],
+				health["test-route"],
+				health["test-gateway"],
+			},
 			Gateways: []clients.GatewayStatus{
 				{
 					Name:     "test-gateway",
@@ -190,6 +233,14 @@ func Test_Run(t *testing.T) {
 			output.LogOutput{
 				Format: "",
 			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     applicationStatus.Resources,
+				Options: resourceHealthFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
 			output.FormattedOutput{
 				Format:  "table",
 				Obj:     applicationStatus.Gateways,
@@ -220,11 +271,14 @@ func Test_Run(t *testing.T) {
 		}
 		outputSink := &output.MockOutput{}
 		runner := &Runner{
-			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
-			Workspace:         workspace,
-			Format:            "table",
-			Output:            outputSink,
-			ApplicationName:   "test-app",
+			ConnectionFactory: &connections.MockFactory{
+				ApplicationsManagementClient: appManagementClient,
+				DiagnosticsClient:            clients.NewMockDiagnosticsClient(ctrl),
+			},
+			Workspace:       workspace,
+			Format:          "table",
+			Output:          outputSink,
+			ApplicationName: "test-app",
 		}
 
 		err := runner.Run(context.Background())
@@ -235,6 +289,88 @@ func Test_Run(t *testing.T) {
 	})
 }
 
+func Test_Run_Watch(t *testing.T) {
+	testcases := []struct {
+		name    string
+		format  string
+		banners bool
+	}{
+		{name: "table", format: "table", banners: true},
+		{name: "table format is case-insensitive", format: "TABLE", banners: true},
+		{name: "json", format: "json", banners: false},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+			appManagementClient.EXPECT().
+				ShowApplication(gomock.Any(), "test-app").
+				Return(v20231001preview.ApplicationResource{Name: to.Ptr("test-app")}, nil).
+				Times(2)
+
+			// Stop watching after the second refresh.
+			refreshes := 0
+			appManagementClient.EXPECT().
+				ListAllResourcesByApplication(gomock.Any(), "test-app").
+				DoAndReturn(func(ctx context.Context, applicationName string) ([]generated.GenericResource, error) {
+					refreshes++
+					if refreshes == 2 {
+						cancel()
+					}
+					return []generated.GenericResource{}, nil
+				}).
+				Times(2)
+
+			outputSink := &output.MockOutput{}
+			runner := &Runner{
+				ConnectionFactory: &connections.MockFactory{
+					ApplicationsManagementClient: appManagementClient,
+					DiagnosticsClient:            clients.NewMockDiagnosticsClient(ctrl),
+				},
+				Workspace: &workspaces.Workspace{
+					Name:  "kind-kind",
+					Scope: "/planes/radius/local/resourceGroups/test-group",
+				},
+				Format:          tc.format,
+				Output:          outputSink,
+				ApplicationName: "test-app",
+				Watch:           true,
+				Interval:        time.Millisecond,
+			}
+
+			err := runner.Run(ctx)
+			require.NoError(t, err)
+
+			// The status is written once, since the context was cancelled during the second refresh.
+			expected := output.FormattedOutput{
+				Format:  tc.format,
+				Obj:     clients.ApplicationStatus{Name: "test-app", Health: clients.HealthStateHealthy},
+				Options: statusFormat(),
+			}
+			require.Contains(t, outputSink.Writes, expected)
+			require.Equal(t, 2, refreshes)
+
+			logs := 0
+			for _, write := range outputSink.Writes {
+				if _, ok := write.(output.LogOutput); ok {
+					logs++
+				}
+			}
+			if tc.banners {
+				require.Equal(t, 3, logs)
+			} else {
+				require.Zero(t, logs)
+			}
+		})
+	}
+}
+
 func mustParse(t *testing.T, s string) resources.ID {
 	t.Helper()
 	id, err := resources.Parse(s)
@@ -0,0 +1,191 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/audit/common"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad audit list` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List audit records",
+		Long: `List audit records of create, update and delete requests, newest first.
+
+By default the records of resources in the resource group of the current workspace are listed. Use --group to list the records of another resource group, or --all to list the records of every scope.
+
+The --since and --until flags accept either a duration relative to the current time, such as 30m or 24h, or an RFC3339 timestamp.`,
+		Example: `
+# List audit records of the current resource group
+rad audit list
+
+# List audit records of the 'prod' resource group from the last day
+rad audit list --group prod --since 24h
+
+# List audit records of every scope in a time range
+rad audit list --all --since 2023-10-01T00:00:00Z --until 2023-10-02T00:00:00Z`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().Bool("all", false, "List the audit records of every scope")
+	cmd.Flags().String("since", "", "Only list records created at or after this time, as a duration (for example 24h) or an RFC3339 timestamp")
+	cmd.Flags().String("until", "", "Only list records created at or before this time, as a duration (for example 1h) or an RFC3339 timestamp")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad audit list` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	Scope             string
+	Since             *time.Time
+	Until             *time.Time
+	Format            string
+}
+
+// NewRunner creates a new instance of the `rad audit list` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad audit list` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	all, err := cmd.Flags().GetBool("all")
+	if err != nil {
+		return err
+	}
+
+	if all {
+		group, err := cmd.Flags().GetString("group")
+		if err != nil {
+			return err
+		}
+		if group != "" {
+			return clierrors.Message("The --all and --group flags cannot be used together.")
+		}
+	} else {
+		r.Scope, err = cli.RequireScope(cmd, *workspace)
+		if err != nil {
+			return err
+		}
+	}
+
+	now := time.Now().UTC()
+	r.Since, err = parseTimeFlag(cmd, "since", now)
+	if err != nil {
+		return err
+	}
+	r.Until, err = parseTimeFlag(cmd, "until", now)
+	if err != nil {
+		return err
+	}
+	if r.Since != nil && r.Until != nil && r.Since.After(*r.Until) {
+		return clierrors.Message("The --since time must be before the --until time.")
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	if format == "" {
+		format = output.FormatTable
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad audit list` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	options := &v20231001preview.AuditRecordsClientListOptions{
+		StartTime: r.Since,
+		EndTime:   r.Until,
+	}
+	if r.Scope != "" {
+		options.Scope = to.Ptr(r.Scope)
+	}
+
+	records, err := client.ListAuditRecords(ctx, "radius", "local", options)
+	if err != nil {
+		return err
+	}
+
+	return r.Output.WriteFormatted(r.Format, records, common.AuditRecordFormat())
+}
+
+// parseTimeFlag parses the flag as a duration before now or as an RFC3339 timestamp. It returns nil if the flag is not set.
+func parseTimeFlag(cmd *cobra.Command, name string, now time.Time) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return nil, nil
+	}
+
+	if duration, err := time.ParseDuration(value); err == nil {
+		if duration < 0 {
+			return nil, clierrors.Message("The --%s duration %q must not be negative.", name, value)
+		}
+		return to.Ptr(now.Add(-duration)), nil
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, clierrors.Message("The --%s value %q must be a duration like 24h or an RFC3339 timestamp like 2023-10-01T00:00:00Z.", name, value)
+	}
+
+	return to.Ptr(timestamp.UTC()), nil
+}
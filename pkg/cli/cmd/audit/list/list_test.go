@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/cmd/audit/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "List Command with no args",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-resource-group", r.Scope)
+				require.Nil(t, r.Since)
+				require.Nil(t, r.Until)
+				require.Equal(t, output.FormatTable, r.Format)
+			},
+		},
+		{
+			Name:          "List Command with group and since duration",
+			Input:         []string{"--group", "prod", "--since", "24h"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "/planes/radius/local/resourceGroups/prod", r.Scope)
+				require.NotNil(t, r.Since)
+				require.WithinDuration(t, time.Now().Add(-24*time.Hour), *r.Since, time.Minute)
+			},
+		},
+		{
+			Name:          "List Command with all and timestamps",
+			Input:         []string{"--all", "--since", "2023-10-01T00:00:00Z", "--until", "2023-10-02T00:00:00Z"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Empty(t, r.Scope)
+				require.Equal(t, time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), *r.Since)
+				require.Equal(t, time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC), *r.Until)
+			},
+		},
+		{
+			Name:          "List Command with all and group",
+			Input:         []string{"--all", "--group", "prod"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "List Command with invalid since",
+			Input:         []string{"--since", "yesterday"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "List Command with since after until",
+			Input:         []string{"--since", "1h", "--until", "2h"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "List Command with too many args",
+			Input:         []string{"prod"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	records := []v20231001preview.AuditRecordResource{
+		{
+			ID:   to.Ptr("/planes/radius/local/providers/System.Resources/auditRecords/record-1"),
+			Name: to.Ptr("record-1"),
+			Properties: &v20231001preview.AuditRecordProperties{
+				Caller:     to.Ptr("alice"),
+				Method:     to.Ptr("PUT"),
+				ResourceID: to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app"),
+				StatusCode: to.Ptr(int32(200)),
+				Result:     to.Ptr(v20231001preview.AuditResultSucceeded),
+			},
+		},
+	}
+
+	since := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	ctrl := gomock.NewController(t)
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		ListAuditRecords(gomock.Any(), "radius", "local", &v20231001preview.AuditRecordsClientListOptions{
+			Scope:     to.Ptr("/planes/radius/local/resourceGroups/test-group"),
+			StartTime: &since,
+		}).
+		Return(records, nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Workspace:         &workspaces.Workspace{},
+		Scope:             "/planes/radius/local/resourceGroups/test-group",
+		Since:             &since,
+		Format:            "table",
+		Output:            outputSink,
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	expected := []any{
+		output.FormattedOutput{
+			Format:  "table",
+			Obj:     records,
+			Options: common.AuditRecordFormat(),
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
@@ -18,6 +18,7 @@ package aws
 
 import (
 	"context"
+	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli"
@@ -34,6 +35,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	// defaultCredentialName is the name of the credential used by Radius for all interactions with AWS.
+	defaultCredentialName = "default"
+)
+
 // NewCommand creates an instance of the command and runner for the `rad credential register aws` command.
 //
 
@@ -51,10 +57,22 @@ This command is intended for scripting or advanced use-cases. See 'rad init' for
 to configure these settings.
 
 Radius will use the provided IAM credential for all interactions with AWS. 
+
+The credential kind is selected with --kind:
+  - AccessKey (default): a long-lived IAM access key pair.
+  - IRSA: IAM Roles for Service Accounts. Radius exchanges the service account token for short-lived credentials.
+  - AssumeRole: Radius assumes the given role using another registered credential or, if the installation allows it, its ambient AWS credentials.
 ` + common.LongDescriptionBlurb,
 		Example: `
 # Register (Add or update) cloud provider credential for AWS with IAM authentication
 rad credential register aws --access-key-id <access-key-id> --secret-access-key <secret-access-key>
+
+# Register (Add or update) cloud provider credential for AWS with IAM Roles for Service Accounts (IRSA)
+rad credential register aws --kind IRSA --role-arn arn:aws:iam::<account-id>:role/<role-name>
+
+# Register an access key as 'base' and assume a role using it
+rad credential register aws --name base --access-key-id <access-key-id> --secret-access-key <secret-access-key>
+rad credential register aws --kind AssumeRole --role-arn arn:aws:iam::<account-id>:role/<role-name> --external-id <external-id> --source-credential base
 `,
 		Args: cobra.ExactArgs(0),
 		RunE: framework.RunCommand(runner),
@@ -63,11 +81,17 @@ rad credential register aws --access-key-id <access-key-id> --secret-access-key
 	commonflags.AddOutputFlag(cmd)
 	commonflags.AddWorkspaceFlag(cmd)
 
-	cmd.Flags().String("access-key-id", "", "The AWS IAM access key id.")
-	_ = cmd.MarkFlagRequired("access-key-id")
+	cmd.Flags().String("kind", string(ucp.AWSCredentialKindAccessKey), "The kind of AWS credential. Supported values: AccessKey, IRSA, AssumeRole.")
+	cmd.Flags().String("name", defaultCredentialName, "The name of the credential. Radius uses the 'default' credential for all interactions with AWS.")
+
+	cmd.Flags().String("access-key-id", "", "The AWS IAM access key id. Required for AccessKey credentials.")
+	cmd.Flags().String("secret-access-key", "", "The AWS IAM secret access key. Required for AccessKey credentials.")
 
-	cmd.Flags().String("secret-access-key", "", "The AWS IAM secret access key.")
-	_ = cmd.MarkFlagRequired("secret-access-key")
+	cmd.Flags().String("role-arn", "", "The ARN of the IAM role to use. Required for IRSA and AssumeRole credentials.")
+	cmd.Flags().String("token-file", "", "The path to the web identity token file for IRSA credentials. Defaults to AWS_WEB_IDENTITY_TOKEN_FILE in the Radius installation, other files must be allowed by the installation.")
+	cmd.Flags().String("session-name", "", "The role session name for IRSA and AssumeRole credentials.")
+	cmd.Flags().String("external-id", "", "The external ID used to assume the role for AssumeRole credentials.")
+	cmd.Flags().String("source-credential", "", "The name of the registered credential used to assume the role for AssumeRole credentials.")
 
 	return cmd, runner
 }
@@ -80,9 +104,16 @@ type Runner struct {
 	Format            string
 	Workspace         *workspaces.Workspace
 
-	AccessKeyID     string
-	SecretAccessKey string
-	KubeContext     string
+	Kind             ucp.AWSCredentialKind
+	Name             string
+	AccessKeyID      string
+	SecretAccessKey  string
+	RoleARN          string
+	TokenFile        string
+	SessionName      string
+	ExternalID       string
+	SourceCredential string
+	KubeContext      string
 }
 
 // NewRunner creates a new instance of the `rad credential register aws` runner.
@@ -97,7 +128,8 @@ func NewRunner(factory framework.Factory) *Runner {
 // Validate runs validation for the `rad credential register aws` command.
 //
 
-// Validate() checks if the required workspace, output format, access key ID and secret access key are present, and if not, returns an error.
+// Validate() checks if the required workspace, output format and the properties required by the credential kind are present,
+// and if not, returns an error.
 func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
 	if err != nil {
@@ -111,22 +143,54 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	}
 	r.Format = format
 
-	accessKeyID, err := cmd.Flags().GetString("access-key-id")
+	kind, err := cmd.Flags().GetString("kind")
 	if err != nil {
 		return err
 	}
-	secretAccessKey, err := cmd.Flags().GetString("secret-access-key")
+	r.Kind, err = parseKind(kind)
 	if err != nil {
 		return err
 	}
-	r.AccessKeyID = accessKeyID
-	r.SecretAccessKey = secretAccessKey
 
-	if r.AccessKeyID == "" {
-		return clierrors.Message("Access Key id %q cannot be empty.", r.AccessKeyID)
+	flags := map[string]*string{
+		"name":              &r.Name,
+		"access-key-id":     &r.AccessKeyID,
+		"secret-access-key": &r.SecretAccessKey,
+		"role-arn":          &r.RoleARN,
+		"token-file":        &r.TokenFile,
+		"session-name":      &r.SessionName,
+		"external-id":       &r.ExternalID,
+		"source-credential": &r.SourceCredential,
 	}
-	if r.SecretAccessKey == "" {
-		return clierrors.Message("Secret Access Key %q cannot be empty.", r.SecretAccessKey)
+	for name, value := range flags {
+		*value, err = cmd.Flags().GetString(name)
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.Name == "" {
+		return clierrors.Message("Credential name cannot be empty.")
+	}
+
+	switch r.Kind {
+	case ucp.AWSCredentialKindAccessKey:
+		if r.AccessKeyID == "" {
+			return clierrors.Message("Access Key id %q cannot be empty.", r.AccessKeyID)
+		}
+		if r.SecretAccessKey == "" {
+			return clierrors.Message("Secret Access Key %q cannot be empty.", r.SecretAccessKey)
+		}
+	case ucp.AWSCredentialKindIRSA, ucp.AWSCredentialKindAssumeRole:
+		if r.RoleARN == "" {
+			return clierrors.Message("Role ARN cannot be empty for %s credentials.", r.Kind)
+		}
+		if r.AccessKeyID != "" || r.SecretAccessKey != "" {
+			return clierrors.Message("Access keys cannot be specified for %s credentials.", r.Kind)
+		}
+		if r.Kind == ucp.AWSCredentialKindAssumeRole && strings.EqualFold(r.SourceCredential, r.Name) {
+			return clierrors.Message("Source credential %q must be different from the credential being registered.", r.SourceCredential)
+		}
 	}
 
 	kubeContext, ok := r.Workspace.KubernetesContext()
@@ -149,15 +213,10 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 	credential := ucp.AwsCredentialResource{
-		Location: to.Ptr(v1.LocationGlobal),
-		Type:     to.Ptr(cli_credential.AWSCredential),
-		Properties: &ucp.AwsAccessKeyCredentialProperties{
-			Storage: &ucp.CredentialStorageProperties{
-				Kind: to.Ptr(ucp.CredentialStorageKindInternal),
-			},
-			AccessKeyID:     &r.AccessKeyID,
-			SecretAccessKey: &r.SecretAccessKey,
-		},
+		Location:   to.Ptr(v1.LocationGlobal),
+		Name:       to.Ptr(r.Name),
+		Type:       to.Ptr(cli_credential.AWSCredential),
+		Properties: r.credentialProperties(),
 	}
 
 	err = client.PutAWS(ctx, credential)
@@ -169,3 +228,52 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// credentialProperties returns the versioned credential properties for the credential kind.
+func (r *Runner) credentialProperties() ucp.AwsCredentialPropertiesClassification {
+	storage := &ucp.CredentialStorageProperties{
+		Kind: to.Ptr(ucp.CredentialStorageKindInternal),
+	}
+
+	switch r.Kind {
+	case ucp.AWSCredentialKindIRSA:
+		return &ucp.AwsIRSACredentialProperties{
+			Storage:     storage,
+			RoleARN:     to.Ptr(r.RoleARN),
+			TokenFile:   optionalString(r.TokenFile),
+			SessionName: optionalString(r.SessionName),
+		}
+	case ucp.AWSCredentialKindAssumeRole:
+		return &ucp.AwsAssumeRoleCredentialProperties{
+			Storage:          storage,
+			RoleARN:          to.Ptr(r.RoleARN),
+			ExternalID:       optionalString(r.ExternalID),
+			SessionName:      optionalString(r.SessionName),
+			SourceCredential: optionalString(r.SourceCredential),
+		}
+	default:
+		return &ucp.AwsAccessKeyCredentialProperties{
+			Storage:         storage,
+			AccessKeyID:     &r.AccessKeyID,
+			SecretAccessKey: &r.SecretAccessKey,
+		}
+	}
+}
+
+// parseKind parses the --kind flag case-insensitively.
+func parseKind(kind string) (ucp.AWSCredentialKind, error) {
+	for _, k := range ucp.PossibleAWSCredentialKindValues() {
+		if strings.EqualFold(kind, string(k)) {
+			return k, nil
+		}
+	}
+
+	return "", clierrors.Message("Credential kind %q is not supported. Supported kinds: AccessKey, IRSA, AssumeRole.", kind)
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return to.Ptr(s)
+}
@@ -37,6 +37,7 @@ import (
 const (
 	testAccessKeyId     = "TEST-ACCESS-KEY-ID"
 	testSecretAccessKey = "TEST-SECRET-ACCESS-KEY"
+	testRoleARN         = "arn:aws:iam::000000000000:role/radius"
 )
 
 func Test_CommandValidation(t *testing.T) {
@@ -92,6 +93,62 @@ func Test_Validate(t *testing.T) {
 			ExpectedValid: false,
 			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
 		},
+		{
+			Name: "Valid AWS IRSA command",
+			Input: []string{
+				"--kind", "IRSA",
+				"--role-arn", testRoleARN,
+			},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name: "AWS IRSA command without role ARN",
+			Input: []string{
+				"--kind", "IRSA",
+			},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name: "AWS IRSA command with access keys",
+			Input: []string{
+				"--kind", "IRSA",
+				"--role-arn", testRoleARN,
+				"--access-key-id", testAccessKeyId,
+			},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name: "Valid AWS AssumeRole command",
+			Input: []string{
+				"--kind", "assumerole",
+				"--role-arn", testRoleARN,
+				"--external-id", "external",
+				"--source-credential", "base",
+			},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name: "AWS AssumeRole command with itself as source credential",
+			Input: []string{
+				"--kind", "AssumeRole",
+				"--role-arn", testRoleARN,
+				"--source-credential", "default",
+			},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name: "AWS command with invalid kind",
+			Input: []string{
+				"--kind", "Password",
+			},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
 	}
 	radcli.SharedValidateValidation(t, NewCommand, testcases)
 }
@@ -102,6 +159,7 @@ func Test_Run(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			expectedPut := ucp.AwsCredentialResource{
 				Location: to.Ptr(v1.LocationGlobal),
+				Name:     to.Ptr("default"),
 				Type:     to.Ptr(cli_credential.AWSCredential),
 				Properties: &ucp.AwsAccessKeyCredentialProperties{
 					Storage: &ucp.CredentialStorageProperties{
@@ -131,6 +189,8 @@ func Test_Run(t *testing.T) {
 					Source: workspaces.SourceUserConfig,
 				},
 				Format:          "table",
+				Kind:            ucp.AWSCredentialKindAccessKey,
+				Name:            "default",
 				AccessKeyID:     testAccessKeyId,
 				SecretAccessKey: testSecretAccessKey,
 				KubeContext:     "my-context",
@@ -151,4 +211,48 @@ func Test_Run(t *testing.T) {
 			require.Equal(t, expected, outputSink.Writes)
 		})
 	})
+
+	t.Run("Create aws assume role credential", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		expectedPut := ucp.AwsCredentialResource{
+			Location: to.Ptr(v1.LocationGlobal),
+			Name:     to.Ptr("default"),
+			Type:     to.Ptr(cli_credential.AWSCredential),
+			Properties: &ucp.AwsAssumeRoleCredentialProperties{
+				Storage: &ucp.CredentialStorageProperties{
+					Kind: to.Ptr(ucp.CredentialStorageKindInternal),
+				},
+				RoleARN:          to.Ptr(testRoleARN),
+				ExternalID:       to.Ptr("external"),
+				SourceCredential: to.Ptr("base"),
+			},
+		}
+
+		client := cli_credential.NewMockCredentialManagementClient(ctrl)
+		client.EXPECT().
+			PutAWS(gomock.Any(), expectedPut).
+			Return(nil).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{CredentialManagementClient: client},
+			Output:            &output.MockOutput{},
+			Workspace: &workspaces.Workspace{
+				Connection: map[string]any{
+					"kind":    workspaces.KindKubernetes,
+					"context": "my-context",
+				},
+				Source: workspaces.SourceUserConfig,
+			},
+			Format:           "table",
+			Kind:             ucp.AWSCredentialKindAssumeRole,
+			Name:             "default",
+			RoleARN:          testRoleARN,
+			ExternalID:       "external",
+			SourceCredential: "base",
+			KubeContext:      "my-context",
+		}
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+	})
 }
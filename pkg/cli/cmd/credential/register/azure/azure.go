@@ -19,6 +19,7 @@ package azure
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli"
@@ -59,10 +60,21 @@ Radius will use the provided subscription and resource group as the default targ
 The provided service principal must have the Contributor or Owner role assigned for the provided resource group
 in order to create or manage resources contained in the group. The resource group should be created before
 calling 'rad credential register azure'.
+
+The credential kind is selected with --kind:
+  - ServicePrincipal (default): a service principal with a client secret.
+  - WorkloadIdentity: Radius exchanges its projected service account token for Azure AD tokens.
+  - ManagedIdentity: Radius uses the managed identity of the node it runs on. Set --client-id for a user-assigned identity.
 ` + common.LongDescriptionBlurb,
 		Example: `
 # Register (Add or update) cloud provider credential for Azure with service principal authentication
 rad credential register azure --client-id <client id/app id> --client-secret <client secret/password> --tenant-id <tenant id>
+
+# Register (Add or update) cloud provider credential for Azure with workload identity
+rad credential register azure --kind WorkloadIdentity --client-id <client id/app id> --tenant-id <tenant id>
+
+# Register (Add or update) cloud provider credential for Azure with a user-assigned managed identity
+rad credential register azure --kind ManagedIdentity --client-id <client id>
 `,
 		Args: cobra.ExactArgs(0),
 		RunE: framework.RunCommand(runner),
@@ -71,14 +83,11 @@ rad credential register azure --client-id <client id/app id> --client-secret <cl
 	commonflags.AddOutputFlag(cmd)
 	commonflags.AddWorkspaceFlag(cmd)
 
-	cmd.Flags().String("client-id", "", "The client id or app id of an Azure service principal.")
-	_ = cmd.MarkFlagRequired("client-id")
-
-	cmd.Flags().String("client-secret", "", "The client secret or password of an Azure service principal.")
-	_ = cmd.MarkFlagRequired("client-secret")
-
-	cmd.Flags().String("tenant-id", "", "The tenant id of an Azure service principal.")
-	_ = cmd.MarkFlagRequired("tenant-id")
+	cmd.Flags().String("kind", string(ucp.AzureCredentialKindServicePrincipal), "The kind of Azure credential. Supported values: ServicePrincipal, WorkloadIdentity, ManagedIdentity.")
+	cmd.Flags().String("client-id", "", "The client id or app id of an Azure service principal or identity. Required for ServicePrincipal and WorkloadIdentity credentials.")
+	cmd.Flags().String("client-secret", "", "The client secret or password of an Azure service principal. Required for ServicePrincipal credentials.")
+	cmd.Flags().String("tenant-id", "", "The tenant id of an Azure service principal or identity. Required for ServicePrincipal and WorkloadIdentity credentials.")
+	cmd.Flags().String("token-file", "", "The path to the federated token file for WorkloadIdentity credentials. Defaults to AZURE_FEDERATED_TOKEN_FILE in the Radius installation, other files must be allowed by the installation.")
 
 	return cmd, runner
 }
@@ -91,9 +100,11 @@ type Runner struct {
 	Format            string
 	Workspace         *workspaces.Workspace
 
+	Kind         ucp.AzureCredentialKind
 	ClientID     string
 	ClientSecret string
 	TenantID     string
+	TokenFile    string
 	KubeContext  string
 }
 
@@ -109,7 +120,7 @@ func NewRunner(factory framework.Factory) *Runner {
 // Validate runs validation for the `rad credential register azure` command.
 //
 
-// Validate checks for the presence of a workspace, output format, client ID, client secret and tenant ID, and
+// Validate checks for the presence of a workspace, output format and the properties required by the credential kind, and
 // sets them in the Runner struct if they are present. If any of these are not present, an error is returned.
 func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
@@ -124,6 +135,15 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	}
 	r.Format = format
 
+	kind, err := cmd.Flags().GetString("kind")
+	if err != nil {
+		return err
+	}
+	r.Kind, err = parseKind(kind)
+	if err != nil {
+		return err
+	}
+
 	clientID, err := cmd.Flags().GetString("client-id")
 	if err != nil {
 		return err
@@ -136,10 +156,33 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	tokenFile, err := cmd.Flags().GetString("token-file")
+	if err != nil {
+		return err
+	}
 
 	r.ClientID = clientID
 	r.ClientSecret = clientSecret
 	r.TenantID = tenantID
+	r.TokenFile = tokenFile
+
+	switch r.Kind {
+	case ucp.AzureCredentialKindServicePrincipal:
+		if r.ClientID == "" || r.ClientSecret == "" || r.TenantID == "" {
+			return clierrors.Message("Client id, client secret and tenant id are required for %s credentials.", r.Kind)
+		}
+	case ucp.AzureCredentialKindWorkloadIdentity:
+		if r.ClientID == "" || r.TenantID == "" {
+			return clierrors.Message("Client id and tenant id are required for %s credentials.", r.Kind)
+		}
+		if r.ClientSecret != "" {
+			return clierrors.Message("Client secret cannot be specified for %s credentials.", r.Kind)
+		}
+	case ucp.AzureCredentialKindManagedIdentity:
+		if r.ClientSecret != "" || r.TenantID != "" {
+			return clierrors.Message("Client secret and tenant id cannot be specified for %s credentials.", r.Kind)
+		}
+	}
 
 	kubeContext, ok := r.Workspace.KubernetesContext()
 	if !ok {
@@ -163,18 +206,10 @@ func (r *Runner) Run(ctx context.Context) error {
 	}
 
 	credential := ucp.AzureCredentialResource{
-		Location: to.Ptr(v1.LocationGlobal),
-		Type:     to.Ptr(cli_credential.AzureCredential),
-		ID:       to.Ptr(fmt.Sprintf(common.AzureCredentialID, "default")),
-		Properties: &ucp.AzureServicePrincipalProperties{
-			Storage: &ucp.CredentialStorageProperties{
-				Kind: to.Ptr(ucp.CredentialStorageKindInternal),
-			},
-			TenantID:     &r.TenantID,
-			ClientID:     &r.ClientID,
-			ClientSecret: &r.ClientSecret,
-			Kind:         to.Ptr(ucp.AzureCredentialKindServicePrincipal),
-		},
+		Location:   to.Ptr(v1.LocationGlobal),
+		Type:       to.Ptr(cli_credential.AzureCredential),
+		ID:         to.Ptr(fmt.Sprintf(common.AzureCredentialID, "default")),
+		Properties: r.credentialProperties(),
 	}
 
 	// Update server-side to add/change credentials
@@ -187,3 +222,53 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// credentialProperties returns the versioned credential properties for the credential kind.
+func (r *Runner) credentialProperties() ucp.AzureCredentialPropertiesClassification {
+	storage := &ucp.CredentialStorageProperties{
+		Kind: to.Ptr(ucp.CredentialStorageKindInternal),
+	}
+
+	switch r.Kind {
+	case ucp.AzureCredentialKindWorkloadIdentity:
+		return &ucp.AzureWorkloadIdentityProperties{
+			Storage:   storage,
+			ClientID:  &r.ClientID,
+			TenantID:  &r.TenantID,
+			TokenFile: optionalString(r.TokenFile),
+			Kind:      to.Ptr(ucp.AzureCredentialKindWorkloadIdentity),
+		}
+	case ucp.AzureCredentialKindManagedIdentity:
+		return &ucp.AzureManagedIdentityProperties{
+			Storage:  storage,
+			ClientID: optionalString(r.ClientID),
+			Kind:     to.Ptr(ucp.AzureCredentialKindManagedIdentity),
+		}
+	default:
+		return &ucp.AzureServicePrincipalProperties{
+			Storage:      storage,
+			TenantID:     &r.TenantID,
+			ClientID:     &r.ClientID,
+			ClientSecret: &r.ClientSecret,
+			Kind:         to.Ptr(ucp.AzureCredentialKindServicePrincipal),
+		}
+	}
+}
+
+// parseKind parses the --kind flag case-insensitively.
+func parseKind(kind string) (ucp.AzureCredentialKind, error) {
+	for _, k := range ucp.PossibleAzureCredentialKindValues() {
+		if strings.EqualFold(kind, string(k)) {
+			return k, nil
+		}
+	}
+
+	return "", clierrors.Message("Credential kind %q is not supported. Supported kinds: ServicePrincipal, WorkloadIdentity, ManagedIdentity.", kind)
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return to.Ptr(s)
+}
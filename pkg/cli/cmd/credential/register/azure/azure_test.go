@@ -113,6 +113,45 @@ func Test_Validate(t *testing.T) {
 			ExpectedValid: false,
 			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
 		},
+		{
+			Name: "Valid Azure workload identity command",
+			Input: []string{
+				"--kind", "WorkloadIdentity",
+				"--client-id", "abcd",
+				"--tenant-id", "ijkl",
+			},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name: "Azure workload identity command with client-secret",
+			Input: []string{
+				"--kind", "WorkloadIdentity",
+				"--client-id", "abcd",
+				"--client-secret", "efgh",
+				"--tenant-id", "ijkl",
+			},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name: "Valid Azure managed identity command",
+			Input: []string{
+				"--kind", "managedidentity",
+			},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name: "Azure command with invalid kind",
+			Input: []string{
+				"--kind", "Certificate",
+				"--client-id", "abcd",
+				"--tenant-id", "ijkl",
+			},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
 	}
 	radcli.SharedValidateValidation(t, NewCommand, testcases)
 }
@@ -57,10 +57,18 @@ func credentialFormat(credentialType string) output.FormatterOptions {
 					Heading:  "REGISTERED",
 					JSONPath: "{ .Enabled }",
 				},
+				{
+					Heading:  "KIND",
+					JSONPath: "{ .AWSCredentials.Kind }",
+				},
 				{
 					Heading:  "ACCESSKEYID",
 					JSONPath: "{ .AWSCredentials.AccessKeyID }",
 				},
+				{
+					Heading:  "ROLEARN",
+					JSONPath: "{ .AWSCredentials.RoleARN }",
+				},
 			},
 		}
 	}
@@ -53,6 +53,7 @@ func Test_credentialFormat_AWS(t *testing.T) {
 			Enabled: true,
 		},
 		AWSCredentials: &credential.AWSCredentialProperties{
+			Kind:        "AccessKey",
 			AccessKeyID: to.Ptr("test-access-key-id"),
 		},
 	}
@@ -61,6 +62,6 @@ func Test_credentialFormat_AWS(t *testing.T) {
 	err := output.Write(output.FormatTable, obj, buffer, credentialFormat("aws"))
 	require.NoError(t, err)
 
-	expected := "NAME      REGISTERED  ACCESSKEYID\ntest      true        test-access-key-id\n"
+	expected := "NAME      REGISTERED  KIND       ACCESSKEYID         ROLEARN\ntest      true        AccessKey  test-access-key-id  \n"
 	require.Equal(t, expected, buffer.String())
 }
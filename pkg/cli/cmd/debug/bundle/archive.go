@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"io"
+	"path"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// archive writes the files of a debug bundle to a ZIP archive. All files are written under a single root directory
+// so that the archive extracts cleanly.
+type archive struct {
+	root   string
+	now    time.Time
+	writer *zip.Writer
+}
+
+func newArchive(w io.Writer, root string, now time.Time) *archive {
+	return &archive{root: root, now: now, writer: zip.NewWriter(w)}
+}
+
+// WriteFile writes a file with the given contents.
+func (a *archive) WriteFile(name string, contents []byte) error {
+	header := &zip.FileHeader{
+		Name:     path.Join(a.root, name),
+		Method:   zip.Deflate,
+		Modified: a.now,
+	}
+
+	w, err := a.writer.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(contents)
+	return err
+}
+
+// WriteJSON writes a file containing the indented JSON representation of obj, with sensitive values redacted.
+func (a *archive) WriteJSON(name string, obj any) error {
+	redacted, err := redactObject(obj)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(redacted, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return a.WriteFile(name, append(b, '\n'))
+}
+
+// WriteYAML writes a file containing the YAML representation of a Kubernetes manifest. The manifest must already be
+// redacted.
+func (a *archive) WriteYAML(name string, manifest map[string]any) error {
+	b, err := yaml.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+
+	return a.WriteFile(name, b)
+}
+
+// Close finishes writing the archive.
+func (a *archive) Close() error {
+	return a.writer.Close()
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/version"
+	"github.com/spf13/cobra"
+	k8s "k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	outputFileFlag = "output-file"
+	manifestFile   = "bundle.json"
+)
+
+// manifest describes the contents of a debug bundle.
+type manifest struct {
+	Application string    `json:"application"`
+	Workspace   string    `json:"workspace"`
+	Scope       string    `json:"scope"`
+	CreatedAt   time.Time `json:"createdAt"`
+	CLIVersion  string    `json:"cliVersion"`
+	Errors      []string  `json:"errors,omitempty"`
+}
+
+// NewCommand creates an instance of the `rad debug bundle` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "Collect a support bundle for a Radius Application",
+		Long: `Collect a support bundle for a Radius Application.
+
+Creates a ZIP archive that can be attached to an issue. The archive contains:
+
+- the application, its environment, its graph and the JSON of its resources
+- the statuses of the asynchronous operations on the application and its resources
+- the errors of recipes that failed to deploy
+- the Kubernetes events of the application's namespaces and the manifests of its Kubernetes output resources
+- the logs of the Radius control plane
+
+Passwords, tokens, connection strings and other secret values are redacted, as well as the data of Kubernetes secrets.
+
+WARNING Please inspect the contents of the archive before sharing it to confirm no private information is included.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `
+# Collect a bundle for the current application
+rad debug bundle
+
+# Collect a bundle for the specified application
+rad debug bundle --application my-app
+
+# Collect a bundle for the specified application and write it to a specific file
+rad debug bundle my-app --output-file ./my-app-bundle.zip
+`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	cmd.Flags().String(outputFileFlag, "", "The path of the archive to create. Defaults to '<application>-debug-bundle.zip' in the current directory")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad debug bundle` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	// KubernetesClient and RuntimeClient are used to collect Kubernetes events, manifests, operation statuses and
+	// logs. They are created from the workspace's Kubernetes context when not set.
+	KubernetesClient k8s.Interface
+	RuntimeClient    client.Client
+
+	ApplicationName string
+	OutputFile      string
+}
+
+// NewRunner creates an instance of the runner for the `rad debug bundle` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad debug bundle` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.ApplicationName, err = cli.RequireApplicationArgs(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := r.Workspace.KubernetesContext(); !ok {
+		return clierrors.Message("A Kubernetes connection is required.")
+	}
+
+	r.OutputFile, err = cmd.Flags().GetString(outputFileFlag)
+	if err != nil {
+		return err
+	}
+	if r.OutputFile == "" {
+		r.OutputFile = fmt.Sprintf("%s-debug-bundle.zip", r.ApplicationName)
+	}
+
+	return nil
+}
+
+// Run runs the `rad debug bundle` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	application, err := client.ShowApplication(ctx, r.ApplicationName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The application %q was not found or has been deleted.", r.ApplicationName)
+	} else if err != nil {
+		return err
+	}
+
+	err = r.createKubernetesClients()
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Collecting a debug bundle for application %q from workspace %q...", r.ApplicationName, r.Workspace.Name)
+
+	file, err := os.Create(r.OutputFile)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to create the archive %q.", r.OutputFile)
+	}
+	defer file.Close()
+
+	now := time.Now().UTC()
+	root := strings.TrimSuffix(filepath.Base(r.OutputFile), filepath.Ext(r.OutputFile))
+	archive := newArchive(file, root, now)
+
+	c := &collector{
+		archive:          archive,
+		client:           client,
+		kubernetesClient: r.KubernetesClient,
+		runtimeClient:    r.RuntimeClient,
+		application:      application,
+	}
+	c.collect(ctx)
+
+	err = archive.WriteJSON(manifestFile, manifest{
+		Application: r.ApplicationName,
+		Workspace:   r.Workspace.Name,
+		Scope:       r.Workspace.Scope,
+		CreatedAt:   now,
+		CLIVersion:  version.Version(),
+		Errors:      c.errors,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = archive.Close()
+	if err != nil {
+		return err
+	}
+
+	for _, message := range c.errors {
+		r.Output.LogInfo("Warning: %s", message)
+	}
+
+	r.Output.LogInfo("Wrote debug bundle %s. Please inspect its contents and remove any private information before sharing it.", r.OutputFile)
+	return nil
+}
+
+func (r *Runner) createKubernetesClients() error {
+	if r.KubernetesClient != nil && r.RuntimeClient != nil {
+		return nil
+	}
+
+	kubeContext, ok := r.Workspace.KubernetesContext()
+	if !ok {
+		return clierrors.Message("A Kubernetes connection is required.")
+	}
+
+	kubernetesClient, _, err := kubernetes.NewClientset(kubeContext)
+	if err != nil {
+		return err
+	}
+
+	runtimeClient, err := kubernetes.NewRuntimeClient(kubeContext, kubernetes.Scheme)
+	if err != nil {
+		return err
+	}
+
+	r.KubernetesClient = kubernetesClient
+	r.RuntimeClient = runtimeClient
+	return nil
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	k8slabels "github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/to"
+	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	testScope         = "/planes/radius/local/resourceGroups/test-group"
+	testApplicationID = testScope + "/providers/Applications.Core/applications/test-app"
+	testContainerID   = testScope + "/providers/Applications.Core/containers/frontend"
+	testRedisID       = testScope + "/providers/Applications.Datastores/redisCaches/cache"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Bundle Command with application",
+			Input:         []string{"-a", "test-app"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "test-app", r.ApplicationName)
+				require.Equal(t, "test-app-debug-bundle.zip", r.OutputFile)
+			},
+		},
+		{
+			Name:          "Bundle Command with output file",
+			Input:         []string{"test-app", "--output-file", "bundle.zip"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "bundle.zip", runner.(*Runner).OutputFile)
+			},
+		},
+		{
+			Name:          "Bundle Command without application",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Bundle Command with too many args",
+			Input:         []string{"foo", "bar"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Name:  "test-workspace",
+		Scope: testScope,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		application := v20231001preview.ApplicationResource{
+			ID:   to.Ptr(testApplicationID),
+			Name: to.Ptr("test-app"),
+			Properties: &v20231001preview.ApplicationProperties{
+				Environment: to.Ptr(testScope + "/providers/Applications.Core/environments/test-env"),
+				Status: &v20231001preview.ResourceStatus{
+					Compute: &v20231001preview.KubernetesCompute{Kind: to.Ptr("kubernetes"), Namespace: to.Ptr("test-ns")},
+				},
+			},
+		}
+		resourceList := []generated.GenericResource{
+			{
+				ID:   to.Ptr(testContainerID),
+				Name: to.Ptr("frontend"),
+				Properties: map[string]any{
+					"provisioningState": "Succeeded",
+					"container": map[string]any{
+						"env": map[string]any{"DB_PASSWORD": map[string]any{"value": "p@ss"}},
+					},
+					"status": map[string]any{
+						"outputResources": []any{
+							map[string]any{"id": "/planes/kubernetes/local/namespaces/test-ns/providers/apps/Deployment/frontend"},
+							map[string]any{"id": "/planes/kubernetes/local/namespaces/test-ns/providers/core/Secret/frontend"},
+							map[string]any{"id": "/planes/kubernetes/local/namespaces/test-ns/providers/core/Service/missing"},
+						},
+					},
+				},
+			},
+			{
+				ID:   to.Ptr(testRedisID),
+				Name: to.Ptr("cache"),
+				Properties: map[string]any{
+					"provisioningState": "Failed",
+					"recipe":            map[string]any{"name": "default"},
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowApplication(gomock.Any(), "test-app").
+			Return(application, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "test-env").
+			Return(v20231001preview.EnvironmentResource{Name: to.Ptr("test-env")}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			GetGraph(gomock.Any(), "test-app").
+			Return(v20231001preview.ApplicationGraphResponse{}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListAllResourcesByApplication(gomock.Any(), "test-app").
+			Return(resourceList, nil).
+			Times(1)
+
+		operation := statusmanager.Status{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				ID:        "/planes/radius/local/providers/applications.datastores/locations/global/operationstatuses/1234",
+				Name:      "1234",
+				Status:    v1.ProvisioningStateFailed,
+				StartTime: time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC),
+				Error:     &v1.ErrorDetails{Code: "RecipeDeploymentFailed", Message: "failed to deploy recipe"},
+			},
+			LinkedResourceID: testRedisID,
+		}
+		data, err := json.Marshal(operation)
+		require.NoError(t, err)
+
+		runtimeClient := fake.NewClientBuilder().
+			WithScheme(kubernetes.Scheme).
+			WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(kubernetes.Scheme)).
+			WithObjects(
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "frontend"}},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "frontend"},
+					Data:       map[string][]byte{"password": []byte("p@ss")},
+				},
+				&corev1.Event{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "frontend.1"},
+					Reason:     "BackOff",
+					Message:    "Back-off restarting failed container",
+				},
+				&ucpv1alpha1.Resource{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "radius-system", Name: "resource.1234"},
+					Entries: []ucpv1alpha1.ResourceEntry{
+						{ID: operation.ID, Data: &runtime.RawExtension{Raw: data}},
+					},
+				},
+			).
+			Build()
+
+		kubernetesClient := k8sfake.NewSimpleClientset(&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "radius-system",
+				Name:      "ucp-1",
+				Labels:    map[string]string{k8slabels.LabelPartOf: k8slabels.ControlPlanePartOfLabelValue},
+			},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "ucp"}}},
+		})
+
+		outputFile := filepath.Join(t.TempDir(), "bundle.zip")
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         workspace,
+			KubernetesClient:  kubernetesClient,
+			RuntimeClient:     runtimeClient,
+			ApplicationName:   "test-app",
+			OutputFile:        outputFile,
+		}
+
+		err = runner.Run(context.Background())
+		require.NoError(t, err)
+
+		files := readArchive(t, outputFile)
+		names := []string{}
+		for name := range files {
+			names = append(names, name)
+		}
+		require.ElementsMatch(t, []string{
+			"bundle/application.json",
+			"bundle/environment.json",
+			"bundle/graph.json",
+			"bundle/resources.json",
+			"bundle/operations.json",
+			"bundle/recipe-errors.json",
+			"bundle/kubernetes/events.json",
+			"bundle/kubernetes/manifests/test-ns/deployment.frontend.yaml",
+			"bundle/kubernetes/manifests/test-ns/secret.frontend.yaml",
+			"bundle/logs/ucp-1.ucp.log",
+			"bundle/bundle.json",
+		}, names)
+
+		// Secret values are redacted.
+		require.NotContains(t, files["bundle/resources.json"], "p@ss")
+		secret := map[string]any{}
+		require.NoError(t, yaml.Unmarshal([]byte(files["bundle/kubernetes/manifests/test-ns/secret.frontend.yaml"]), &secret))
+		require.Equal(t, map[string]any{"password": redactedValue}, secret["data"])
+
+		// The failed recipe is reported with its last operation.
+		recipeErrors := []recipeError{}
+		require.NoError(t, json.Unmarshal([]byte(files["bundle/recipe-errors.json"]), &recipeErrors))
+		require.Len(t, recipeErrors, 1)
+		require.Equal(t, testRedisID, recipeErrors[0].ResourceID)
+		require.Equal(t, "default", recipeErrors[0].Recipe)
+		require.Equal(t, "failed to deploy recipe", recipeErrors[0].LastOperation.Error.Message)
+
+		require.Contains(t, files["bundle/kubernetes/events.json"], "Back-off restarting failed container")
+
+		// The missing output resource is reported as an error in the manifest.
+		m := manifest{}
+		require.NoError(t, json.Unmarshal([]byte(files["bundle/bundle.json"]), &m))
+		require.Equal(t, "test-app", m.Application)
+		require.Len(t, m.Errors, 1)
+		require.Contains(t, m.Errors[0], "core/Service/missing")
+
+		require.Equal(t, output.LogOutput{
+			Format: "Wrote debug bundle %s. Please inspect its contents and remove any private information before sharing it.",
+			Params: []any{outputFile},
+		}, outputSink.Writes[len(outputSink.Writes)-1])
+	})
+
+	t.Run("Error: Application Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowApplication(gomock.Any(), "test-app").
+			Return(v20231001preview.ApplicationResource{}, radcli.Create404Error()).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         workspace,
+			ApplicationName:   "test-app",
+			OutputFile:        filepath.Join(t.TempDir(), "bundle.zip"),
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The application %q was not found or has been deleted.", "test-app"), err)
+	})
+}
+
+func readArchive(t *testing.T, path string) map[string]string {
+	reader, err := zip.OpenReader(path)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	files := map[string]string{}
+	for _, file := range reader.File {
+		r, err := file.Open()
+		require.NoError(t, err)
+		b, err := io.ReadAll(r)
+		require.NoError(t, err)
+		r.Close()
+		files[file.Name] = string(b)
+	}
+
+	return files
+}
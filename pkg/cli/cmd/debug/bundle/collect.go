@@ -0,0 +1,360 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/helm"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	k8slabels "github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8s "k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	operationStatusesType = "operationstatuses"
+)
+
+// recipeError describes a resource whose recipe failed to deploy.
+type recipeError struct {
+	ResourceID        string                `json:"resourceId"`
+	Recipe            string                `json:"recipe"`
+	ProvisioningState string                `json:"provisioningState"`
+	LastOperation     *statusmanager.Status `json:"lastOperation,omitempty"`
+	Status            map[string]any        `json:"status,omitempty"`
+}
+
+// collector collects the contents of a debug bundle. Collection is best effort: a failure to collect one part of
+// the bundle is recorded and collection continues, since a partial bundle is still useful.
+type collector struct {
+	archive          *archive
+	client           clients.ApplicationsManagementClient
+	kubernetesClient k8s.Interface
+	runtimeClient    client.Client
+
+	application corerp.ApplicationResource
+	resources   []generated.GenericResource
+	operations  []statusmanager.Status
+	errors      []string
+}
+
+// collect collects all parts of the bundle for the application.
+func (c *collector) collect(ctx context.Context) {
+	c.run("application", func() error { return c.archive.WriteJSON("application.json", c.application) })
+	c.run("environment", func() error { return c.collectEnvironment(ctx) })
+	c.run("application graph", func() error { return c.collectGraph(ctx) })
+	c.run("resources", func() error { return c.collectResources(ctx) })
+	c.run("operation statuses", func() error { return c.collectOperations(ctx) })
+	c.run("recipe errors", func() error { return c.collectRecipeErrors() })
+	c.run("kubernetes events", func() error { return c.collectEvents(ctx) })
+	c.run("output resource manifests", func() error { return c.collectManifests(ctx) })
+	c.run("control plane logs", func() error { return c.collectLogs(ctx) })
+}
+
+func (c *collector) run(step string, collect func() error) {
+	err := collect()
+	if err != nil {
+		c.errors = append(c.errors, fmt.Sprintf("failed to collect %s: %v", step, err))
+	}
+}
+
+func (c *collector) collectEnvironment(ctx context.Context) error {
+	if c.application.Properties == nil || c.application.Properties.Environment == nil {
+		return nil
+	}
+
+	id, err := resources.ParseResource(*c.application.Properties.Environment)
+	if err != nil {
+		return err
+	}
+
+	environment, err := c.client.GetEnvDetails(ctx, id.Name())
+	if err != nil {
+		return err
+	}
+
+	return c.archive.WriteJSON("environment.json", environment)
+}
+
+func (c *collector) collectGraph(ctx context.Context) error {
+	graph, err := c.client.GetGraph(ctx, to.String(c.application.Name))
+	if err != nil {
+		return err
+	}
+
+	return c.archive.WriteJSON("graph.json", graph)
+}
+
+func (c *collector) collectResources(ctx context.Context) error {
+	list, err := c.client.ListAllResourcesByApplication(ctx, to.String(c.application.Name))
+	if err != nil {
+		return err
+	}
+
+	c.resources = list
+	return c.archive.WriteJSON("resources.json", list)
+}
+
+// collectOperations collects the statuses of the asynchronous operations on the application and its resources.
+// Operation statuses are not exposed by the API for listing, so they are read from the Kubernetes data store used
+// by the control plane.
+func (c *collector) collectOperations(ctx context.Context) error {
+	ids := map[string]bool{strings.ToLower(to.String(c.application.ID)): true}
+	for _, resource := range c.resources {
+		ids[strings.ToLower(to.String(resource.ID))] = true
+	}
+
+	list := ucpv1alpha1.ResourceList{}
+	err := c.runtimeClient.List(ctx, &list, client.InNamespace(helm.RadiusSystemNamespace))
+	if err != nil {
+		return err
+	}
+
+	operations := []statusmanager.Status{}
+	for _, item := range list.Items {
+		for _, entry := range item.Entries {
+			id, err := resources.Parse(entry.ID)
+			if err != nil || !strings.HasSuffix(strings.ToLower(id.Type()), "/"+operationStatusesType) || entry.Data == nil {
+				continue
+			}
+
+			status := statusmanager.Status{}
+			err = json.Unmarshal(entry.Data.Raw, &status)
+			if err != nil {
+				continue
+			}
+
+			if ids[strings.ToLower(status.LinkedResourceID)] {
+				operations = append(operations, status)
+			}
+		}
+	}
+
+	// Newest first, so that the operation that failed most recently is easy to find.
+	sort.SliceStable(operations, func(i, j int) bool {
+		return operations[i].StartTime.After(operations[j].StartTime)
+	})
+
+	c.operations = operations
+	return c.archive.WriteJSON("operations.json", operations)
+}
+
+// collectRecipeErrors collects the resources whose recipe did not deploy successfully, along with the last
+// operation on the resource, which contains the error reported by the recipe engine.
+func (c *collector) collectRecipeErrors() error {
+	errors := []recipeError{}
+	for _, resource := range c.resources {
+		recipe, ok := resource.Properties["recipe"].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		provisioningState, _ := resource.Properties["provisioningState"].(string)
+		if strings.EqualFold(provisioningState, "Succeeded") {
+			continue
+		}
+
+		name, _ := recipe["name"].(string)
+		if name == "" {
+			name = "default"
+		}
+
+		entry := recipeError{
+			ResourceID:        to.String(resource.ID),
+			Recipe:            name,
+			ProvisioningState: provisioningState,
+		}
+		entry.Status, _ = resource.Properties["status"].(map[string]any)
+		for i := range c.operations {
+			if strings.EqualFold(c.operations[i].LinkedResourceID, entry.ResourceID) {
+				entry.LastOperation = &c.operations[i]
+				break
+			}
+		}
+
+		errors = append(errors, entry)
+	}
+
+	return c.archive.WriteJSON("recipe-errors.json", errors)
+}
+
+// kubernetesOutputResources returns the IDs of the Kubernetes output resources of the application and its resources.
+func (c *collector) kubernetesOutputResources() []resources.ID {
+	ids := []resources.ID{}
+	for _, resource := range c.resources {
+		status, ok := resource.Properties["status"].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		outputResources, ok := status["outputResources"].([]any)
+		if !ok {
+			continue
+		}
+
+		for _, obj := range outputResources {
+			outputResource, ok := obj.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			value, _ := outputResource["id"].(string)
+			id, err := resources.ParseResource(value)
+			if err != nil || id.FindScope(resources_kubernetes.PlaneTypeKubernetes) == "" {
+				continue
+			}
+
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// namespaces returns the Kubernetes namespaces used by the application.
+func (c *collector) namespaces() []string {
+	namespaces := map[string]bool{}
+	if c.application.Properties != nil && c.application.Properties.Status != nil {
+		if compute, ok := c.application.Properties.Status.Compute.(*corerp.KubernetesCompute); ok && compute.Namespace != nil {
+			namespaces[*compute.Namespace] = true
+		}
+	}
+
+	for _, id := range c.kubernetesOutputResources() {
+		if namespace := id.FindScope(resources_kubernetes.ScopeNamespaces); namespace != "" {
+			namespaces[namespace] = true
+		}
+	}
+
+	result := []string{}
+	for namespace := range namespaces {
+		result = append(result, namespace)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (c *collector) collectEvents(ctx context.Context) error {
+	events := []corev1.Event{}
+	for _, namespace := range c.namespaces() {
+		list := corev1.EventList{}
+		err := c.runtimeClient.List(ctx, &list, client.InNamespace(namespace))
+		if err != nil {
+			return err
+		}
+
+		events = append(events, list.Items...)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastTimestamp.Before(&events[j].LastTimestamp)
+	})
+
+	return c.archive.WriteJSON(path.Join("kubernetes", "events.json"), events)
+}
+
+// collectManifests collects the current manifests of the Kubernetes output resources. Output resources that no
+// longer exist are recorded as errors, since a missing output resource is often the cause of a failure.
+func (c *collector) collectManifests(ctx context.Context) error {
+	for _, id := range c.kubernetesOutputResources() {
+		group, kind, namespace, name := resources_kubernetes.ToParts(id)
+
+		mapping, err := c.runtimeClient.RESTMapper().RESTMapping(schema.GroupKind{Group: group, Kind: kind})
+		if err != nil {
+			c.errors = append(c.errors, fmt.Sprintf("failed to collect the manifest of %s: %v", id.String(), err))
+			continue
+		}
+
+		obj := unstructured.Unstructured{}
+		obj.SetGroupVersionKind(mapping.GroupVersionKind)
+		err = c.runtimeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &obj)
+		if err != nil {
+			c.errors = append(c.errors, fmt.Sprintf("failed to collect the manifest of %s: %v", id.String(), err))
+			continue
+		}
+
+		filename := path.Join("kubernetes", "manifests", namespace, fmt.Sprintf("%s.%s.yaml", strings.ToLower(kind), name))
+		err = c.archive.WriteYAML(filename, redactManifest(obj.Object))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collectLogs collects the logs of the containers of the Radius control plane.
+func (c *collector) collectLogs(ctx context.Context) error {
+	pods, err := c.kubernetesClient.CoreV1().Pods(helm.RadiusSystemNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", k8slabels.LabelPartOf, k8slabels.ControlPlanePartOfLabelValue),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, pod := range pods.Items {
+		for _, container := range pod.Spec.Containers {
+			logs, err := c.containerLogs(ctx, pod.Name, container.Name)
+			if err != nil {
+				c.errors = append(c.errors, fmt.Sprintf("failed to collect the logs of %s/%s: %v", pod.Name, container.Name, err))
+				continue
+			}
+
+			err = c.archive.WriteFile(path.Join("logs", fmt.Sprintf("%s.%s.log", pod.Name, container.Name)), redactText(logs))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *collector) containerLogs(ctx context.Context, pod string, container string) ([]byte, error) {
+	stream, err := c.kubernetesClient.CoreV1().Pods(helm.RadiusSystemNamespace).GetLogs(pod, &corev1.PodLogOptions{Container: container}).Stream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	buffer := bytes.Buffer{}
+	_, err = io.Copy(&buffer, stream)
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+const (
+	// redactedValue replaces sensitive values in the bundle.
+	redactedValue = "<redacted>"
+)
+
+// sensitiveKeyFragments are the fragments of property names whose values are redacted. The match is case-insensitive.
+var sensitiveKeyFragments = []string{
+	"password",
+	"secret",
+	"token",
+	"connectionstring",
+	"apikey",
+	"accesskey",
+	"privatekey",
+	"credential",
+}
+
+// sensitiveTextPattern matches assignments of sensitive values in unstructured text, such as logs. The name and
+// separator are kept and the value is redacted.
+var sensitiveTextPattern = regexp.MustCompile(`(?i)((?:password|secret|token|connectionstring|apikey|accesskey|privatekey)["']?\s*[:=]\s*["']?)[^\s"',;&]+`)
+
+// isSensitiveKey reports whether the value of a property with the given name should be redacted.
+func isSensitiveKey(key string) bool {
+	lower := strings.ToLower(key)
+	for _, fragment := range sensitiveKeyFragments {
+		if strings.Contains(lower, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactObject returns a copy of obj in its JSON representation with the values of sensitive properties redacted.
+func redactObject(obj any) (any, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var value any
+	err = json.Unmarshal(b, &value)
+	if err != nil {
+		return nil, err
+	}
+
+	return redactValue(value), nil
+}
+
+// redactValue redacts the sensitive properties of a JSON value. A property is sensitive if its name is sensitive,
+// or if it is the value of a name/value pair with a sensitive name, such as a Kubernetes environment variable.
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		if name, ok := v["name"].(string); ok && isSensitiveKey(name) {
+			if _, ok := v["value"]; ok {
+				v["value"] = redactedValue
+			}
+		}
+
+		for key, child := range v {
+			if isSensitiveKey(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactValue(child)
+		}
+		return v
+	case []any:
+		for i, child := range v {
+			v[i] = redactValue(child)
+		}
+		return v
+	default:
+		return value
+	}
+}
+
+// redactManifest redacts a Kubernetes manifest. The data of secrets is always redacted, as well as any sensitive
+// properties.
+func redactManifest(manifest map[string]any) map[string]any {
+	kind, _ := manifest["kind"].(string)
+	isSecret := kind == "Secret"
+	if isSecret {
+		for _, field := range []string{"data", "stringData"} {
+			data, ok := manifest[field].(map[string]any)
+			if !ok {
+				continue
+			}
+			for key := range data {
+				data[key] = redactedValue
+			}
+		}
+	}
+
+	// The managed fields are noise for debugging and can be large.
+	if metadata, ok := manifest["metadata"].(map[string]any); ok {
+		delete(metadata, "managedFields")
+	}
+
+	for key, child := range manifest {
+		if isSecret && (key == "data" || key == "stringData") {
+			continue
+		}
+		manifest[key] = redactValue(child)
+	}
+
+	return manifest
+}
+
+// redactText redacts assignments of sensitive values in unstructured text.
+func redactText(text []byte) []byte {
+	return sensitiveTextPattern.ReplaceAll(text, []byte("${1}"+redactedValue))
+}
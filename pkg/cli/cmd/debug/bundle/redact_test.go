@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_redactObject(t *testing.T) {
+	obj := map[string]any{
+		"name": "test",
+		"properties": map[string]any{
+			"host":    "redis.example.com",
+			"secrets": map[string]any{"password": "p@ss"},
+			"container": map[string]any{
+				"env": map[string]any{
+					"DB_PASSWORD": map[string]any{"value": "p@ss"},
+					"DB_HOST":     map[string]any{"value": "db"},
+				},
+			},
+			"connectionString": "Server=db;Password=p@ss",
+		},
+		"env": []any{
+			map[string]any{"name": "API_TOKEN", "value": "abc"},
+			map[string]any{"name": "PORT", "value": "80"},
+		},
+	}
+
+	redacted, err := redactObject(obj)
+	require.NoError(t, err)
+
+	expected := map[string]any{
+		"name": "test",
+		"properties": map[string]any{
+			"host":    "redis.example.com",
+			"secrets": redactedValue,
+			"container": map[string]any{
+				"env": map[string]any{
+					"DB_PASSWORD": redactedValue,
+					"DB_HOST":     map[string]any{"value": "db"},
+				},
+			},
+			"connectionString": redactedValue,
+		},
+		"env": []any{
+			map[string]any{"name": "API_TOKEN", "value": redactedValue},
+			map[string]any{"name": "PORT", "value": "80"},
+		},
+	}
+	require.Equal(t, expected, redacted)
+}
+
+func Test_redactManifest(t *testing.T) {
+	t.Run("secret", func(t *testing.T) {
+		manifest := map[string]any{
+			"kind": "Secret",
+			"metadata": map[string]any{
+				"name":          "test",
+				"managedFields": []any{map[string]any{"manager": "test"}},
+			},
+			"data":       map[string]any{"url": "aHR0cDovL2V4YW1wbGUuY29t"},
+			"stringData": map[string]any{"host": "example.com"},
+		}
+
+		expected := map[string]any{
+			"kind":       "Secret",
+			"metadata":   map[string]any{"name": "test"},
+			"data":       map[string]any{"url": redactedValue},
+			"stringData": map[string]any{"host": redactedValue},
+		}
+		require.Equal(t, expected, redactManifest(manifest))
+	})
+
+	t.Run("config map", func(t *testing.T) {
+		manifest := map[string]any{
+			"kind":     "ConfigMap",
+			"metadata": map[string]any{"name": "test"},
+			"data":     map[string]any{"host": "example.com", "password": "p@ss"},
+		}
+
+		expected := map[string]any{
+			"kind":     "ConfigMap",
+			"metadata": map[string]any{"name": "test"},
+			"data":     map[string]any{"host": "example.com", "password": redactedValue},
+		}
+		require.Equal(t, expected, redactManifest(manifest))
+	})
+}
+
+func Test_redactText(t *testing.T) {
+	input := "connecting with password=p@ss and token: \"abc123\" to host=db\n" +
+		`{"level":"info","apiKey":"xyz"}`
+	expected := "connecting with password=<redacted> and token: \"<redacted>\" to host=db\n" +
+		`{"level":"info","apiKey":"<redacted>"}`
+	require.Equal(t, expected, string(redactText([]byte(input))))
+}
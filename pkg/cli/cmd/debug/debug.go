@@ -0,0 +1,46 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	debug_bundle "github.com/radius-project/radius/pkg/cli/cmd/debug/bundle"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new cobra command for debugging Radius Applications, with a subcommand for collecting
+// support bundles.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Debug Radius Applications",
+		Long: `Debug Radius Applications
+
+Collect diagnostics for Radius Applications, for example to attach to an issue.
+`,
+		Example: `
+# Collect a support bundle for the current application
+rad debug bundle
+`,
+	}
+
+	bundle, _ := debug_bundle.NewCommand(factory)
+	cmd.AddCommand(bundle)
+
+	return cmd
+}
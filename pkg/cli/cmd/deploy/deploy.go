@@ -18,7 +18,10 @@ package deploy
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli"
@@ -28,7 +31,9 @@ import (
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/deploy"
+	"github.com/radius-project/radius/pkg/cli/filewatch"
 	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/history"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
@@ -67,6 +72,9 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	
 	You can specify parameters using multiple sources. Parameters can be overridden based on the 
 	order the are provided. Parameters appearing later in the argument list will override those defined earlier.
+
+	Use '--watch' to keep watching the template, its local modules and parameter files after the deployment,
+	and redeploy whenever they change.
 	`,
 		Example: `
 # deploy a Bicep template
@@ -98,6 +106,9 @@ rad deploy myapp.bicep --parameters @myfile.json
 
 # specify parameters from multiple sources
 rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
+
+# redeploy whenever the template or its modules change
+rad deploy myapp.bicep --watch
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -108,6 +119,7 @@ rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddParameterFlag(cmd)
+	AddWatchFlag(cmd)
 
 	return cmd, runner
 }
@@ -118,6 +130,7 @@ type Runner struct {
 	ConfigHolder      *framework.ConfigHolder
 	ConnectionFactory connections.Factory
 	Deploy            deploy.Interface
+	History           history.Interface
 	Output            output.Interface
 
 	ApplicationName string
@@ -126,6 +139,25 @@ type Runner struct {
 	Parameters      map[string]map[string]any
 	Workspace       *workspaces.Workspace
 	Providers       *clients.Providers
+
+	// Watch is true when the template should be redeployed whenever it, its modules or its parameter files change.
+	Watch bool
+
+	// WatchDebounce is the amount of time to wait after the last change before redeploying.
+	WatchDebounce time.Duration
+
+	// parameterArgs are the unparsed parameter arguments, used to parse the parameters again when redeploying.
+	parameterArgs []string
+}
+
+const (
+	// watchFlag is the name of the flag used to enable watch mode.
+	watchFlag = "watch"
+)
+
+// AddWatchFlag adds the flag used to enable watch mode to the command. It is shared with `rad run`.
+func AddWatchFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool(watchFlag, false, "Watch the template, its local modules and parameter files and redeploy when they change")
 }
 
 // NewRunner creates a new instance of the `rad deploy` runner.
@@ -135,6 +167,7 @@ func NewRunner(factory framework.Factory) *Runner {
 		ConnectionFactory: factory.GetConnectionFactory(),
 		ConfigHolder:      factory.GetConfigHolder(),
 		Deploy:            factory.GetDeploy(),
+		History:           factory.GetHistory(),
 		Output:            factory.GetOutput(),
 	}
 }
@@ -231,6 +264,13 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	r.parameterArgs = parameterArgs
+
+	r.Watch, err = cmd.Flags().GetBool(watchFlag)
+	if err != nil {
+		return err
+	}
+	r.WatchDebounce = filewatch.DefaultDebounce
 
 	return nil
 }
@@ -240,7 +280,22 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 
 // Run deploys a Bicep template into an environment from a workspace, optionally creating an application if
 // specified, and displays progress and completion messages. It returns an error if any of the operations fail.
+// In watch mode Run then blocks, redeploying the template when it changes, until the context is cancelled.
 func (r *Runner) Run(ctx context.Context) error {
+	err := r.DeployTemplate(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !r.Watch {
+		return nil
+	}
+
+	return r.WatchAndRedeploy(ctx)
+}
+
+// DeployTemplate deploys the template once.
+func (r *Runner) DeployTemplate(ctx context.Context) error {
 	template, err := r.Bicep.PrepareTemplate(r.FilePath)
 	if err != nil {
 		return err
@@ -288,7 +343,7 @@ func (r *Runner) Run(ctx context.Context) error {
 				"Deployment In Progress... ", r.FilePath, r.ApplicationName, r.EnvironmentName, r.Workspace.Name)
 	}
 
-	_, err = r.Deploy.DeployWithProgress(ctx, deploy.Options{
+	result, err := r.Deploy.DeployWithProgress(ctx, deploy.Options{
 		ConnectionFactory: r.ConnectionFactory,
 		Workspace:         *r.Workspace,
 		Template:          template,
@@ -297,9 +352,96 @@ func (r *Runner) Run(ctx context.Context) error {
 		CompletionText:    "Deployment Complete",
 		Providers:         r.Providers,
 	})
+	r.recordHistory(ctx, template, result, err)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// recordHistory records the deployment in the deployment history of the applications it affected. Failing to
+// record the history does not fail the deployment.
+func (r *Runner) recordHistory(ctx context.Context, template map[string]any, result clients.DeploymentResult, deploymentErr error) {
+	if r.History == nil {
+		return
+	}
+
+	_, err := history.RecordDeployment(ctx, r.History, history.DeploymentOptions{
+		Workspace:       r.Workspace.Name,
+		Scope:           r.Workspace.Scope,
+		Environment:     r.EnvironmentName,
+		ApplicationName: r.ApplicationName,
+		TemplateFile:    r.FilePath,
+		Template:        template,
+		Parameters:      r.Parameters,
+		Providers:       r.Providers,
+		Result:          result,
+		Error:           deploymentErr,
+	})
+	if err != nil {
+		r.Output.LogInfo("Failed to record the deployment history: %v", err)
+	}
+}
+
+// WatchAndRedeploy watches the template, its local modules and its parameter files, and redeploys the template
+// whenever they change. Failed redeployments are reported and watching continues, so that the user can fix
+// the template. WatchAndRedeploy blocks until the context is cancelled.
+func (r *Runner) WatchAndRedeploy(ctx context.Context) error {
+	r.Output.LogInfo("")
+	r.Output.LogInfo("Watching '%v' for changes. Press CTRL+C to stop.", r.FilePath)
+
+	err := filewatch.Watch(ctx, filewatch.Options{
+		Files:    r.watchedFiles,
+		Debounce: r.WatchDebounce,
+		OnChange: func(ctx context.Context, changed []string) {
+			r.Output.LogInfo("")
+			r.Output.LogInfo("Detected changes to %v, redeploying...", strings.Join(changed, ", "))
+
+			err := r.redeploy(ctx)
+			if err != nil {
+				r.Output.LogInfo("Deployment failed: %v", err)
+			}
+			r.Output.LogInfo("Watching '%v' for changes. Press CTRL+C to stop.", r.FilePath)
+		},
+	})
+
+	// context.Canceled here means the user stopped watching.
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
+
+// redeploy parses the parameters again, since parameter files may have changed, and deploys the template.
+func (r *Runner) redeploy(ctx context.Context) error {
+	if r.parameterArgs != nil {
+		parser := bicep.ParameterParser{FileSystem: bicep.OSFileSystem{}}
+		parameters, err := parser.Parse(r.parameterArgs...)
+		if err != nil {
+			return err
+		}
+		r.Parameters = parameters
+	}
+
+	return r.DeployTemplate(ctx)
+}
+
+// watchedFiles returns the files to watch: the template, its local dependencies and the parameter files.
+func (r *Runner) watchedFiles() ([]string, error) {
+	files, err := bicep.Dependencies(r.FilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, arg := range r.parameterArgs {
+		// Parameter files are specified as either @file.json or name=@file.json.
+		if strings.HasPrefix(arg, "@") {
+			files = append(files, strings.TrimPrefix(arg, "@"))
+		} else if _, file, found := strings.Cut(arg, "=@"); found {
+			files = append(files, file)
+		}
+	}
+
+	return files, nil
+}
@@ -19,7 +19,10 @@ package deploy
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/radius-project/radius/pkg/cli/bicep"
@@ -28,6 +31,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/deploy"
 	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/history"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
@@ -58,6 +62,26 @@ func Test_Validate(t *testing.T) {
 					Times(1)
 			},
 		},
+		{
+			Name:          "rad deploy - valid with watch",
+			Input:         []string{"app.bicep", "--watch", "-p", "foo=bar"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvDetails(gomock.Any(), radcli.TestEnvironmentName).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.True(t, r.Watch)
+				require.Equal(t, []string{"foo=bar"}, r.parameterArgs)
+			},
+		},
 		{
 			Name:          "rad deploy - valid with parameters",
 			Input:         []string{"app.bicep", "-p", "foo=bar", "--parameters", "a=b"},
@@ -434,6 +458,73 @@ func Test_Run(t *testing.T) {
 		require.Empty(t, outputSink.Writes)
 	})
 
+	t.Run("Deployment is recorded in history", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		template := map[string]any{"resources": map[string]any{}}
+		bicep := bicep.NewMockInterface(ctrl)
+		bicep.EXPECT().
+			PrepareTemplate("app.bicep").
+			Return(template, nil).
+			Times(1)
+
+		appManagmentMock := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagmentMock.EXPECT().
+			GetEnvDetails(gomock.Any(), radcli.TestEnvironmentName).
+			Return(v20231001preview.EnvironmentResource{}, nil).
+			Times(1)
+		appManagmentMock.EXPECT().
+			CreateApplicationIfNotFound(gomock.Any(), "test-application", gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		deployMock := deploy.NewMockInterface(ctrl)
+		deployMock.EXPECT().
+			DeployWithProgress(gomock.Any(), gomock.Any()).
+			Return(clients.DeploymentResult{}, nil).
+			Times(1)
+
+		workspace := &workspaces.Workspace{
+			Name:  "kind-kind",
+			Scope: "/planes/radius/local/resourceGroups/test-group",
+		}
+		providers := clients.Providers{
+			Radius: &clients.RadiusProvider{
+				EnvironmentID: workspace.Scope + "/providers/applications.core/environments/" + radcli.TestEnvironmentName,
+				ApplicationID: workspace.Scope + "/providers/applications.core/applications/test-application",
+			},
+		}
+
+		store := &history.FileStore{Directory: t.TempDir()}
+		runner := &Runner{
+			Bicep:             bicep,
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagmentMock},
+			Deploy:            deployMock,
+			History:           store,
+			Output:            &output.MockOutput{},
+			Providers:         &providers,
+			FilePath:          "app.bicep",
+			ApplicationName:   "test-application",
+			EnvironmentName:   radcli.TestEnvironmentName,
+			Parameters:        map[string]map[string]any{},
+			Workspace:         workspace,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		revisions, err := store.List(context.Background(), history.Key{Workspace: "kind-kind", Scope: workspace.Scope, Application: "test-application"})
+		require.NoError(t, err)
+		require.Len(t, revisions, 1)
+		require.Equal(t, 1, revisions[0].Revision)
+		require.Equal(t, history.StatusSucceeded, revisions[0].Status)
+		require.Equal(t, "app.bicep", revisions[0].TemplateFile)
+		require.Equal(t, radcli.TestEnvironmentName, revisions[0].Environment)
+		require.Equal(t, template, revisions[0].Template)
+		require.Equal(t, &providers, revisions[0].Providers)
+	})
+
 	t.Run("Deployment that doesn't need an app or env", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -492,3 +583,87 @@ func Test_Run(t *testing.T) {
 		require.Empty(t, outputSink.Writes)
 	})
 }
+
+func Test_Run_Watch(t *testing.T) {
+	directory := t.TempDir()
+	filePath := filepath.Join(directory, "app.bicep")
+	require.NoError(t, os.WriteFile(filePath, []byte("param environment string"), 0644))
+
+	ctrl := gomock.NewController(t)
+
+	bicep := bicep.NewMockInterface(ctrl)
+	bicep.EXPECT().
+		PrepareTemplate(filePath).
+		Return(map[string]any{}, nil).
+		MinTimes(2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	deployments := make(chan struct{}, 10)
+	deployMock := deploy.NewMockInterface(ctrl)
+	deployMock.EXPECT().
+		DeployWithProgress(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, o deploy.Options) (clients.DeploymentResult, error) {
+			deployments <- struct{}{}
+			return clients.DeploymentResult{}, nil
+		}).
+		MinTimes(2)
+
+	runner := &Runner{
+		Bicep:           bicep,
+		Deploy:          deployMock,
+		Output:          &output.MockOutput{},
+		FilePath:        filePath,
+		EnvironmentName: radcli.TestEnvironmentName,
+		Parameters:      map[string]map[string]any{},
+		Workspace:       &workspaces.Workspace{Name: "kind-kind"},
+		Watch:           true,
+		WatchDebounce:   10 * time.Millisecond,
+	}
+
+	result := make(chan error, 1)
+	go func() {
+		result <- runner.Run(ctx)
+	}()
+
+	// Initial deployment.
+	select {
+	case <-deployments:
+	case <-ctx.Done():
+		require.Fail(t, "timed out waiting for the initial deployment")
+	}
+
+	// The watcher starts asynchronously, so keep changing the template until it is redeployed.
+	redeployed := false
+	for i := 0; !redeployed; i++ {
+		require.NoError(t, os.WriteFile(filePath, []byte(fmt.Sprintf("param environment string // %d", i)), 0644))
+
+		select {
+		case <-deployments:
+			redeployed = true
+		case <-time.After(500 * time.Millisecond):
+		case <-ctx.Done():
+			require.Fail(t, "timed out waiting for the redeployment")
+		}
+	}
+
+	// Cancelling the context stops watching without an error.
+	cancel()
+	require.NoError(t, <-result)
+}
+
+func Test_watchedFiles(t *testing.T) {
+	directory := t.TempDir()
+	filePath := filepath.Join(directory, "app.bicep")
+	require.NoError(t, os.WriteFile(filePath, []byte("param environment string"), 0644))
+
+	runner := &Runner{
+		FilePath:      filePath,
+		parameterArgs: []string{"@params.json", "config=@config.json", "version=latest"},
+	}
+
+	files, err := runner.watchedFiles()
+	require.NoError(t, err)
+	require.Equal(t, []string{filePath, "params.json", "config.json"}, files)
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/cmd/resource/common"
+)
+
+const (
+	// ActionCreate indicates that the resource does not exist and will be created.
+	ActionCreate = "Create"
+
+	// ActionUpdate indicates that the resource exists and one or more of its properties will change.
+	ActionUpdate = "Update"
+
+	// ActionNoChange indicates that the resource exists and none of its properties will change.
+	ActionNoChange = "NoChange"
+
+	// ActionOrphaned indicates that the resource exists in an application of the template, but is no longer
+	// declared by the template.
+	ActionOrphaned = "Orphaned"
+
+	// ActionUnknown indicates that the name of the resource can only be determined during deployment.
+	ActionUnknown = "Unknown"
+
+	// ChangeAdded indicates that the property will be added.
+	ChangeAdded = "Added"
+
+	// ChangeChanged indicates that the value of the property will change.
+	ChangeChanged = "Changed"
+
+	// ChangeRemoved indicates that the property will be removed.
+	ChangeRemoved = "Removed"
+)
+
+// Result is the result of comparing a template against the live state of the Radius resources it declares.
+type Result struct {
+	Template    string         `json:"template"`
+	Environment string         `json:"environment"`
+	Resources   []ResourceDiff `json:"resources"`
+	Summary     Summary        `json:"summary"`
+}
+
+// Summary counts the resources by action.
+type Summary struct {
+	Create   int `json:"create"`
+	Update   int `json:"update"`
+	NoChange int `json:"noChange"`
+	Orphaned int `json:"orphaned"`
+	Unknown  int `json:"unknown"`
+}
+
+// HasChanges returns true if deploying the template would create, update or orphan any resource.
+func (s Summary) HasChanges() bool {
+	return s.Create+s.Update+s.Orphaned+s.Unknown > 0
+}
+
+// ResourceDiff describes the changes to a single resource.
+type ResourceDiff struct {
+	ID           string           `json:"id,omitempty"`
+	Type         string           `json:"type"`
+	Name         string           `json:"name,omitempty"`
+	SymbolicName string           `json:"symbolicName,omitempty"`
+	Action       string           `json:"action"`
+	Changes      []PropertyChange `json:"changes,omitempty"`
+}
+
+// PropertyChange describes the change to a single property of a resource. Values that can only be determined
+// during deployment, and the values of secure parameters, are represented by bicep.UnknownValue.
+type PropertyChange struct {
+	Path   string `json:"path"`
+	Kind   string `json:"kind"`
+	Before any    `json:"before,omitempty"`
+	After  any    `json:"after,omitempty"`
+}
+
+// compareResource compares a resource declared in the template with its live state. live is nil when the
+// resource does not exist.
+func compareResource(desired bicep.TemplateResource, live *generated.GenericResource) ResourceDiff {
+	result := ResourceDiff{
+		ID:           desired.ID,
+		Type:         desired.Type,
+		Name:         desired.Name,
+		SymbolicName: desired.SymbolicName,
+	}
+
+	if desired.Unresolved {
+		result.Action = ActionUnknown
+		return result
+	}
+
+	if live == nil {
+		result.Action = ActionCreate
+		return result
+	}
+
+	changes := []PropertyChange{}
+	if properties, ok := desired.Body["properties"]; ok {
+		// The live properties include the values computed by the resource provider and the recipes, such as the
+		// status, the URL of a gateway or the host of a portable resource, and omit the write-only secrets. Only the
+		// properties declared in the template are compared, except for the write-only properties which can't be.
+		compareValues("properties", toAny(live.Properties), withoutWriteOnlyProperties(desired.Type, properties), true, &changes)
+	}
+	if tags, ok := desired.Body["tags"]; ok {
+		liveTags := map[string]any{}
+		for key, value := range live.Tags {
+			if value != nil {
+				liveTags[key] = *value
+			}
+		}
+		compareValues("tags", liveTags, tags, false, &changes)
+	}
+
+	result.Action = ActionNoChange
+	if len(changes) > 0 {
+		result.Action = ActionUpdate
+		result.Changes = changes
+	}
+	return result
+}
+
+// withoutWriteOnlyProperties returns a copy of the properties without the write-only properties of the resource type.
+// The server never returns them, so they would always be reported as added, and their values are secrets.
+func withoutWriteOnlyProperties(resourceType string, properties any) any {
+	propertiesMap, ok := properties.(map[string]any)
+	if !ok {
+		return properties
+	}
+
+	result := map[string]any{}
+	for key, value := range propertiesMap {
+		result[key] = value
+	}
+	for _, property := range common.WriteOnlyProperties(resourceType) {
+		delete(result, property)
+	}
+	return result
+}
+
+// orphanedResource returns the diff for a live resource that is no longer declared by the template.
+func orphanedResource(live generated.GenericResource) ResourceDiff {
+	return ResourceDiff{
+		ID:     valueOrEmpty(live.ID),
+		Type:   valueOrEmpty(live.Type),
+		Name:   valueOrEmpty(live.Name),
+		Action: ActionOrphaned,
+	}
+}
+
+// compareValues records the changes between the live value (before) and the declared value (after). Values that
+// can only be determined during deployment are only reported when the property does not exist yet, since whether
+// they change cannot be known ahead of time. When declaredOnly is true, the keys of objects that are not declared
+// are not compared, otherwise they are reported as removed.
+func compareValues(path string, before any, after any, declaredOnly bool, changes *[]PropertyChange) {
+	if before == nil && after == nil {
+		return
+	}
+
+	if before == nil {
+		*changes = append(*changes, PropertyChange{Path: path, Kind: ChangeAdded, After: after})
+		return
+	}
+
+	if _, ok := after.(bicep.UnknownValue); ok {
+		return
+	}
+
+	beforeMap, beforeIsMap := before.(map[string]any)
+	afterMap, afterIsMap := after.(map[string]any)
+	if beforeIsMap && afterIsMap {
+		keys := map[string]bool{}
+		if !declaredOnly {
+			for key := range beforeMap {
+				keys[key] = true
+			}
+		}
+		for key := range afterMap {
+			keys[key] = true
+		}
+
+		sorted := []string{}
+		for key := range keys {
+			sorted = append(sorted, key)
+		}
+		sort.Strings(sorted)
+
+		for _, key := range sorted {
+			childPath := path + "." + key
+			afterValue, declared := afterMap[key]
+			if !declared {
+				*changes = append(*changes, PropertyChange{Path: childPath, Kind: ChangeRemoved, Before: beforeMap[key]})
+				continue
+			}
+
+			compareValues(childPath, beforeMap[key], afterValue, declaredOnly, changes)
+		}
+		return
+	}
+
+	if after == nil {
+		*changes = append(*changes, PropertyChange{Path: path, Kind: ChangeRemoved, Before: before})
+		return
+	}
+
+	if !reflect.DeepEqual(toAny(before), toAny(after)) && !bicep.IsUnknown(after) {
+		*changes = append(*changes, PropertyChange{Path: path, Kind: ChangeChanged, Before: before, After: after})
+	}
+}
+
+// toAny normalizes a value through a JSON round-trip so that values from the template and values from the
+// server can be compared. Values containing unknowns are returned as-is.
+func toAny(value any) any {
+	if value == nil || bicep.IsUnknown(value) {
+		return value
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+
+	var result any
+	if err := json.Unmarshal(b, &result); err != nil {
+		return value
+	}
+	return result
+}
+
+// renderText renders the result as human-readable text, one line per entry.
+func renderText(result Result) []string {
+	lines := []string{}
+	for _, resource := range result.Resources {
+		switch resource.Action {
+		case ActionCreate:
+			lines = append(lines, fmt.Sprintf("+ %s %s", resource.Type, resource.Name))
+		case ActionUpdate:
+			lines = append(lines, fmt.Sprintf("~ %s %s", resource.Type, resource.Name))
+			for _, change := range resource.Changes {
+				switch change.Kind {
+				case ChangeAdded:
+					lines = append(lines, fmt.Sprintf("    + %s: %s", change.Path, formatValue(change.After)))
+				case ChangeRemoved:
+					lines = append(lines, fmt.Sprintf("    - %s: %s", change.Path, formatValue(change.Before)))
+				case ChangeChanged:
+					lines = append(lines, fmt.Sprintf("    ~ %s: %s => %s", change.Path, formatValue(change.Before), formatValue(change.After)))
+				}
+			}
+		case ActionNoChange:
+			lines = append(lines, fmt.Sprintf("= %s %s", resource.Type, resource.Name))
+		case ActionOrphaned:
+			lines = append(lines, fmt.Sprintf("- %s %s (orphaned)", resource.Type, resource.Name))
+		case ActionUnknown:
+			lines = append(lines, fmt.Sprintf("? %s %s (name known after deployment)", resource.Type, resource.SymbolicName))
+		}
+	}
+
+	summary := result.Summary
+	lines = append(lines, "", fmt.Sprintf("Summary: %d to create, %d to update, %d unchanged, %d orphaned, %d unknown.",
+		summary.Create, summary.Update, summary.NoChange, summary.Orphaned, summary.Unknown))
+	return lines
+}
+
+func formatValue(value any) string {
+	if unknown, ok := value.(bicep.UnknownValue); ok {
+		return unknown.String()
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(b)
+}
+
+func summarize(resources []ResourceDiff) Summary {
+	summary := Summary{}
+	for _, resource := range resources {
+		switch resource.Action {
+		case ActionCreate:
+			summary.Create++
+		case ActionUpdate:
+			summary.Update++
+		case ActionNoChange:
+			summary.NoChange++
+		case ActionOrphaned:
+			summary.Orphaned++
+		case ActionUnknown:
+			summary.Unknown++
+		}
+	}
+	return summary
+}
+
+func valueOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_compareResource(t *testing.T) {
+	desired := bicep.TemplateResource{
+		SymbolicName: "frontend",
+		Type:         "Applications.Core/containers",
+		Name:         "frontend",
+		ID:           "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend",
+		Body: map[string]any{
+			"name": "frontend",
+			"tags": map[string]any{"team": "web"},
+			"properties": map[string]any{
+				"application": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app",
+				"container": map[string]any{
+					"image": "nginx:1.25",
+					"ports": map[string]any{
+						"web": map[string]any{"containerPort": float64(80)},
+					},
+					"env": map[string]any{
+						"BACKEND": bicep.UnknownValue{},
+						"MODE":    "production",
+					},
+				},
+				"extensions": []any{map[string]any{"kind": "daprSidecar"}},
+			},
+		},
+	}
+
+	t.Run("create", func(t *testing.T) {
+		result := compareResource(desired, nil)
+		require.Equal(t, ActionCreate, result.Action)
+		require.Empty(t, result.Changes)
+	})
+
+	t.Run("unresolved", func(t *testing.T) {
+		unresolved := bicep.TemplateResource{SymbolicName: "frontend", Type: "Applications.Core/containers", Unresolved: true}
+		result := compareResource(unresolved, nil)
+		require.Equal(t, ActionUnknown, result.Action)
+	})
+
+	t.Run("no change", func(t *testing.T) {
+		live := generated.GenericResource{
+			Tags: map[string]*string{"team": to.Ptr("web")},
+			Properties: map[string]any{
+				"provisioningState": "Succeeded",
+				"status":            map[string]any{"outputResources": []any{}},
+				"application":       "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app",
+				"container": map[string]any{
+					"image": "nginx:1.25",
+					"ports": map[string]any{
+						"web": map[string]any{"containerPort": int32(80)},
+					},
+					"env": map[string]any{
+						"BACKEND": "http://backend:3000",
+						"MODE":    "production",
+					},
+				},
+				"extensions": []any{map[string]any{"kind": "daprSidecar"}},
+			},
+		}
+
+		result := compareResource(desired, &live)
+		require.Equal(t, ActionNoChange, result.Action)
+		require.Empty(t, result.Changes)
+	})
+
+	t.Run("computed properties are not compared", func(t *testing.T) {
+		gateway := bicep.TemplateResource{
+			Type: "Applications.Core/gateways",
+			Name: "gateway",
+			Body: map[string]any{
+				"properties": map[string]any{
+					"routes": []any{map[string]any{"path": "/", "destination": "http://frontend:80"}},
+				},
+			},
+		}
+
+		live := generated.GenericResource{
+			Properties: map[string]any{
+				"provisioningState": "Succeeded",
+				"url":               "http://gateway.example.com",
+				"hostname":          map[string]any{"fullyQualifiedHostname": "gateway.example.com"},
+				"routes":            []any{map[string]any{"path": "/", "destination": "http://frontend:80"}},
+			},
+		}
+
+		result := compareResource(gateway, &live)
+		require.Equal(t, ActionNoChange, result.Action)
+		require.Empty(t, result.Changes)
+
+		// The host and port of a portable resource are set by its recipe and the secrets are never returned.
+		redis := bicep.TemplateResource{
+			Type: "Applications.Datastores/redisCaches",
+			Name: "cache",
+			Body: map[string]any{
+				"properties": map[string]any{
+					"environment": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/default",
+				},
+			},
+		}
+
+		live = generated.GenericResource{
+			Properties: map[string]any{
+				"environment": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/default",
+				"host":        "redis.default.svc.cluster.local",
+				"port":        float64(6379),
+				"recipe":      map[string]any{"name": "default"},
+			},
+		}
+
+		result = compareResource(redis, &live)
+		require.Equal(t, ActionNoChange, result.Action)
+	})
+
+	t.Run("write-only properties are not compared", func(t *testing.T) {
+		secretStore := bicep.TemplateResource{
+			Type: "Applications.Core/secretStores",
+			Name: "secrets",
+			Body: map[string]any{
+				"properties": map[string]any{
+					"application": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app",
+					"data":        map[string]any{"password": map[string]any{"value": "hunter2"}},
+				},
+			},
+		}
+
+		live := generated.GenericResource{
+			Properties: map[string]any{
+				"application": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app",
+			},
+		}
+
+		result := compareResource(secretStore, &live)
+		require.Equal(t, ActionNoChange, result.Action)
+		require.Empty(t, result.Changes)
+
+		// The template resource is not modified.
+		require.Contains(t, secretStore.Body["properties"], "data")
+	})
+
+	t.Run("update", func(t *testing.T) {
+		live := generated.GenericResource{
+			Tags: map[string]*string{"team": to.Ptr("api"), "owner": to.Ptr("alice")},
+			Properties: map[string]any{
+				"provisioningState": "Succeeded",
+				"application":       "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app",
+				"container": map[string]any{
+					"image": "nginx:1.24",
+					"env": map[string]any{
+						"MODE": "production",
+					},
+				},
+				"extensions": []any{},
+			},
+		}
+
+		result := compareResource(desired, &live)
+		require.Equal(t, ActionUpdate, result.Action)
+		require.Equal(t, []PropertyChange{
+			{Path: "properties.container.env.BACKEND", Kind: ChangeAdded, After: bicep.UnknownValue{}},
+			{Path: "properties.container.image", Kind: ChangeChanged, Before: "nginx:1.24", After: "nginx:1.25"},
+			{Path: "properties.container.ports", Kind: ChangeAdded, After: map[string]any{"web": map[string]any{"containerPort": float64(80)}}},
+			{Path: "properties.extensions", Kind: ChangeChanged, Before: []any{}, After: []any{map[string]any{"kind": "daprSidecar"}}},
+			{Path: "tags.owner", Kind: ChangeRemoved, Before: "alice"},
+			{Path: "tags.team", Kind: ChangeChanged, Before: "api", After: "web"},
+		}, result.Changes)
+	})
+}
+
+func Test_renderText(t *testing.T) {
+	resources := []ResourceDiff{
+		{Type: "Applications.Core/applications", Name: "app", Action: ActionNoChange},
+		{Type: "Applications.Core/containers", Name: "backend", Action: ActionCreate},
+		{
+			Type:   "Applications.Core/containers",
+			Name:   "frontend",
+			Action: ActionUpdate,
+			Changes: []PropertyChange{
+				{Path: "properties.container.env.BACKEND", Kind: ChangeAdded, After: bicep.UnknownValue{}},
+				{Path: "properties.container.image", Kind: ChangeChanged, Before: "nginx:1.24", After: "nginx:1.25"},
+				{Path: "tags.owner", Kind: ChangeRemoved, Before: "alice"},
+				{Path: "properties.container.env.PASSWORD", Kind: ChangeAdded, After: bicep.UnknownValue{Secure: true}},
+			},
+		},
+		{Type: "Applications.Core/containers", SymbolicName: "worker", Action: ActionUnknown},
+		{Type: "Applications.Datastores/redisCaches", Name: "cache", Action: ActionOrphaned},
+	}
+
+	result := Result{Resources: resources, Summary: summarize(resources)}
+	require.True(t, result.Summary.HasChanges())
+
+	expected := []string{
+		"= Applications.Core/applications app",
+		"+ Applications.Core/containers backend",
+		"~ Applications.Core/containers frontend",
+		"    + properties.container.env.BACKEND: (known after deployment)",
+		"    ~ properties.container.image: \"nginx:1.24\" => \"nginx:1.25\"",
+		"    - tags.owner: \"alice\"",
+		"    + properties.container.env.PASSWORD: (secure value)",
+		"? Applications.Core/containers worker (name known after deployment)",
+		"- Applications.Datastores/redisCaches cache (orphaned)",
+		"",
+		"Summary: 1 to create, 1 to update, 1 unchanged, 1 orphaned, 1 unknown.",
+	}
+	require.Equal(t, expected, renderText(result))
+
+	require.False(t, Summary{NoChange: 3}.HasChanges())
+}
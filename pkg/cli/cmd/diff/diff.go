@@ -0,0 +1,330 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	formatText = "text"
+
+	applicationsResourceType = "Applications.Core/applications"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad diff` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "diff [file]",
+		Short: "Show the changes a template would make",
+		Long: `Show the changes a template would make
+
+The diff command compiles a Bicep or ARM template and compares the Radius resources it declares with their current state, without deploying anything.
+
+Each resource is reported as created, updated, unchanged, or orphaned. Orphaned resources exist in an application declared by the template (or specified with '--application') but are no longer declared by the template. Updated resources list the properties that would be added, changed, or removed. Only the properties declared in the template are compared, the properties computed by Radius and its recipes are not reported.
+
+Values that can only be determined during deployment, such as properties of other resources, are shown as '(known after deployment)' and are not reported as changes.
+
+Use '--output json' to produce machine-readable output, and '--exit-code' to exit with a non-zero status when there are changes.
+
+Parameters are specified the same way as for 'rad deploy'.
+`,
+		Example: `
+# show the changes a Bicep template would make
+rad diff app.bicep
+
+# show the changes against a specific environment
+rad diff app.bicep --environment production
+
+# specify a string parameter
+rad diff app.bicep --parameters version=latest
+
+# produce JSON output and fail if there are changes, for use in CI
+rad diff app.bicep --output json --exit-code
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	commonflags.AddParameterFlag(cmd)
+	cmd.Flags().StringP("output", "o", formatText, "output format (supported formats are text, json)")
+	cmd.Flags().Bool("exit-code", false, "Exit with a non-zero status if deploying the template would make any changes")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad diff` command.
+type Runner struct {
+	Bicep             bicep.Interface
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+
+	ApplicationName string
+	EnvironmentName string
+	ExitCode        bool
+	FilePath        string
+	Format          string
+	Parameters      map[string]map[string]any
+	Workspace       *workspaces.Workspace
+}
+
+// NewRunner creates a new instance of the `rad diff` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		Bicep:             factory.GetBicep(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad diff` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+
+	r.Workspace = workspace
+
+	// Allow --group to override the scope
+	scope, err := cli.RequireScope(cmd, *workspace)
+	if err != nil {
+		return err
+	}
+	workspace.Scope = scope
+
+	r.EnvironmentName, err = cli.RequireEnvironmentName(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+
+	// This might be empty, and that's fine!
+	r.ApplicationName, err = cli.ReadApplicationName(cmd, *workspace)
+	if err != nil {
+		return err
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(cmd.Context(), *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.GetEnvDetails(cmd.Context(), r.EnvironmentName)
+	if err != nil {
+		if !clients.Is404Error(err) {
+			return err
+		}
+
+		// As with `rad deploy`, the environment is only required if the user specified it.
+		if cli.DidSpecifyEnvironmentName(cmd, args) {
+			return clierrors.Message("The environment %q does not exist in scope %q. Run `rad env create` first.", r.EnvironmentName, r.Workspace.Scope)
+		}
+	}
+
+	r.Workspace.Environment = r.Workspace.Scope + "/providers/applications.core/environments/" + r.EnvironmentName
+
+	format, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return err
+	}
+	if !slices.Contains([]string{formatText, output.FormatJson}, format) {
+		return clierrors.Message("Unsupported output format %q. Supported formats are: %s, %s.", format, formatText, output.FormatJson)
+	}
+
+	r.ExitCode, err = cmd.Flags().GetBool("exit-code")
+	if err != nil {
+		return err
+	}
+
+	r.FilePath = args[0]
+	r.Format = format
+
+	parameterArgs, err := cmd.Flags().GetStringArray("parameters")
+	if err != nil {
+		return err
+	}
+
+	parser := bicep.ParameterParser{FileSystem: bicep.OSFileSystem{}}
+	r.Parameters, err = parser.Parse(parameterArgs...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad diff` command.
+func (r *Runner) Run(ctx context.Context) error {
+	template, err := r.Bicep.PrepareTemplate(r.FilePath)
+	if err != nil {
+		return err
+	}
+
+	// Inject the environment and application the same way `rad deploy` does so that the resources
+	// are compared with what would actually be deployed.
+	err = bicep.InjectEnvironmentParam(template, r.Parameters, r.Workspace.Environment)
+	if err != nil {
+		return err
+	}
+
+	if r.ApplicationName != "" {
+		err = bicep.InjectApplicationParam(template, r.Parameters, r.Workspace.Scope+"/providers/applications.core/applications/"+r.ApplicationName)
+		if err != nil {
+			return err
+		}
+	}
+
+	declared, err := bicep.RadiusResources(template, r.Parameters, r.Workspace.Scope)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to evaluate the resources declared in %q.", r.FilePath)
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	// Resources can be declared in a different scope than the workspace, eg: with the scope property of a module.
+	scopeClients := map[string]clients.ApplicationsManagementClient{strings.ToLower(r.Workspace.Scope): client}
+
+	resources := []ResourceDiff{}
+	declaredIDs := map[string]bool{}
+	applications := []string{}
+	if r.ApplicationName != "" {
+		applications = append(applications, r.ApplicationName)
+	}
+
+	for _, resource := range declared {
+		if resource.Unresolved {
+			resources = append(resources, compareResource(resource, nil))
+			continue
+		}
+
+		declaredIDs[strings.ToLower(resource.ID)] = true
+		if strings.EqualFold(resource.Type, applicationsResourceType) && !slices.Contains(applications, resource.Name) {
+			applications = append(applications, resource.Name)
+		}
+
+		resourceClient, err := r.clientForScope(ctx, scopeClients, scopeOf(resource.ID, r.Workspace.Scope))
+		if err != nil {
+			return err
+		}
+
+		live, err := resourceClient.ShowResource(ctx, resource.Type, resource.Name)
+		if clients.Is404Error(err) {
+			resources = append(resources, compareResource(resource, nil))
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		resources = append(resources, compareResource(resource, &live))
+	}
+
+	for _, application := range applications {
+		live, err := client.ListAllResourcesByApplication(ctx, application)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range live {
+			id := strings.ToLower(valueOrEmpty(resource.ID))
+			if id == "" || declaredIDs[id] {
+				continue
+			}
+
+			// Avoid reporting the same resource twice if it belongs to more than one of the applications.
+			declaredIDs[id] = true
+			resources = append(resources, orphanedResource(resource))
+		}
+	}
+
+	result := Result{
+		Template:    r.FilePath,
+		Environment: r.EnvironmentName,
+		Resources:   resources,
+		Summary:     summarize(resources),
+	}
+
+	if r.Format == output.FormatJson {
+		err = r.Output.WriteFormatted(output.FormatJson, result, output.FormatterOptions{})
+		if err != nil {
+			return err
+		}
+	} else {
+		r.Output.LogInfo("Comparing template '%s' with environment '%s' in workspace '%s'...", r.FilePath, r.EnvironmentName, r.Workspace.Name)
+		r.Output.LogInfo("")
+		for _, line := range renderText(result) {
+			r.Output.LogInfo("%s", line)
+		}
+	}
+
+	if r.ExitCode && result.Summary.HasChanges() {
+		return clierrors.Message("Deploying the template would make changes.")
+	}
+
+	return nil
+}
+
+// clientForScope returns the client for the given scope, creating it if it's not in the cache yet.
+func (r *Runner) clientForScope(ctx context.Context, cache map[string]clients.ApplicationsManagementClient, scope string) (clients.ApplicationsManagementClient, error) {
+	if client, ok := cache[strings.ToLower(scope)]; ok {
+		return client, nil
+	}
+
+	workspace := *r.Workspace
+	workspace.Scope = scope
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	cache[strings.ToLower(scope)] = client
+	return client, nil
+}
+
+// scopeOf returns the root scope of the resource ID, or the default scope if the ID can't be parsed.
+func scopeOf(id string, defaultScope string) string {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return defaultScope
+	}
+
+	return parsed.RootScope()
+}
@@ -0,0 +1,338 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testScope = "/planes/radius/local/resourceGroups/test-group"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "rad diff - valid",
+			Input:         []string{"app.bicep"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvDetails(gomock.Any(), radcli.TestEnvironmentName).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "app.bicep", r.FilePath)
+				require.Equal(t, formatText, r.Format)
+				require.False(t, r.ExitCode)
+			},
+		},
+		{
+			Name:          "rad diff - json output with exit code",
+			Input:         []string{"app.bicep", "--output", "json", "--exit-code"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvDetails(gomock.Any(), radcli.TestEnvironmentName).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, output.FormatJson, r.Format)
+				require.True(t, r.ExitCode)
+			},
+		},
+		{
+			Name:          "rad diff - unsupported output format",
+			Input:         []string{"app.bicep", "--output", "table"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvDetails(gomock.Any(), radcli.TestEnvironmentName).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+		},
+		{
+			Name:          "rad diff - specified environment does not exist",
+			Input:         []string{"app.bicep", "--environment", "missing"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvDetails(gomock.Any(), "missing").
+					Return(v20231001preview.EnvironmentResource{}, radcli.Create404Error()).
+					Times(1)
+			},
+		},
+		{
+			Name:          "rad diff - too many args",
+			Input:         []string{"app.bicep", "other.bicep"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func testTemplate() map[string]any {
+	return map[string]any{
+		"parameters": map[string]any{
+			"environment": map[string]any{"type": "string"},
+		},
+		"imports": map[string]any{
+			"radius": map[string]any{"provider": "Radius"},
+		},
+		"resources": map[string]any{
+			"app": map[string]any{
+				"import": "radius",
+				"type":   "Applications.Core/applications@2023-10-01-preview",
+				"properties": map[string]any{
+					"name":       "myapp",
+					"properties": map[string]any{"environment": "[parameters('environment')]"},
+				},
+			},
+			"frontend": map[string]any{
+				"import": "radius",
+				"type":   "Applications.Core/containers@2023-10-01-preview",
+				"properties": map[string]any{
+					"name": "frontend",
+					"properties": map[string]any{
+						"application": "[reference('app').id]",
+						"container":   map[string]any{"image": "nginx:1.25"},
+					},
+				},
+			},
+			"backend": map[string]any{
+				"import": "radius",
+				"type":   "Applications.Core/containers@2023-10-01-preview",
+				"properties": map[string]any{
+					"name": "backend",
+					"properties": map[string]any{
+						"application": "[reference('app').id]",
+						"container":   map[string]any{"image": "backend:latest"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func setupRunner(t *testing.T, format string, exitCode bool) (*Runner, *output.MockOutput) {
+	ctrl := gomock.NewController(t)
+
+	bicepMock := bicep.NewMockInterface(ctrl)
+	bicepMock.EXPECT().PrepareTemplate("app.bicep").Return(testTemplate(), nil).Times(1)
+
+	environmentID := testScope + "/providers/applications.core/environments/default"
+	applicationID := testScope + "/providers/Applications.Core/applications/myapp"
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		ShowResource(gomock.Any(), "Applications.Core/applications", "myapp").
+		Return(generated.GenericResource{
+			ID:         to.Ptr(applicationID),
+			Properties: map[string]any{"environment": environmentID, "provisioningState": "Succeeded"},
+		}, nil).
+		Times(1)
+	appManagementClient.EXPECT().
+		ShowResource(gomock.Any(), "Applications.Core/containers", "backend").
+		Return(generated.GenericResource{}, radcli.Create404Error()).
+		Times(1)
+	appManagementClient.EXPECT().
+		ShowResource(gomock.Any(), "Applications.Core/containers", "frontend").
+		Return(generated.GenericResource{
+			Properties: map[string]any{
+				"application": applicationID,
+				"container":   map[string]any{"image": "nginx:1.24"},
+			},
+		}, nil).
+		Times(1)
+	appManagementClient.EXPECT().
+		ListAllResourcesByApplication(gomock.Any(), "myapp").
+		Return([]generated.GenericResource{
+			{
+				ID:   to.Ptr(testScope + "/providers/Applications.Core/containers/frontend"),
+				Name: to.Ptr("frontend"),
+				Type: to.Ptr("Applications.Core/containers"),
+			},
+			{
+				ID:   to.Ptr(testScope + "/providers/Applications.Datastores/redisCaches/cache"),
+				Name: to.Ptr("cache"),
+				Type: to.Ptr("Applications.Datastores/redisCaches"),
+			},
+		}, nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		Bicep:             bicepMock,
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Output:            outputSink,
+		EnvironmentName:   "default",
+		ExitCode:          exitCode,
+		FilePath:          "app.bicep",
+		Format:            format,
+		Parameters:        map[string]map[string]any{},
+		Workspace: &workspaces.Workspace{
+			Name:        "test-workspace",
+			Scope:       testScope,
+			Environment: environmentID,
+		},
+	}
+	return runner, outputSink
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("text", func(t *testing.T) {
+		runner, outputSink := setupRunner(t, formatText, false)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		lines := []string{
+			"= Applications.Core/applications myapp",
+			"+ Applications.Core/containers backend",
+			"~ Applications.Core/containers frontend",
+			"    ~ properties.container.image: \"nginx:1.24\" => \"nginx:1.25\"",
+			"- Applications.Datastores/redisCaches cache (orphaned)",
+			"",
+			"Summary: 1 to create, 1 to update, 1 unchanged, 1 orphaned, 0 unknown.",
+		}
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Comparing template '%s' with environment '%s' in workspace '%s'...",
+				Params: []any{"app.bicep", "default", "test-workspace"},
+			},
+			output.LogOutput{Format: ""},
+		}
+		for _, line := range lines {
+			expected = append(expected, output.LogOutput{Format: "%s", Params: []any{line}})
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("json with exit code", func(t *testing.T) {
+		runner, outputSink := setupRunner(t, output.FormatJson, true)
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("Deploying the template would make changes."), err)
+
+		require.Len(t, outputSink.Writes, 1)
+		formatted := outputSink.Writes[0].(output.FormattedOutput)
+		require.Equal(t, output.FormatJson, formatted.Format)
+
+		result := formatted.Obj.(Result)
+		require.Equal(t, "app.bicep", result.Template)
+		require.Equal(t, "default", result.Environment)
+		require.Equal(t, Summary{Create: 1, Update: 1, NoChange: 1, Orphaned: 1}, result.Summary)
+
+		actions := []string{}
+		for _, resource := range result.Resources {
+			actions = append(actions, resource.Name+":"+resource.Action)
+		}
+		require.Equal(t, []string{"myapp:NoChange", "backend:Create", "frontend:Update", "cache:Orphaned"}, actions)
+	})
+}
+
+// scopedFactory returns a client per workspace scope and records the scopes that clients are created for.
+type scopedFactory struct {
+	connections.MockFactory
+	clients map[string]clients.ApplicationsManagementClient
+	scopes  []string
+}
+
+func (f *scopedFactory) CreateApplicationsManagementClient(ctx context.Context, workspace workspaces.Workspace) (clients.ApplicationsManagementClient, error) {
+	f.scopes = append(f.scopes, workspace.Scope)
+	return f.clients[workspace.Scope], nil
+}
+
+func Test_clientForScope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	otherScope := "/planes/radius/local/resourceGroups/other-group"
+	otherClient := clients.NewMockApplicationsManagementClient(ctrl)
+
+	factory := &scopedFactory{clients: map[string]clients.ApplicationsManagementClient{otherScope: otherClient}}
+	runner := &Runner{
+		ConnectionFactory: factory,
+		Workspace:         &workspaces.Workspace{Name: "test-workspace", Scope: testScope},
+	}
+
+	workspaceClient := clients.NewMockApplicationsManagementClient(ctrl)
+	cache := map[string]clients.ApplicationsManagementClient{strings.ToLower(testScope): workspaceClient}
+
+	client, err := runner.clientForScope(context.Background(), cache, testScope)
+	require.NoError(t, err)
+	require.Same(t, workspaceClient, client)
+
+	client, err = runner.clientForScope(context.Background(), cache, otherScope)
+	require.NoError(t, err)
+	require.Same(t, otherClient, client)
+
+	// The client is cached.
+	_, err = runner.clientForScope(context.Background(), cache, otherScope)
+	require.NoError(t, err)
+	require.Equal(t, []string{otherScope}, factory.scopes)
+}
+
+func Test_scopeOf(t *testing.T) {
+	require.Equal(t, "/planes/radius/local/resourceGroups/other-group", scopeOf("/planes/radius/local/resourceGroups/other-group/providers/Applications.Core/containers/frontend", testScope))
+	require.Equal(t, testScope, scopeOf("", testScope))
+}
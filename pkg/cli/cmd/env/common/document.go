@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DocumentVersionV1 is the first version of the environment document. The properties of a v1 document use the
+	// 2023-10-01-preview API version of Applications.Core/environments.
+	DocumentVersionV1 = "v1"
+
+	// DocumentKind is the kind of the environment document.
+	DocumentKind = "Environment"
+)
+
+// EnvironmentDocument is the portable definition of an environment used by `rad env export` and `rad env import`.
+type EnvironmentDocument struct {
+	// Version is the version of the document format.
+	Version string `json:"version"`
+
+	// Kind is always Environment.
+	Kind string `json:"kind"`
+
+	// Name is the name of the environment.
+	Name string `json:"name"`
+
+	// Properties contains the definition of the environment: compute, providers, recipes, extensions, extender kinds
+	// and the simulated flag.
+	Properties *corerp.EnvironmentProperties `json:"properties"`
+}
+
+// NewEnvironmentDocument creates the environment document for the given environment. Fields that are computed by
+// the server are omitted.
+func NewEnvironmentDocument(environment corerp.EnvironmentResource) EnvironmentDocument {
+	properties := corerp.EnvironmentProperties{}
+	if environment.Properties != nil {
+		properties = *environment.Properties
+	}
+	properties.ProvisioningState = nil
+
+	name := ""
+	if environment.Name != nil {
+		name = *environment.Name
+	}
+
+	return EnvironmentDocument{
+		Version:    DocumentVersionV1,
+		Kind:       DocumentKind,
+		Name:       name,
+		Properties: &properties,
+	}
+}
+
+// ReadEnvironmentDocument reads and validates an environment document from a JSON or YAML file.
+func ReadEnvironmentDocument(filePath string) (EnvironmentDocument, error) {
+	b, err := os.ReadFile(filePath)
+	if err != nil {
+		return EnvironmentDocument{}, clierrors.MessageWithCause(err, "Failed to read environment file %q.", filePath)
+	}
+
+	// YAML is a superset of JSON, so this handles both formats.
+	b, err = yaml.YAMLToJSON(b)
+	if err != nil {
+		return EnvironmentDocument{}, clierrors.MessageWithCause(err, "Failed to parse environment file %q as JSON or YAML.", filePath)
+	}
+
+	document := EnvironmentDocument{}
+	err = json.Unmarshal(b, &document)
+	if err != nil {
+		return EnvironmentDocument{}, clierrors.MessageWithCause(err, "Failed to parse environment file %q.", filePath)
+	}
+
+	if document.Version != DocumentVersionV1 {
+		return EnvironmentDocument{}, clierrors.Message("The environment file %q has unsupported version %q. Supported versions are: %s.", filePath, document.Version, DocumentVersionV1)
+	}
+
+	if document.Kind != DocumentKind {
+		return EnvironmentDocument{}, clierrors.Message("The environment file %q has unsupported kind %q. The kind must be %q.", filePath, document.Kind, DocumentKind)
+	}
+
+	if document.Properties == nil || document.Properties.Compute == nil {
+		return EnvironmentDocument{}, clierrors.Message("The environment file %q must contain the environment properties, including compute.", filePath)
+	}
+
+	document.Properties.ProvisioningState = nil
+
+	return document, nil
+}
+
+// DiffEnvironmentDocuments returns a unified diff between the YAML representation of the current and the desired
+// environment documents. The current document is nil when the environment does not exist. An empty string is
+// returned when there are no changes.
+func DiffEnvironmentDocuments(current *EnvironmentDocument, desired EnvironmentDocument) (string, error) {
+	before := ""
+	if current != nil {
+		b, err := yaml.Marshal(current)
+		if err != nil {
+			return "", err
+		}
+		before = string(b)
+	}
+
+	b, err := yaml.Marshal(desired)
+	if err != nil {
+		return "", err
+	}
+	after := string(b)
+
+	if before == after {
+		return "", nil
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(before),
+		B:        difflib.SplitLines(after),
+		FromFile: "current",
+		ToFile:   "desired",
+		Context:  3,
+	})
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+func testEnvironmentProperties() *corerp.EnvironmentProperties {
+	return &corerp.EnvironmentProperties{
+		Compute: &corerp.KubernetesCompute{
+			Kind:      to.Ptr("kubernetes"),
+			Namespace: to.Ptr("test-ns"),
+		},
+		Providers: &corerp.Providers{
+			Aws: &corerp.ProvidersAws{
+				Scope: to.Ptr("/planes/aws/aws/accounts/000000000000/regions/us-west-2"),
+			},
+		},
+		Recipes: map[string]map[string]corerp.RecipePropertiesClassification{
+			"Applications.Datastores/redisCaches": {
+				"default": &corerp.BicepRecipeProperties{
+					TemplateKind: to.Ptr("bicep"),
+					TemplatePath: to.Ptr("ghcr.io/radius-project/recipes/local-dev/rediscaches:latest"),
+				},
+			},
+		},
+		Simulated: to.Ptr(false),
+	}
+}
+
+func Test_NewEnvironmentDocument(t *testing.T) {
+	properties := testEnvironmentProperties()
+	properties.ProvisioningState = to.Ptr(corerp.ProvisioningStateSucceeded)
+
+	env := corerp.EnvironmentResource{
+		ID:         to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env"),
+		Name:       to.Ptr("test-env"),
+		Properties: properties,
+	}
+
+	document := NewEnvironmentDocument(env)
+
+	expected := EnvironmentDocument{
+		Version:    DocumentVersionV1,
+		Kind:       DocumentKind,
+		Name:       "test-env",
+		Properties: testEnvironmentProperties(),
+	}
+	require.Equal(t, expected, document)
+
+	// The environment is not modified.
+	require.NotNil(t, env.Properties.ProvisioningState)
+}
+
+func Test_ReadEnvironmentDocument(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		document, err := ReadEnvironmentDocument("testdata/env.yaml")
+		require.NoError(t, err)
+
+		expected := EnvironmentDocument{
+			Version:    DocumentVersionV1,
+			Kind:       DocumentKind,
+			Name:       "test-env",
+			Properties: testEnvironmentProperties(),
+		}
+		require.Equal(t, expected, document)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		_, err := ReadEnvironmentDocument("testdata/env-unsupported-version.yaml")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported version \"v2\"")
+	})
+
+	t.Run("missing compute", func(t *testing.T) {
+		_, err := ReadEnvironmentDocument("testdata/env-no-compute.yaml")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "including compute")
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := ReadEnvironmentDocument("testdata/missing.yaml")
+		require.Error(t, err)
+	})
+}
+
+func Test_DiffEnvironmentDocuments(t *testing.T) {
+	current := EnvironmentDocument{
+		Version:    DocumentVersionV1,
+		Kind:       DocumentKind,
+		Name:       "test-env",
+		Properties: testEnvironmentProperties(),
+	}
+
+	t.Run("no changes", func(t *testing.T) {
+		diff, err := DiffEnvironmentDocuments(&current, current)
+		require.NoError(t, err)
+		require.Empty(t, diff)
+	})
+
+	t.Run("changes", func(t *testing.T) {
+		desired := current
+		desired.Properties = testEnvironmentProperties()
+		desired.Properties.Simulated = to.Ptr(true)
+
+		diff, err := DiffEnvironmentDocuments(&current, desired)
+		require.NoError(t, err)
+		require.Contains(t, diff, "--- current\n+++ desired\n")
+		require.Contains(t, diff, "\n-  simulated: false\n+  simulated: true\n")
+	})
+
+	t.Run("new environment", func(t *testing.T) {
+		diff, err := DiffEnvironmentDocuments(nil, current)
+		require.NoError(t, err)
+		require.Contains(t, diff, "+name: test-env\n")
+	})
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envimport
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/env/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	importConfirmation = "Do you want to apply these changes to the environment %q?"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad env import` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "import [environment]",
+		Short: "Create or update an environment from a definition",
+		Long: `Create or update an environment from a YAML or JSON document created by 'rad env export'.
+
+The changes to the environment are displayed as a diff and confirmed before they are applied. The name of the
+environment defaults to the name in the document.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `
+# Import an environment from a file
+rad env import --file my-env.yaml
+
+# Import an environment from a file using a different name
+rad env import staging --file my-env.yaml
+
+# Preview the changes without applying them
+rad env import --file my-env.yaml --dry-run
+
+# Import an environment without prompting for confirmation
+rad env import --file my-env.yaml --yes
+`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddConfirmationFlag(cmd)
+	cmd.Flags().StringP("file", "f", "", "The YAML or JSON file containing the environment definition")
+	_ = cmd.MarkFlagRequired("file")
+	cmd.Flags().Bool("dry-run", false, "Display the changes without applying them")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad env import` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Workspace         *workspaces.Workspace
+	Output            output.Interface
+	InputPrompter     prompt.Interface
+
+	EnvironmentName string
+	Document        common.EnvironmentDocument
+	Confirm         bool
+	DryRun          bool
+}
+
+// NewRunner creates a new instance of the `rad env import` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+		InputPrompter:     factory.GetPrompter(),
+	}
+}
+
+// Validate runs validation for the `rad env import` command.
+//
+// Validate checks the workspace, scope and environment document, and sets the corresponding fields in the Runner
+// struct. The environment name is taken from the arguments if provided, or from the document otherwise.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	filePath, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	r.Document, err = common.ReadEnvironmentDocument(filePath)
+	if err != nil {
+		return err
+	}
+
+	r.EnvironmentName = r.Document.Name
+	if len(args) > 0 {
+		r.EnvironmentName = args[0]
+		r.Document.Name = args[0]
+	}
+
+	if r.EnvironmentName == "" {
+		return clierrors.Message("The environment name must be specified in the file %q or as an argument.", filePath)
+	}
+
+	r.Confirm, err = cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+
+	r.DryRun, err = cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad env import` command.
+//
+// Run compares the environment document with the existing environment, displays the changes and, once confirmed,
+// creates or updates the environment. It returns an error if the environment cannot be retrieved or updated.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	var current *common.EnvironmentDocument
+	env, err := client.GetEnvDetails(ctx, r.EnvironmentName)
+	if err == nil {
+		document := common.NewEnvironmentDocument(env)
+		document.Name = r.EnvironmentName
+		current = &document
+	} else if !clients.Is404Error(err) {
+		return err
+	}
+
+	diff, err := common.DiffEnvironmentDocuments(current, r.Document)
+	if err != nil {
+		return err
+	}
+
+	if diff == "" {
+		r.Output.LogInfo("The environment %q is up to date.", r.EnvironmentName)
+		return nil
+	}
+
+	if current == nil {
+		r.Output.LogInfo("The environment %q will be created:\n", r.EnvironmentName)
+	} else {
+		r.Output.LogInfo("The environment %q will be updated:\n", r.EnvironmentName)
+	}
+	r.Output.LogInfo("%s", diff)
+
+	if r.DryRun {
+		return nil
+	}
+
+	if !r.Confirm {
+		confirmed, err := prompt.YesOrNoPrompt(fmt.Sprintf(importConfirmation, r.EnvironmentName), prompt.ConfirmNo, r.InputPrompter)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			r.Output.LogInfo("The environment %q was NOT updated.", r.EnvironmentName)
+			return nil
+		}
+	}
+
+	err = client.CreateEnvironment(ctx, r.EnvironmentName, v1.LocationGlobal, r.Document.Properties)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to import the environment %q.", r.EnvironmentName)
+	}
+
+	r.Output.LogInfo("Successfully imported environment %q.", r.EnvironmentName)
+
+	return nil
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envimport
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/cmd/env/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Import Command with name from file",
+			Input:         []string{"--file", "testdata/env.yaml"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "test-env", runner.EnvironmentName)
+				require.False(t, runner.Confirm)
+				require.False(t, runner.DryRun)
+			},
+		},
+		{
+			Name:          "Import Command with name override",
+			Input:         []string{"staging", "--file", "testdata/env.yaml", "--yes", "--dry-run"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "staging", runner.EnvironmentName)
+				require.Equal(t, "staging", runner.Document.Name)
+				require.True(t, runner.Confirm)
+				require.True(t, runner.DryRun)
+			},
+		},
+		{
+			Name:          "Import Command without name",
+			Input:         []string{"--file", "testdata/env-no-name.yaml"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Import Command with missing file",
+			Input:         []string{"--file", "testdata/missing.yaml"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	newDocument := func(simulated bool) common.EnvironmentDocument {
+		return common.EnvironmentDocument{
+			Version: common.DocumentVersionV1,
+			Kind:    common.DocumentKind,
+			Name:    "test-env",
+			Properties: &corerp.EnvironmentProperties{
+				Compute: &corerp.KubernetesCompute{
+					Kind:      to.Ptr("kubernetes"),
+					Namespace: to.Ptr("test-ns"),
+				},
+				Simulated: to.Ptr(simulated),
+			},
+		}
+	}
+
+	existing := corerp.EnvironmentResource{
+		Name:       to.Ptr("test-env"),
+		Properties: newDocument(false).Properties,
+	}
+
+	t.Run("Create environment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		document := newDocument(false)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "test-env").
+			Return(corerp.EnvironmentResource{}, radcli.Create404Error()).
+			Times(1)
+		appManagementClient.EXPECT().
+			CreateEnvironment(gomock.Any(), "test-env", v1.LocationGlobal, document.Properties).
+			Return(nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			Output:            outputSink,
+			EnvironmentName:   "test-env",
+			Document:          document,
+			Confirm:           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		diff, err := common.DiffEnvironmentDocuments(nil, document)
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{Format: "The environment %q will be created:\n", Params: []any{"test-env"}},
+			output.LogOutput{Format: "%s", Params: []any{diff}},
+			output.LogOutput{Format: "Successfully imported environment %q.", Params: []any{"test-env"}},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Update environment after confirmation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		document := newDocument(true)
+		promptMock := prompt.NewMockInterface(ctrl)
+		promptMock.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, fmt.Sprintf(importConfirmation, "test-env")).
+			Return(prompt.ConfirmYes, nil).
+			Times(1)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "test-env").
+			Return(existing, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			CreateEnvironment(gomock.Any(), "test-env", v1.LocationGlobal, document.Properties).
+			Return(nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			Output:            outputSink,
+			InputPrompter:     promptMock,
+			EnvironmentName:   "test-env",
+			Document:          document,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, outputSink.Writes, 3)
+		require.Equal(t, output.LogOutput{Format: "The environment %q will be updated:\n", Params: []any{"test-env"}}, outputSink.Writes[0])
+		require.Contains(t, outputSink.Writes[1].(output.LogOutput).Params[0], "+  simulated: true")
+	})
+
+	t.Run("Update cancelled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		promptMock := prompt.NewMockInterface(ctrl)
+		promptMock.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, fmt.Sprintf(importConfirmation, "test-env")).
+			Return(prompt.ConfirmNo, nil).
+			Times(1)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "test-env").
+			Return(existing, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			Output:            outputSink,
+			InputPrompter:     promptMock,
+			EnvironmentName:   "test-env",
+			Document:          newDocument(true),
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, output.LogOutput{Format: "The environment %q was NOT updated.", Params: []any{"test-env"}}, outputSink.Writes[2])
+	})
+
+	t.Run("Dry run", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "test-env").
+			Return(existing, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			Output:            outputSink,
+			EnvironmentName:   "test-env",
+			Document:          newDocument(true),
+			DryRun:            true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Len(t, outputSink.Writes, 2)
+	})
+
+	t.Run("No changes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "test-env").
+			Return(existing, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			Output:            outputSink,
+			EnvironmentName:   "test-env",
+			Document:          newDocument(false),
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{Format: "The environment %q is up to date.", Params: []any{"test-env"}},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"os"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/env/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad env export` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "export [environment]",
+		Short: "Export an environment definition",
+		Long: `Export an environment definition to a versioned YAML or JSON document.
+
+The document contains the compute, cloud providers, recipes, extensions, extender kinds and simulated flag of the
+environment. Use 'rad env import' to create or update an environment from the document, for example to promote an
+environment to another cluster or to keep its definition in source control.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `
+# Export the current environment as YAML
+rad env export
+
+# Export the specified environment to a file
+rad env export my-env --file my-env.yaml
+
+# Export the specified environment as JSON
+rad env export my-env --output json
+`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	cmd.Flags().StringP("output", "o", output.FormatYaml, "output format (supported formats are yaml, json)")
+	cmd.Flags().StringP("file", "f", "", "The file to write the environment definition to. Defaults to the standard output")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad env export` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Workspace         *workspaces.Workspace
+	Output            output.Interface
+
+	EnvironmentName string
+	Format          string
+	FilePath        string
+}
+
+// NewRunner creates a new instance of the `rad env export` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad env export` command.
+//
+// Validate checks the workspace, scope, environment name, output format and file, and sets the corresponding fields
+// in the Runner struct. It returns an error if any of these values are not valid.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.EnvironmentName, err = cli.RequireEnvironmentNameArgs(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+
+	// The output flag is read directly because only the document formats are supported.
+	r.Format, err = cmd.Flags().GetString("output")
+	if err != nil {
+		return err
+	}
+
+	if r.Format != output.FormatYaml && r.Format != output.FormatJson {
+		return clierrors.Message("Unsupported output format %q. Supported formats are yaml, json.", r.Format)
+	}
+
+	r.FilePath, err = cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad env export` command.
+//
+// Run retrieves the environment and writes its definition to the output or to the specified file. It returns an error
+// if the environment does not exist or the definition cannot be written.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	env, err := client.GetEnvDetails(ctx, r.EnvironmentName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The environment %q was not found or has been deleted.", r.EnvironmentName)
+	} else if err != nil {
+		return err
+	}
+
+	document := common.NewEnvironmentDocument(env)
+	if r.FilePath == "" {
+		return r.Output.WriteFormatted(r.Format, document, output.FormatterOptions{})
+	}
+
+	buffer := &bytes.Buffer{}
+	err = output.Write(r.Format, document, buffer, output.FormatterOptions{})
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(r.FilePath, buffer.Bytes(), 0644)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to write environment definition to %q.", r.FilePath)
+	}
+
+	r.Output.LogInfo("Exported environment %q to %q.", r.EnvironmentName, r.FilePath)
+
+	return nil
+}
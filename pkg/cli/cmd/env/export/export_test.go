@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/env/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Export Command with default environment",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, radcli.TestEnvironmentName, runner.EnvironmentName)
+				require.Equal(t, "yaml", runner.Format)
+			},
+		},
+		{
+			Name:          "Export Command with environment, format and file",
+			Input:         []string{"my-env", "--output", "json", "--file", "my-env.json"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "my-env", runner.EnvironmentName)
+				require.Equal(t, "json", runner.Format)
+				require.Equal(t, "my-env.json", runner.FilePath)
+			},
+		},
+		{
+			Name:          "Export Command with unsupported format",
+			Input:         []string{"my-env", "--output", "table"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Export Command with too many args",
+			Input:         []string{"my-env", "other-env"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	environment := corerp.EnvironmentResource{
+		Name: to.Ptr("test-env"),
+		Properties: &corerp.EnvironmentProperties{
+			Compute: &corerp.KubernetesCompute{
+				Kind:      to.Ptr("kubernetes"),
+				Namespace: to.Ptr("test-ns"),
+			},
+			ProvisioningState: to.Ptr(corerp.ProvisioningStateSucceeded),
+		},
+	}
+
+	t.Run("Export to output", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "test-env").
+			Return(environment, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			Output:            outputSink,
+			EnvironmentName:   "test-env",
+			Format:            "yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "yaml",
+				Obj:     common.NewEnvironmentDocument(environment),
+				Options: output.FormatterOptions{},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Export to file", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "test-env").
+			Return(environment, nil).
+			Times(1)
+
+		filePath := filepath.Join(t.TempDir(), "test-env.yaml")
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			Output:            outputSink,
+			EnvironmentName:   "test-env",
+			Format:            "yaml",
+			FilePath:          filePath,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		b, err := os.ReadFile(filePath)
+		require.NoError(t, err)
+
+		expectedFile := `kind: Environment
+name: test-env
+properties:
+  compute:
+    kind: kubernetes
+    namespace: test-ns
+version: v1
+`
+		require.Equal(t, expectedFile, string(b))
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Exported environment %q to %q.",
+				Params: []any{"test-env", filePath},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+
+		// The exported file can be imported.
+		document, err := common.ReadEnvironmentDocument(filePath)
+		require.NoError(t, err)
+		require.Equal(t, common.NewEnvironmentDocument(environment), document)
+	})
+
+	t.Run("Environment not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "test-env").
+			Return(corerp.EnvironmentResource{}, radcli.Create404Error()).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			Output:            &output.MockOutput{},
+			EnvironmentName:   "test-env",
+			Format:            "yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The environment %q was not found or has been deleted.", "test-env"), err)
+	})
+}
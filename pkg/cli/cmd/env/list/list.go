@@ -26,6 +26,8 @@ import (
 	"github.com/radius-project/radius/pkg/cli/objectformats"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/spf13/cobra"
 )
 
@@ -45,6 +47,9 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 		Example: `
 # List environments
 rad env list
+
+# List environments, including soft deleted environments that have not been purged yet
+rad env list --include-deleted
 `,
 		RunE: framework.RunCommand(runner),
 	}
@@ -52,6 +57,7 @@ rad env list
 	commonflags.AddWorkspaceFlag(cmd)
 	commonflags.AddResourceGroupFlag(cmd)
 	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().Bool("include-deleted", false, "Include soft deleted environments that have not been purged yet")
 
 	return cmd, runner
 }
@@ -63,7 +69,8 @@ type Runner struct {
 	Workspace         *workspaces.Workspace
 	Output            output.Interface
 
-	Format string
+	Format         string
+	IncludeDeleted bool
 }
 
 // NewRunner creates a new instance of the `rad env list` runner.
@@ -101,6 +108,11 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 
 	r.Format = format
 
+	r.IncludeDeleted, err = cmd.Flags().GetBool("include-deleted")
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -115,10 +127,23 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
-	environments, err := client.ListEnvironmentsInResourceGroup(ctx)
+	options := &v20231001preview.EnvironmentsClientListByScopeOptions{}
+	if r.IncludeDeleted {
+		options.IncludeDeleted = to.Ptr(true)
+	}
+
+	environments, err := client.ListEnvironmentsInResourceGroup(ctx, options)
 	if err != nil {
 		return err
 	}
 
-	return r.Output.WriteFormatted(r.Format, environments, objectformats.GetResourceTableFormat())
+	formatterOptions := objectformats.GetResourceTableFormat()
+	if r.IncludeDeleted {
+		formatterOptions.Columns = append(formatterOptions.Columns, output.Column{
+			Heading:  "DELETED",
+			JSONPath: "{ .Properties.DeletedTime }",
+		})
+	}
+
+	return r.Output.WriteFormatted(r.Format, environments, formatterOptions)
 }
@@ -97,7 +97,7 @@ func Test_Run(t *testing.T) {
 
 	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
 	appManagementClient.EXPECT().
-		ListEnvironmentsInResourceGroup(gomock.Any()).
+		ListEnvironmentsInResourceGroup(gomock.Any(), &v20231001preview.EnvironmentsClientListByScopeOptions{}).
 		Return(environments, nil).
 		Times(1)
 
@@ -130,3 +130,37 @@ func Test_Run(t *testing.T) {
 
 	require.Equal(t, expected, outputSink.Writes)
 }
+
+func Test_Run_IncludeDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	environments := []v20231001preview.EnvironmentResource{
+		{
+			Name: to.Ptr("A"),
+		},
+	}
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		ListEnvironmentsInResourceGroup(gomock.Any(), &v20231001preview.EnvironmentsClientListByScopeOptions{IncludeDeleted: to.Ptr(true)}).
+		Return(environments, nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Workspace:         &workspaces.Workspace{},
+		Format:            "table",
+		Output:            outputSink,
+		IncludeDeleted:    true,
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, outputSink.Writes, 1)
+	formatted := outputSink.Writes[0].(output.FormattedOutput)
+	require.Equal(t, environments, formatted.Obj)
+	require.Equal(t, "DELETED", formatted.Options.Columns[len(formatted.Options.Columns)-1].Heading)
+}
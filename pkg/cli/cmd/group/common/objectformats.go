@@ -33,3 +33,24 @@ func ResourceGroupFormat() output.FormatterOptions {
 		},
 	}
 }
+
+// DeletedResourceGroupFormat returns a FormatterOptions object like ResourceGroupFormat with an additional column for the
+// time at which a soft deleted resource group was deleted.
+func DeletedResourceGroupFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "GROUP",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "ID",
+				JSONPath: "{ .ID }",
+			},
+			{
+				Heading:  "DELETED",
+				JSONPath: "{ .Properties.DeletedTime }",
+			},
+		},
+	}
+}
@@ -19,6 +19,7 @@ package common
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/to"
@@ -39,3 +40,20 @@ func Test_ResourceGroupFormat(t *testing.T) {
 	expected := "GROUP     ID\ntest      /planes/radius/local/resourceGroups/test-group\n"
 	require.Equal(t, expected, buffer.String())
 }
+
+func Test_DeletedResourceGroupFormat(t *testing.T) {
+	obj := ucpv20231001preview.ResourceGroupResource{
+		Name: to.Ptr("test"),
+		ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group"),
+		Properties: &ucpv20231001preview.ResourceGroupProperties{
+			DeletedTime: to.Ptr(time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)),
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, DeletedResourceGroupFormat())
+	require.NoError(t, err)
+
+	expected := "GROUP     ID                                              DELETED\ntest      /planes/radius/local/resourceGroups/test-group  2023-10-01 12:00:00 +0000 UTC\n"
+	require.Equal(t, expected, buffer.String())
+}
@@ -21,6 +21,7 @@ import (
 	group_delete "github.com/radius-project/radius/pkg/cli/cmd/group/delete"
 	group_switch "github.com/radius-project/radius/pkg/cli/cmd/group/groupswitch"
 	group_list "github.com/radius-project/radius/pkg/cli/cmd/group/list"
+	group_restore "github.com/radius-project/radius/pkg/cli/cmd/group/restore"
 	group_show "github.com/radius-project/radius/pkg/cli/cmd/group/show"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/spf13/cobra"
@@ -30,7 +31,7 @@ import (
 //
 
 // NewCommand creates a new cobra command for managing resource groups, with subcommands for creating, deleting, listing,
-// showing, and switching resource groups.
+// restoring, showing, and switching resource groups.
 func NewCommand(factory framework.Factory) *cobra.Command {
 	// This command is not runnable, and thus has no runner.
 	cmd := &cobra.Command{
@@ -54,6 +55,9 @@ rad group create prod -w localWorkspace
 # Delete resource group in default workspace
 rad group delete prod
 
+# Restore soft deleted resource group in default workspace
+rad group restore prod
+
 # Show details of resource group in default workspace
 rad group show dev
 `,
@@ -68,6 +72,9 @@ rad group show dev
 	list, _ := group_list.NewCommand(factory)
 	cmd.AddCommand(list)
 
+	restore, _ := group_restore.NewCommand(factory)
+	cmd.AddCommand(restore)
+
 	show, _ := group_show.NewCommand(factory)
 	cmd.AddCommand(show)
 
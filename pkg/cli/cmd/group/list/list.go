@@ -26,6 +26,8 @@ import (
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/spf13/cobra"
 )
 
@@ -47,13 +49,19 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	A Radius Application and its resources can span one or more resource groups, and do not have to be in the same resource group as the Radius Environment into which it's being deployed into.
 			
 	Note that these resource groups are separate from the Azure cloud provider and Azure resource groups configured with the cloud provider.`,
-		Example: `rad group list`,
-		Args:    cobra.ExactArgs(0),
-		RunE:    framework.RunCommand(runner),
+		Example: `
+# List resource groups
+rad group list
+
+# List resource groups, including soft deleted resource groups that have not been purged yet
+rad group list --include-deleted`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
 	}
 
 	commonflags.AddWorkspaceFlag(cmd)
 	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().Bool("include-deleted", false, "Include soft deleted resource groups that have not been purged yet")
 
 	return cmd, runner
 }
@@ -68,6 +76,7 @@ type Runner struct {
 	ResourceType         string
 	ResourceName         string
 	Format               string
+	IncludeDeleted       bool
 }
 
 // NewRunner creates a new instance of the `rad group list` runner.
@@ -100,6 +109,11 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	r.Format = format
 	r.Workspace = workspace
 
+	r.IncludeDeleted, err = cmd.Flags().GetBool("include-deleted")
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -114,10 +128,19 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
-	resourceGroupDetails, err := client.ListUCPGroup(ctx, "radius", "local")
+	options := &v20231001preview.ResourceGroupsClientListOptions{}
+	if r.IncludeDeleted {
+		options.IncludeDeleted = to.Ptr(true)
+	}
+
+	resourceGroupDetails, err := client.ListUCPGroup(ctx, "radius", "local", options)
 	if err != nil {
 		return err
 	}
 
+	if r.IncludeDeleted {
+		return r.Output.WriteFormatted(r.Format, resourceGroupDetails, common.DeletedResourceGroupFormat())
+	}
+
 	return r.Output.WriteFormatted(r.Format, resourceGroupDetails, common.ResourceGroupFormat())
 }
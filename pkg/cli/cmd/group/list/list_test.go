@@ -94,7 +94,7 @@ func Test_Run(t *testing.T) {
 		}
 
 		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
-		appManagementClient.EXPECT().ListUCPGroup(gomock.Any(), gomock.Any(), gomock.Any()).Return(resourceGroups, nil).Times(1)
+		appManagementClient.EXPECT().ListUCPGroup(gomock.Any(), gomock.Any(), gomock.Any(), &v20231001preview.ResourceGroupsClientListOptions{}).Return(resourceGroups, nil).Times(1)
 
 		workspace := &workspaces.Workspace{
 			Connection: map[string]any{
@@ -138,4 +138,40 @@ func Test_Run(t *testing.T) {
 		require.Equal(t, expected, outputSink.Writes)
 	})
 
+	t.Run("Validate rad group list --include-deleted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		resourceGroups := []v20231001preview.ResourceGroupResource{
+			radcli.CreateResourceGroup("rg1"),
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListUCPGroup(gomock.Any(), "radius", "local", &v20231001preview.ResourceGroupsClientListOptions{IncludeDeleted: to.Ptr(true)}).
+			Return(resourceGroups, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			Format:            "table",
+			Output:            outputSink,
+			IncludeDeleted:    true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     resourceGroups,
+				Options: common.DeletedResourceGroupFormat(),
+			},
+		}
+
+		require.Equal(t, expected, outputSink.Writes)
+	})
 }
@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/group/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad group restore` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "restore resourcegroupname",
+		Short: "Restore a soft deleted resource group",
+		Long: `Restore a soft deleted resource group
+
+When soft delete is enabled, deleting a resource group marks it and the resources it contains as deleted instead of removing them. The resource group can be restored until the retention period expires and it is purged.
+
+Restoring a resource group also restores the resources that were deleted along with it.
+`,
+		Example: `
+# Restore the soft deleted resource group 'prod'
+rad group restore prod
+
+# List soft deleted resource groups that can be restored
+rad group list --include-deleted`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad group restore` command.
+type Runner struct {
+	ConfigHolder         *framework.ConfigHolder
+	ConnectionFactory    connections.Factory
+	Output               output.Interface
+	Workspace            *workspaces.Workspace
+	UCPResourceGroupName string
+	Format               string
+}
+
+// NewRunner creates a new instance of the `rad group restore` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad group restore` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	r.UCPResourceGroupName, err = cli.RequireUCPResourceGroup(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	if format == "" {
+		format = output.FormatTable
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad group restore` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := client.RestoreUCPGroup(ctx, "radius", "local", r.UCPResourceGroupName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The resource group %q does not exist or has already been purged.", r.UCPResourceGroupName)
+	} else if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Resource group %s restored", r.UCPResourceGroupName)
+
+	return r.Output.WriteFormatted(r.Format, resourceGroup, common.ResourceGroupFormat())
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/group/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Restore Command with incorrect args",
+			Input:         []string{""},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Restore Command with correct options",
+			Input:         []string{"groupname"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Restore Command with too many args",
+			Input:         []string{"groupname", "othergroup"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		resourceGroup := v20231001preview.ResourceGroupResource{
+			ID:   to.Ptr("/planes/radius/local/resourceGroups/testrg"),
+			Name: to.Ptr("testrg"),
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			RestoreUCPGroup(gomock.Any(), "radius", "local", "testrg").
+			Return(resourceGroup, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory:    &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:            &workspaces.Workspace{},
+			UCPResourceGroupName: "testrg",
+			Format:               "table",
+			Output:               outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Resource group %s restored",
+				Params: []any{"testrg"},
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     resourceGroup,
+				Options: common.ResourceGroupFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			RestoreUCPGroup(gomock.Any(), "radius", "local", "testrg").
+			Return(v20231001preview.ResourceGroupResource{}, radcli.Create404Error()).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory:    &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:            &workspaces.Workspace{},
+			UCPResourceGroupName: "testrg",
+			Format:               "table",
+			Output:               &output.MockOutput{},
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The resource group %q does not exist or has already been purged.", "testrg"), err)
+	})
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/spf13/cobra"
+)
+
+const (
+	planeScope = "/planes/radius/local"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad lock create` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "create lockname",
+		Short: "Create or update a resource lock",
+		Long: `Create or update a resource lock.
+
+By default the lock applies to the resource group of the current workspace. Use --group to lock another resource group, --resource to lock a single resource in the resource group such as an environment or application, or --plane to lock every resource in the plane.
+
+A CanNotDelete lock prevents the locked resources from being deleted. A ReadOnly lock prevents them from being updated or deleted.`,
+		Example: `
+# Prevent the resources in the current resource group from being deleted
+rad lock create protect-rg --level CanNotDelete
+
+# Prevent the resources in the 'prod' resource group from being changed
+rad lock create protect-prod --level ReadOnly --group prod --notes "Change freeze"
+
+# Prevent the 'prod' environment and its resources from being deleted
+rad lock create protect-env --level CanNotDelete --resource Applications.Core/environments/prod
+
+# Prevent every resource in the plane from being deleted
+rad lock create protect-all --level CanNotDelete --plane`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	cmd.Flags().String("level", "", fmt.Sprintf("The level of the lock, one of %q", v20231001preview.PossibleLockLevelValues()))
+	_ = cmd.MarkFlagRequired("level")
+	cmd.Flags().String("resource", "", "The type and name of the resource to lock, for example Applications.Core/environments/prod")
+	cmd.Flags().Bool("plane", false, "Lock every resource in the plane")
+	cmd.Flags().String("notes", "", "Notes about why the lock was created")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad lock create` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	LockName          string
+	Level             v20231001preview.LockLevel
+	Scope             string
+	Notes             string
+}
+
+// NewRunner creates a new instance of the `rad lock create` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad lock create` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+	r.LockName = args[0]
+
+	level, err := cmd.Flags().GetString("level")
+	if err != nil {
+		return err
+	}
+	r.Level, err = requireLockLevel(level)
+	if err != nil {
+		return err
+	}
+
+	r.Notes, err = cmd.Flags().GetString("notes")
+	if err != nil {
+		return err
+	}
+
+	plane, err := cmd.Flags().GetBool("plane")
+	if err != nil {
+		return err
+	}
+	group, err := cmd.Flags().GetString("group")
+	if err != nil {
+		return err
+	}
+	resource, err := cmd.Flags().GetString("resource")
+	if err != nil {
+		return err
+	}
+
+	if plane {
+		if group != "" || resource != "" {
+			return clierrors.Message("The --plane flag cannot be used together with the --group or --resource flags.")
+		}
+		r.Scope = planeScope
+		return nil
+	}
+
+	r.Scope, err = cli.RequireScope(cmd, *workspace)
+	if err != nil {
+		return err
+	}
+
+	if resource != "" {
+		r.Scope, err = resourceScope(r.Scope, resource)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Run runs the `rad lock create` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	lock := v20231001preview.LockResource{
+		Properties: &v20231001preview.LockProperties{
+			Level: to.Ptr(r.Level),
+			Scope: to.Ptr(r.Scope),
+		},
+	}
+	if r.Notes != "" {
+		lock.Properties.Notes = to.Ptr(r.Notes)
+	}
+
+	err = client.CreateOrUpdateLock(ctx, "radius", "local", r.LockName, lock)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Lock %q with level %s created on %s.", r.LockName, r.Level, r.Scope)
+	return nil
+}
+
+// requireLockLevel returns the lock level matching the value, ignoring case.
+func requireLockLevel(value string) (v20231001preview.LockLevel, error) {
+	for _, level := range v20231001preview.PossibleLockLevelValues() {
+		if strings.EqualFold(string(level), value) {
+			return level, nil
+		}
+	}
+
+	return "", clierrors.Message("The lock level %q is invalid. Valid levels are %q.", value, v20231001preview.PossibleLockLevelValues())
+}
+
+// resourceScope returns the ID of the resource with the given type and name in the resource group scope.
+func resourceScope(scope string, resource string) (string, error) {
+	index := strings.LastIndex(resource, "/")
+	if index <= 0 || index == len(resource)-1 || !strings.Contains(resource[:index], "/") {
+		return "", clierrors.Message("The resource %q is invalid. Use the format <resource type>/<resource name>, for example Applications.Core/environments/prod.", resource)
+	}
+
+	id, err := resources.Parse(scope + "/providers/" + resource)
+	if err != nil {
+		return "", clierrors.Message("The resource %q is invalid. Use the format <resource type>/<resource name>, for example Applications.Core/environments/prod.", resource)
+	}
+
+	return id.String(), nil
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Create Command on the workspace resource group",
+			Input:         []string{"protect-rg", "--level", "CanNotDelete"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "protect-rg", r.LockName)
+				require.Equal(t, v20231001preview.LockLevelCanNotDelete, r.Level)
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-resource-group", r.Scope)
+			},
+		},
+		{
+			Name:          "Create Command on a resource in another group",
+			Input:         []string{"protect-env", "--level", "readonly", "--group", "prod", "--resource", "Applications.Core/environments/prod", "--notes", "Change freeze"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, v20231001preview.LockLevelReadOnly, r.Level)
+				require.Equal(t, "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/prod", r.Scope)
+				require.Equal(t, "Change freeze", r.Notes)
+			},
+		},
+		{
+			Name:          "Create Command on the plane",
+			Input:         []string{"protect-all", "--level", "CanNotDelete", "--plane"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "/planes/radius/local", runner.(*Runner).Scope)
+			},
+		},
+		{
+			Name:          "Create Command with plane and resource",
+			Input:         []string{"protect-all", "--level", "CanNotDelete", "--plane", "--resource", "Applications.Core/environments/prod"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create Command with invalid level",
+			Input:         []string{"protect-rg", "--level", "NoAccess"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create Command with invalid resource",
+			Input:         []string{"protect-env", "--level", "CanNotDelete", "--resource", "prod"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create Command without level",
+			Input:         []string{"protect-rg"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create Command without name",
+			Input:         []string{"--level", "CanNotDelete"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	expectedLock := v20231001preview.LockResource{
+		Properties: &v20231001preview.LockProperties{
+			Level: to.Ptr(v20231001preview.LockLevelReadOnly),
+			Scope: to.Ptr("/planes/radius/local/resourceGroups/prod"),
+			Notes: to.Ptr("Change freeze"),
+		},
+	}
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		CreateOrUpdateLock(gomock.Any(), "radius", "local", "protect-prod", expectedLock).
+		Return(nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Workspace:         &workspaces.Workspace{},
+		Output:            outputSink,
+		LockName:          "protect-prod",
+		Level:             v20231001preview.LockLevelReadOnly,
+		Scope:             "/planes/radius/local/resourceGroups/prod",
+		Notes:             "Change freeze",
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	expected := []any{
+		output.LogOutput{
+			Format: "Lock %q with level %s created on %s.",
+			Params: []any{"protect-prod", v20231001preview.LockLevelReadOnly, "/planes/radius/local/resourceGroups/prod"},
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/lock/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad lock list` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List resource locks",
+		Long: `List resource locks.
+
+By default the locks that apply to the resource group of the current workspace are listed. This includes locks on the plane, on the resource group itself and on resources in the resource group. Use --group to list the locks of another resource group, or --all to list every lock in the plane.`,
+		Example: `
+# List the locks of the current resource group
+rad lock list
+
+# List the locks of the 'prod' resource group
+rad lock list --group prod
+
+# List every lock in the plane
+rad lock list --all`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().Bool("all", false, "List every lock in the plane")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad lock list` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	Scope             string
+	Format            string
+}
+
+// NewRunner creates a new instance of the `rad lock list` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad lock list` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	all, err := cmd.Flags().GetBool("all")
+	if err != nil {
+		return err
+	}
+
+	if all {
+		group, err := cmd.Flags().GetString("group")
+		if err != nil {
+			return err
+		}
+		if group != "" {
+			return clierrors.Message("The --all and --group flags cannot be used together.")
+		}
+	} else {
+		r.Scope, err = cli.RequireScope(cmd, *workspace)
+		if err != nil {
+			return err
+		}
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	if format == "" {
+		format = output.FormatTable
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad lock list` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	locks, err := client.ListLocks(ctx, "radius", "local")
+	if err != nil {
+		return err
+	}
+
+	if r.Scope != "" {
+		filtered := []v20231001preview.LockResource{}
+		for _, lock := range locks {
+			if lock.Properties != nil && overlaps(to.String(lock.Properties.Scope), r.Scope) {
+				filtered = append(filtered, lock)
+			}
+		}
+		locks = filtered
+	}
+
+	return r.Output.WriteFormatted(r.Format, locks, common.LockFormat())
+}
+
+// overlaps returns true if the lock scope contains the scope or is contained in it.
+func overlaps(lockScope string, scope string) bool {
+	lockScope = strings.ToLower(strings.TrimSuffix(lockScope, "/"))
+	scope = strings.ToLower(strings.TrimSuffix(scope, "/"))
+	if lockScope == "" {
+		return false
+	}
+
+	return lockScope == scope || strings.HasPrefix(scope, lockScope+"/") || strings.HasPrefix(lockScope, scope+"/")
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lock
+
+import (
+	lock_create "github.com/radius-project/radius/pkg/cli/cmd/lock/create"
+	lock_delete "github.com/radius-project/radius/pkg/cli/cmd/lock/delete"
+	lock_list "github.com/radius-project/radius/pkg/cli/cmd/lock/list"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new cobra command for managing resource locks, with subcommands for creating, listing and
+// deleting locks.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "lock",
+		Short: "Manage resource locks",
+		Long: `Manage resource locks
+
+Locks protect a plane, resource group, environment, application or resource, and everything inside it, from accidental changes.
+A CanNotDelete lock prevents deletion. A ReadOnly lock prevents both updates and deletion. Remove the lock to make changes again.
+`,
+		Example: `
+# Prevent the resources in the current resource group from being deleted
+rad lock create protect-rg --level CanNotDelete
+
+# Prevent the 'prod' environment and its resources from being changed
+rad lock create protect-prod --level ReadOnly --resource Applications.Core/environments/prod
+
+# List the locks of the current resource group
+rad lock list
+
+# Delete a lock
+rad lock delete protect-rg
+`,
+	}
+
+	create, _ := lock_create.NewCommand(factory)
+	cmd.AddCommand(create)
+
+	list, _ := lock_list.NewCommand(factory)
+	cmd.AddCommand(list)
+
+	delete, _ := lock_delete.NewCommand(factory)
+	cmd.AddCommand(delete)
+
+	return cmd
+}
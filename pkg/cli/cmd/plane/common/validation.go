@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+var planeSegment = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-_.]*$`)
+
+// RequirePlaneTypeAndName validates the plane type and plane name arguments and returns them.
+func RequirePlaneTypeAndName(args []string) (string, string, error) {
+	if len(args) < 2 {
+		return "", "", clierrors.Message("The plane type and plane name are required, for example: rad plane show radius local.")
+	}
+
+	planeType, planeName := args[0], args[1]
+	if !planeSegment.MatchString(planeType) {
+		return "", "", clierrors.Message("The plane type %q is invalid. Plane types can only contain alphanumerics, hyphens, underscores and periods.", planeType)
+	}
+	if !planeSegment.MatchString(planeName) {
+		return "", "", clierrors.Message("The plane name %q is invalid. Plane names can only contain alphanumerics, hyphens, underscores and periods.", planeName)
+	}
+
+	return planeType, planeName, nil
+}
+
+// DefaultPlaneKind returns the plane kind used for a plane type when the kind is not specified: the aws and azure plane
+// types use the AWS and Azure kinds, and every other plane type is a UCP native plane.
+func DefaultPlaneKind(planeType string) v20231001preview.PlaneKind {
+	switch strings.ToLower(planeType) {
+	case "aws":
+		return v20231001preview.PlaneKindAWS
+	case "azure":
+		return v20231001preview.PlaneKindAzure
+	default:
+		return v20231001preview.PlaneKindUCPNative
+	}
+}
+
+// ParsePlaneKind parses the plane kind, ignoring case, and returns an error if the kind is not supported.
+func ParsePlaneKind(kind string) (v20231001preview.PlaneKind, error) {
+	supported := []string{}
+	for _, k := range v20231001preview.PossiblePlaneKindValues() {
+		if strings.EqualFold(string(k), kind) {
+			return k, nil
+		}
+		supported = append(supported, string(k))
+	}
+
+	return "", clierrors.Message("The plane kind %q is not supported. Supported kinds are: %s.", kind, strings.Join(supported, ", "))
+}
+
+// ParseResourceProviders parses resource provider mappings in the form <namespace>=<url>, for example
+// Applications.Core=http://applications-rp.radius-system:5443.
+func ParseResourceProviders(values []string) (map[string]*string, error) {
+	result := map[string]*string{}
+	for _, value := range values {
+		namespace, url, found := strings.Cut(value, "=")
+		if !found || strings.TrimSpace(namespace) == "" || strings.TrimSpace(url) == "" {
+			return nil, clierrors.Message("The resource provider %q is invalid. Resource providers must be specified as <namespace>=<url>.", value)
+		}
+
+		result[strings.TrimSpace(namespace)] = to.Ptr(strings.TrimSpace(url))
+	}
+
+	return result, nil
+}
+
+// ValidatePlaneProperties validates the plane properties using the same rules as the server: UCP native planes require
+// at least one resource provider and Azure planes require a URL.
+func ValidatePlaneProperties(properties *v20231001preview.PlaneResourceProperties) error {
+	switch *properties.Kind {
+	case v20231001preview.PlaneKindUCPNative:
+		if len(properties.ResourceProviders) == 0 {
+			return clierrors.Message("Planes of kind %s require at least one resource provider. Use --resource-provider to specify one.", *properties.Kind)
+		}
+	case v20231001preview.PlaneKindAzure:
+		if properties.URL == nil || *properties.URL == "" {
+			return clierrors.Message("Planes of kind %s require a URL. Use --url to specify one.", *properties.Kind)
+		}
+	}
+
+	return nil
+}
+
+// SortedResourceProviders returns the namespaces of the resource providers in sorted order.
+func SortedResourceProviders(resourceProviders map[string]*string) []string {
+	namespaces := []string{}
+	for namespace := range resourceProviders {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
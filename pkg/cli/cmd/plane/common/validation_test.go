@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RequirePlaneTypeAndName(t *testing.T) {
+	planeType, planeName, err := RequirePlaneTypeAndName([]string{"radius", "local"})
+	require.NoError(t, err)
+	require.Equal(t, "radius", planeType)
+	require.Equal(t, "local", planeName)
+
+	_, _, err = RequirePlaneTypeAndName([]string{"radius"})
+	require.Error(t, err)
+
+	_, _, err = RequirePlaneTypeAndName([]string{"radius", "lo/cal"})
+	require.Error(t, err)
+}
+
+func Test_DefaultPlaneKind(t *testing.T) {
+	require.Equal(t, v20231001preview.PlaneKindAWS, DefaultPlaneKind("aws"))
+	require.Equal(t, v20231001preview.PlaneKindAzure, DefaultPlaneKind("Azure"))
+	require.Equal(t, v20231001preview.PlaneKindUCPNative, DefaultPlaneKind("radius"))
+	require.Equal(t, v20231001preview.PlaneKindUCPNative, DefaultPlaneKind("custom"))
+}
+
+func Test_ParsePlaneKind(t *testing.T) {
+	kind, err := ParsePlaneKind("ucpnative")
+	require.NoError(t, err)
+	require.Equal(t, v20231001preview.PlaneKindUCPNative, kind)
+
+	_, err = ParsePlaneKind("gcp")
+	require.Error(t, err)
+}
+
+func Test_ParseResourceProviders(t *testing.T) {
+	providers, err := ParseResourceProviders([]string{"Applications.Core=http://localhost:8080", "Applications.Dapr = http://localhost:8081"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]*string{
+		"Applications.Core": to.Ptr("http://localhost:8080"),
+		"Applications.Dapr": to.Ptr("http://localhost:8081"),
+	}, providers)
+	require.Equal(t, []string{"Applications.Core", "Applications.Dapr"}, SortedResourceProviders(providers))
+
+	for _, value := range []string{"Applications.Core", "=http://localhost:8080", "Applications.Core="} {
+		_, err = ParseResourceProviders([]string{value})
+		require.Error(t, err, value)
+	}
+}
+
+func Test_ValidatePlaneProperties(t *testing.T) {
+	err := ValidatePlaneProperties(&v20231001preview.PlaneResourceProperties{Kind: to.Ptr(v20231001preview.PlaneKindUCPNative)})
+	require.Error(t, err)
+
+	err = ValidatePlaneProperties(&v20231001preview.PlaneResourceProperties{
+		Kind:              to.Ptr(v20231001preview.PlaneKindUCPNative),
+		ResourceProviders: map[string]*string{"Applications.Core": to.Ptr("http://localhost:8080")},
+	})
+	require.NoError(t, err)
+
+	err = ValidatePlaneProperties(&v20231001preview.PlaneResourceProperties{Kind: to.Ptr(v20231001preview.PlaneKindAzure)})
+	require.Error(t, err)
+
+	err = ValidatePlaneProperties(&v20231001preview.PlaneResourceProperties{Kind: to.Ptr(v20231001preview.PlaneKindAzure), URL: to.Ptr("https://management.azure.com")})
+	require.NoError(t, err)
+
+	err = ValidatePlaneProperties(&v20231001preview.PlaneResourceProperties{Kind: to.Ptr(v20231001preview.PlaneKindAWS)})
+	require.NoError(t, err)
+}
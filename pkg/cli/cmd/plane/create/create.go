@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/plane/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad plane create` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "create planetype planename",
+		Short: "Create or update a UCP plane",
+		Long: `Create or update a UCP plane.
+
+The kind of the plane defaults to AWS for the aws plane type, Azure for the azure plane type and UCPNative for every other plane type.
+
+Planes of kind UCPNative route requests to resource providers. Specify one or more resource providers with '--resource-provider <namespace>=<url>'.
+Planes of kind Azure route requests to the URL specified with '--url'.`,
+		Example: `
+# Create a radius plane with a resource provider for Applications.Core
+rad plane create radius custom --resource-provider Applications.Core=http://applications-rp.radius-system:5443
+
+# Create a custom plane type with multiple resource providers
+rad plane create mycloud local --resource-provider MyCompany.Storage=http://storage-rp:8080 --resource-provider MyCompany.Compute=http://compute-rp:8080
+
+# Create an Azure plane
+rad plane create azure azurecloud --url https://management.azure.com
+
+# Create an AWS plane
+rad plane create aws aws`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().String("kind", "", "The kind of the plane: UCPNative, Azure or AWS. Defaults to a kind based on the plane type.")
+	cmd.Flags().String("url", "", "The URL used to route requests for planes of kind Azure.")
+	cmd.Flags().StringArray("resource-provider", []string{}, "A resource provider mapping in the form <namespace>=<url> for planes of kind UCPNative. Can be specified multiple times.")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad plane create` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	PlaneType         string
+	PlaneName         string
+	Properties        *ucp.PlaneResourceProperties
+}
+
+// NewRunner creates a new instance of the `rad plane create` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad plane create` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+
+	planeType, planeName, err := common.RequirePlaneTypeAndName(args)
+	if err != nil {
+		return err
+	}
+
+	kindFlag, err := cmd.Flags().GetString("kind")
+	if err != nil {
+		return err
+	}
+
+	kind := common.DefaultPlaneKind(planeType)
+	if kindFlag != "" {
+		kind, err = common.ParsePlaneKind(kindFlag)
+		if err != nil {
+			return err
+		}
+	}
+
+	url, err := cmd.Flags().GetString("url")
+	if err != nil {
+		return err
+	}
+
+	resourceProviderFlags, err := cmd.Flags().GetStringArray("resource-provider")
+	if err != nil {
+		return err
+	}
+
+	resourceProviders, err := common.ParseResourceProviders(resourceProviderFlags)
+	if err != nil {
+		return err
+	}
+
+	properties := &ucp.PlaneResourceProperties{
+		Kind: to.Ptr(kind),
+	}
+	if url != "" {
+		properties.URL = to.Ptr(url)
+	}
+	if len(resourceProviders) > 0 {
+		properties.ResourceProviders = resourceProviders
+	}
+
+	err = common.ValidatePlaneProperties(properties)
+	if err != nil {
+		return err
+	}
+
+	r.Workspace = workspace
+	r.PlaneType = planeType
+	r.PlaneName = planeName
+	r.Properties = properties
+
+	return nil
+}
+
+// Run runs the `rad plane create` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Creating plane %q of type %q with kind %s...", r.PlaneName, r.PlaneType, *r.Properties.Kind)
+	for _, namespace := range common.SortedResourceProviders(r.Properties.ResourceProviders) {
+		r.Output.LogInfo("  %s -> %s", namespace, *r.Properties.ResourceProviders[namespace])
+	}
+
+	plane := ucp.PlaneResource{
+		Location:   to.Ptr(v1.LocationGlobal),
+		Properties: r.Properties,
+	}
+	err = client.CreateUCPPlane(ctx, r.PlaneType, r.PlaneName, plane)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Plane %q of type %q created.", r.PlaneName, r.PlaneType)
+	return nil
+}
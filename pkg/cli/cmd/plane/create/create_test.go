@@ -0,0 +1,200 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Create radius plane with resource providers",
+			Input:         []string{"radius", "custom", "--resource-provider", "Applications.Core=http://localhost:8080", "--resource-provider", "Applications.Dapr=http://localhost:8081"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "radius", r.PlaneType)
+				require.Equal(t, "custom", r.PlaneName)
+				require.Equal(t, &ucp.PlaneResourceProperties{
+					Kind: to.Ptr(ucp.PlaneKindUCPNative),
+					ResourceProviders: map[string]*string{
+						"Applications.Core": to.Ptr("http://localhost:8080"),
+						"Applications.Dapr": to.Ptr("http://localhost:8081"),
+					},
+				}, r.Properties)
+			},
+		},
+		{
+			Name:          "Create radius plane without resource providers",
+			Input:         []string{"radius", "custom"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create plane with invalid resource provider",
+			Input:         []string{"radius", "custom", "--resource-provider", "Applications.Core"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create aws plane",
+			Input:         []string{"aws", "aws"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, &ucp.PlaneResourceProperties{Kind: to.Ptr(ucp.PlaneKindAWS)}, runner.(*Runner).Properties)
+			},
+		},
+		{
+			Name:          "Create azure plane with url",
+			Input:         []string{"azure", "azurecloud", "--url", "https://management.azure.com"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, &ucp.PlaneResourceProperties{
+					Kind: to.Ptr(ucp.PlaneKindAzure),
+					URL:  to.Ptr("https://management.azure.com"),
+				}, runner.(*Runner).Properties)
+			},
+		},
+		{
+			Name:          "Create azure plane without url",
+			Input:         []string{"azure", "azurecloud"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create custom plane type with explicit kind",
+			Input:         []string{"mycloud", "local", "--kind", "azure", "--url", "http://localhost:9000"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, ucp.PlaneKindAzure, *runner.(*Runner).Properties.Kind)
+			},
+		},
+		{
+			Name:          "Create plane with unsupported kind",
+			Input:         []string{"mycloud", "local", "--kind", "gcp"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create plane with missing plane name",
+			Input:         []string{"aws"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	properties := &ucp.PlaneResourceProperties{
+		Kind: to.Ptr(ucp.PlaneKindUCPNative),
+		ResourceProviders: map[string]*string{
+			"Applications.Dapr": to.Ptr("http://localhost:8081"),
+			"Applications.Core": to.Ptr("http://localhost:8080"),
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		CreateUCPPlane(gomock.Any(), "radius", "custom", ucp.PlaneResource{Location: to.Ptr(v1.LocationGlobal), Properties: properties}).
+		Return(nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Workspace:         &workspaces.Workspace{},
+		PlaneType:         "radius",
+		PlaneName:         "custom",
+		Properties:        properties,
+		Output:            outputSink,
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	expected := []any{
+		output.LogOutput{
+			Format: "Creating plane %q of type %q with kind %s...",
+			Params: []any{"custom", "radius", ucp.PlaneKindUCPNative},
+		},
+		output.LogOutput{
+			Format: "  %s -> %s",
+			Params: []any{"Applications.Core", "http://localhost:8080"},
+		},
+		output.LogOutput{
+			Format: "  %s -> %s",
+			Params: []any{"Applications.Dapr", "http://localhost:8081"},
+		},
+		output.LogOutput{
+			Format: "Plane %q of type %q created.",
+			Params: []any{"custom", "radius"},
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
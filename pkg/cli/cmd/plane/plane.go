@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plane
+
+import (
+	plane_create "github.com/radius-project/radius/pkg/cli/cmd/plane/create"
+	plane_delete "github.com/radius-project/radius/pkg/cli/cmd/plane/delete"
+	plane_list "github.com/radius-project/radius/pkg/cli/cmd/plane/list"
+	plane_show "github.com/radius-project/radius/pkg/cli/cmd/plane/show"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new cobra command for managing UCP planes, with subcommands for creating, deleting, listing
+// and showing planes.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "plane",
+		Short: "Manage UCP planes",
+		Long: `Manage UCP planes
+
+Planes are the top level of the resource hierarchy in the Universal Control Plane (UCP). Each plane has a type and a name, for example the radius/local plane hosts Radius resources, while the aws/aws and azure/azurecloud planes proxy requests to the corresponding cloud providers.
+
+Planes of kind UCPNative route requests to the resource providers configured on the plane. Planes of kind Azure route requests to the configured URL, and planes of kind AWS route requests to AWS.
+`,
+		Example: `
+# List all planes
+rad plane list
+
+# List planes of type radius
+rad plane list --type radius
+
+# Show details of the radius/local plane
+rad plane show radius local
+
+# Create a plane with a custom resource provider
+rad plane create radius custom --resource-provider Applications.Core=http://applications-rp.radius-system:5443
+
+# Delete a plane
+rad plane delete radius custom
+`,
+	}
+
+	create, _ := plane_create.NewCommand(factory)
+	cmd.AddCommand(create)
+
+	delete, _ := plane_delete.NewCommand(factory)
+	cmd.AddCommand(delete)
+
+	list, _ := plane_list.NewCommand(factory)
+	cmd.AddCommand(list)
+
+	show, _ := plane_show.NewCommand(factory)
+	cmd.AddCommand(show)
+
+	return cmd
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
@@ -171,14 +172,7 @@ func (r *Runner) Run(ctx context.Context) error {
 		recipe.PlainHTTP = *recipeDetails.PlainHTTP
 	}
 
-	err = r.Output.WriteFormatted(r.Format, recipe, common.RecipeFormat())
-	if err != nil {
-		return err
-	}
-
-	r.Output.LogInfo("")
-
-	var recipeParams []types.RecipeParameter
+	recipeParams := []types.RecipeParameter{}
 
 	for parameter := range recipeDetails.Parameters {
 		values := recipeDetails.Parameters[parameter].(map[string]any)
@@ -211,6 +205,20 @@ func (r *Runner) Run(ctx context.Context) error {
 		return recipeParams[i].Name > recipeParams[j].Name
 	})
 
+	// The table and json formats write the recipe and its parameters separately, as they always have. The formats
+	// added later write them as a single object, so that one expression or template can refer to both.
+	format := strings.TrimSpace(r.Format)
+	if !strings.EqualFold(format, output.FormatTable) && !strings.EqualFold(format, output.FormatJson) {
+		return r.Output.WriteFormatted(r.Format, types.RecipeDetails{EnvironmentRecipe: recipe, Parameters: recipeParams}, common.RecipeFormat())
+	}
+
+	err = r.Output.WriteFormatted(r.Format, recipe, common.RecipeFormat())
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("")
+
 	err = r.Output.WriteFormatted(r.Format, recipeParams, common.RecipeParametersFormat())
 	if err != nil {
 		return err
@@ -322,4 +322,127 @@ func Test_Run(t *testing.T) {
 		}
 		require.Equal(t, expected, outputSink.Writes)
 	})
+
+	t.Run("Show recipe details as yaml - Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		envRecipe := v20231001preview.RecipeGetMetadataResponse{
+			TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+			TemplatePath: to.Ptr("ghcr.io/testpublicrecipe/bicep/modules/mongodatabases:v1"),
+			Parameters: map[string]any{
+				"sku": map[string]any{
+					"type": "string",
+				},
+			},
+		}
+		recipeDetails := types.RecipeDetails{
+			EnvironmentRecipe: types.EnvironmentRecipe{
+				Name:         "cosmosDB",
+				ResourceType: datastoresrp.MongoDatabasesResourceType,
+				TemplateKind: recipes.TemplateKindBicep,
+				TemplatePath: "ghcr.io/testpublicrecipe/bicep/modules/mongodatabases:v1",
+			},
+			Parameters: []types.RecipeParameter{
+				{
+					Name:         "sku",
+					Type:         "string",
+					MaxValue:     "-",
+					MinValue:     "-",
+					DefaultValue: "-",
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowRecipe(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(envRecipe, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			Format:            "yaml",
+			RecipeName:        "cosmosDB",
+			ResourceType:      datastoresrp.MongoDatabasesResourceType,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "yaml",
+				Obj:     recipeDetails,
+				Options: common.RecipeFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Show recipe details as json - Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		envRecipe := v20231001preview.RecipeGetMetadataResponse{
+			TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+			TemplatePath: to.Ptr("ghcr.io/testpublicrecipe/bicep/modules/mongodatabases:v1"),
+			Parameters: map[string]any{
+				"sku": map[string]any{
+					"type": "string",
+				},
+			},
+		}
+		recipe := types.EnvironmentRecipe{
+			Name:         "cosmosDB",
+			ResourceType: datastoresrp.MongoDatabasesResourceType,
+			TemplateKind: recipes.TemplateKindBicep,
+			TemplatePath: "ghcr.io/testpublicrecipe/bicep/modules/mongodatabases:v1",
+		}
+		recipeParams := []types.RecipeParameter{
+			{
+				Name:         "sku",
+				Type:         "string",
+				MaxValue:     "-",
+				MinValue:     "-",
+				DefaultValue: "-",
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowRecipe(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(envRecipe, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		// The format is matched case-insensitively, the same as when the formatter is created.
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			Format:            "JSON",
+			RecipeName:        "cosmosDB",
+			ResourceType:      datastoresrp.MongoDatabasesResourceType,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "JSON",
+				Obj:     recipe,
+				Options: common.RecipeFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format:  "JSON",
+				Obj:     recipeParams,
+				Options: common.RecipeParametersFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
 }
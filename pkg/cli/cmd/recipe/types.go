@@ -25,6 +25,13 @@ type EnvironmentRecipe struct {
 	PlainHTTP       bool   `json:"plainHTTP"`
 }
 
+// RecipeDetails is a recipe with its parameters, which is written as a single object by rad recipe show with the
+// yaml, jsonpath and go-template formats.
+type RecipeDetails struct {
+	EnvironmentRecipe
+	Parameters []RecipeParameter `json:"parameters"`
+}
+
 type RecipeParameter struct {
 	Name         string      `json:"name,omitempty"`
 	DefaultValue interface{} `json:"defaultValue,omitempty"`
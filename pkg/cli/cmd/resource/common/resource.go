@@ -0,0 +1,235 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/deploy"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// FileFlag is the flag used to specify the file containing the resource definition.
+	FileFlag = "file"
+
+	// DefaultLocation is the location used when the resource definition does not specify one.
+	DefaultLocation = "global"
+)
+
+// writeOnlyProperties are the properties of each resource type that are never returned by the server, keyed by the
+// lowercase resource type. They are lost when a resource is updated from the result of a GET unless they are provided
+// again.
+var writeOnlyProperties = map[string][]string{
+	"applications.core/extenders":            {"secrets"},
+	"applications.core/secretstores":         {"data"},
+	"applications.datastores/mongodatabases": {"secrets"},
+	"applications.datastores/rediscaches":    {"secrets"},
+	"applications.datastores/sqldatabases":   {"secrets"},
+	"applications.messaging/rabbitmqqueues":  {"secrets"},
+}
+
+// WriteOnlyProperties returns the names of the write-only properties of the resource type, eg: "secrets".
+func WriteOnlyProperties(resourceType string) []string {
+	return writeOnlyProperties[strings.ToLower(resourceType)]
+}
+
+// MissingWriteOnlyProperties returns the write-only properties of the resource type that are not set by the changes,
+// eg: "properties.secrets".
+func MissingWriteOnlyProperties(resourceType string, changes generated.GenericResource) []string {
+	missing := []string{}
+	for _, property := range WriteOnlyProperties(resourceType) {
+		if changes.Properties[property] == nil {
+			missing = append(missing, "properties."+property)
+		}
+	}
+	return missing
+}
+
+// AddFileFlag adds a required flag to the given command that allows the user to specify the JSON or YAML file
+// containing the resource definition.
+func AddFileFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP(FileFlag, "f", "", "The JSON or YAML file containing the resource definition")
+	_ = cmd.MarkFlagRequired(FileFlag)
+}
+
+// ReadResourceFile reads the resource definition from a JSON or YAML file. The name and type of the resource are
+// optional in the file, but must match the resource type and name passed on the command line when they are present.
+func ReadResourceFile(filePath string, resourceType string, resourceName string) (generated.GenericResource, error) {
+	b, err := os.ReadFile(filePath)
+	if err != nil {
+		return generated.GenericResource{}, clierrors.MessageWithCause(err, "Failed to read resource file %q.", filePath)
+	}
+
+	// YAML is a superset of JSON, so this handles both formats.
+	b, err = yaml.YAMLToJSON(b)
+	if err != nil {
+		return generated.GenericResource{}, clierrors.MessageWithCause(err, "Failed to parse resource file %q as JSON or YAML.", filePath)
+	}
+
+	resource := generated.GenericResource{}
+	err = json.Unmarshal(b, &resource)
+	if err != nil {
+		return generated.GenericResource{}, clierrors.MessageWithCause(err, "Failed to parse resource file %q.", filePath)
+	}
+
+	if resource.Name != nil && !strings.EqualFold(*resource.Name, resourceName) {
+		return generated.GenericResource{}, clierrors.Message("The resource name %q in file %q does not match the resource name %q.", *resource.Name, filePath, resourceName)
+	}
+
+	if resource.Type != nil && !strings.EqualFold(*resource.Type, resourceType) {
+		return generated.GenericResource{}, clierrors.Message("The resource type %q in file %q does not match the resource type %q.", *resource.Type, filePath, resourceType)
+	}
+
+	// The read-only fields are ignored by the server, so they are cleared to avoid sending stale values.
+	resource.ID = nil
+	resource.Name = nil
+	resource.Type = nil
+	resource.SystemData = nil
+
+	return resource, nil
+}
+
+// MergeResource applies the changes to the existing resource and returns the result. The properties are merged
+// using JSON merge patch semantics: objects are merged recursively and null values remove the property. Tags with
+// a null value are removed.
+func MergeResource(existing generated.GenericResource, changes generated.GenericResource) generated.GenericResource {
+	result := generated.GenericResource{
+		Location:   existing.Location,
+		Properties: mergePatch(existing.Properties, changes.Properties),
+		Tags:       map[string]*string{},
+	}
+
+	if changes.Location != nil {
+		result.Location = changes.Location
+	}
+
+	// These properties are computed by the server.
+	delete(result.Properties, "provisioningState")
+	delete(result.Properties, "status")
+
+	for key, value := range existing.Tags {
+		result.Tags[key] = value
+	}
+	for key, value := range changes.Tags {
+		if value == nil {
+			delete(result.Tags, key)
+		} else {
+			result.Tags[key] = value
+		}
+	}
+
+	if len(result.Tags) == 0 {
+		result.Tags = nil
+	}
+
+	return result
+}
+
+// CreateWithProgress creates the resource and displays progress to the user while waiting for the operation to
+// complete. The operation fails with a precondition failed error if the resource already exists.
+func CreateWithProgress(ctx context.Context, client clients.ApplicationsManagementClient, scope string, resourceType string, resourceName string, resource generated.GenericResource) (generated.GenericResource, error) {
+	return withProgress(scope, resourceType, resourceName, resource, func(resource generated.GenericResource) (generated.GenericResource, error) {
+		return client.CreateResource(ctx, resourceType, resourceName, resource)
+	})
+}
+
+// CreateOrUpdateWithProgress creates or updates the resource and displays progress to the user while waiting for the
+// operation to complete. When etag is not empty, the operation fails if the resource was modified since the ETag was
+// read.
+func CreateOrUpdateWithProgress(ctx context.Context, client clients.ApplicationsManagementClient, scope string, resourceType string, resourceName string, resource generated.GenericResource, etag string) (generated.GenericResource, error) {
+	return withProgress(scope, resourceType, resourceName, resource, func(resource generated.GenericResource) (generated.GenericResource, error) {
+		return client.CreateOrUpdateResource(ctx, resourceType, resourceName, resource, etag)
+	})
+}
+
+// withProgress sends the resource with the given function and displays progress to the user while waiting for the
+// operation to complete.
+func withProgress(scope string, resourceType string, resourceName string, resource generated.GenericResource, send func(generated.GenericResource) (generated.GenericResource, error)) (generated.GenericResource, error) {
+	if resource.Location == nil {
+		resource.Location = to.Ptr(DefaultLocation)
+	}
+
+	id, err := resources.ParseResource(scope + "/providers/" + resourceType + "/" + resourceName)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	progressChan := make(chan clients.ResourceProgress, 1)
+	listener := deploy.NewProgressListener(progressChan)
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		listener.Run()
+		wg.Done()
+	}()
+
+	progressChan <- clients.ResourceProgress{Resource: id, Status: clients.StatusStarted}
+	result, err := send(resource)
+	if err != nil {
+		progressChan <- clients.ResourceProgress{Resource: id, Status: clients.StatusFailed}
+	} else {
+		progressChan <- clients.ResourceProgress{Resource: id, Status: clients.StatusCompleted}
+	}
+	close(progressChan)
+
+	// Drain any UI progress updates before we return.
+	wg.Wait()
+	output.LogInfo("")
+
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	return result, nil
+}
+
+func mergePatch(target map[string]any, patch map[string]any) map[string]any {
+	result := map[string]any{}
+	for key, value := range target {
+		result[key] = value
+	}
+
+	for key, value := range patch {
+		if value == nil {
+			delete(result, key)
+			continue
+		}
+
+		patchObject, ok := value.(map[string]any)
+		if !ok {
+			result[key] = value
+			continue
+		}
+
+		targetObject, _ := result[key].(map[string]any)
+		result[key] = mergePatch(targetObject, patchObject)
+	}
+
+	return result
+}
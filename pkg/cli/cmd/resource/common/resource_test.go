@@ -0,0 +1,143 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReadResourceFile(t *testing.T) {
+	t.Run("yaml", func(t *testing.T) {
+		resource, err := ReadResourceFile("testdata/redis.yaml", "Applications.Datastores/redisCaches", "cache")
+		require.NoError(t, err)
+
+		expected := generated.GenericResource{
+			Location: to.Ptr("global"),
+			Properties: map[string]any{
+				"application":          "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app",
+				"environment":          "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env",
+				"resourceProvisioning": "manual",
+				"host":                 "redis.example.com",
+				"port":                 float64(6379),
+			},
+			Tags: map[string]*string{
+				"team": to.Ptr("storefront"),
+			},
+		}
+		require.Equal(t, expected, resource)
+	})
+
+	t.Run("json with matching name and type", func(t *testing.T) {
+		resource, err := ReadResourceFile("testdata/redis.json", "Applications.Datastores/redisCaches", "cache")
+		require.NoError(t, err)
+		require.Nil(t, resource.Name)
+		require.Nil(t, resource.Type)
+		require.Equal(t, "redis.example.com", resource.Properties["host"])
+	})
+
+	t.Run("mismatched name", func(t *testing.T) {
+		_, err := ReadResourceFile("testdata/redis-wrong-name.yaml", "Applications.Datastores/redisCaches", "cache")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "does not match the resource name")
+	})
+
+	t.Run("mismatched type", func(t *testing.T) {
+		_, err := ReadResourceFile("testdata/redis.json", "Applications.Core/containers", "cache")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "does not match the resource type")
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		_, err := ReadResourceFile("testdata/invalid.yaml", "Applications.Datastores/redisCaches", "cache")
+		require.Error(t, err)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := ReadResourceFile("testdata/missing.yaml", "Applications.Datastores/redisCaches", "cache")
+		require.Error(t, err)
+	})
+}
+
+func Test_MergeResource(t *testing.T) {
+	existing := generated.GenericResource{
+		ID:       to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/cache"),
+		Name:     to.Ptr("cache"),
+		Type:     to.Ptr("Applications.Datastores/redisCaches"),
+		Location: to.Ptr("global"),
+		Properties: map[string]any{
+			"host":              "redis.example.com",
+			"port":              float64(6379),
+			"provisioningState": "Succeeded",
+			"status":            map[string]any{"outputResources": []any{}},
+			"secrets": map[string]any{
+				"password": "old",
+				"url":      "redis://redis.example.com",
+			},
+		},
+		Tags: map[string]*string{
+			"team":  to.Ptr("storefront"),
+			"owner": to.Ptr("alice"),
+		},
+	}
+
+	changes := generated.GenericResource{
+		Properties: map[string]any{
+			"host":    "redis-2.example.com",
+			"port":    nil,
+			"secrets": map[string]any{"password": "new"},
+		},
+		Tags: map[string]*string{
+			"team": nil,
+			"tier": to.Ptr("gold"),
+		},
+	}
+
+	expected := generated.GenericResource{
+		Location: to.Ptr("global"),
+		Properties: map[string]any{
+			"host": "redis-2.example.com",
+			"secrets": map[string]any{
+				"password": "new",
+				"url":      "redis://redis.example.com",
+			},
+		},
+		Tags: map[string]*string{
+			"owner": to.Ptr("alice"),
+			"tier":  to.Ptr("gold"),
+		},
+	}
+
+	require.Equal(t, expected, MergeResource(existing, changes))
+
+	// The existing resource is not modified.
+	require.Equal(t, "redis.example.com", existing.Properties["host"])
+	require.Equal(t, "old", existing.Properties["secrets"].(map[string]any)["password"])
+}
+
+func Test_MissingWriteOnlyProperties(t *testing.T) {
+	withSecrets := generated.GenericResource{Properties: map[string]any{"secrets": map[string]any{"password": "new"}}}
+	withoutSecrets := generated.GenericResource{Properties: map[string]any{"host": "redis.example.com"}}
+
+	require.Equal(t, []string{"properties.secrets"}, MissingWriteOnlyProperties("Applications.Datastores/redisCaches", withoutSecrets))
+	require.Empty(t, MissingWriteOnlyProperties("applications.datastores/rediscaches", withSecrets))
+	require.Equal(t, []string{"properties.data"}, MissingWriteOnlyProperties("Applications.Core/secretStores", withSecrets))
+	require.Empty(t, MissingWriteOnlyProperties("Applications.Core/containers", withoutSecrets))
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/resource/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource create` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "create [resourceType] [resourceName]",
+		Short: "Create a Radius resource from a file",
+		Long: `Create a Radius resource from a JSON or YAML file.
+
+The file contains the resource body, for example:
+
+  location: global
+  properties:
+    application: /planes/radius/local/resourceGroups/default/providers/Applications.Core/applications/icecream-store
+    environment: /planes/radius/local/resourceGroups/default/providers/Applications.Core/environments/default
+    host: redis.example.com
+    port: 6379
+    resourceProvisioning: manual
+
+The command fails if the resource already exists. Use 'rad resource update' to update an existing resource.`,
+		Example: `
+	sample list of resourceType: containers, gateways, httpRoutes, daprPubSubBrokers, extenders, mongoDatabases, rabbitMQMessageQueues, redisCaches, sqlDatabases, daprStateStores, daprSecretStores
+
+	# create a redis cache from a YAML file
+	rad resource create redisCaches cache -f cache.yaml
+
+	# create a redis cache from a JSON file and print the result as JSON
+	rad resource create redisCaches cache -f cache.json --output json
+	`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	common.AddFileFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource create` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	ResourceType      string
+	ResourceName      string
+	Resource          generated.GenericResource
+	Format            string
+}
+
+// NewRunner creates a new instance of the `rad resource create` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource create` command.
+//
+// Validate checks the workspace, scope, resource type and name, output format and resource file, and sets them in the
+// Runner struct. It returns an error if any of these values are not valid.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceType, resourceName, err := cli.RequireResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	r.ResourceType = resourceType
+	r.ResourceName = resourceName
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	filePath, err := cmd.Flags().GetString(common.FileFlag)
+	if err != nil {
+		return err
+	}
+
+	r.Resource, err = common.ReadResourceFile(filePath, r.ResourceType, r.ResourceName)
+	if err != nil {
+		return err
+	}
+
+	if r.Resource.Properties == nil {
+		return clierrors.Message("The resource file %q must contain the resource properties.", filePath)
+	}
+
+	return nil
+}
+
+// Run runs the `rad resource create` command.
+//
+// Run checks that the resource does not exist yet, creates it and waits for the operation to complete, then writes the
+// resource in the specified format to the output. It returns an error if any of these steps fail.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ShowResource(ctx, r.ResourceType, r.ResourceName)
+	if err == nil {
+		return r.alreadyExistsError()
+	} else if !clients.Is404Error(err) {
+		return err
+	}
+
+	// The resource can be created concurrently after the check above, the request is conditional on the resource not
+	// existing so that the other resource is not overwritten.
+	resource, err := common.CreateWithProgress(ctx, client, r.Workspace.Scope, r.ResourceType, r.ResourceName, r.Resource)
+	if clients.IsPreconditionFailedError(err) {
+		return r.alreadyExistsError()
+	} else if err != nil {
+		return err
+	}
+
+	return r.Output.WriteFormatted(r.Format, resource, objectformats.GetGenericResourceTableFormat())
+}
+
+func (r *Runner) alreadyExistsError() error {
+	return clierrors.Message("The resource %q of type %q already exists. Use 'rad resource update' to update it.", r.ResourceName, r.ResourceType)
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Create Command",
+			Input:         []string{"redisCaches", "cache", "-f", "testdata/redis.yaml"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "Applications.Datastores/redisCaches", runner.ResourceType)
+				require.Equal(t, "cache", runner.ResourceName)
+				require.Equal(t, "redis.example.com", runner.Resource.Properties["host"])
+			},
+		},
+		{
+			Name:          "Create Command without file",
+			Input:         []string{"redisCaches", "cache"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create Command with missing file",
+			Input:         []string{"redisCaches", "cache", "-f", "testdata/missing.yaml"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create Command without properties",
+			Input:         []string{"redisCaches", "cache", "-f", "testdata/redis-no-properties.yaml"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create Command with invalid resource type",
+			Input:         []string{"invalidResourceType", "cache", "-f", "testdata/redis.yaml"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create Command with insufficient args",
+			Input:         []string{"redisCaches", "-f", "testdata/redis.yaml"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	resourceType := "Applications.Datastores/redisCaches"
+	input := generated.GenericResource{
+		Location:   to.Ptr("global"),
+		Properties: map[string]any{"host": "redis.example.com"},
+	}
+
+	t.Run("Create resource", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		created := radcli.CreateResource(resourceType, "cache")
+		created.Properties = map[string]any{"host": "redis.example.com", "provisioningState": "Succeeded"}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowResource(gomock.Any(), resourceType, "cache").
+			Return(generated.GenericResource{}, &azcore.ResponseError{ErrorCode: v1.CodeNotFound}).
+			Times(1)
+		appManagementClient.EXPECT().
+			CreateResource(gomock.Any(), resourceType, "cache", input).
+			Return(created, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			ResourceType:      resourceType,
+			ResourceName:      "cache",
+			Resource:          input,
+			Format:            "json",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "json",
+				Obj:     created,
+				Options: objectformats.GetGenericResourceTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Resource already exists", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowResource(gomock.Any(), resourceType, "cache").
+			Return(radcli.CreateResource(resourceType, "cache"), nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			ResourceType:      resourceType,
+			ResourceName:      "cache",
+			Resource:          input,
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		expected := clierrors.Message("The resource %q of type %q already exists. Use 'rad resource update' to update it.", "cache", resourceType)
+		require.Equal(t, expected, err)
+		require.Empty(t, outputSink.Writes)
+	})
+
+	t.Run("Resource created concurrently", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowResource(gomock.Any(), resourceType, "cache").
+			Return(generated.GenericResource{}, &azcore.ResponseError{ErrorCode: v1.CodeNotFound}).
+			Times(1)
+		appManagementClient.EXPECT().
+			CreateResource(gomock.Any(), resourceType, "cache", input).
+			Return(generated.GenericResource{}, &azcore.ResponseError{StatusCode: http.StatusPreconditionFailed}).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			ResourceType:      resourceType,
+			ResourceName:      "cache",
+			Resource:          input,
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		expected := clierrors.Message("The resource %q of type %q already exists. Use 'rad resource update' to update it.", "cache", resourceType)
+		require.Equal(t, expected, err)
+		require.Empty(t, outputSink.Writes)
+	})
+}
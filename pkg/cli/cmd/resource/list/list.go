@@ -18,6 +18,9 @@ package list
 
 import (
 	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
@@ -58,6 +61,12 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	
 	# list all resources of a specified type in an application (shorthand flag)
 	rad resource list containers -a icecream-store
+
+	# list all resources of a specified type that have the tag 'team' set to 'payments'
+	rad resource list containers --tag team=payments
+
+	# list all resources of a specified type that have the tag 'team' set to any value
+	rad resource list containers --tag team
 	`,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -67,6 +76,7 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	commonflags.AddResourceGroupFlag(cmd)
 	commonflags.AddOutputFlag(cmd)
 	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().String("tag", "", "Only list resources with the given tag, specified as 'key=value' or 'key'")
 
 	return cmd, runner
 }
@@ -80,6 +90,8 @@ type Runner struct {
 	ApplicationName   string
 	Format            string
 	ResourceType      string
+	TagName           string
+	TagValue          string
 }
 
 // NewRunner creates a new instance of the `rad resource list` runner.
@@ -128,6 +140,19 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	}
 	r.Format = format
 
+	tag, err := cmd.Flags().GetString("tag")
+	if err != nil {
+		return err
+	}
+	if tag != "" {
+		name, value, _ := strings.Cut(tag, "=")
+		if strings.TrimSpace(name) == "" {
+			return clierrors.Message("The tag %q is invalid. Specify the tag as 'key=value' or 'key'.", tag)
+		}
+		r.TagName = name
+		r.TagValue = value
+	}
+
 	return nil
 }
 
@@ -136,7 +161,8 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 
 // Run checks if an application name is provided and if so, checks if the application exists in the workspace, then
 // lists all resources of the specified type in the application, and finally writes the resources to the output in the
-// specified format. If no application name is provided, it lists all resources of the specified type. An error is
+// specified format. If no application name is provided, it lists all resources of the specified type. When a tag is
+// provided, only resources with a matching tag are listed. An error is
 // returned if the application does not exist in the workspace.
 func (r *Runner) Run(ctx context.Context) error {
 	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
@@ -144,10 +170,18 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	var options *generated.GenericResourcesClientListByRootScopeOptions
+	if r.TagName != "" {
+		options = &generated.GenericResourcesClientListByRootScopeOptions{TagName: to.Ptr(r.TagName)}
+		if r.TagValue != "" {
+			options.TagValue = to.Ptr(r.TagValue)
+		}
+	}
+
 	var resourceList []generated.GenericResource
 
 	if r.ApplicationName == "" {
-		resourceList, err = client.ListAllResourcesByType(ctx, r.ResourceType)
+		resourceList, err = client.ListAllResourcesByType(ctx, r.ResourceType, options)
 		if err != nil {
 			return err
 		}
@@ -159,7 +193,7 @@ func (r *Runner) Run(ctx context.Context) error {
 			return err
 		}
 
-		resourceList, err = client.ListAllResourcesOfTypeInApplication(ctx, r.ApplicationName, r.ResourceType)
+		resourceList, err = client.ListAllResourcesOfTypeInApplication(ctx, r.ApplicationName, r.ResourceType, options)
 		if err != nil {
 			return err
 		}
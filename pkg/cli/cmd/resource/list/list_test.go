@@ -20,6 +20,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/golang/mock/gomock"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
@@ -95,6 +96,43 @@ func Test_Validate(t *testing.T) {
 				Config:         configWithWorkspace,
 			},
 		},
+		{
+			Name:          "List Command with tag key and value",
+			Input:         []string{"containers", "--tag", "team=payments"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "team", r.TagName)
+				require.Equal(t, "payments", r.TagValue)
+			},
+		},
+		{
+			Name:          "List Command with tag key only",
+			Input:         []string{"containers", "--tag", "team"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "team", r.TagName)
+				require.Equal(t, "", r.TagValue)
+			},
+		},
+		{
+			Name:          "List Command with invalid tag",
+			Input:         []string{"containers", "--tag", "=payments"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 		{
 			Name:          "List Command with ambiguous args",
 			Input:         []string{"secretStores"},
@@ -147,7 +185,7 @@ func Test_Run(t *testing.T) {
 				ShowApplication(gomock.Any(), "test-app").
 				Return(v20231001preview.ApplicationResource{}, nil).Times(1)
 			appManagementClient.EXPECT().
-				ListAllResourcesOfTypeInApplication(gomock.Any(), "test-app", "containers").
+				ListAllResourcesOfTypeInApplication(gomock.Any(), "test-app", "containers", nil).
 				Return(resources, nil).Times(1)
 
 			outputSink := &output.MockOutput{}
@@ -185,7 +223,7 @@ func Test_Run(t *testing.T) {
 
 			appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
 			appManagementClient.EXPECT().
-				ListAllResourcesByType(gomock.Any(), "containers").
+				ListAllResourcesByType(gomock.Any(), "containers", nil).
 				Return(resources, nil).Times(1)
 
 			outputSink := &output.MockOutput{}
@@ -212,4 +250,45 @@ func Test_Run(t *testing.T) {
 			require.Equal(t, expected, outputSink.Writes)
 		})
 	})
+	t.Run("List resources by type with tag", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		resources := []generated.GenericResource{
+			radcli.CreateResource("containers", "A"),
+		}
+
+		expectedOptions := &generated.GenericResourcesClientListByRootScopeOptions{
+			TagName:  to.Ptr("team"),
+			TagValue: to.Ptr("payments"),
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListAllResourcesByType(gomock.Any(), "containers", expectedOptions).
+			Return(resources, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			ResourceType:      "containers",
+			TagName:           "team",
+			TagValue:          "payments",
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     resources,
+				Options: objectformats.GetGenericResourceTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
 }
@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package update
+
+import (
+	"context"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/resource/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource update` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "update [resourceType] [resourceName]",
+		Short: "Update a Radius resource from a file",
+		Long: `Update an existing Radius resource from a JSON or YAML file.
+
+The file contains the changes to apply to the resource. Properties are merged into the existing resource using
+JSON merge patch semantics: nested objects are merged, other values are replaced, and a null value removes the
+property. Tags are merged in the same way.
+
+The update fails if the resource is modified by someone else while it is being updated. Properties that are never
+returned by the server, such as the secrets of a datastore, must be included in the file because they cannot be merged.`,
+		Example: `
+	sample list of resourceType: containers, gateways, httpRoutes, daprPubSubBrokers, extenders, mongoDatabases, rabbitMQMessageQueues, redisCaches, sqlDatabases, daprStateStores, daprSecretStores
+
+	# update a redis cache from a YAML file
+	rad resource update redisCaches cache -f changes.yaml
+
+	# update a redis cache from a JSON file and print the result as JSON
+	rad resource update redisCaches cache -f changes.json --output json
+	`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	common.AddFileFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource update` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	ResourceType      string
+	ResourceName      string
+	Changes           generated.GenericResource
+	Format            string
+}
+
+// NewRunner creates a new instance of the `rad resource update` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource update` command.
+//
+// Validate checks the workspace, scope, resource type and name, output format and resource file, and sets them in the
+// Runner struct. It returns an error if any of these values are not valid.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceType, resourceName, err := cli.RequireResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	r.ResourceType = resourceType
+	r.ResourceName = resourceName
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	filePath, err := cmd.Flags().GetString(common.FileFlag)
+	if err != nil {
+		return err
+	}
+
+	r.Changes, err = common.ReadResourceFile(filePath, r.ResourceType, r.ResourceName)
+	if err != nil {
+		return err
+	}
+
+	// Write-only properties are not returned by the server, so merging would remove them from the resource.
+	if missing := common.MissingWriteOnlyProperties(r.ResourceType, r.Changes); len(missing) > 0 {
+		return clierrors.Message("Resources of type %q have properties that are not returned by the server and cannot be merged. Include %s in the file to update the resource.", r.ResourceType, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// Run runs the `rad resource update` command.
+//
+// Run retrieves the existing resource, merges the changes into it, updates the resource if it has not been modified
+// since it was retrieved and waits for the operation to complete, then writes the resource in the specified format to
+// the output. It returns an error if any of these steps fail.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	existing, etag, err := client.ShowResourceWithETag(ctx, r.ResourceType, r.ResourceName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The resource %q of type %q does not exist. Use 'rad resource create' to create it.", r.ResourceName, r.ResourceType)
+	} else if err != nil {
+		return err
+	}
+
+	resource, err := common.CreateOrUpdateWithProgress(ctx, client, r.Workspace.Scope, r.ResourceType, r.ResourceName, common.MergeResource(existing, r.Changes), etag)
+	if clients.IsPreconditionFailedError(err) {
+		return clierrors.Message("The resource %q of type %q was modified while it was being updated. Run the command again to apply the changes to the latest version.", r.ResourceName, r.ResourceType)
+	} else if err != nil {
+		return err
+	}
+
+	return r.Output.WriteFormatted(r.Format, resource, objectformats.GetGenericResourceTableFormat())
+}
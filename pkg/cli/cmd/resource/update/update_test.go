@@ -0,0 +1,218 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package update
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Update Command",
+			Input:         []string{"redisCaches", "cache", "-f", "testdata/redis-changes.yaml"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "Applications.Datastores/redisCaches", runner.ResourceType)
+				require.Equal(t, "cache", runner.ResourceName)
+				require.Equal(t, "redis-2.example.com", runner.Changes.Properties["host"])
+				require.Contains(t, runner.Changes.Tags, "team")
+				require.Nil(t, runner.Changes.Tags["team"])
+			},
+		},
+		{
+			Name:          "Update Command without write-only properties",
+			Input:         []string{"redisCaches", "cache", "-f", "testdata/redis-changes-without-secrets.yaml"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Update Command without file",
+			Input:         []string{"redisCaches", "cache"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Update Command with missing file",
+			Input:         []string{"redisCaches", "cache", "-f", "testdata/missing.yaml"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Update Command with too many args",
+			Input:         []string{"redisCaches", "a", "b", "-f", "testdata/redis-changes.yaml"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	resourceType := "Applications.Datastores/redisCaches"
+	changes := generated.GenericResource{
+		Properties: map[string]any{"host": "redis-2.example.com"},
+		Tags:       map[string]*string{"team": nil},
+	}
+
+	t.Run("Update resource", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		existing := radcli.CreateResource(resourceType, "cache")
+		existing.Properties = map[string]any{"host": "redis.example.com", "port": float64(6379), "provisioningState": "Succeeded"}
+		existing.Tags = map[string]*string{"team": to.Ptr("storefront")}
+
+		merged := generated.GenericResource{
+			Location:   existing.Location,
+			Properties: map[string]any{"host": "redis-2.example.com", "port": float64(6379)},
+		}
+
+		updated := radcli.CreateResource(resourceType, "cache")
+		updated.Properties = map[string]any{"host": "redis-2.example.com", "port": float64(6379), "provisioningState": "Succeeded"}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowResourceWithETag(gomock.Any(), resourceType, "cache").
+			Return(existing, "etag-1", nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			CreateOrUpdateResource(gomock.Any(), resourceType, "cache", merged, "etag-1").
+			Return(updated, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			ResourceType:      resourceType,
+			ResourceName:      "cache",
+			Changes:           changes,
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     updated,
+				Options: objectformats.GetGenericResourceTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Resource does not exist", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowResourceWithETag(gomock.Any(), resourceType, "cache").
+			Return(generated.GenericResource{}, "", &azcore.ResponseError{ErrorCode: v1.CodeNotFound}).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			ResourceType:      resourceType,
+			ResourceName:      "cache",
+			Changes:           changes,
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		expected := clierrors.Message("The resource %q of type %q does not exist. Use 'rad resource create' to create it.", "cache", resourceType)
+		require.Equal(t, expected, err)
+		require.Empty(t, outputSink.Writes)
+	})
+
+	t.Run("Resource modified concurrently", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		existing := radcli.CreateResource(resourceType, "cache")
+		existing.Properties = map[string]any{"host": "redis.example.com"}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowResourceWithETag(gomock.Any(), resourceType, "cache").
+			Return(existing, "etag-1", nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			CreateOrUpdateResource(gomock.Any(), resourceType, "cache", gomock.Any(), "etag-1").
+			Return(generated.GenericResource{}, &azcore.ResponseError{StatusCode: http.StatusPreconditionFailed}).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			ResourceType:      resourceType,
+			ResourceName:      "cache",
+			Changes:           changes,
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		expected := clierrors.Message("The resource %q of type %q was modified while it was being updated. Run the command again to apply the changes to the latest version.", "cache", resourceType)
+		require.Equal(t, expected, err)
+		require.Empty(t, outputSink.Writes)
+	})
+}
@@ -53,6 +53,8 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 The run command compiles a Bicep or ARM template and runs it in your default environment (unless otherwise specified). It also automatically port-forwards container ports and streams container logs to a user's terminal.
 		
 The run command accepts the same parameters as the 'rad deploy' command. See the 'rad deploy' help for more information.
+
+Use '--watch' to redeploy the application whenever the template, its local modules or parameter files change. The log stream and port-forwards stay open across redeployments and follow the new replicas as they start.
 	`,
 		Example: `
 # Run app.bicep
@@ -66,6 +68,9 @@ rad run app.bicep --parameters version=latest
 
 # Run app.bicep and specify parameters from multiple sources
 rad run app.bicep --parameters @myfile.json --parameters version=latest
+
+# Run app.bicep and redeploy whenever it changes
+rad run app.bicep --watch
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -76,6 +81,7 @@ rad run app.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	cmd.Flags().StringArrayP("parameters", "p", []string{}, "Specify parameters for the deployment")
+	deploycmd.AddWatchFlag(cmd)
 
 	return cmd, runner
 }
@@ -122,13 +128,16 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 // returns an error if any of the operations fail.
 func (r *Runner) Run(ctx context.Context) error {
 	// Call into base first to deploy, and then set up port-forwards and logs.
-	err := r.Runner.Run(ctx)
+	err := r.Runner.DeployTemplate(ctx)
 	if err != nil {
 		return err
 	}
 
 	kubeContext, ok := r.Workspace.KubernetesContext()
 	if !ok {
+		if r.Watch {
+			return r.Runner.WatchAndRedeploy(ctx)
+		}
 		return nil
 	}
 
@@ -159,7 +168,7 @@ func (r *Runner) Run(ctx context.Context) error {
 		return clierrors.Message("Only kubernetes runtimes are supported.")
 	}
 
-	// We start three background jobs and wait for them to complete.
+	// We start three background jobs (four in watch mode) and wait for them to complete.
 	group, ctx := errgroup.WithContext(ctx)
 
 	// 1. Display port-forward messages
@@ -193,6 +202,14 @@ func (r *Runner) Run(ctx context.Context) error {
 		})
 	})
 
+	// 4. Redeploy on changes. The port-forwards and log stream watch the application's pods, so they
+	// attach to the new replicas after each redeployment.
+	if r.Watch {
+		group.Go(func() error {
+			return r.Runner.WatchAndRedeploy(ctx)
+		})
+	}
+
 	err = group.Wait()
 
 	// context.Canceled here means the user canceled.
@@ -23,6 +23,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/to"
 	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 )
 
@@ -42,8 +43,12 @@ const (
 )
 
 type AWSCredentialProperties struct {
+	// Kind is the kind of the AWS credential.
+	Kind string
 	// AccessKeyID is the access key ID for the AWS credential.
 	AccessKeyID *string
+	// RoleARN is the ARN of the IAM role used by IRSA and AssumeRole credentials.
+	RoleARN string
 }
 
 // AWSCredentialManagementClient is used to interface with cloud provider configuration and credentials.
@@ -62,10 +67,14 @@ type AWSCredentialManagementClientInterface interface {
 //
 
 // "Put" checks if the credential type is "AWSCredential" and if so, creates or updates the credential in the AWS plane,
-// otherwise it returns an error.
+// otherwise it returns an error. The credential is registered as "default" unless it has a name.
 func (cpm *AWSCredentialManagementClient) Put(ctx context.Context, credential ucp.AwsCredentialResource) error {
 	if strings.EqualFold(*credential.Type, AWSCredential) {
-		_, err := cpm.AWSCredentialClient.CreateOrUpdate(ctx, AWSPlaneName, defaultSecretName, credential, nil)
+		name := defaultSecretName
+		if credential.Name != nil && *credential.Name != "" {
+			name = *credential.Name
+		}
+		_, err := cpm.AWSCredentialClient.CreateOrUpdate(ctx, AWSPlaneName, name, credential, nil)
 		return err
 	}
 	return &ErrUnsupportedCloudProvider{}
@@ -84,8 +93,27 @@ func (cpm *AWSCredentialManagementClient) Get(ctx context.Context, credentialNam
 	if err != nil {
 		return ProviderCredentialConfiguration{}, err
 	}
-	awsAccessKeyCredentials, ok := resp.AwsCredentialResource.Properties.(*ucp.AwsAccessKeyCredentialProperties)
-	if !ok {
+	var properties *AWSCredentialProperties
+	switch p := resp.AwsCredentialResource.Properties.(type) {
+	case *ucp.AwsAccessKeyCredentialProperties:
+		properties = &AWSCredentialProperties{
+			Kind:        string(ucp.AWSCredentialKindAccessKey),
+			AccessKeyID: p.AccessKeyID,
+		}
+	case *ucp.AwsIRSACredentialProperties:
+		// Role based credentials have no access key; use an empty value so that it is displayed as blank.
+		properties = &AWSCredentialProperties{
+			Kind:        string(ucp.AWSCredentialKindIRSA),
+			AccessKeyID: new(string),
+			RoleARN:     to.String(p.RoleARN),
+		}
+	case *ucp.AwsAssumeRoleCredentialProperties:
+		properties = &AWSCredentialProperties{
+			Kind:        string(ucp.AWSCredentialKindAssumeRole),
+			AccessKeyID: new(string),
+			RoleARN:     to.String(p.RoleARN),
+		}
+	default:
 		return ProviderCredentialConfiguration{}, clierrors.Message("Unable to find credentials for cloud provider %s.", AWSCredential)
 	}
 
@@ -94,9 +122,7 @@ func (cpm *AWSCredentialManagementClient) Get(ctx context.Context, credentialNam
 			Name:    AWSCredential,
 			Enabled: true,
 		},
-		AWSCredentials: &AWSCredentialProperties{
-			AccessKeyID: awsAccessKeyCredentials.AccessKeyID,
-		},
+		AWSCredentials: properties,
 	}
 	return providerCredentialConfiguration, nil
 }
@@ -71,13 +71,13 @@ type ProviderCredentialConfiguration struct {
 }
 
 type AzureCredentialProperties struct {
-	// clientId for ServicePrincipal
+	// clientId for ServicePrincipal, WorkloadIdentity and user-assigned ManagedIdentity
 	ClientID *string
 
 	// The credential kind
 	Kind *string
 
-	// tenantId for ServicePrincipal
+	// tenantId for ServicePrincipal and WorkloadIdentity
 	TenantID *string
 }
 
@@ -124,8 +124,31 @@ func (cpm *AzureCredentialManagementClient) Get(ctx context.Context, credentialN
 		return ProviderCredentialConfiguration{}, err
 	}
 
-	azureServicePrincipal, ok := resp.AzureCredentialResource.Properties.(*ucp.AzureServicePrincipalProperties)
-	if !ok {
+	var properties *AzureCredentialProperties
+	switch p := resp.AzureCredentialResource.Properties.(type) {
+	case *ucp.AzureServicePrincipalProperties:
+		properties = &AzureCredentialProperties{
+			ClientID: p.ClientID,
+			Kind:     (*string)(p.Kind),
+			TenantID: p.TenantID,
+		}
+	case *ucp.AzureWorkloadIdentityProperties:
+		properties = &AzureCredentialProperties{
+			ClientID: p.ClientID,
+			Kind:     (*string)(p.Kind),
+			TenantID: p.TenantID,
+		}
+	case *ucp.AzureManagedIdentityProperties:
+		// Managed identities may use the system-assigned identity, in which case there is no client id.
+		properties = &AzureCredentialProperties{
+			ClientID: p.ClientID,
+			Kind:     (*string)(p.Kind),
+			TenantID: new(string),
+		}
+		if properties.ClientID == nil {
+			properties.ClientID = new(string)
+		}
+	default:
 		return ProviderCredentialConfiguration{}, clierrors.Message("Unable to find credentials for cloud provider %s.", AzureCredential)
 	}
 
@@ -134,11 +157,7 @@ func (cpm *AzureCredentialManagementClient) Get(ctx context.Context, credentialN
 			Name:    AzureCredential,
 			Enabled: true,
 		},
-		AzureCredentials: &AzureCredentialProperties{
-			ClientID: azureServicePrincipal.ClientID,
-			Kind:     (*string)(azureServicePrincipal.Kind),
-			TenantID: azureServicePrincipal.TenantID,
-		},
+		AzureCredentials: properties,
 	}
 
 	return providerCredentialConfiguration, nil
@@ -0,0 +1,266 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// restartCountThreshold is the number of container restarts after which a deployment is considered degraded.
+	restartCountThreshold = 5
+
+	httpProxyStatusValid    = "valid"
+	httpProxyStatusInvalid  = "invalid"
+	httpProxyStatusOrphaned = "orphaned"
+)
+
+// unhealthyWaitingReasons are the reasons for a waiting container that mean the container cannot start.
+var unhealthyWaitingReasons = map[string]bool{
+	"CrashLoopBackOff":           true,
+	"ImagePullBackOff":           true,
+	"ErrImagePull":               true,
+	"CreateContainerConfigError": true,
+	"InvalidImageName":           true,
+}
+
+// GetResourceHealth computes the health of a Radius resource from its provisioning state and the state of its
+// Kubernetes output resources. Deployments are checked for ready replicas and failing containers, and Contour
+// HTTPProxies for a valid status. Other output resources are not inspected.
+func (dc *ARMDiagnosticsClient) GetResourceHealth(ctx context.Context, options clients.ResourceHealthOptions) (*clients.ResourceHealth, error) {
+	return resourceHealth(ctx, dc.K8sRuntimeClient, options.Resource)
+}
+
+func resourceHealth(ctx context.Context, c client.Client, resource generated.GenericResource) (*clients.ResourceHealth, error) {
+	health := &clients.ResourceHealth{
+		Name:  to.String(resource.Name),
+		Type:  to.String(resource.Type),
+		State: clients.HealthStateHealthy,
+	}
+
+	messages := []string{}
+	state, message := provisioningHealth(resource.Properties)
+	health.State = state
+	if message != "" {
+		messages = append(messages, message)
+	}
+
+	for _, id := range outputResourceIDs(resource.Properties) {
+		outputResource := outputResourceHealth(ctx, c, id)
+		if outputResource == nil {
+			continue
+		}
+
+		health.OutputResources = append(health.OutputResources, *outputResource)
+		health.State = clients.WorstHealthState(health.State, outputResource.State)
+		if outputResource.Message != "" {
+			_, kind, _, name := resources_kubernetes.ToParts(id)
+			messages = append(messages, fmt.Sprintf("%s %s: %s", kind, name, outputResource.Message))
+		}
+	}
+
+	health.Message = strings.Join(messages, "; ")
+	return health, nil
+}
+
+// provisioningHealth returns the health state implied by the provisioning state of the resource. A failed
+// recipe is reported as such, since that is the most likely cause for a failed portable resource.
+func provisioningHealth(properties map[string]any) (string, string) {
+	provisioningState, _ := properties["provisioningState"].(string)
+	switch {
+	case provisioningState == "" || strings.EqualFold(provisioningState, "Succeeded"):
+		return clients.HealthStateHealthy, ""
+	case strings.EqualFold(provisioningState, "Failed") || strings.EqualFold(provisioningState, "Canceled"):
+		if recipe, ok := properties["recipe"].(map[string]any); ok {
+			name, _ := recipe["name"].(string)
+			if name == "" {
+				name = "default"
+			}
+			return clients.HealthStateUnhealthy, fmt.Sprintf("recipe %q failed to provision", name)
+		}
+		return clients.HealthStateUnhealthy, fmt.Sprintf("provisioning state is %s", provisioningState)
+	default:
+		return clients.HealthStateDegraded, fmt.Sprintf("provisioning state is %s", provisioningState)
+	}
+}
+
+// outputResourceIDs returns the IDs of the output resources in the status of the resource.
+func outputResourceIDs(properties map[string]any) []resources.ID {
+	status, ok := properties["status"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	outputResources, ok := status["outputResources"].([]any)
+	if !ok {
+		return nil
+	}
+
+	ids := []resources.ID{}
+	for _, obj := range outputResources {
+		outputResource, ok := obj.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		value, _ := outputResource["id"].(string)
+		id, err := resources.ParseResource(value)
+		if err != nil {
+			continue
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+// outputResourceHealth returns the health of a Kubernetes output resource, or nil if the output resource is not
+// inspected.
+func outputResourceHealth(ctx context.Context, c client.Client, id resources.ID) *clients.OutputResourceHealth {
+	if id.FindScope(resources_kubernetes.PlaneTypeKubernetes) == "" {
+		return nil
+	}
+
+	var state, message string
+	var err error
+	switch strings.ToLower(id.Type()) {
+	case strings.ToLower(resources_kubernetes.ResourceTypeDeployment):
+		state, message, err = deploymentHealth(ctx, c, id)
+	case strings.ToLower(resources_kubernetes.ResourceTypeContourHTTPProxy):
+		state, message, err = httpProxyHealth(ctx, c, id)
+	default:
+		return nil
+	}
+
+	if apierrors.IsNotFound(err) {
+		state, message = clients.HealthStateUnhealthy, "not found"
+	} else if err != nil {
+		state, message = clients.HealthStateUnknown, err.Error()
+	}
+
+	return &clients.OutputResourceHealth{
+		ID:      id.String(),
+		State:   state,
+		Message: message,
+	}
+}
+
+// deploymentHealth returns the health of a Kubernetes deployment from its ready replicas and the state of its pods.
+func deploymentHealth(ctx context.Context, c client.Client, id resources.ID) (string, string, error) {
+	_, _, namespace, name := resources_kubernetes.ToParts(id)
+
+	deployment := appsv1.Deployment{}
+	err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &deployment)
+	if err != nil {
+		return "", "", err
+	}
+
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+
+	state := clients.HealthStateHealthy
+	messages := []string{}
+	if deployment.Status.ReadyReplicas < desired {
+		state = clients.HealthStateDegraded
+		if deployment.Status.ReadyReplicas == 0 {
+			state = clients.HealthStateUnhealthy
+		}
+		messages = append(messages, fmt.Sprintf("%d/%d replicas ready", deployment.Status.ReadyReplicas, desired))
+	}
+
+	if deployment.Spec.Selector != nil {
+		selector, err := v1.LabelSelectorAsSelector(deployment.Spec.Selector)
+		if err != nil {
+			return "", "", err
+		}
+
+		pods := corev1.PodList{}
+		err = c.List(ctx, &pods, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector})
+		if err != nil {
+			return "", "", err
+		}
+
+		podState, podMessages := podsHealth(pods.Items)
+		state = clients.WorstHealthState(state, podState)
+		messages = append(messages, podMessages...)
+	}
+
+	return state, strings.Join(messages, ", "), nil
+}
+
+// podsHealth returns the health of the containers of a deployment's pods. Containers that cannot start make
+// the deployment unhealthy, and frequently restarting containers make it degraded.
+func podsHealth(pods []corev1.Pod) (string, []string) {
+	state := clients.HealthStateHealthy
+	messages := []string{}
+	restarts := int32(0)
+	for _, pod := range pods {
+		for _, status := range pod.Status.ContainerStatuses {
+			restarts += status.RestartCount
+			if status.State.Waiting != nil && unhealthyWaitingReasons[status.State.Waiting.Reason] {
+				state = clients.HealthStateUnhealthy
+				messages = append(messages, fmt.Sprintf("container %s in pod %s is in %s", status.Name, pod.Name, status.State.Waiting.Reason))
+			}
+		}
+	}
+
+	if restarts >= restartCountThreshold {
+		state = clients.WorstHealthState(state, clients.HealthStateDegraded)
+		messages = append(messages, fmt.Sprintf("containers restarted %d times", restarts))
+	}
+
+	return state, messages
+}
+
+// httpProxyHealth returns the health of a Contour HTTPProxy from the status reported by Contour.
+func httpProxyHealth(ctx context.Context, c client.Client, id resources.ID) (string, string, error) {
+	_, _, namespace, name := resources_kubernetes.ToParts(id)
+
+	proxy := contourv1.HTTPProxy{}
+	err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &proxy)
+	if err != nil {
+		return "", "", err
+	}
+
+	switch proxy.Status.CurrentStatus {
+	case httpProxyStatusValid:
+		return clients.HealthStateHealthy, "", nil
+	case httpProxyStatusInvalid:
+		return clients.HealthStateUnhealthy, proxy.Status.Description, nil
+	case httpProxyStatusOrphaned:
+		return clients.HealthStateDegraded, proxy.Status.Description, nil
+	default:
+		return clients.HealthStateUnknown, "status not reported", nil
+	}
+}
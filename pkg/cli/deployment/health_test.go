@@ -0,0 +1,194 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+	"testing"
+
+	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testDeploymentID = "/planes/kubernetes/local/namespaces/test-ns/providers/apps/Deployment/frontend"
+	testHTTPProxyID  = "/planes/kubernetes/local/namespaces/test-ns/providers/projectcontour.io/HTTPProxy/gateway"
+	testServiceID    = "/planes/kubernetes/local/namespaces/test-ns/providers/core/Service/frontend"
+	testAzureID      = "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/cache"
+)
+
+func Test_resourceHealth(t *testing.T) {
+	testDeployment := func(replicas int32, ready int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: v1.ObjectMeta{Namespace: "test-ns", Name: "frontend"},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: to.Ptr(replicas),
+				Selector: &v1.LabelSelector{MatchLabels: map[string]string{"app": "frontend"}},
+			},
+			Status: appsv1.DeploymentStatus{ReadyReplicas: ready},
+		}
+	}
+	testPod := func(name string, restarts int32, waitingReason string) *corev1.Pod {
+		status := corev1.ContainerStatus{Name: "frontend", RestartCount: restarts}
+		if waitingReason != "" {
+			status.State.Waiting = &corev1.ContainerStateWaiting{Reason: waitingReason}
+		}
+		return &corev1.Pod{
+			ObjectMeta: v1.ObjectMeta{Namespace: "test-ns", Name: name, Labels: map[string]string{"app": "frontend"}},
+			Status:     corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{status}},
+		}
+	}
+	testProxy := func(status string, description string) *contourv1.HTTPProxy {
+		return &contourv1.HTTPProxy{
+			ObjectMeta: v1.ObjectMeta{Namespace: "test-ns", Name: "gateway"},
+			Status:     contourv1.HTTPProxyStatus{CurrentStatus: status, Description: description},
+		}
+	}
+	testResource := func(provisioningState string, ids ...string) generated.GenericResource {
+		outputResources := []any{}
+		for _, id := range ids {
+			outputResources = append(outputResources, map[string]any{"id": id})
+		}
+		return generated.GenericResource{
+			Name: to.Ptr("frontend"),
+			Type: to.Ptr("Applications.Core/containers"),
+			Properties: map[string]any{
+				"provisioningState": provisioningState,
+				"status": map[string]any{
+					"outputResources": outputResources,
+				},
+			},
+		}
+	}
+
+	testcases := []struct {
+		name            string
+		objects         []client.Object
+		resource        generated.GenericResource
+		expectedState   string
+		expectedMessage string
+		outputResources int
+	}{
+		{
+			name:            "healthy deployment",
+			objects:         []client.Object{testDeployment(2, 2), testPod("frontend-1", 0, ""), testPod("frontend-2", 1, "")},
+			resource:        testResource("Succeeded", testDeploymentID, testServiceID, testAzureID),
+			expectedState:   clients.HealthStateHealthy,
+			outputResources: 1,
+		},
+		{
+			name:            "partially ready deployment",
+			objects:         []client.Object{testDeployment(2, 1)},
+			resource:        testResource("Succeeded", testDeploymentID),
+			expectedState:   clients.HealthStateDegraded,
+			expectedMessage: "Deployment frontend: 1/2 replicas ready",
+			outputResources: 1,
+		},
+		{
+			name:            "crashing deployment",
+			objects:         []client.Object{testDeployment(1, 0), testPod("frontend-1", 7, "CrashLoopBackOff")},
+			resource:        testResource("Succeeded", testDeploymentID),
+			expectedState:   clients.HealthStateUnhealthy,
+			expectedMessage: "Deployment frontend: 0/1 replicas ready, container frontend in pod frontend-1 is in CrashLoopBackOff, containers restarted 7 times",
+			outputResources: 1,
+		},
+		{
+			name:            "restarting deployment",
+			objects:         []client.Object{testDeployment(1, 1), testPod("frontend-1", 5, "")},
+			resource:        testResource("Succeeded", testDeploymentID),
+			expectedState:   clients.HealthStateDegraded,
+			expectedMessage: "Deployment frontend: containers restarted 5 times",
+			outputResources: 1,
+		},
+		{
+			name:            "missing deployment",
+			resource:        testResource("Succeeded", testDeploymentID),
+			expectedState:   clients.HealthStateUnhealthy,
+			expectedMessage: "Deployment frontend: not found",
+			outputResources: 1,
+		},
+		{
+			name:            "valid http proxy",
+			objects:         []client.Object{testProxy("valid", "Valid HTTPProxy")},
+			resource:        testResource("Succeeded", testHTTPProxyID),
+			expectedState:   clients.HealthStateHealthy,
+			outputResources: 1,
+		},
+		{
+			name:            "invalid http proxy",
+			objects:         []client.Object{testProxy("invalid", "route has no services")},
+			resource:        testResource("Succeeded", testHTTPProxyID),
+			expectedState:   clients.HealthStateUnhealthy,
+			expectedMessage: "HTTPProxy gateway: route has no services",
+			outputResources: 1,
+		},
+		{
+			name:            "http proxy without status",
+			objects:         []client.Object{testProxy("", "")},
+			resource:        testResource("Succeeded", testHTTPProxyID),
+			expectedState:   clients.HealthStateUnknown,
+			expectedMessage: "HTTPProxy gateway: status not reported",
+			outputResources: 1,
+		},
+		{
+			name:            "provisioning in progress",
+			resource:        testResource("Updating"),
+			expectedState:   clients.HealthStateDegraded,
+			expectedMessage: "provisioning state is Updating",
+		},
+		{
+			name:            "failed provisioning",
+			resource:        testResource("Failed"),
+			expectedState:   clients.HealthStateUnhealthy,
+			expectedMessage: "provisioning state is Failed",
+		},
+		{
+			name: "failed recipe",
+			resource: generated.GenericResource{
+				Name: to.Ptr("cache"),
+				Type: to.Ptr("Applications.Datastores/redisCaches"),
+				Properties: map[string]any{
+					"provisioningState": "Failed",
+					"recipe":            map[string]any{"name": "azure"},
+				},
+			},
+			expectedState:   clients.HealthStateUnhealthy,
+			expectedMessage: "recipe \"azure\" failed to provision",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(kubernetes.Scheme).WithObjects(tc.objects...).Build()
+
+			health, err := resourceHealth(context.Background(), c, tc.resource)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedState, health.State)
+			require.Equal(t, tc.expectedMessage, health.Message)
+			require.Len(t, health.OutputResources, tc.outputResources)
+		})
+	}
+}
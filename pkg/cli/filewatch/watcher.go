@@ -0,0 +1,171 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filewatch
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// DefaultDebounce is the default amount of time to wait after the last change before reacting to changes.
+const DefaultDebounce = 500 * time.Millisecond
+
+// Options specifies the options for Watch.
+type Options struct {
+	// Files returns the files to watch. It is called when the watch starts and again after every change so that the
+	// set of files can change over time, for example when a module is added to a Bicep file. If it fails after the
+	// watch has started the previous set of files is kept.
+	Files func() ([]string, error)
+
+	// Debounce is the amount of time to wait after the last change before calling OnChange. Editors often write a
+	// file several times when saving, so changes are batched. Defaults to DefaultDebounce.
+	Debounce time.Duration
+
+	// OnChange is called with the sorted list of changed files. Changes that happen while OnChange is running are
+	// reported in the next call.
+	OnChange func(ctx context.Context, changed []string)
+}
+
+// Watch watches the files returned by options.Files and calls options.OnChange when any of them change. Watch blocks
+// until the context is cancelled, and returns the context's error.
+//
+// The directories containing the files are watched rather than the files themselves so that changes made by
+// editors that save by replacing the file are detected.
+func Watch(ctx context.Context, options Options) error {
+	debounce := options.Debounce
+	if debounce == 0 {
+		debounce = DefaultDebounce
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	files, err := options.Files()
+	if err != nil {
+		return err
+	}
+
+	state := &watchState{watcher: watcher, files: map[string]bool{}, directories: map[string]bool{}}
+	err = state.update(files)
+	if err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(debounce)
+	if !timer.Stop() {
+		<-timer.C
+	}
+
+	pending := map[string]bool{}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return ctx.Err()
+			}
+
+			path := clean(event.Name)
+			if !state.files[path] || event.Op == fsnotify.Chmod {
+				continue
+			}
+
+			pending[path] = true
+			timer.Reset(debounce)
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return ctx.Err()
+			}
+			return err
+
+		case <-timer.C:
+			changed := []string{}
+			for path := range pending {
+				changed = append(changed, path)
+			}
+			sort.Strings(changed)
+			pending = map[string]bool{}
+
+			options.OnChange(ctx, changed)
+
+			files, err := options.Files()
+			if err == nil {
+				err = state.update(files)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// watchState tracks the files being watched and the directories registered with the watcher.
+type watchState struct {
+	watcher     *fsnotify.Watcher
+	files       map[string]bool
+	directories map[string]bool
+}
+
+// update replaces the set of watched files, adding and removing directories from the watcher as needed.
+func (s *watchState) update(files []string) error {
+	s.files = map[string]bool{}
+	directories := map[string]bool{}
+	for _, file := range files {
+		path := clean(file)
+		s.files[path] = true
+		directories[filepath.Dir(path)] = true
+	}
+
+	for directory := range directories {
+		if s.directories[directory] {
+			continue
+		}
+
+		err := s.watcher.Add(directory)
+		if err != nil {
+			return err
+		}
+	}
+
+	for directory := range s.directories {
+		if !directories[directory] {
+			// The directory may have been deleted, in which case it is no longer watched anyway.
+			_ = s.watcher.Remove(directory)
+		}
+	}
+
+	s.directories = directories
+	return nil
+}
+
+func clean(path string) string {
+	absolute, err := filepath.Abs(path)
+	if err != nil {
+		return filepath.Clean(path)
+	}
+	return absolute
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filewatch
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Watch(t *testing.T) {
+	directory := t.TempDir()
+	main := filepath.Join(directory, "app.bicep")
+	module := filepath.Join(directory, "modules", "module.bicep")
+	unrelated := filepath.Join(directory, "unrelated.txt")
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(module), 0755))
+	require.NoError(t, os.WriteFile(main, []byte("main"), 0644))
+	require.NoError(t, os.WriteFile(module, []byte("module"), 0644))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	mutex := sync.Mutex{}
+	files := []string{main}
+	changes := make(chan []string, 10)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- Watch(ctx, Options{
+			Files: func() ([]string, error) {
+				mutex.Lock()
+				defer mutex.Unlock()
+				return files, nil
+			},
+			Debounce: 50 * time.Millisecond,
+			OnChange: func(ctx context.Context, changed []string) {
+				changes <- changed
+			},
+		})
+	}()
+
+	// The watcher starts asynchronously, so keep writing until the change is observed.
+	waitForChange := func(path string) []string {
+		for i := 0; ; i++ {
+			require.NoError(t, os.WriteFile(path, []byte(fmt.Sprintf("content %d", i)), 0644))
+
+			select {
+			case changed := <-changes:
+				return changed
+			case <-time.After(500 * time.Millisecond):
+			case <-ctx.Done():
+				require.Fail(t, "timed out waiting for changes")
+			}
+		}
+	}
+
+	// Files that are not watched are ignored.
+	require.NoError(t, os.WriteFile(unrelated, []byte("unrelated"), 0644))
+	mutex.Lock()
+	files = []string{main, module}
+	mutex.Unlock()
+	require.Equal(t, []string{main}, waitForChange(main))
+
+	// The set of files is refreshed after each change, so the module is now watched.
+	require.Equal(t, []string{module}, waitForChange(module))
+
+	// Changes in quick succession are batched.
+	require.NoError(t, os.WriteFile(main, []byte("batched 1"), 0644))
+	require.NoError(t, os.WriteFile(module, []byte("batched 2"), 0644))
+	require.NoError(t, os.WriteFile(main, []byte("batched 3"), 0644))
+	select {
+	case changed := <-changes:
+		require.Equal(t, []string{main, module}, changed)
+	case <-ctx.Done():
+		require.Fail(t, "timed out waiting for changes")
+	}
+
+	select {
+	case changed := <-changes:
+		require.Fail(t, "unexpected change", changed)
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+}
+
+func Test_Watch_FilesError(t *testing.T) {
+	err := Watch(context.Background(), Options{
+		Files: func() ([]string, error) {
+			return nil, os.ErrNotExist
+		},
+		OnChange: func(ctx context.Context, changed []string) {},
+	})
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
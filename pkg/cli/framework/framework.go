@@ -26,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/deploy"
 	"github.com/radius-project/radius/pkg/cli/helm"
+	"github.com/radius-project/radius/pkg/cli/history"
 	"github.com/radius-project/radius/pkg/cli/kubernetes"
 	"github.com/radius-project/radius/pkg/cli/kubernetes/logstream"
 	"github.com/radius-project/radius/pkg/cli/kubernetes/portforward"
@@ -56,6 +57,9 @@ type Factory interface {
 
 	// GetAzureClient returns the Azure Client.
 	GetAzureClient() azure.Client
+
+	// GetHistory returns the store for the deployment history of applications.
+	GetHistory() history.Interface
 }
 
 type Impl struct {
@@ -77,6 +81,9 @@ type Impl struct {
 
 	// AzureClient is the client for Azure.
 	AzureClient azure.Client
+
+	// History is the store for the deployment history of applications.
+	History history.Interface
 }
 
 // GetBicep() returns the Bicep interface stored in the Impl struct.
@@ -173,6 +180,11 @@ func (i *Impl) GetAzureClient() azure.Client {
 	return i.AzureClient
 }
 
+// GetHistory returns the store for the deployment history of applications.
+func (i *Impl) GetHistory() history.Interface {
+	return i.History
+}
+
 type Runner interface {
 	Validate(cmd *cobra.Command, args []string) error
 	Run(ctx context.Context) error
@@ -0,0 +1,238 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/flock"
+)
+
+const (
+	// DefaultMaxRevisions is the default number of revisions kept for each application.
+	DefaultMaxRevisions = 50
+
+	revisionFileExtension = ".json"
+	lockFileName          = ".lock"
+)
+
+var _ Interface = (*FileStore)(nil)
+
+// FileStore stores the deployment history as JSON files, one file per revision, in a directory per application:
+// <directory>/<workspace>/<scope>/<application>/<revision>.json.
+type FileStore struct {
+	// Directory is the root directory of the history. When empty, ~/.rad/history is used.
+	Directory string
+
+	// MaxRevisions is the number of revisions kept for each application. Older revisions are removed when a new
+	// revision is recorded. When zero, DefaultMaxRevisions is used.
+	MaxRevisions int
+}
+
+// Record records a new revision for the application identified by the key, assigning the next revision number.
+func (s *FileStore) Record(ctx context.Context, key Key, revision Revision) (Revision, error) {
+	directory, err := s.applicationDirectory(key)
+	if err != nil {
+		return Revision{}, err
+	}
+
+	// Revisions contain the deployed templates and parameters, so they are only readable by the user.
+	err = os.MkdirAll(directory, 0700)
+	if err != nil {
+		return Revision{}, fmt.Errorf("failed to create history directory: %w", err)
+	}
+
+	// Concurrent deployments of the same application must not be assigned the same revision number, so the
+	// directory is locked while the next number is computed and the revision is written.
+	fileLock := flock.New(filepath.Join(directory, lockFileName))
+	lockCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	_, err = fileLock.TryLockContext(lockCtx, 100*time.Millisecond)
+	if err != nil {
+		return Revision{}, fmt.Errorf("failed to acquire lock on history directory %q: %w", directory, err)
+	}
+	defer func() { _ = fileLock.Unlock() }()
+
+	numbers, err := revisionNumbers(directory)
+	if err != nil {
+		return Revision{}, err
+	}
+
+	revision.Revision = 1
+	if len(numbers) > 0 {
+		revision.Revision = numbers[len(numbers)-1] + 1
+	}
+
+	b, err := json.MarshalIndent(revision, "", "  ")
+	if err != nil {
+		return Revision{}, err
+	}
+
+	err = os.WriteFile(filepath.Join(directory, revisionFileName(revision.Revision)), b, 0600)
+	if err != nil {
+		return Revision{}, fmt.Errorf("failed to write revision: %w", err)
+	}
+
+	numbers = append(numbers, revision.Revision)
+	maxRevisions := s.MaxRevisions
+	if maxRevisions <= 0 {
+		maxRevisions = DefaultMaxRevisions
+	}
+	for len(numbers) > maxRevisions {
+		err = os.Remove(filepath.Join(directory, revisionFileName(numbers[0])))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return Revision{}, fmt.Errorf("failed to remove old revision: %w", err)
+		}
+		numbers = numbers[1:]
+	}
+
+	return revision, nil
+}
+
+// List returns the revisions of the application identified by the key, newest first.
+func (s *FileStore) List(ctx context.Context, key Key) ([]Revision, error) {
+	directory, err := s.applicationDirectory(key)
+	if err != nil {
+		return nil, err
+	}
+
+	numbers, err := revisionNumbers(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := []Revision{}
+	for i := len(numbers) - 1; i >= 0; i-- {
+		revision, err := readRevision(filepath.Join(directory, revisionFileName(numbers[i])))
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, nil
+}
+
+// Get returns a single revision of the application identified by the key.
+func (s *FileStore) Get(ctx context.Context, key Key, revision int) (Revision, error) {
+	directory, err := s.applicationDirectory(key)
+	if err != nil {
+		return Revision{}, err
+	}
+
+	result, err := readRevision(filepath.Join(directory, revisionFileName(revision)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return Revision{}, ErrNotFound
+	} else if err != nil {
+		return Revision{}, err
+	}
+
+	return result, nil
+}
+
+func (s *FileStore) applicationDirectory(key Key) (string, error) {
+	root := s.Directory
+	if root == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to find the user's home directory: %w", err)
+		}
+		root = filepath.Join(home, ".rad", "history")
+	}
+
+	if key.Workspace == "" || key.Application == "" {
+		return "", fmt.Errorf("the workspace and application are required")
+	}
+
+	// Names are case-insensitive, so the directories use lowercase names.
+	segments := []string{root, pathSegment(key.Workspace)}
+	for _, segment := range strings.Split(strings.Trim(key.Scope, "/"), "/") {
+		if segment != "" {
+			segments = append(segments, pathSegment(segment))
+		}
+	}
+	segments = append(segments, pathSegment(key.Application))
+
+	return filepath.Join(segments...), nil
+}
+
+// pathSegment makes a name safe to use as a single path segment.
+func pathSegment(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|':
+			return '_'
+		}
+		return r
+	}, strings.ToLower(name))
+}
+
+func revisionFileName(revision int) string {
+	return fmt.Sprintf("%06d%s", revision, revisionFileExtension)
+}
+
+// revisionNumbers returns the revision numbers found in the directory, in ascending order.
+func revisionNumbers(directory string) ([]int, error) {
+	entries, err := os.ReadDir(directory)
+	if errors.Is(err, fs.ErrNotExist) {
+		return []int{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read history directory: %w", err)
+	}
+
+	numbers := []int{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, revisionFileExtension) {
+			continue
+		}
+
+		number, err := strconv.Atoi(strings.TrimSuffix(name, revisionFileExtension))
+		if err != nil {
+			continue
+		}
+		numbers = append(numbers, number)
+	}
+
+	sort.Ints(numbers)
+	return numbers, nil
+}
+
+func readRevision(path string) (Revision, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return Revision{}, err
+	}
+
+	revision := Revision{}
+	err = json.Unmarshal(b, &revision)
+	if err != nil {
+		return Revision{}, fmt.Errorf("failed to read revision %q: %w", path, err)
+	}
+
+	return revision, nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FileStore(t *testing.T) {
+	ctx := context.Background()
+	store := &FileStore{Directory: t.TempDir(), MaxRevisions: 3}
+	key := Key{Workspace: "default", Scope: "/planes/radius/local/resourceGroups/Default", Application: "MyApp"}
+
+	revisions, err := store.List(ctx, key)
+	require.NoError(t, err)
+	require.Empty(t, revisions)
+
+	timestamp := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	for i := 1; i <= 4; i++ {
+		recorded, err := store.Record(ctx, key, Revision{
+			Timestamp:    timestamp.Add(time.Duration(i) * time.Minute),
+			Status:       StatusSucceeded,
+			TemplateFile: "app.bicep",
+			Template:     map[string]any{"resources": map[string]any{}},
+			Parameters:   map[string]map[string]any{"image": {"value": "nginx"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, i, recorded.Revision)
+	}
+
+	// Only the newest revisions are kept.
+	revisions, err = store.List(ctx, key)
+	require.NoError(t, err)
+	require.Len(t, revisions, 3)
+	require.Equal(t, []int{4, 3, 2}, []int{revisions[0].Revision, revisions[1].Revision, revisions[2].Revision})
+
+	revision, err := store.Get(ctx, key, 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, revision.Revision)
+	require.Equal(t, timestamp.Add(3*time.Minute), revision.Timestamp)
+	require.Equal(t, map[string]map[string]any{"image": {"value": "nginx"}}, revision.Parameters)
+
+	_, err = store.Get(ctx, key, 1)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// Keys are case-insensitive.
+	_, err = store.Get(ctx, Key{Workspace: "DEFAULT", Scope: "/planes/radius/local/resourcegroups/default", Application: "myapp"}, 4)
+	require.NoError(t, err)
+
+	// Applications are isolated from each other.
+	revisions, err = store.List(ctx, Key{Workspace: "default", Scope: key.Scope, Application: "other"})
+	require.NoError(t, err)
+	require.Empty(t, revisions)
+
+	info, err := os.Stat(filepath.Join(store.Directory, "default", "planes", "radius", "local", "resourcegroups", "default", "myapp", "000004.json"))
+	require.NoError(t, err)
+	if runtime.GOOS != "windows" {
+		require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+		info, err = os.Stat(filepath.Join(store.Directory, "default", "planes", "radius", "local", "resourcegroups", "default", "myapp"))
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0700), info.Mode().Perm())
+	}
+}
+
+func Test_FileStore_ConcurrentRecord(t *testing.T) {
+	ctx := context.Background()
+	store := &FileStore{Directory: t.TempDir()}
+	key := Key{Workspace: "default", Scope: "/planes/radius/local/resourceGroups/Default", Application: "MyApp"}
+
+	const count = 10
+	numbers := make([]int, count)
+	errs := make([]error, count)
+	wg := sync.WaitGroup{}
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			recorded, err := store.Record(ctx, key, Revision{Status: StatusSucceeded})
+			numbers[i], errs[i] = recorded.Revision, err
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	// Every revision gets its own number and none of them are overwritten.
+	sort.Ints(numbers)
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, numbers)
+
+	revisions, err := store.List(ctx, key)
+	require.NoError(t, err)
+	require.Len(t, revisions, count)
+}
+
+func Test_FileStore_InvalidKey(t *testing.T) {
+	store := &FileStore{Directory: t.TempDir()}
+	_, err := store.Record(context.Background(), Key{Workspace: "default"}, Revision{})
+	require.Error(t, err)
+}
+
+func Test_HashTemplate(t *testing.T) {
+	first, err := HashTemplate(map[string]any{"a": float64(1), "b": "two"})
+	require.NoError(t, err)
+
+	second, err := HashTemplate(map[string]any{"b": "two", "a": float64(1)})
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+	require.Len(t, first, 64)
+
+	third, err := HashTemplate(map[string]any{"a": float64(2), "b": "two"})
+	require.NoError(t, err)
+	require.NotEqual(t, first, third)
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"os"
+	"os/user"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+)
+
+const (
+	applicationsResourceType = "Applications.Core/applications"
+)
+
+// DeploymentOptions describes a deployment to record with RecordDeployment.
+type DeploymentOptions struct {
+	// Workspace is the name of the workspace used for the deployment.
+	Workspace string
+
+	// Scope is the resource group scope of the deployment.
+	Scope string
+
+	// Environment is the name of the environment of the deployment.
+	Environment string
+
+	// ApplicationName is the name of the application specified for the deployment. This may be empty, in which
+	// case the revision is recorded for the applications deployed by the template.
+	ApplicationName string
+
+	// TemplateFile is the path of the template that was deployed.
+	TemplateFile string
+
+	// Template is the compiled template that was deployed.
+	Template map[string]any
+
+	// Parameters are the parameters of the deployment. Parameters declared as secure by the template are not recorded.
+	Parameters clients.DeploymentParameters
+
+	// Providers are the providers used for the deployment.
+	Providers *clients.Providers
+
+	// Result is the result of the deployment.
+	Result clients.DeploymentResult
+
+	// Error is the error returned by the deployment, if it failed.
+	Error error
+
+	// RollbackOf is the revision redeployed by `rad app rollback`.
+	RollbackOf int
+}
+
+// RecordDeployment records a revision for every application affected by the deployment: the application specified
+// for the deployment and the applications deployed by the template. Nothing is recorded if no application is known,
+// for example when deploying a template that only contains an environment.
+func RecordDeployment(ctx context.Context, store Interface, options DeploymentOptions) ([]Revision, error) {
+	applications := []string{}
+	if options.ApplicationName != "" {
+		applications = append(applications, options.ApplicationName)
+	}
+	for _, id := range options.Result.Resources {
+		if strings.EqualFold(id.Type(), applicationsResourceType) && !containsFold(applications, id.Name()) {
+			applications = append(applications, id.Name())
+		}
+	}
+
+	if len(applications) == 0 {
+		return []Revision{}, nil
+	}
+
+	hash, err := HashTemplate(options.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := []string{}
+	for _, id := range options.Result.Resources {
+		resources = append(resources, id.String())
+	}
+
+	parameters, secureParameters := removeSecureParameters(options.Template, options.Parameters)
+
+	revision := Revision{
+		Timestamp:        time.Now().UTC(),
+		User:             currentUser(),
+		Status:           StatusSucceeded,
+		TemplateFile:     options.TemplateFile,
+		TemplateHash:     hash,
+		Environment:      options.Environment,
+		RollbackOf:       options.RollbackOf,
+		Resources:        resources,
+		Template:         options.Template,
+		Parameters:       parameters,
+		SecureParameters: secureParameters,
+		Providers:        options.Providers,
+	}
+	if options.Error != nil {
+		revision.Status = StatusFailed
+		revision.Error = options.Error.Error()
+	}
+
+	recorded := []Revision{}
+	for _, application := range applications {
+		key := Key{Workspace: options.Workspace, Scope: options.Scope, Application: application}
+		result, err := store.Record(ctx, key, revision)
+		if err != nil {
+			return nil, err
+		}
+		recorded = append(recorded, result)
+	}
+
+	return recorded, nil
+}
+
+// removeSecureParameters returns a copy of the parameters without the parameters that the template declares as
+// secure (securestring or secureobject), and the sorted names of the removed parameters.
+func removeSecureParameters(template map[string]any, parameters clients.DeploymentParameters) (clients.DeploymentParameters, []string) {
+	secure := map[string]bool{}
+	declarations, _ := template["parameters"].(map[string]any)
+	for name, declaration := range declarations {
+		declaration, ok := declaration.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		parameterType, _ := declaration["type"].(string)
+		if strings.EqualFold(parameterType, "securestring") || strings.EqualFold(parameterType, "secureobject") {
+			// Parameter names are case-insensitive.
+			secure[strings.ToLower(name)] = true
+		}
+	}
+
+	result := clients.DeploymentParameters{}
+	removed := []string{}
+	for name, value := range parameters {
+		if secure[strings.ToLower(name)] {
+			removed = append(removed, name)
+			continue
+		}
+		result[name] = value
+	}
+
+	if len(removed) == 0 {
+		return parameters, nil
+	}
+
+	sort.Strings(removed)
+	return result, removed
+}
+
+func currentUser() string {
+	current, err := user.Current()
+	if err == nil && current.Username != "" {
+		return current.Username
+	}
+
+	for _, variable := range []string{"USER", "USERNAME"} {
+		if value := os.Getenv(variable); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
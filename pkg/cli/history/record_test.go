@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RecordDeployment(t *testing.T) {
+	scope := "/planes/radius/local/resourceGroups/default"
+	appID := resources.MustParse(scope + "/providers/Applications.Core/applications/myapp")
+	containerID := resources.MustParse(scope + "/providers/Applications.Core/containers/frontend")
+
+	options := DeploymentOptions{
+		Workspace:    "default",
+		Scope:        scope,
+		Environment:  "default",
+		TemplateFile: "app.bicep",
+		Template:     map[string]any{"resources": map[string]any{}},
+		Parameters:   map[string]map[string]any{"environment": {"value": scope + "/providers/Applications.Core/environments/default"}},
+		Result:       clients.DeploymentResult{Resources: []resources.ID{appID, containerID}},
+	}
+
+	t.Run("application from template", func(t *testing.T) {
+		store := &FileStore{Directory: t.TempDir()}
+		recorded, err := RecordDeployment(context.Background(), store, options)
+		require.NoError(t, err)
+		require.Len(t, recorded, 1)
+
+		revision, err := store.Get(context.Background(), Key{Workspace: "default", Scope: scope, Application: "myapp"}, 1)
+		require.NoError(t, err)
+		require.Equal(t, StatusSucceeded, revision.Status)
+		require.Equal(t, "app.bicep", revision.TemplateFile)
+		require.Equal(t, []string{appID.String(), containerID.String()}, revision.Resources)
+		require.NotEmpty(t, revision.TemplateHash)
+		require.False(t, revision.Timestamp.IsZero())
+	})
+
+	t.Run("application specified and failed", func(t *testing.T) {
+		store := &FileStore{Directory: t.TempDir()}
+		failed := options
+		failed.ApplicationName = "other"
+		failed.Result = clients.DeploymentResult{}
+		failed.Error = errors.New("deployment failed")
+
+		recorded, err := RecordDeployment(context.Background(), store, failed)
+		require.NoError(t, err)
+		require.Len(t, recorded, 1)
+		require.Equal(t, StatusFailed, recorded[0].Status)
+		require.Equal(t, "deployment failed", recorded[0].Error)
+
+		revisions, err := store.List(context.Background(), Key{Workspace: "default", Scope: scope, Application: "other"})
+		require.NoError(t, err)
+		require.Len(t, revisions, 1)
+	})
+
+	t.Run("secure parameters are not recorded", func(t *testing.T) {
+		store := &FileStore{Directory: t.TempDir()}
+		secure := options
+		secure.Template = map[string]any{
+			"parameters": map[string]any{
+				"password": map[string]any{"type": "securestring"},
+				"image":    map[string]any{"type": "string"},
+			},
+			"resources": map[string]any{},
+		}
+		secure.Parameters = clients.DeploymentParameters{
+			"Password": {"value": "hunter2"},
+			"image":    {"value": "nginx"},
+		}
+
+		recorded, err := RecordDeployment(context.Background(), store, secure)
+		require.NoError(t, err)
+		require.Len(t, recorded, 1)
+
+		revision, err := store.Get(context.Background(), Key{Workspace: "default", Scope: scope, Application: "myapp"}, 1)
+		require.NoError(t, err)
+		require.Equal(t, clients.DeploymentParameters{"image": {"value": "nginx"}}, revision.Parameters)
+		require.Equal(t, []string{"Password"}, revision.SecureParameters)
+
+		// The caller's parameters are not modified.
+		require.Contains(t, secure.Parameters, "Password")
+	})
+
+	t.Run("no application", func(t *testing.T) {
+		store := &FileStore{Directory: t.TempDir()}
+		none := options
+		none.Result = clients.DeploymentResult{}
+
+		recorded, err := RecordDeployment(context.Background(), store, none)
+		require.NoError(t, err)
+		require.Empty(t, recorded)
+	})
+}
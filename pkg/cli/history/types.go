@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+)
+
+const (
+	// StatusSucceeded is the status of a revision whose deployment succeeded.
+	StatusSucceeded = "Succeeded"
+
+	// StatusFailed is the status of a revision whose deployment failed.
+	StatusFailed = "Failed"
+)
+
+// ErrNotFound is returned when a revision does not exist.
+var ErrNotFound = errors.New("revision not found")
+
+// Interface is the interface for storing and retrieving the deployment history of applications.
+type Interface interface {
+	// Record records a new revision for the application identified by the key, assigning the next revision number.
+	// The recorded revision is returned.
+	Record(ctx context.Context, key Key, revision Revision) (Revision, error)
+
+	// List returns the revisions of the application identified by the key, newest first.
+	List(ctx context.Context, key Key) ([]Revision, error)
+
+	// Get returns a single revision of the application identified by the key. ErrNotFound is returned if the
+	// revision does not exist.
+	Get(ctx context.Context, key Key, revision int) (Revision, error)
+}
+
+//go:generate mockgen -destination=./mock_history.go -package=history -self_package github.com/radius-project/radius/pkg/cli/history github.com/radius-project/radius/pkg/cli/history Interface
+
+// Key identifies the application that a revision belongs to.
+type Key struct {
+	// Workspace is the name of the workspace used for the deployment.
+	Workspace string
+
+	// Scope is the resource group scope of the application, eg: /planes/radius/local/resourceGroups/default.
+	Scope string
+
+	// Application is the name of the application.
+	Application string
+}
+
+// Revision is a single deployment of an application.
+type Revision struct {
+	// Revision is the revision number. Revision numbers start at 1 and increase with each deployment.
+	Revision int `json:"revision"`
+
+	// Timestamp is the time the deployment completed.
+	Timestamp time.Time `json:"timestamp"`
+
+	// User is the name of the user who made the deployment.
+	User string `json:"user,omitempty"`
+
+	// Status is the result of the deployment, either StatusSucceeded or StatusFailed.
+	Status string `json:"status"`
+
+	// Error is the error message of a failed deployment.
+	Error string `json:"error,omitempty"`
+
+	// TemplateFile is the path of the template that was deployed.
+	TemplateFile string `json:"templateFile"`
+
+	// TemplateHash is the SHA-256 hash of the compiled template.
+	TemplateHash string `json:"templateHash"`
+
+	// Environment is the name of the environment the application was deployed to.
+	Environment string `json:"environment"`
+
+	// RollbackOf is the revision that this revision redeployed, if it was created by `rad app rollback`.
+	RollbackOf int `json:"rollbackOf,omitempty"`
+
+	// Resources are the IDs of the resources created or updated by the deployment.
+	Resources []string `json:"resources,omitempty"`
+
+	// Template is the compiled template that was deployed.
+	Template map[string]any `json:"template,omitempty"`
+
+	// Parameters are the parameters of the deployment, including the injected environment and application. The
+	// values of secure parameters are not recorded.
+	Parameters clients.DeploymentParameters `json:"parameters,omitempty"`
+
+	// SecureParameters are the names of the secure parameters of the deployment. Their values are not recorded and
+	// must be provided again when rolling back to the revision.
+	SecureParameters []string `json:"secureParameters,omitempty"`
+
+	// Providers are the providers used for the deployment.
+	Providers *clients.Providers `json:"providers,omitempty"`
+}
+
+// HashTemplate returns the SHA-256 hash of the template. The hash is computed over the JSON encoding of the template,
+// which has sorted keys, so it does not depend on the formatting of the template file.
+func HashTemplate(template map[string]any) (string, error) {
+	b, err := json.Marshal(template)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(b)
+	return hex.EncodeToString(hash[:]), nil
+}
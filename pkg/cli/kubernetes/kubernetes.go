@@ -36,6 +36,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/output"
 	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
 	"github.com/radius-project/radius/pkg/kubeutil"
+	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
 )
 
 var (
@@ -51,6 +52,7 @@ func init() {
 	_ = clientgoscheme.AddToScheme(Scheme)
 	_ = contourv1.AddToScheme(Scheme)
 	_ = radappiov1alpha3.AddToScheme(Scheme)
+	_ = ucpv1alpha1.AddToScheme(Scheme)
 }
 
 // NewDynamicClient creates a new dynamic client by context name, otherwise returns an error.
@@ -19,7 +19,14 @@ package output
 const (
 	FormatJson    = "json"
 	FormatTable   = "table"
+	FormatYaml    = "yaml"
 	DefaultFormat = FormatTable
+
+	// FormatJSONPathPrefix is the prefix of the jsonpath format. The expression follows the prefix, eg: jsonpath={.name}.
+	FormatJSONPathPrefix = "jsonpath="
+
+	// FormatGoTemplatePrefix is the prefix of the go-template format. The template follows the prefix, eg: go-template={{.name}}.
+	FormatGoTemplatePrefix = "go-template="
 )
 
 // SupportedFormats returns a slice of strings containing the supported formats for a request.
@@ -27,5 +34,8 @@ func SupportedFormats() []string {
 	return []string{
 		FormatJson,
 		FormatTable,
+		FormatYaml,
+		FormatJSONPathPrefix + "<expression>",
+		FormatGoTemplatePrefix + "<template>",
 	}
 }
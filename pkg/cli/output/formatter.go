@@ -17,6 +17,8 @@ limitations under the License.
 package output
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"reflect"
@@ -46,18 +48,55 @@ type Formatter interface {
 }
 
 // NewFormatter takes in a string and returns a Formatter interface and an error if the format is not supported.
+// The jsonpath and go-template formats carry their expression after the prefix, and the expression is parsed
+// so that invalid expressions are reported before any output is written.
 func NewFormatter(format string) (Formatter, error) {
-	normalized := strings.ToLower(strings.TrimSpace(format))
-	switch normalized {
-	case FormatJson:
+	trimmed := strings.TrimSpace(format)
+	normalized := strings.ToLower(trimmed)
+	switch {
+	case normalized == FormatJson:
 		return &JSONFormatter{}, nil
-	case FormatTable:
+	case normalized == FormatTable:
 		return &TableFormatter{}, nil
+	case normalized == FormatYaml:
+		return &YAMLFormatter{}, nil
+	case strings.HasPrefix(normalized, FormatJSONPathPrefix):
+		formatter := &JSONPathFormatter{Expression: trimmed[len(FormatJSONPathPrefix):]}
+		if _, err := formatter.parse(); err != nil {
+			return nil, err
+		}
+		return formatter, nil
+	case strings.HasPrefix(normalized, FormatGoTemplatePrefix):
+		formatter := &GoTemplateFormatter{Template: trimmed[len(FormatGoTemplatePrefix):]}
+		if _, err := formatter.parse(); err != nil {
+			return nil, err
+		}
+		return formatter, nil
 	default:
 		return nil, fmt.Errorf("unsupported format %s", format)
 	}
 }
 
+// convertToJSONObject converts the object to its JSON representation (maps, slices and scalars) so that expressions
+// and templates use the same field names as the json and yaml formats.
+func convertToJSONObject(obj any) (any, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	var result any
+	err = decoder.Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func convertToSlice(obj any) ([]any, error) {
 	// We use reflection here because we're building a table and thus need to handle both scalars (structs)
 	// and slices/arrays of structs.
@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewFormatter(t *testing.T) {
+	tests := []struct {
+		format   string
+		expected Formatter
+	}{
+		{format: "json", expected: &JSONFormatter{}},
+		{format: "Table", expected: &TableFormatter{}},
+		{format: " yaml ", expected: &YAMLFormatter{}},
+		{format: "jsonpath={.Name}", expected: &JSONPathFormatter{Expression: "{.Name}"}},
+		{format: "go-template={{.Name}}", expected: &GoTemplateFormatter{Template: "{{.Name}}"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			formatter, err := NewFormatter(tt.format)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, formatter)
+		})
+	}
+}
+
+func Test_NewFormatter_Invalid(t *testing.T) {
+	formats := []string{
+		"xml",
+		"jsonpath=",
+		"jsonpath={.name",
+		"go-template=",
+		"go-template={{.name",
+	}
+
+	for _, format := range formats {
+		t.Run(format, func(t *testing.T) {
+			_, err := NewFormatter(format)
+			require.Error(t, err)
+		})
+	}
+}
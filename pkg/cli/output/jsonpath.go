@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+type JSONPathFormatter struct {
+	// Expression is the JSONPath expression to evaluate, eg: {.name}. The surrounding braces are optional.
+	Expression string
+}
+
+// Format takes in an object, a writer and an options object and evaluates the JSONPath expression against the JSON
+// representation of the object, writing the result to the writer. An error is returned if the expression is invalid
+// or cannot be evaluated.
+func (f *JSONPathFormatter) Format(obj any, writer io.Writer, options FormatterOptions) error {
+	p, err := f.parse()
+	if err != nil {
+		return err
+	}
+
+	data, err := convertToJSONObject(obj)
+	if err != nil {
+		return err
+	}
+
+	err = p.Execute(writer, data)
+	if err != nil {
+		return fmt.Errorf("failed to evaluate jsonpath expression %q: %w", f.Expression, err)
+	}
+
+	_, err = writer.Write([]byte("\n"))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (f *JSONPathFormatter) parse() (*jsonpath.JSONPath, error) {
+	expression := strings.TrimSpace(f.Expression)
+	if expression == "" {
+		return nil, errors.New("jsonpath format requires an expression, eg: jsonpath={.name}")
+	}
+
+	// Allow the shorthand form used by kubectl, eg: jsonpath=.name
+	if !strings.Contains(expression, "{") {
+		expression = "{" + expression + "}"
+	}
+
+	p := jsonpath.New("output")
+	err := p.Parse(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jsonpath expression %q: %w", f.Expression, err)
+	}
+
+	return p, nil
+}
+
+var _ Formatter = (*JSONPathFormatter)(nil)
@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type jsonPathInput struct {
+	Name       string             `json:"name"`
+	Properties jsonPathProperties `json:"properties"`
+}
+
+type jsonPathProperties struct {
+	Replicas int64 `json:"replicas"`
+}
+
+func Test_JSONPath_Scalar(t *testing.T) {
+	obj := jsonPathInput{Name: "frontend", Properties: jsonPathProperties{Replicas: 3}}
+
+	formatter := &JSONPathFormatter{Expression: "{.name} {.properties.replicas}"}
+
+	buffer := &bytes.Buffer{}
+	err := formatter.Format(obj, buffer, FormatterOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "frontend 3\n", buffer.String())
+}
+
+func Test_JSONPath_Slice(t *testing.T) {
+	obj := []jsonPathInput{{Name: "frontend"}, {Name: "backend"}}
+
+	formatter := &JSONPathFormatter{Expression: `{range [*]}{.name}{"\n"}{end}`}
+
+	buffer := &bytes.Buffer{}
+	err := formatter.Format(obj, buffer, FormatterOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "frontend\nbackend\n\n", buffer.String())
+}
+
+func Test_JSONPath_WithoutBraces(t *testing.T) {
+	obj := jsonPathInput{Name: "frontend"}
+
+	formatter := &JSONPathFormatter{Expression: ".name"}
+
+	buffer := &bytes.Buffer{}
+	err := formatter.Format(obj, buffer, FormatterOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "frontend\n", buffer.String())
+}
+
+func Test_JSONPath_Invalid(t *testing.T) {
+	formatter := &JSONPathFormatter{Expression: "{.name"}
+
+	buffer := &bytes.Buffer{}
+	err := formatter.Format(jsonPathInput{}, buffer, FormatterOptions{})
+	require.Error(t, err)
+	require.Empty(t, buffer.String())
+}
+
+func Test_JSONPath_MissingKey(t *testing.T) {
+	formatter := &JSONPathFormatter{Expression: "{.missing}"}
+
+	buffer := &bytes.Buffer{}
+	err := formatter.Format(jsonPathInput{}, buffer, FormatterOptions{})
+	require.Error(t, err)
+}
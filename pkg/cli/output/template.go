@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+)
+
+type GoTemplateFormatter struct {
+	// Template is the Go template to execute, eg: {{.name}}.
+	Template string
+}
+
+// Format takes in an object, a writer and an options object and executes the Go template against the JSON
+// representation of the object, writing the result to the writer. An error is returned if the template is invalid
+// or cannot be executed.
+func (f *GoTemplateFormatter) Format(obj any, writer io.Writer, options FormatterOptions) error {
+	t, err := f.parse()
+	if err != nil {
+		return err
+	}
+
+	data, err := convertToJSONObject(obj)
+	if err != nil {
+		return err
+	}
+
+	err = t.Execute(writer, data)
+	if err != nil {
+		return fmt.Errorf("failed to execute go-template: %w", err)
+	}
+
+	return nil
+}
+
+func (f *GoTemplateFormatter) parse() (*template.Template, error) {
+	if strings.TrimSpace(f.Template) == "" {
+		return nil, errors.New("go-template format requires a template, eg: go-template={{.name}}")
+	}
+
+	t, err := template.New("output").Parse(f.Template)
+	if err != nil {
+		return nil, fmt.Errorf("invalid go-template: %w", err)
+	}
+
+	return t, nil
+}
+
+var _ Formatter = (*GoTemplateFormatter)(nil)
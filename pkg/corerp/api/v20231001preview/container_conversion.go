@@ -29,8 +29,77 @@ import (
 func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 	// Note: SystemData conversion isn't required since this property comes ARM and datastore.
 
+	converted := &datamodel.ContainerResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       to.String(src.ID),
+				Name:     to.String(src.Name),
+				Type:     to.String(src.Type),
+				Location: to.String(src.Location),
+				Tags:     to.StringMap(src.Tags),
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion:      Version,
+				AsyncProvisioningState: toProvisioningStateDataModel(src.Properties.ProvisioningState),
+			},
+		},
+		Properties: datamodel.ContainerProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Application: to.String(src.Properties.Application),
+			},
+			Connections:          toConnectionsDataModel(src.Properties.Connections),
+			Container:            toContainerDataModel(src.Properties.Container),
+			Extensions:           toExtensionsDataModel(src.Properties.Extensions),
+			Identity:             toIdentitySettingsDataModel(src.Properties.Identity),
+			Runtimes:             toRuntimePropertiesDataModel(src.Properties.Runtimes),
+			ResourceProvisioning: toContainerResourceProvisioningDataModel(src.Properties.ResourceProvisioning),
+			Resources:            toResourceReferencesDataModel(src.Properties.Resources),
+			RestartPolicy:        toRestartPolicyDataModel(src.Properties.RestartPolicy),
+			RolloutStrategy:      toRolloutStrategyDataModel(src.Properties.RolloutStrategy),
+			DisruptionBudget:     toDisruptionBudgetDataModel(src.Properties.DisruptionBudget),
+		},
+	}
+
+	return converted, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned Container resource.
+func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
+	c, ok := src.(*datamodel.ContainerResource)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(c.ID)
+	dst.Name = to.Ptr(c.Name)
+	dst.Type = to.Ptr(c.Type)
+	dst.SystemData = fromSystemDataModel(c.SystemData)
+	dst.Location = to.Ptr(c.Location)
+	dst.Tags = *to.StringMapPtr(c.Tags)
+	dst.Properties = &ContainerProperties{
+		Status: &ResourceStatus{
+			OutputResources: toOutputResourcesDataModel(c.Properties.Status.OutputResources),
+		},
+		ProvisioningState:    fromProvisioningStateDataModel(c.InternalMetadata.AsyncProvisioningState),
+		Application:          to.Ptr(c.Properties.Application),
+		Connections:          fromConnectionsDataModel(c.Properties.Connections),
+		Container:            fromContainerDataModel(c.Properties.Container),
+		Extensions:           fromExtensionsDataModel(c.Properties.Extensions),
+		Identity:             fromIdentitySettingsDataModel(c.Properties.Identity),
+		Runtimes:             fromRuntimePropertiesDataModel(c.Properties.Runtimes),
+		Resources:            fromResourceReferencesDataModel(c.Properties.Resources),
+		ResourceProvisioning: fromContainerResourceProvisioningDataModel(c.Properties.ResourceProvisioning),
+		RestartPolicy:        fromRestartPolicyDataModel(c.Properties.RestartPolicy),
+		RolloutStrategy:      fromRolloutStrategyDataModel(c.Properties.RolloutStrategy),
+		DisruptionBudget:     fromDisruptionBudgetDataModel(c.Properties.DisruptionBudget),
+	}
+
+	return nil
+}
+
+func toConnectionsDataModel(c map[string]*ConnectionProperties) map[string]datamodel.ConnectionProperties {
 	connections := make(map[string]datamodel.ConnectionProperties)
-	for key, val := range src.Properties.Connections {
+	for key, val := range c {
 		if val != nil {
 			roles := []string{}
 			var kind datamodel.IAMKind
@@ -58,18 +127,56 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 		}
 	}
 
+	return connections
+}
+
+func fromConnectionsDataModel(c map[string]datamodel.ConnectionProperties) map[string]*ConnectionProperties {
+	connections := make(map[string]*ConnectionProperties)
+	for key, val := range c {
+		roles := []*string{}
+		var kind *IAMKind
+
+		for _, r := range val.IAM.Roles {
+			roles = append(roles, to.Ptr(r))
+		}
+
+		kind = fromKindDataModel(val.IAM.Kind)
+
+		var disableDefaultEnvVars bool
+		if val.DisableDefaultEnvVars != nil {
+			disableDefaultEnvVars = to.Bool(val.DisableDefaultEnvVars)
+		}
+
+		connections[key] = &ConnectionProperties{
+			Source:                to.Ptr(val.Source),
+			DisableDefaultEnvVars: &disableDefaultEnvVars,
+			Iam: &IamProperties{
+				Kind:  kind,
+				Roles: roles,
+			},
+		}
+	}
+
+	return connections
+}
+
+func toContainerDataModel(c *Container) datamodel.Container {
+	if c == nil {
+		return datamodel.Container{}
+	}
+
 	var livenessProbe datamodel.HealthProbeProperties
-	if src.Properties.Container.LivenessProbe != nil {
-		livenessProbe = toHealthProbePropertiesDataModel(src.Properties.Container.LivenessProbe)
+	if c.LivenessProbe != nil {
+		livenessProbe = toHealthProbePropertiesDataModel(c.LivenessProbe)
 	}
 
 	var readinessProbe datamodel.HealthProbeProperties
-	if src.Properties.Container.ReadinessProbe != nil {
-		readinessProbe = toHealthProbePropertiesDataModel(src.Properties.Container.ReadinessProbe)
+	if c.ReadinessProbe != nil {
+		readinessProbe = toHealthProbePropertiesDataModel(c.ReadinessProbe)
 	}
 
 	ports := make(map[string]datamodel.ContainerPort)
-	for key, val := range src.Properties.Container.Ports {
+	for key, val := range c.Ports {
 		port := datamodel.ContainerPort{
 			ContainerPort: to.Int32(val.ContainerPort),
 			Protocol:      toPortProtocolDataModel(val.Protocol),
@@ -88,115 +195,40 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 	}
 
 	var volumes map[string]datamodel.VolumeProperties
-	if src.Properties.Container.Volumes != nil {
+	if c.Volumes != nil {
 		volumes = make(map[string]datamodel.VolumeProperties)
-		for key, val := range src.Properties.Container.Volumes {
+		for key, val := range c.Volumes {
 			volumes[key] = toVolumePropertiesDataModel(val)
 		}
 	}
 
-	var extensions []datamodel.Extension
-	if src.Properties.Extensions != nil {
-		for _, e := range src.Properties.Extensions {
-			extensions = append(extensions, toExtensionDataModel(e))
-		}
+	return datamodel.Container{
+		Image:           to.String(c.Image),
+		ImagePullPolicy: toImagePullPolicyDataModel(c.ImagePullPolicy),
+		Env:             to.StringMap(c.Env),
+		LivenessProbe:   livenessProbe,
+		Ports:           ports,
+		ReadinessProbe:  readinessProbe,
+		Volumes:         volumes,
+		Command:         stringSlice(c.Command),
+		Args:            stringSlice(c.Args),
+		WorkingDir:      to.String(c.WorkingDir),
 	}
-
-	converted := &datamodel.ContainerResource{
-		BaseResource: v1.BaseResource{
-			TrackedResource: v1.TrackedResource{
-				ID:       to.String(src.ID),
-				Name:     to.String(src.Name),
-				Type:     to.String(src.Type),
-				Location: to.String(src.Location),
-				Tags:     to.StringMap(src.Tags),
-			},
-			InternalMetadata: v1.InternalMetadata{
-				UpdatedAPIVersion:      Version,
-				AsyncProvisioningState: toProvisioningStateDataModel(src.Properties.ProvisioningState),
-			},
-		},
-		Properties: datamodel.ContainerProperties{
-			BasicResourceProperties: rpv1.BasicResourceProperties{
-				Application: to.String(src.Properties.Application),
-			},
-			Connections: connections,
-			Container: datamodel.Container{
-				Image:           to.String(src.Properties.Container.Image),
-				ImagePullPolicy: toImagePullPolicyDataModel(src.Properties.Container.ImagePullPolicy),
-				Env:             to.StringMap(src.Properties.Container.Env),
-				LivenessProbe:   livenessProbe,
-				Ports:           ports,
-				ReadinessProbe:  readinessProbe,
-				Volumes:         volumes,
-				Command:         stringSlice(src.Properties.Container.Command),
-				Args:            stringSlice(src.Properties.Container.Args),
-				WorkingDir:      to.String(src.Properties.Container.WorkingDir),
-			},
-			Extensions:           extensions,
-			Runtimes:             toRuntimePropertiesDataModel(src.Properties.Runtimes),
-			ResourceProvisioning: toContainerResourceProvisioningDataModel(src.Properties.ResourceProvisioning),
-			Resources:            toResourceReferencesDataModel(src.Properties.Resources),
-			RestartPolicy:        toRestartPolicyDataModel(src.Properties.RestartPolicy),
-		},
-	}
-
-	if src.Properties.Identity != nil {
-		converted.Properties.Identity = &rpv1.IdentitySettings{
-			Kind:       toIdentityKindDataModel(src.Properties.Identity.Kind),
-			OIDCIssuer: to.String(src.Properties.Identity.OidcIssuer),
-			Resource:   to.String(src.Properties.Identity.Resource),
-		}
-	}
-
-	return converted, nil
 }
 
-// ConvertFrom converts from version-agnostic datamodel to the versioned Container resource.
-func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
-	c, ok := src.(*datamodel.ContainerResource)
-	if !ok {
-		return v1.ErrInvalidModelConversion
-	}
-
-	connections := make(map[string]*ConnectionProperties)
-	for key, val := range c.Properties.Connections {
-		roles := []*string{}
-		var kind *IAMKind
-
-		for _, r := range val.IAM.Roles {
-			roles = append(roles, to.Ptr(r))
-		}
-
-		kind = fromKindDataModel(val.IAM.Kind)
-
-		var disableDefaultEnvVars bool
-		if val.DisableDefaultEnvVars != nil {
-			disableDefaultEnvVars = to.Bool(val.DisableDefaultEnvVars)
-		}
-
-		connections[key] = &ConnectionProperties{
-			Source:                to.Ptr(val.Source),
-			DisableDefaultEnvVars: &disableDefaultEnvVars,
-			Iam: &IamProperties{
-				Kind:  kind,
-				Roles: roles,
-			},
-		}
-	}
-
+func fromContainerDataModel(c datamodel.Container) *Container {
 	var livenessProbe HealthProbePropertiesClassification
-	if !c.Properties.Container.LivenessProbe.IsEmpty() {
-		livenessProbe = fromHealthProbePropertiesDataModel(c.Properties.Container.LivenessProbe)
+	if !c.LivenessProbe.IsEmpty() {
+		livenessProbe = fromHealthProbePropertiesDataModel(c.LivenessProbe)
 	}
 
 	var readinessProbe HealthProbePropertiesClassification
-	if !c.Properties.Container.ReadinessProbe.IsEmpty() {
-		readinessProbe = fromHealthProbePropertiesDataModel(c.Properties.Container.ReadinessProbe)
+	if !c.ReadinessProbe.IsEmpty() {
+		readinessProbe = fromHealthProbePropertiesDataModel(c.ReadinessProbe)
 	}
 
 	ports := make(map[string]*ContainerPortProperties)
-	for key, val := range c.Properties.Container.Ports {
+	for key, val := range c.Ports {
 		ports[key] = &ContainerPortProperties{
 			ContainerPort: to.Ptr(val.ContainerPort),
 			Protocol:      fromPortProtocolDataModel(val.Protocol),
@@ -213,63 +245,67 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 	}
 
 	var volumes map[string]VolumeClassification
-	if c.Properties.Container.Volumes != nil {
+	if c.Volumes != nil {
 		volumes = make(map[string]VolumeClassification)
-		for key, val := range c.Properties.Container.Volumes {
+		for key, val := range c.Volumes {
 			volumes[key] = fromVolumePropertiesDataModel(val)
 		}
 	}
 
+	return &Container{
+		Image:           to.Ptr(c.Image),
+		ImagePullPolicy: fromImagePullPolicyDataModel(c.ImagePullPolicy),
+		Env:             *to.StringMapPtr(c.Env),
+		LivenessProbe:   livenessProbe,
+		Ports:           ports,
+		ReadinessProbe:  readinessProbe,
+		Volumes:         volumes,
+		Command:         to.SliceOfPtrs(c.Command...),
+		Args:            to.SliceOfPtrs(c.Args...),
+		WorkingDir:      to.Ptr(c.WorkingDir),
+	}
+}
+
+func toExtensionsDataModel(e []ExtensionClassification) []datamodel.Extension {
+	var extensions []datamodel.Extension
+	for _, ext := range e {
+		extensions = append(extensions, toExtensionDataModel(ext))
+	}
+
+	return extensions
+}
+
+func fromExtensionsDataModel(e []datamodel.Extension) []ExtensionClassification {
 	var extensions []ExtensionClassification
-	if c.Properties.Extensions != nil {
-		for _, e := range c.Properties.Extensions {
-			extensions = append(extensions, fromExtensionClassificationDataModel(e))
-		}
+	for _, ext := range e {
+		extensions = append(extensions, fromExtensionClassificationDataModel(ext))
 	}
 
-	var identity *IdentitySettings
-	if c.Properties.Identity != nil {
-		identity = &IdentitySettings{
-			Kind:       fromIdentityKind(c.Properties.Identity.Kind),
-			Resource:   to.Ptr(c.Properties.Identity.Resource),
-			OidcIssuer: to.Ptr(c.Properties.Identity.OIDCIssuer),
-		}
+	return extensions
+}
+
+func toIdentitySettingsDataModel(identity *IdentitySettings) *rpv1.IdentitySettings {
+	if identity == nil {
+		return nil
 	}
 
-	dst.ID = to.Ptr(c.ID)
-	dst.Name = to.Ptr(c.Name)
-	dst.Type = to.Ptr(c.Type)
-	dst.SystemData = fromSystemDataModel(c.SystemData)
-	dst.Location = to.Ptr(c.Location)
-	dst.Tags = *to.StringMapPtr(c.Tags)
-	dst.Properties = &ContainerProperties{
-		Status: &ResourceStatus{
-			OutputResources: toOutputResourcesDataModel(c.Properties.Status.OutputResources),
-		},
-		ProvisioningState: fromProvisioningStateDataModel(c.InternalMetadata.AsyncProvisioningState),
-		Application:       to.Ptr(c.Properties.Application),
-		Connections:       connections,
-		Container: &Container{
-			Image:           to.Ptr(c.Properties.Container.Image),
-			ImagePullPolicy: fromImagePullPolicyDataModel(c.Properties.Container.ImagePullPolicy),
-			Env:             *to.StringMapPtr(c.Properties.Container.Env),
-			LivenessProbe:   livenessProbe,
-			Ports:           ports,
-			ReadinessProbe:  readinessProbe,
-			Volumes:         volumes,
-			Command:         to.SliceOfPtrs(c.Properties.Container.Command...),
-			Args:            to.SliceOfPtrs(c.Properties.Container.Args...),
-			WorkingDir:      to.Ptr(c.Properties.Container.WorkingDir),
-		},
-		Extensions:           extensions,
-		Identity:             identity,
-		Runtimes:             fromRuntimePropertiesDataModel(c.Properties.Runtimes),
-		Resources:            fromResourceReferencesDataModel(c.Properties.Resources),
-		ResourceProvisioning: fromContainerResourceProvisioningDataModel(c.Properties.ResourceProvisioning),
-		RestartPolicy:        fromRestartPolicyDataModel(c.Properties.RestartPolicy),
+	return &rpv1.IdentitySettings{
+		Kind:       toIdentityKindDataModel(identity.Kind),
+		OIDCIssuer: to.String(identity.OidcIssuer),
+		Resource:   to.String(identity.Resource),
 	}
+}
 
-	return nil
+func fromIdentitySettingsDataModel(identity *rpv1.IdentitySettings) *IdentitySettings {
+	if identity == nil {
+		return nil
+	}
+
+	return &IdentitySettings{
+		Kind:       fromIdentityKind(identity.Kind),
+		Resource:   to.Ptr(identity.Resource),
+		OidcIssuer: to.Ptr(identity.OIDCIssuer),
+	}
 }
 
 func toImagePullPolicyDataModel(pullPolicy *ImagePullPolicy) string {
@@ -628,6 +664,76 @@ func fromRestartPolicyDataModel(rp string) *RestartPolicy {
 	}
 }
 
+func toRolloutStrategyDataModel(rs *RolloutStrategy) *datamodel.RolloutStrategy {
+	if rs == nil {
+		return nil
+	}
+
+	r := &datamodel.RolloutStrategy{
+		Kind:           datamodel.RolloutStrategyKindRollingUpdate,
+		MaxSurge:       to.String(rs.MaxSurge),
+		MaxUnavailable: to.String(rs.MaxUnavailable),
+	}
+
+	if rs.Kind != nil && *rs.Kind == RolloutStrategyKindRecreate {
+		r.Kind = datamodel.RolloutStrategyKindRecreate
+	}
+
+	return r
+}
+
+func fromRolloutStrategyDataModel(rs *datamodel.RolloutStrategy) *RolloutStrategy {
+	if rs == nil {
+		return nil
+	}
+
+	r := &RolloutStrategy{
+		Kind: to.Ptr(RolloutStrategyKindRollingUpdate),
+	}
+
+	if rs.Kind == datamodel.RolloutStrategyKindRecreate {
+		r.Kind = to.Ptr(RolloutStrategyKindRecreate)
+	}
+
+	if rs.MaxSurge != "" {
+		r.MaxSurge = to.Ptr(rs.MaxSurge)
+	}
+
+	if rs.MaxUnavailable != "" {
+		r.MaxUnavailable = to.Ptr(rs.MaxUnavailable)
+	}
+
+	return r
+}
+
+func toDisruptionBudgetDataModel(db *DisruptionBudget) *datamodel.DisruptionBudget {
+	if db == nil {
+		return nil
+	}
+
+	return &datamodel.DisruptionBudget{
+		MinAvailable:   to.String(db.MinAvailable),
+		MaxUnavailable: to.String(db.MaxUnavailable),
+	}
+}
+
+func fromDisruptionBudgetDataModel(db *datamodel.DisruptionBudget) *DisruptionBudget {
+	if db == nil {
+		return nil
+	}
+
+	d := &DisruptionBudget{}
+	if db.MinAvailable != "" {
+		d.MinAvailable = to.Ptr(db.MinAvailable)
+	}
+
+	if db.MaxUnavailable != "" {
+		d.MaxUnavailable = to.Ptr(db.MaxUnavailable)
+	}
+
+	return d
+}
+
 func toPermissionDataModel(rbac *VolumePermission) datamodel.VolumePermission {
 	if rbac == nil {
 		return datamodel.VolumePermissionRead
@@ -61,6 +61,11 @@ func TestContainerConvertVersionedToDataModel(t *testing.T) {
 			err:      nil,
 			emptyExt: true,
 		},
+		{
+			filename: "containerresource-rollout.json",
+			err:      nil,
+			emptyExt: true,
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -91,6 +96,16 @@ func TestContainerConvertVersionedToDataModel(t *testing.T) {
 					return
 				}
 
+				if tt.filename == "containerresource-rollout.json" {
+					require.Equal(t, &datamodel.RolloutStrategy{
+						Kind:           datamodel.RolloutStrategyKindRollingUpdate,
+						MaxSurge:       "1",
+						MaxUnavailable: "25%",
+					}, ct.Properties.RolloutStrategy)
+					require.Equal(t, &datamodel.DisruptionBudget{MinAvailable: "50%"}, ct.Properties.DisruptionBudget)
+					return
+				}
+
 				val, ok := ct.Properties.Connections["inventory"]
 				require.True(t, ok)
 				require.Equal(t, "inventory_route_id", val.Source)
@@ -148,6 +163,9 @@ func TestContainerConvertDataModelToVersioned(t *testing.T) {
 		{
 			filename: "containerresourcedatamodel-manual.json",
 		},
+		{
+			filename: "containerresourcedatamodel-rollout.json",
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -176,6 +194,12 @@ func TestContainerConvertDataModelToVersioned(t *testing.T) {
 					return
 				}
 
+				if tt.filename == "containerresourcedatamodel-rollout.json" {
+					require.Equal(t, &RolloutStrategy{Kind: to.Ptr(RolloutStrategyKindRecreate)}, versioned.Properties.RolloutStrategy)
+					require.Equal(t, &DisruptionBudget{MaxUnavailable: to.Ptr("1")}, versioned.Properties.DisruptionBudget)
+					return
+				}
+
 				val, ok := r.Properties.Connections["inventory"]
 				require.True(t, ok)
 				require.Equal(t, "inventory_route_id", val.Source)
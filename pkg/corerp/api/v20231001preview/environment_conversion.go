@@ -115,6 +115,10 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 		converted.Properties.Extensions = extensions
 	}
 
+	if src.Properties.ExtenderKinds != nil {
+		converted.Properties.ExtenderKinds = toExtenderKindsDataModel(src.Properties.ExtenderKinds)
+	}
+
 	return converted, nil
 }
 
@@ -133,6 +137,7 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Tags = *to.StringMapPtr(env.Tags)
 	dst.Properties = &EnvironmentProperties{
 		ProvisioningState: fromProvisioningStateDataModel(env.InternalMetadata.AsyncProvisioningState),
+		DeletedTime:       env.InternalMetadata.DeletedTime,
 	}
 
 	dst.Properties.Compute = fromEnvironmentComputeDataModel(&env.Properties.Compute)
@@ -177,9 +182,41 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 		dst.Properties.Extensions = extensions
 	}
 
+	if env.Properties.ExtenderKinds != nil {
+		dst.Properties.ExtenderKinds = fromExtenderKindsDataModel(env.Properties.ExtenderKinds)
+	}
+
 	return nil
 }
 
+func toExtenderKindsDataModel(kinds map[string]*ExtenderKindProperties) map[string]datamodel.ExtenderKindProperties {
+	converted := map[string]datamodel.ExtenderKindProperties{}
+	for name, kind := range kinds {
+		if kind == nil {
+			converted[name] = datamodel.ExtenderKindProperties{}
+			continue
+		}
+		converted[name] = datamodel.ExtenderKindProperties{
+			Schema:          kind.Schema,
+			RequiredOutputs: stringSlice(kind.RequiredOutputs),
+			RequiredSecrets: stringSlice(kind.RequiredSecrets),
+		}
+	}
+	return converted
+}
+
+func fromExtenderKindsDataModel(kinds map[string]datamodel.ExtenderKindProperties) map[string]*ExtenderKindProperties {
+	converted := map[string]*ExtenderKindProperties{}
+	for name, kind := range kinds {
+		converted[name] = &ExtenderKindProperties{
+			Schema:          kind.Schema,
+			RequiredOutputs: to.SliceOfPtrs(kind.RequiredOutputs...),
+			RequiredSecrets: to.SliceOfPtrs(kind.RequiredSecrets...),
+		}
+	}
+	return converted
+}
+
 func toEnvironmentComputeDataModel(h EnvironmentComputeClassification) (*rpv1.EnvironmentCompute, error) {
 	switch v := h.(type) {
 	case *KubernetesCompute:
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
@@ -269,6 +270,49 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			filename: "environmentresource-with-extender-kinds.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					ExtenderKinds: map[string]datamodel.ExtenderKindProperties{
+						"postgres": {
+							Schema: map[string]any{
+								"type": "object",
+								"properties": map[string]any{
+									"database": map[string]any{
+										"type": "string",
+									},
+								},
+								"required": []any{"database"},
+							},
+							RequiredOutputs: []string{"host", "port"},
+							RequiredSecrets: []string{"password"},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
 		{
 			filename: "environmentresource-invalid-missing-namespace.json",
 			err:      &v1.ErrModelConversion{PropertyName: "$.properties.compute.namespace", ValidValue: "63 characters or less"},
@@ -441,6 +485,49 @@ func TestConvertDataModelWithIdentityToVersioned(t *testing.T) {
 	require.Equal(t, "https://oidcurl/guid", string(*versioned.Properties.Compute.GetEnvironmentCompute().Identity.OidcIssuer))
 }
 
+func TestConvertDataModelWithExtenderKindsToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("environmentresourcedatamodel-with-extender-kinds.json")
+	r := &datamodel.Environment{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &EnvironmentResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, map[string]*ExtenderKindProperties{
+		"postgres": {
+			Schema: map[string]any{
+				"type":     "object",
+				"required": []any{"database"},
+			},
+			RequiredOutputs: []*string{to.Ptr("host"), to.Ptr("port")},
+			RequiredSecrets: []*string{to.Ptr("password")},
+		},
+	}, versioned.Properties.ExtenderKinds)
+}
+
+func TestConvertDeletedDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("environmentresourcedatamodel.json")
+	r := &datamodel.Environment{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+	deletedTime := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	r.DeletedTime = &deletedTime
+
+	// act
+	versioned := &EnvironmentResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, &deletedTime, versioned.Properties.DeletedTime)
+}
+
 func TestConvertFromValidation(t *testing.T) {
 	validationTests := []struct {
 		src v1.DataModelInterface
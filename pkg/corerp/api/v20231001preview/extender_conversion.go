@@ -48,6 +48,7 @@ func (src *ExtenderResource) ConvertTo() (v1.DataModelInterface, error) {
 				Environment: to.String(src.Properties.Environment),
 				Application: to.String(src.Properties.Application),
 			},
+			Kind:                 to.String(src.Properties.Kind),
 			AdditionalProperties: src.Properties.AdditionalProperties,
 			Secrets:              src.Properties.Secrets,
 			ResourceRecipe:       toRecipeDataModel(src.Properties.Recipe),
@@ -83,6 +84,7 @@ func (dst *ExtenderResource) ConvertFrom(src v1.DataModelInterface) error {
 		ProvisioningState:    fromProvisioningStateDataModel(extender.InternalMetadata.AsyncProvisioningState),
 		Environment:          to.Ptr(extender.Properties.Environment),
 		Application:          to.Ptr(extender.Properties.Application),
+		Kind:                 toStringPtr(extender.Properties.Kind),
 		AdditionalProperties: extender.Properties.AdditionalProperties,
 		Recipe:               fromRecipeDataModel(extender.Properties.ResourceRecipe),
 		ResourceProvisioning: fromResourceProvisioningDataModel(extender.Properties.ResourceProvisioning),
@@ -123,6 +123,36 @@ func TestExtender_ConvertVersionedToDataModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "extender resource with kind",
+			file: "extender_kind.json",
+			expected: &datamodel.Extender{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/extenders/extender0",
+						Name: "extender0",
+						Type: datamodel.ExtenderResourceType,
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+					SystemData: v1.SystemData{},
+				},
+				Properties: datamodel.ExtenderProperties{
+					BasicResourceProperties: rpv1.BasicResourceProperties{
+						Application: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/applications/testApplication",
+						Environment: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+					},
+					Kind:                 "postgres",
+					AdditionalProperties: map[string]any{"database": "orders"},
+					ResourceProvisioning: portableresources.ResourceProvisioningRecipe,
+					ResourceRecipe:       portableresources.ResourceRecipe{Name: "test-recipe"},
+				},
+			},
+		},
 	}
 
 	for _, payload := range testset {
@@ -214,6 +244,29 @@ func TestExtender_ConvertDataModelToVersioned(t *testing.T) {
 				Type: to.Ptr(datamodel.ExtenderResourceType),
 			},
 		},
+		{
+			desc: "extender resource with kind datamodel",
+			file: "extenderdatamodel_kind.json",
+			expected: &ExtenderResource{
+				Location: to.Ptr(""),
+				Properties: &ExtenderProperties{
+					Environment:          to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0"),
+					Application:          to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/applications/testApplication"),
+					Kind:                 to.Ptr("postgres"),
+					ResourceProvisioning: to.Ptr(ResourceProvisioningRecipe),
+					ProvisioningState:    to.Ptr(ProvisioningStateAccepted),
+					Recipe:               &Recipe{Name: to.Ptr("test-recipe"), Parameters: nil},
+					AdditionalProperties: map[string]any{"database": "orders"},
+					Status:               &ResourceStatus{},
+				},
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/extenders/extender0"),
+				Name: to.Ptr("extender0"),
+				Type: to.Ptr(datamodel.ExtenderResourceType),
+			},
+		},
 	}
 
 	for _, tc := range testset {
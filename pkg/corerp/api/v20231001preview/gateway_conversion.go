@@ -49,6 +49,10 @@ func (src *GatewayResource) ConvertTo() (v1.DataModelInterface, error) {
 				Destination:   to.String(r.Destination),
 				Path:          to.String(r.Path),
 				ReplacePrefix: to.String(r.ReplacePrefix),
+				Destinations:  toGatewayRouteDestinationsDataModel(r.Destinations),
+				Headers:       toGatewayRouteHeadersDataModel(r.Headers),
+				Cookies:       toGatewayRouteCookiesDataModel(r.Cookies),
+				Mirror:        to.String(r.Mirror),
 			}
 			routes = append(routes, s)
 		}
@@ -113,6 +117,10 @@ func (dst *GatewayResource) ConvertFrom(src v1.DataModelInterface) error {
 				Destination:   to.Ptr(r.Destination),
 				Path:          to.Ptr(r.Path),
 				ReplacePrefix: to.Ptr(r.ReplacePrefix),
+				Destinations:  fromGatewayRouteDestinationsDataModel(r.Destinations),
+				Headers:       fromGatewayRouteHeadersDataModel(r.Headers),
+				Cookies:       fromGatewayRouteCookiesDataModel(r.Cookies),
+				Mirror:        toStringPtr(r.Mirror),
 			}
 			routes = append(routes, s)
 		}
@@ -175,3 +183,76 @@ func fromTLSMinVersionDataModel(tlsMinVersion datamodel.MinimumTLSProtocolVersio
 
 	return &t
 }
+
+func toGatewayRouteDestinationsDataModel(destinations []*GatewayRouteDestination) []datamodel.GatewayRouteDestination {
+	var converted []datamodel.GatewayRouteDestination
+	for _, d := range destinations {
+		converted = append(converted, datamodel.GatewayRouteDestination{
+			Destination: to.String(d.Destination),
+			Weight:      to.Int32(d.Weight),
+		})
+	}
+	return converted
+}
+
+func fromGatewayRouteDestinationsDataModel(destinations []datamodel.GatewayRouteDestination) []*GatewayRouteDestination {
+	var converted []*GatewayRouteDestination
+	for _, d := range destinations {
+		converted = append(converted, &GatewayRouteDestination{
+			Destination: to.Ptr(d.Destination),
+			Weight:      to.Ptr(d.Weight),
+		})
+	}
+	return converted
+}
+
+func toGatewayRouteHeadersDataModel(headers []*GatewayRouteHeaderMatch) []datamodel.GatewayRouteHeaderMatch {
+	var converted []datamodel.GatewayRouteHeaderMatch
+	for _, h := range headers {
+		converted = append(converted, datamodel.GatewayRouteHeaderMatch{
+			Name:     to.String(h.Name),
+			Exact:    to.String(h.Exact),
+			Contains: to.String(h.Contains),
+			Present:  to.Bool(h.Present),
+		})
+	}
+	return converted
+}
+
+func fromGatewayRouteHeadersDataModel(headers []datamodel.GatewayRouteHeaderMatch) []*GatewayRouteHeaderMatch {
+	var converted []*GatewayRouteHeaderMatch
+	for _, h := range headers {
+		m := &GatewayRouteHeaderMatch{
+			Name:     to.Ptr(h.Name),
+			Exact:    toStringPtr(h.Exact),
+			Contains: toStringPtr(h.Contains),
+		}
+		if h.Present {
+			m.Present = to.Ptr(true)
+		}
+		converted = append(converted, m)
+	}
+	return converted
+}
+
+func toGatewayRouteCookiesDataModel(cookies []*GatewayRouteCookieMatch) []datamodel.GatewayRouteCookieMatch {
+	var converted []datamodel.GatewayRouteCookieMatch
+	for _, c := range cookies {
+		converted = append(converted, datamodel.GatewayRouteCookieMatch{
+			Name:  to.String(c.Name),
+			Value: to.String(c.Value),
+		})
+	}
+	return converted
+}
+
+func fromGatewayRouteCookiesDataModel(cookies []datamodel.GatewayRouteCookieMatch) []*GatewayRouteCookieMatch {
+	var converted []*GatewayRouteCookieMatch
+	for _, c := range cookies {
+		converted = append(converted, &GatewayRouteCookieMatch{
+			Name:  to.Ptr(c.Name),
+			Value: to.Ptr(c.Value),
+		})
+	}
+	return converted
+}
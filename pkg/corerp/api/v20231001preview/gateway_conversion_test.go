@@ -23,6 +23,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/testutil"
 	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
 
@@ -81,6 +82,69 @@ func TestGatewayConvertDataModelToVersioned(t *testing.T) {
 	require.Equal(t, resourcetypeutil.MustPopulateResourceStatus(&ResourceStatus{}), versioned.Properties.Status)
 }
 
+func TestGatewayTrafficSplitConvertVersionedToDataModel(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresource-with-trafficsplit.json")
+	r := &GatewayResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	gw := dm.(*datamodel.Gateway)
+	require.Equal(t, []datamodel.GatewayRoute{
+		{
+			Path: "/",
+			Destinations: []datamodel.GatewayRouteDestination{
+				{Destination: "frontendv1", Weight: 90},
+				{Destination: "frontendv2", Weight: 10},
+			},
+			Mirror: "frontendshadow",
+		},
+		{
+			Path:        "/",
+			Destination: "frontendv2",
+			Headers: []datamodel.GatewayRouteHeaderMatch{
+				{Name: "x-canary", Exact: "true"},
+			},
+			Cookies: []datamodel.GatewayRouteCookieMatch{
+				{Name: "version", Value: "v2"},
+			},
+		},
+	}, gw.Properties.Routes)
+}
+
+func TestGatewayTrafficSplitConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-trafficsplit.json")
+	r := &datamodel.Gateway{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &GatewayResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, versioned.Properties.Routes, 2)
+	require.Equal(t, []*GatewayRouteDestination{
+		{Destination: to.Ptr("frontendv1"), Weight: to.Ptr[int32](90)},
+		{Destination: to.Ptr("frontendv2"), Weight: to.Ptr[int32](10)},
+	}, versioned.Properties.Routes[0].Destinations)
+	require.Equal(t, "frontendshadow", *versioned.Properties.Routes[0].Mirror)
+	require.Equal(t, []*GatewayRouteHeaderMatch{
+		{Name: to.Ptr("x-canary"), Present: to.Ptr(true)},
+	}, versioned.Properties.Routes[1].Headers)
+	require.Equal(t, []*GatewayRouteCookieMatch{
+		{Name: to.Ptr("version"), Value: to.Ptr("v2")},
+	}, versioned.Properties.Routes[1].Cookies)
+	require.Nil(t, versioned.Properties.Routes[1].Mirror)
+}
+
 func TestGatewaySSLPassthroughConvertVersionedToDataModel(t *testing.T) {
 	// arrange
 	rawPayload := testutil.ReadFixture("gatewayresource-with-sslpassthrough.json")
@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+// ConvertTo converts from the versioned Job resource to version-agnostic datamodel.
+func (src *JobResource) ConvertTo() (v1.DataModelInterface, error) {
+	// Note: SystemData conversion isn't required since this property comes ARM and datastore.
+
+	converted := &datamodel.JobResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       to.String(src.ID),
+				Name:     to.String(src.Name),
+				Type:     to.String(src.Type),
+				Location: to.String(src.Location),
+				Tags:     to.StringMap(src.Tags),
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion:      Version,
+				AsyncProvisioningState: toProvisioningStateDataModel(src.Properties.ProvisioningState),
+			},
+		},
+		Properties: datamodel.JobProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Application: to.String(src.Properties.Application),
+				Environment: to.String(src.Properties.Environment),
+			},
+			Connections:              toConnectionsDataModel(src.Properties.Connections),
+			Container:                toContainerDataModel(src.Properties.Container),
+			Extensions:               toExtensionsDataModel(src.Properties.Extensions),
+			Identity:                 toIdentitySettingsDataModel(src.Properties.Identity),
+			Runtimes:                 toRuntimePropertiesDataModel(src.Properties.Runtimes),
+			RestartPolicy:            toRestartPolicyDataModel(src.Properties.RestartPolicy),
+			Schedule:                 to.String(src.Properties.Schedule),
+			ConcurrencyPolicy:        toJobConcurrencyPolicyDataModel(src.Properties.ConcurrencyPolicy),
+			Completions:              src.Properties.Completions,
+			Parallelism:              src.Properties.Parallelism,
+			BackoffLimit:             src.Properties.BackoffLimit,
+			ActiveDeadlineSeconds:    src.Properties.ActiveDeadlineSeconds,
+			TTLSecondsAfterFinished:  src.Properties.TTLSecondsAfterFinished,
+			CompletionTimeoutSeconds: src.Properties.CompletionTimeoutSeconds,
+		},
+	}
+
+	return converted, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned Job resource.
+func (dst *JobResource) ConvertFrom(src v1.DataModelInterface) error {
+	j, ok := src.(*datamodel.JobResource)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(j.ID)
+	dst.Name = to.Ptr(j.Name)
+	dst.Type = to.Ptr(j.Type)
+	dst.SystemData = fromSystemDataModel(j.SystemData)
+	dst.Location = to.Ptr(j.Location)
+	dst.Tags = *to.StringMapPtr(j.Tags)
+	dst.Properties = &JobProperties{
+		Status: &ResourceStatus{
+			OutputResources: toOutputResourcesDataModel(j.Properties.Status.OutputResources),
+		},
+		ProvisioningState:        fromProvisioningStateDataModel(j.InternalMetadata.AsyncProvisioningState),
+		Application:              to.Ptr(j.Properties.Application),
+		Environment:              to.Ptr(j.Properties.Environment),
+		Connections:              fromConnectionsDataModel(j.Properties.Connections),
+		Container:                fromContainerDataModel(j.Properties.Container),
+		Extensions:               fromExtensionsDataModel(j.Properties.Extensions),
+		Identity:                 fromIdentitySettingsDataModel(j.Properties.Identity),
+		Runtimes:                 fromRuntimePropertiesDataModel(j.Properties.Runtimes),
+		RestartPolicy:            fromRestartPolicyDataModel(j.Properties.RestartPolicy),
+		ConcurrencyPolicy:        fromJobConcurrencyPolicyDataModel(j.Properties.ConcurrencyPolicy),
+		Completions:              j.Properties.Completions,
+		Parallelism:              j.Properties.Parallelism,
+		BackoffLimit:             j.Properties.BackoffLimit,
+		ActiveDeadlineSeconds:    j.Properties.ActiveDeadlineSeconds,
+		TTLSecondsAfterFinished:  j.Properties.TTLSecondsAfterFinished,
+		CompletionTimeoutSeconds: j.Properties.CompletionTimeoutSeconds,
+	}
+
+	if j.Properties.Schedule != "" {
+		dst.Properties.Schedule = to.Ptr(j.Properties.Schedule)
+	}
+
+	return nil
+}
+
+func toJobConcurrencyPolicyDataModel(policy *JobConcurrencyPolicy) datamodel.JobConcurrencyPolicy {
+	if policy == nil {
+		return ""
+	}
+
+	switch *policy {
+	case JobConcurrencyPolicyAllow:
+		return datamodel.JobConcurrencyPolicyAllow
+	case JobConcurrencyPolicyForbid:
+		return datamodel.JobConcurrencyPolicyForbid
+	case JobConcurrencyPolicyReplace:
+		return datamodel.JobConcurrencyPolicyReplace
+	default:
+		return ""
+	}
+}
+
+func fromJobConcurrencyPolicyDataModel(policy datamodel.JobConcurrencyPolicy) *JobConcurrencyPolicy {
+	switch policy {
+	case datamodel.JobConcurrencyPolicyAllow:
+		return to.Ptr(JobConcurrencyPolicyAllow)
+	case datamodel.JobConcurrencyPolicyForbid:
+		return to.Ptr(JobConcurrencyPolicyForbid)
+	case datamodel.JobConcurrencyPolicyReplace:
+		return to.Ptr(JobConcurrencyPolicyReplace)
+	default:
+		return nil
+	}
+}
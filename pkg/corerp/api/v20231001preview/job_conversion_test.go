@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobConvertVersionedToDataModel(t *testing.T) {
+	// arrange
+	r := &JobResource{}
+	err := json.Unmarshal(testutil.ReadFixture("jobresource.json"), r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	job := dm.(*datamodel.JobResource)
+	require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/jobs/job0", job.ID)
+	require.Equal(t, "job0", job.Name)
+	require.Equal(t, "Applications.Core/jobs", job.Type)
+	require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/app0", job.Properties.Application)
+	require.Equal(t, "2023-10-01-preview", job.InternalMetadata.UpdatedAPIVersion)
+	require.Equal(t, "ghcr.io/radius-project/migrations", job.Properties.Container.Image)
+	require.Equal(t, []string{"/bin/sh"}, job.Properties.Container.Command)
+	require.Equal(t, []string{"-c", "./migrate.sh"}, job.Properties.Container.Args)
+	require.Equal(t, map[string]string{"LOG_LEVEL": "debug"}, job.Properties.Container.Env)
+	require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Datastores/sqlDatabases/db0", job.Properties.Connections["db"].Source)
+	require.Equal(t, "Never", job.Properties.RestartPolicy)
+	require.Equal(t, "0 * * * *", job.Properties.Schedule)
+	require.True(t, job.IsScheduled())
+	require.Equal(t, datamodel.JobConcurrencyPolicyForbid, job.Properties.ConcurrencyPolicy)
+	require.Equal(t, to.Ptr(int32(2)), job.Properties.Completions)
+	require.Equal(t, to.Ptr(int32(1)), job.Properties.Parallelism)
+	require.Equal(t, to.Ptr(int32(3)), job.Properties.BackoffLimit)
+	require.Equal(t, to.Ptr(int64(600)), job.Properties.ActiveDeadlineSeconds)
+	require.Equal(t, to.Ptr(int32(3600)), job.Properties.TTLSecondsAfterFinished)
+}
+
+func TestJobConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	r := &datamodel.JobResource{}
+	err := json.Unmarshal(testutil.ReadFixture("jobresourcedatamodel.json"), r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &JobResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/jobs/job0", to.String(versioned.ID))
+	require.Equal(t, "job0", to.String(versioned.Name))
+	require.Equal(t, "Applications.Core/jobs", to.String(versioned.Type))
+	require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/app0", to.String(versioned.Properties.Application))
+	require.Equal(t, "ghcr.io/radius-project/migrations", to.String(versioned.Properties.Container.Image))
+	require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Datastores/sqlDatabases/db0", to.String(versioned.Properties.Connections["db"].Source))
+	require.Equal(t, RestartPolicyOnFailure, *versioned.Properties.RestartPolicy)
+	require.Nil(t, versioned.Properties.Schedule)
+	require.Nil(t, versioned.Properties.ConcurrencyPolicy)
+	require.Equal(t, to.Ptr(int32(3)), versioned.Properties.Completions)
+	require.Equal(t, to.Ptr(int32(2)), versioned.Properties.BackoffLimit)
+	require.Nil(t, versioned.Properties.Parallelism)
+	require.Equal(t, "Job", to.String(versioned.Properties.Status.OutputResources[0].LocalID))
+}
+
+func TestJobConvertFromValidation(t *testing.T) {
+	validationTests := []struct {
+		src v1.DataModelInterface
+		err error
+	}{
+		{&resourcetypeutil.FakeResource{}, v1.ErrInvalidModelConversion},
+		{nil, v1.ErrInvalidModelConversion},
+	}
+
+	for _, tc := range validationTests {
+		versioned := &JobResource{}
+		err := versioned.ConvertFrom(tc.src)
+		require.ErrorAs(t, tc.err, &err)
+	}
+}
+
+func TestToJobConcurrencyPolicyDataModel(t *testing.T) {
+	tests := []struct {
+		versioned *JobConcurrencyPolicy
+		datamodel datamodel.JobConcurrencyPolicy
+	}{
+		{nil, ""},
+		{to.Ptr(JobConcurrencyPolicyAllow), datamodel.JobConcurrencyPolicyAllow},
+		{to.Ptr(JobConcurrencyPolicyForbid), datamodel.JobConcurrencyPolicyForbid},
+		{to.Ptr(JobConcurrencyPolicyReplace), datamodel.JobConcurrencyPolicyReplace},
+		{to.Ptr(JobConcurrencyPolicy("invalid")), ""},
+	}
+
+	for _, tc := range tests {
+		require.Equal(t, tc.datamodel, toJobConcurrencyPolicyDataModel(tc.versioned))
+		if tc.datamodel != "" {
+			require.Equal(t, tc.versioned, fromJobConcurrencyPolicyDataModel(tc.datamodel))
+		}
+	}
+}
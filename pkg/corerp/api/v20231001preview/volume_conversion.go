@@ -72,6 +72,18 @@ func (src *VolumeResource) ConvertTo() (v1.DataModelInterface, error) {
 			}
 		}
 		converted.Properties.AzureKeyVault = dm
+	case *KubernetesPersistentVolumeClaimVolumeProperties:
+		dm := &datamodel.KubernetesPersistentVolumeClaimVolumeProperties{
+			StorageClass:   to.String(p.StorageClass),
+			Size:           to.String(p.Size),
+			RetainOnDelete: to.Bool(p.RetainOnDelete),
+		}
+		for _, mode := range p.AccessModes {
+			if mode != nil {
+				dm.AccessModes = append(dm.AccessModes, datamodel.PersistentVolumeClaimAccessMode(*mode))
+			}
+		}
+		converted.Properties.KubernetesPersistentVolumeClaim = dm
 	}
 	return converted, nil
 }
@@ -121,6 +133,23 @@ func (dst *VolumeResource) ConvertFrom(src v1.DataModelInterface) error {
 			}
 		}
 		dst.Properties = p
+	case datamodel.KubernetesPersistentVolumeClaimVolume:
+		pvcProp := resource.Properties.KubernetesPersistentVolumeClaim
+		p := &KubernetesPersistentVolumeClaimVolumeProperties{
+			Status: &ResourceStatus{
+				OutputResources: toOutputResourcesDataModel(resource.Properties.Status.OutputResources),
+			},
+			Kind:              to.Ptr(resource.Properties.Kind),
+			Application:       to.Ptr(resource.Properties.Application),
+			Size:              to.Ptr(pvcProp.Size),
+			StorageClass:      toStringPtr(pvcProp.StorageClass),
+			RetainOnDelete:    to.Ptr(pvcProp.RetainOnDelete),
+			ProvisioningState: fromProvisioningStateDataModel(resource.InternalMetadata.AsyncProvisioningState),
+		}
+		for _, mode := range pvcProp.AccessModes {
+			p.AccessModes = append(p.AccessModes, to.Ptr(PersistentVolumeClaimAccessMode(mode)))
+		}
+		dst.Properties = p
 	}
 
 	return nil
@@ -77,6 +77,47 @@ func TestVolumeConvertDataModelToVersioned(t *testing.T) {
 	require.Equal(t, expected.Properties, versioned.Properties)
 }
 
+func TestVolumeConvertVersionedToDataModel_PersistentVolumeClaim(t *testing.T) {
+	// arrange
+	r := &VolumeResource{}
+	err := json.Unmarshal(testutil.ReadFixture("volume-k8s-pvc.json"), r)
+	require.NoError(t, err)
+
+	expected := &datamodel.VolumeResource{}
+	err = json.Unmarshal(testutil.ReadFixture("volume-k8s-pvc-datamodel.json"), expected)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	ct := dm.(*datamodel.VolumeResource)
+	require.Equal(t, "data0", ct.Name)
+	require.Equal(t, datamodel.KubernetesPersistentVolumeClaimVolume, ct.Properties.Kind)
+	require.Nil(t, ct.Properties.AzureKeyVault)
+	require.Equal(t, expected.Properties.KubernetesPersistentVolumeClaim, ct.Properties.KubernetesPersistentVolumeClaim)
+}
+
+func TestVolumeConvertDataModelToVersioned_PersistentVolumeClaim(t *testing.T) {
+	// arrange
+	r := &datamodel.VolumeResource{}
+	err := json.Unmarshal(testutil.ReadFixture("volume-k8s-pvc-datamodel.json"), r)
+	require.NoError(t, err)
+
+	expected := &VolumeResource{}
+	err = json.Unmarshal(testutil.ReadFixture("volume-k8s-pvc.json"), expected)
+	require.NoError(t, err)
+
+	// act
+	versioned := &VolumeResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, expected.Properties, versioned.Properties)
+}
+
 func TestVolumeConvertFromValidation(t *testing.T) {
 	validationTests := []struct {
 		src v1.DataModelInterface
@@ -196,6 +196,27 @@ func PossibleImagePullPolicyValues() []ImagePullPolicy {
 	}
 }
 
+// JobConcurrencyPolicy - Specifies how concurrent executions of a scheduled job are treated.
+type JobConcurrencyPolicy string
+
+const (
+	// JobConcurrencyPolicyAllow - Allow the executions of the job to run concurrently
+	JobConcurrencyPolicyAllow JobConcurrencyPolicy = "Allow"
+	// JobConcurrencyPolicyForbid - Skip the next execution if the previous one hasn't finished yet
+	JobConcurrencyPolicyForbid JobConcurrencyPolicy = "Forbid"
+	// JobConcurrencyPolicyReplace - Replace the currently running execution with the new one
+	JobConcurrencyPolicyReplace JobConcurrencyPolicy = "Replace"
+)
+
+// PossibleJobConcurrencyPolicyValues returns the possible values for the JobConcurrencyPolicy const type.
+func PossibleJobConcurrencyPolicyValues() []JobConcurrencyPolicy {
+	return []JobConcurrencyPolicy{	
+		JobConcurrencyPolicyAllow,
+		JobConcurrencyPolicyForbid,
+		JobConcurrencyPolicyReplace,
+	}
+}
+
 // ManagedStore - The managed store for the ephemeral volume
 type ManagedStore string
 
@@ -233,6 +254,30 @@ func PossibleOriginValues() []Origin {
 	}
 }
 
+// PersistentVolumeClaimAccessMode - Represents the access modes of a Kubernetes PersistentVolumeClaim
+type PersistentVolumeClaimAccessMode string
+
+const (
+	// PersistentVolumeClaimAccessModeReadOnlyMany - The volume can be mounted as read-only by many nodes
+	PersistentVolumeClaimAccessModeReadOnlyMany PersistentVolumeClaimAccessMode = "ReadOnlyMany"
+	// PersistentVolumeClaimAccessModeReadWriteMany - The volume can be mounted as read-write by many nodes
+	PersistentVolumeClaimAccessModeReadWriteMany PersistentVolumeClaimAccessMode = "ReadWriteMany"
+	// PersistentVolumeClaimAccessModeReadWriteOnce - The volume can be mounted as read-write by a single node
+	PersistentVolumeClaimAccessModeReadWriteOnce PersistentVolumeClaimAccessMode = "ReadWriteOnce"
+	// PersistentVolumeClaimAccessModeReadWriteOncePod - The volume can be mounted as read-write by a single pod
+	PersistentVolumeClaimAccessModeReadWriteOncePod PersistentVolumeClaimAccessMode = "ReadWriteOncePod"
+)
+
+// PossiblePersistentVolumeClaimAccessModeValues returns the possible values for the PersistentVolumeClaimAccessMode const type.
+func PossiblePersistentVolumeClaimAccessModeValues() []PersistentVolumeClaimAccessMode {
+	return []PersistentVolumeClaimAccessMode{	
+		PersistentVolumeClaimAccessModeReadOnlyMany,
+		PersistentVolumeClaimAccessModeReadWriteMany,
+		PersistentVolumeClaimAccessModeReadWriteOnce,
+		PersistentVolumeClaimAccessModeReadWriteOncePod,
+	}
+}
+
 // PortProtocol - The protocol in use by the port
 type PortProtocol string
 
@@ -325,6 +370,24 @@ func PossibleRestartPolicyValues() []RestartPolicy {
 	}
 }
 
+// RolloutStrategyKind - The kind of rollout strategy for the container
+type RolloutStrategyKind string
+
+const (
+	// RolloutStrategyKindRecreate - Terminate all existing replicas before new ones are created
+	RolloutStrategyKindRecreate RolloutStrategyKind = "Recreate"
+	// RolloutStrategyKindRollingUpdate - Replace the replicas gradually, honoring maxSurge and maxUnavailable
+	RolloutStrategyKindRollingUpdate RolloutStrategyKind = "RollingUpdate"
+)
+
+// PossibleRolloutStrategyKindValues returns the possible values for the RolloutStrategyKind const type.
+func PossibleRolloutStrategyKindValues() []RolloutStrategyKind {
+	return []RolloutStrategyKind{	
+		RolloutStrategyKindRecreate,
+		RolloutStrategyKindRollingUpdate,
+	}
+}
+
 // SecretStoreDataType - The type of SecretStore data
 type SecretStoreDataType string
 
@@ -16,6 +16,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -295,6 +296,9 @@ func (client *EnvironmentsClient) listByScopeCreateRequest(ctx context.Context,
 	}
 	reqQP := req.Raw().URL.Query()
 	reqQP.Set("api-version", "2023-10-01-preview")
+	if options != nil && options.IncludeDeleted != nil {
+		reqQP.Set("includeDeleted", strconv.FormatBool(*options.IncludeDeleted))
+	}
 	req.Raw().URL.RawQuery = reqQP.Encode()
 	req.Raw().Header["Accept"] = []string{"application/json"}
 	return req, nil
@@ -309,6 +313,58 @@ func (client *EnvironmentsClient) listByScopeHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// Restore - Restores a soft-deleted environment and the applications and resources that were deleted with it.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - environmentName - environment name
+//   - options - EnvironmentsClientRestoreOptions contains the optional parameters for the EnvironmentsClient.Restore method.
+func (client *EnvironmentsClient) Restore(ctx context.Context, environmentName string, options *EnvironmentsClientRestoreOptions) (EnvironmentsClientRestoreResponse, error) {
+	var err error
+	req, err := client.restoreCreateRequest(ctx, environmentName, options)
+	if err != nil {
+		return EnvironmentsClientRestoreResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return EnvironmentsClientRestoreResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return EnvironmentsClientRestoreResponse{}, err
+	}
+	resp, err := client.restoreHandleResponse(httpResp)
+	return resp, err
+}
+
+// restoreCreateRequest creates the Restore request.
+func (client *EnvironmentsClient) restoreCreateRequest(ctx context.Context, environmentName string, options *EnvironmentsClientRestoreOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/environments/{environmentName}/restore"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if environmentName == "" {
+		return nil, errors.New("parameter environmentName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{environmentName}", url.PathEscape(environmentName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// restoreHandleResponse handles the Restore response.
+func (client *EnvironmentsClient) restoreHandleResponse(resp *http.Response) (EnvironmentsClientRestoreResponse, error) {
+	result := EnvironmentsClientRestoreResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.EnvironmentResource); err != nil {
+		return EnvironmentsClientRestoreResponse{}, err
+	}
+	return result, nil
+}
+
 // Update - Update a EnvironmentResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
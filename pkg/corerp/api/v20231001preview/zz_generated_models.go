@@ -351,6 +351,9 @@ type ContainerProperties struct {
 	// Specifies a connection to another resource.
 	Connections map[string]*ConnectionProperties
 
+	// Specifies the availability guarantees for the replicas of the container during voluntary disruptions
+	DisruptionBudget *DisruptionBudget
+
 	// Fully qualified resource ID for the environment that the application is linked to
 	Environment *string
 
@@ -369,6 +372,9 @@ type ContainerProperties struct {
 	// The restart policy for the underlying container
 	RestartPolicy *RestartPolicy
 
+	// Specifies how the replicas of the container are updated
+	RolloutStrategy *RolloutStrategy
+
 	// Specifies Runtime-specific functionality
 	Runtimes *RuntimesProperties
 
@@ -432,6 +438,9 @@ type ContainerResourceUpdateProperties struct {
 	// Definition of a container.
 	Container *ContainerUpdate
 
+	// Specifies the availability guarantees for the replicas of the container during voluntary disruptions
+	DisruptionBudget *DisruptionBudget
+
 	// Fully qualified resource ID for the environment that the application is linked to
 	Environment *string
 
@@ -450,6 +459,9 @@ type ContainerResourceUpdateProperties struct {
 	// The restart policy for the underlying container
 	RestartPolicy *RestartPolicy
 
+	// Specifies how the replicas of the container are updated
+	RolloutStrategy *RolloutStrategy
+
 	// Specifies Runtime-specific functionality
 	Runtimes *RuntimesProperties
 }
@@ -512,6 +524,18 @@ func (d *DaprSidecarExtension) GetExtension() *Extension {
 	}
 }
 
+// DisruptionBudget - Specifies the availability guarantees for the replicas of a container during voluntary
+// disruptions. Only one of minAvailable and maxUnavailable can be specified.
+type DisruptionBudget struct {
+	// The number of replicas that can be unavailable. The value can be an absolute number (e.g. "1") or a percentage (e.g.
+// "50%").
+	MaxUnavailable *string
+
+	// The number of replicas that must remain available. The value can be an absolute number (e.g. "1") or a percentage
+// (e.g. "50%").
+	MinAvailable *string
+}
+
 // EnvironmentCompute - Represents backing compute resource
 type EnvironmentCompute struct {
 	// REQUIRED; Discriminator property for EnvironmentCompute.
@@ -547,6 +571,9 @@ type EnvironmentProperties struct {
 	// REQUIRED; The compute resource used by application environment.
 	Compute EnvironmentComputeClassification
 
+	// Specifies the extender kinds registered with the Environment, keyed by kind name.
+	ExtenderKinds map[string]*ExtenderKindProperties
+
 	// The environment extension.
 	Extensions []ExtensionClassification
 
@@ -559,6 +586,9 @@ type EnvironmentProperties struct {
 	// Simulated environment.
 	Simulated *bool
 
+	// READ-ONLY; The time the environment was soft deleted. A soft-deleted environment can be restored until it is purged.
+	DeletedTime *time.Time
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
@@ -709,6 +739,18 @@ func (e *ExecHealthProbeProperties) GetHealthProbeProperties() *HealthProbePrope
 	}
 }
 
+// ExtenderKindProperties - Describes an extender kind registered with the environment.
+type ExtenderKindProperties struct {
+	// Names of the output values that extenders of this kind must provide.
+	RequiredOutputs []*string
+
+	// Names of the secrets that extenders of this kind must provide.
+	RequiredSecrets []*string
+
+	// JSON schema used to validate the properties of extenders of this kind.
+	Schema map[string]any
+}
+
 // ExtenderProperties - ExtenderResource portable resource properties
 type ExtenderProperties struct {
 	// REQUIRED; Fully qualified resource ID for the environment that the portable resource is linked to
@@ -720,6 +762,10 @@ type ExtenderProperties struct {
 	// Fully qualified resource ID for the application that the portable resource is consumed by (if applicable)
 	Application *string
 
+	// The extender kind registered with the environment. When set, the extender is validated against the kind's schema and
+// required outputs.
+	Kind *string
+
 	// The recipe used to automatically deploy underlying infrastructure for the extender portable resource
 	Recipe *Recipe
 
@@ -911,9 +957,22 @@ type GatewayResourceUpdateProperties struct {
 
 // GatewayRoute - Route attached to Gateway
 type GatewayRoute struct {
+	// Cookie conditions that must all match for a request to use the route. At most 3 cookie conditions can be specified. Cookies are matched as substrings of the Cookie header, so a condition on the cookie 'user' with the value 'beta' also matches the headers 'xuser=beta; a=1' and 'a=1; user=beta2'.
+	Cookies []*GatewayRouteCookieMatch
+
 	// The HttpRoute to route to. Ex - myserviceroute.id.
 	Destination *string
 
+	// Weighted destinations to split the traffic of the route between. Mutually exclusive with 'destination'.
+	Destinations []*GatewayRouteDestination
+
+	// Header conditions that must all match for a request to use the route.
+	Headers []*GatewayRouteHeaderMatch
+
+	// The HttpRoute to mirror the traffic of the route to. Responses from the mirror are discarded. Ex -
+// myserviceroute.id.
+	Mirror *string
+
 	// The path to match the incoming request path on. Ex - /myservice.
 	Path *string
 
@@ -922,6 +981,40 @@ type GatewayRoute struct {
 	ReplacePrefix *string
 }
 
+// GatewayRouteCookieMatch - Cookie condition of a Gateway route
+type GatewayRouteCookieMatch struct {
+	// REQUIRED; The name of the cookie.
+	Name *string
+
+	// REQUIRED; The cookie value must be equal to this value.
+	Value *string
+}
+
+// GatewayRouteDestination - Weighted destination of a Gateway route
+type GatewayRouteDestination struct {
+	// REQUIRED; The HttpRoute to route to. Ex - myserviceroute.id.
+	Destination *string
+
+	// REQUIRED; The relative weight of the traffic sent to the destination.
+	Weight *int32
+}
+
+// GatewayRouteHeaderMatch - Header condition of a Gateway route. Exactly one of 'exact', 'contains' or 'present' must
+// be specified.
+type GatewayRouteHeaderMatch struct {
+	// REQUIRED; The name of the header.
+	Name *string
+
+	// The header value must contain this value.
+	Contains *string
+
+	// The header value must be equal to this value.
+	Exact *string
+
+	// The header must be present in the request.
+	Present *bool
+}
+
 // GatewayTLS - TLS configuration definition for Gateway resource.
 type GatewayTLS struct {
 	// The resource id for the secret containing the TLS certificate and key for the gateway.
@@ -1119,6 +1212,156 @@ type IdentitySettingsUpdate struct {
 	Resource *string
 }
 
+// JobProperties - Job properties. A job runs its container to completion, either once or on a schedule.
+type JobProperties struct {
+	// REQUIRED; Fully qualified resource ID for the application
+	Application *string
+
+	// REQUIRED; Definition of the container run by the job.
+	Container *Container
+
+	// The duration in seconds the job may be active before it is terminated.
+	ActiveDeadlineSeconds *int64
+
+	// The number of retries before the job is marked as failed. Defaults to 6.
+	BackoffLimit *int32
+
+	// When specified, the deployment of the job waits up to this duration in seconds for the job to complete, and fails if the job fails or doesn't complete in time. By default the deployment doesn't wait for the job to complete. Must be at most 1800 and can't be specified for a scheduled job.
+	CompletionTimeoutSeconds *int32
+
+	// The number of pods that must complete successfully for the job to complete. Defaults to 1.
+	Completions *int32
+
+	// Specifies how concurrent executions of a scheduled job are treated. Defaults to Allow.
+	ConcurrencyPolicy *JobConcurrencyPolicy
+
+	// Specifies a connection to another resource.
+	Connections map[string]*ConnectionProperties
+
+	// Fully qualified resource ID for the environment that the application is linked to
+	Environment *string
+
+	// Extensions spec of the resource
+	Extensions []ExtensionClassification
+
+	// Configuration for supported external identity providers
+	Identity *IdentitySettings
+
+	// The maximum number of pods of the job running at the same time. Defaults to 1.
+	Parallelism *int32
+
+	// The restart policy for the pods of the job. Must be either OnFailure or Never. Defaults to OnFailure.
+	RestartPolicy *RestartPolicy
+
+	// Specifies Runtime-specific functionality
+	Runtimes *RuntimesProperties
+
+	// The schedule of the job in cron format. When specified, the job runs on the schedule instead of running once.
+	Schedule *string
+
+	// The duration in seconds after which a finished job is cleaned up.
+	TTLSecondsAfterFinished *int32
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; Status of a resource.
+	Status *ResourceStatus
+}
+
+// JobResource - Concrete tracked resource types can be created by aliasing this type using a specific property type.
+type JobResource struct {
+	// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+	// REQUIRED; The resource-specific properties for this resource.
+	Properties *JobProperties
+
+	// Resource tags.
+	Tags map[string]*string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// JobResourceListResult - The response of a JobResource list operation.
+type JobResourceListResult struct {
+	// REQUIRED; The JobResource items on this page
+	Value []*JobResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// JobResourceUpdate - The type used for update operations of the JobResource.
+type JobResourceUpdate struct {
+	// The updatable properties of the JobResource.
+	Properties *JobResourceUpdateProperties
+
+	// Resource tags.
+	Tags map[string]*string
+}
+
+// JobResourceUpdateProperties - The updatable properties of the JobResource.
+type JobResourceUpdateProperties struct {
+	// The duration in seconds the job may be active before it is terminated.
+	ActiveDeadlineSeconds *int64
+
+	// Fully qualified resource ID for the application
+	Application *string
+
+	// The number of retries before the job is marked as failed. Defaults to 6.
+	BackoffLimit *int32
+
+	// When specified, the deployment of the job waits up to this duration in seconds for the job to complete, and fails if the job fails or doesn't complete in time. By default the deployment doesn't wait for the job to complete. Must be at most 1800 and can't be specified for a scheduled job.
+	CompletionTimeoutSeconds *int32
+
+	// The number of pods that must complete successfully for the job to complete. Defaults to 1.
+	Completions *int32
+
+	// Specifies how concurrent executions of a scheduled job are treated. Defaults to Allow.
+	ConcurrencyPolicy *JobConcurrencyPolicy
+
+	// Specifies a connection to another resource.
+	Connections map[string]*ConnectionPropertiesUpdate
+
+	// Definition of the container run by the job.
+	Container *ContainerUpdate
+
+	// Fully qualified resource ID for the environment that the application is linked to
+	Environment *string
+
+	// Extensions spec of the resource
+	Extensions []ExtensionClassification
+
+	// Configuration for supported external identity providers
+	Identity *IdentitySettingsUpdate
+
+	// The maximum number of pods of the job running at the same time. Defaults to 1.
+	Parallelism *int32
+
+	// The restart policy for the pods of the job. Must be either OnFailure or Never. Defaults to OnFailure.
+	RestartPolicy *RestartPolicy
+
+	// Specifies Runtime-specific functionality
+	Runtimes *RuntimesProperties
+
+	// The schedule of the job in cron format. When specified, the job runs on the schedule instead of running once.
+	Schedule *string
+
+	// The duration in seconds after which a finished job is cleaned up.
+	TTLSecondsAfterFinished *int32
+}
+
 // KeyObjectProperties - Represents key object properties
 type KeyObjectProperties struct {
 	// REQUIRED; The name of the key
@@ -1214,6 +1457,48 @@ func (k *KubernetesNamespaceExtension) GetExtension() *Extension {
 	}
 }
 
+// KubernetesPersistentVolumeClaimVolumeProperties - Represents Kubernetes PersistentVolumeClaim Volume properties
+type KubernetesPersistentVolumeClaimVolumeProperties struct {
+	// REQUIRED; Fully qualified resource ID for the application
+	Application *string
+
+	// REQUIRED; Discriminator property for VolumeProperties.
+	Kind *string
+
+	// REQUIRED; The requested size of the volume, as a Kubernetes quantity. For example, 10Gi.
+	Size *string
+
+	// The access modes of the volume. Defaults to ReadWriteOnce.
+	AccessModes []*PersistentVolumeClaimAccessMode
+
+	// Fully qualified resource ID for the environment that the application is linked to
+	Environment *string
+
+	// Keep the PersistentVolumeClaim and its data when the volume resource is deleted. Defaults to false.
+	RetainOnDelete *bool
+
+	// The name of the storage class used to provision the volume. The default storage class of the cluster is used if not
+// specified.
+	StorageClass *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; Status of a resource.
+	Status *ResourceStatus
+}
+
+// GetVolumeProperties implements the VolumePropertiesClassification interface for type KubernetesPersistentVolumeClaimVolumeProperties.
+func (k *KubernetesPersistentVolumeClaimVolumeProperties) GetVolumeProperties() *VolumeProperties {
+	return &VolumeProperties{
+		Application: k.Application,
+		Environment: k.Environment,
+		Kind: k.Kind,
+		ProvisioningState: k.ProvisioningState,
+		Status: k.Status,
+	}
+}
+
 // KubernetesRuntimeProperties - The runtime configuration properties for Kubernetes
 type KubernetesRuntimeProperties struct {
 	// The serialized YAML manifest which represents the base Kubernetes resources to deploy, such as Deployment, Service, ServiceAccount,
@@ -1490,6 +1775,20 @@ type ResourceStatus struct {
 	Recipe *RecipeStatus
 }
 
+// RolloutStrategy - Specifies how the replicas of a container are replaced by new ones during an update.
+type RolloutStrategy struct {
+	// The kind of rollout strategy. Defaults to RollingUpdate.
+	Kind *RolloutStrategyKind
+
+	// The maximum number of replicas that can be created over the desired number of replicas during a rolling update. The
+// value can be an absolute number (e.g. "1") or a percentage (e.g. "25%").
+	MaxSurge *string
+
+	// The maximum number of replicas that can be unavailable during a rolling update. The value can be an absolute number
+// (e.g. "1") or a percentage (e.g. "25%").
+	MaxUnavailable *string
+}
+
 // RuntimesProperties - The properties for runtime configuration
 type RuntimesProperties struct {
 	// The runtime configuration properties for Kubernetes
@@ -769,6 +769,7 @@ func (c ContainerProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "application", c.Application)
 	populate(objectMap, "connections", c.Connections)
 	populate(objectMap, "container", c.Container)
+	populate(objectMap, "disruptionBudget", c.DisruptionBudget)
 	populate(objectMap, "environment", c.Environment)
 	populate(objectMap, "extensions", c.Extensions)
 	populate(objectMap, "identity", c.Identity)
@@ -776,6 +777,7 @@ func (c ContainerProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "resourceProvisioning", c.ResourceProvisioning)
 	populate(objectMap, "resources", c.Resources)
 	populate(objectMap, "restartPolicy", c.RestartPolicy)
+	populate(objectMap, "rolloutStrategy", c.RolloutStrategy)
 	populate(objectMap, "runtimes", c.Runtimes)
 	populate(objectMap, "status", c.Status)
 	return json.Marshal(objectMap)
@@ -799,6 +801,9 @@ func (c *ContainerProperties) UnmarshalJSON(data []byte) error {
 		case "container":
 				err = unpopulate(val, "Container", &c.Container)
 			delete(rawMsg, key)
+		case "disruptionBudget":
+				err = unpopulate(val, "DisruptionBudget", &c.DisruptionBudget)
+			delete(rawMsg, key)
 		case "environment":
 				err = unpopulate(val, "Environment", &c.Environment)
 			delete(rawMsg, key)
@@ -820,6 +825,9 @@ func (c *ContainerProperties) UnmarshalJSON(data []byte) error {
 		case "restartPolicy":
 				err = unpopulate(val, "RestartPolicy", &c.RestartPolicy)
 			delete(rawMsg, key)
+		case "rolloutStrategy":
+				err = unpopulate(val, "RolloutStrategy", &c.RolloutStrategy)
+			delete(rawMsg, key)
 		case "runtimes":
 				err = unpopulate(val, "Runtimes", &c.Runtimes)
 			delete(rawMsg, key)
@@ -953,12 +961,14 @@ func (c ContainerResourceUpdateProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "application", c.Application)
 	populate(objectMap, "connections", c.Connections)
 	populate(objectMap, "container", c.Container)
+	populate(objectMap, "disruptionBudget", c.DisruptionBudget)
 	populate(objectMap, "environment", c.Environment)
 	populate(objectMap, "extensions", c.Extensions)
 	populate(objectMap, "identity", c.Identity)
 	populate(objectMap, "resourceProvisioning", c.ResourceProvisioning)
 	populate(objectMap, "resources", c.Resources)
 	populate(objectMap, "restartPolicy", c.RestartPolicy)
+	populate(objectMap, "rolloutStrategy", c.RolloutStrategy)
 	populate(objectMap, "runtimes", c.Runtimes)
 	return json.Marshal(objectMap)
 }
@@ -981,6 +991,9 @@ func (c *ContainerResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 		case "container":
 				err = unpopulate(val, "Container", &c.Container)
 			delete(rawMsg, key)
+		case "disruptionBudget":
+				err = unpopulate(val, "DisruptionBudget", &c.DisruptionBudget)
+			delete(rawMsg, key)
 		case "environment":
 				err = unpopulate(val, "Environment", &c.Environment)
 			delete(rawMsg, key)
@@ -999,6 +1012,9 @@ func (c *ContainerResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 		case "restartPolicy":
 				err = unpopulate(val, "RestartPolicy", &c.RestartPolicy)
 			delete(rawMsg, key)
+		case "rolloutStrategy":
+				err = unpopulate(val, "RolloutStrategy", &c.RolloutStrategy)
+			delete(rawMsg, key)
 		case "runtimes":
 				err = unpopulate(val, "Runtimes", &c.Runtimes)
 			delete(rawMsg, key)
@@ -1116,6 +1132,37 @@ func (d *DaprSidecarExtension) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type DisruptionBudget.
+func (d DisruptionBudget) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "maxUnavailable", d.MaxUnavailable)
+	populate(objectMap, "minAvailable", d.MinAvailable)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type DisruptionBudget.
+func (d *DisruptionBudget) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", d, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "maxUnavailable":
+				err = unpopulate(val, "MaxUnavailable", &d.MaxUnavailable)
+			delete(rawMsg, key)
+		case "minAvailable":
+				err = unpopulate(val, "MinAvailable", &d.MinAvailable)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", d, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type EnvironmentCompute.
 func (e EnvironmentCompute) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -1190,6 +1237,8 @@ func (e *EnvironmentComputeUpdate) UnmarshalJSON(data []byte) error {
 func (e EnvironmentProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "compute", e.Compute)
+	populateTimeRFC3339(objectMap, "deletedTime", e.DeletedTime)
+	populate(objectMap, "extenderKinds", e.ExtenderKinds)
 	populate(objectMap, "extensions", e.Extensions)
 	populate(objectMap, "providers", e.Providers)
 	populate(objectMap, "provisioningState", e.ProvisioningState)
@@ -1210,6 +1259,12 @@ func (e *EnvironmentProperties) UnmarshalJSON(data []byte) error {
 		case "compute":
 			e.Compute, err = unmarshalEnvironmentComputeClassification(val)
 			delete(rawMsg, key)
+		case "deletedTime":
+				err = unpopulateTimeRFC3339(val, "DeletedTime", &e.DeletedTime)
+			delete(rawMsg, key)
+		case "extenderKinds":
+				err = unpopulate(val, "ExtenderKinds", &e.ExtenderKinds)
+			delete(rawMsg, key)
 		case "extensions":
 			e.Extensions, err = unmarshalExtensionClassificationArray(val)
 			delete(rawMsg, key)
@@ -1594,11 +1649,47 @@ func (e *ExecHealthProbeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ExtenderKindProperties.
+func (e ExtenderKindProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "requiredOutputs", e.RequiredOutputs)
+	populate(objectMap, "requiredSecrets", e.RequiredSecrets)
+	populate(objectMap, "schema", e.Schema)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ExtenderKindProperties.
+func (e *ExtenderKindProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "requiredOutputs":
+				err = unpopulate(val, "RequiredOutputs", &e.RequiredOutputs)
+			delete(rawMsg, key)
+		case "requiredSecrets":
+				err = unpopulate(val, "RequiredSecrets", &e.RequiredSecrets)
+			delete(rawMsg, key)
+		case "schema":
+				err = unpopulate(val, "Schema", &e.Schema)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type ExtenderProperties.
 func (e ExtenderProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "application", e.Application)
 	populate(objectMap, "environment", e.Environment)
+	populate(objectMap, "kind", e.Kind)
 	populate(objectMap, "provisioningState", e.ProvisioningState)
 	populate(objectMap, "recipe", e.Recipe)
 	populate(objectMap, "resourceProvisioning", e.ResourceProvisioning)
@@ -1627,6 +1718,9 @@ func (e *ExtenderProperties) UnmarshalJSON(data []byte) error {
 		case "environment":
 				err = unpopulate(val, "Environment", &e.Environment)
 			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &e.Kind)
+			delete(rawMsg, key)
 		case "provisioningState":
 				err = unpopulate(val, "ProvisioningState", &e.ProvisioningState)
 			delete(rawMsg, key)
@@ -2096,7 +2190,11 @@ func (g *GatewayResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type GatewayRoute.
 func (g GatewayRoute) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "cookies", g.Cookies)
 	populate(objectMap, "destination", g.Destination)
+	populate(objectMap, "destinations", g.Destinations)
+	populate(objectMap, "headers", g.Headers)
+	populate(objectMap, "mirror", g.Mirror)
 	populate(objectMap, "path", g.Path)
 	populate(objectMap, "replacePrefix", g.ReplacePrefix)
 	return json.Marshal(objectMap)
@@ -2111,9 +2209,21 @@ func (g *GatewayRoute) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "cookies":
+				err = unpopulate(val, "Cookies", &g.Cookies)
+			delete(rawMsg, key)
 		case "destination":
 				err = unpopulate(val, "Destination", &g.Destination)
 			delete(rawMsg, key)
+		case "destinations":
+				err = unpopulate(val, "Destinations", &g.Destinations)
+			delete(rawMsg, key)
+		case "headers":
+				err = unpopulate(val, "Headers", &g.Headers)
+			delete(rawMsg, key)
+		case "mirror":
+				err = unpopulate(val, "Mirror", &g.Mirror)
+			delete(rawMsg, key)
 		case "path":
 				err = unpopulate(val, "Path", &g.Path)
 			delete(rawMsg, key)
@@ -2128,6 +2238,107 @@ func (g *GatewayRoute) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteCookieMatch.
+func (g GatewayRouteCookieMatch) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "name", g.Name)
+	populate(objectMap, "value", g.Value)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteCookieMatch.
+func (g *GatewayRouteCookieMatch) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "name":
+				err = unpopulate(val, "Name", &g.Name)
+			delete(rawMsg, key)
+		case "value":
+				err = unpopulate(val, "Value", &g.Value)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteDestination.
+func (g GatewayRouteDestination) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "destination", g.Destination)
+	populate(objectMap, "weight", g.Weight)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteDestination.
+func (g *GatewayRouteDestination) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "destination":
+				err = unpopulate(val, "Destination", &g.Destination)
+			delete(rawMsg, key)
+		case "weight":
+				err = unpopulate(val, "Weight", &g.Weight)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteHeaderMatch.
+func (g GatewayRouteHeaderMatch) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "contains", g.Contains)
+	populate(objectMap, "exact", g.Exact)
+	populate(objectMap, "name", g.Name)
+	populate(objectMap, "present", g.Present)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteHeaderMatch.
+func (g *GatewayRouteHeaderMatch) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "contains":
+				err = unpopulate(val, "Contains", &g.Contains)
+			delete(rawMsg, key)
+		case "exact":
+				err = unpopulate(val, "Exact", &g.Exact)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &g.Name)
+			delete(rawMsg, key)
+		case "present":
+				err = unpopulate(val, "Present", &g.Present)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type GatewayTLS.
 func (g GatewayTLS) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -2600,6 +2811,301 @@ func (i *IdentitySettingsUpdate) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type JobProperties.
+func (j JobProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "activeDeadlineSeconds", j.ActiveDeadlineSeconds)
+	populate(objectMap, "application", j.Application)
+	populate(objectMap, "backoffLimit", j.BackoffLimit)
+	populate(objectMap, "completionTimeoutSeconds", j.CompletionTimeoutSeconds)
+	populate(objectMap, "completions", j.Completions)
+	populate(objectMap, "concurrencyPolicy", j.ConcurrencyPolicy)
+	populate(objectMap, "connections", j.Connections)
+	populate(objectMap, "container", j.Container)
+	populate(objectMap, "environment", j.Environment)
+	populate(objectMap, "extensions", j.Extensions)
+	populate(objectMap, "identity", j.Identity)
+	populate(objectMap, "parallelism", j.Parallelism)
+	populate(objectMap, "provisioningState", j.ProvisioningState)
+	populate(objectMap, "restartPolicy", j.RestartPolicy)
+	populate(objectMap, "runtimes", j.Runtimes)
+	populate(objectMap, "schedule", j.Schedule)
+	populate(objectMap, "status", j.Status)
+	populate(objectMap, "ttlSecondsAfterFinished", j.TTLSecondsAfterFinished)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type JobProperties.
+func (j *JobProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", j, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "activeDeadlineSeconds":
+				err = unpopulate(val, "ActiveDeadlineSeconds", &j.ActiveDeadlineSeconds)
+			delete(rawMsg, key)
+		case "application":
+				err = unpopulate(val, "Application", &j.Application)
+			delete(rawMsg, key)
+		case "backoffLimit":
+				err = unpopulate(val, "BackoffLimit", &j.BackoffLimit)
+			delete(rawMsg, key)
+		case "completionTimeoutSeconds":
+				err = unpopulate(val, "CompletionTimeoutSeconds", &j.CompletionTimeoutSeconds)
+			delete(rawMsg, key)
+		case "completions":
+				err = unpopulate(val, "Completions", &j.Completions)
+			delete(rawMsg, key)
+		case "concurrencyPolicy":
+				err = unpopulate(val, "ConcurrencyPolicy", &j.ConcurrencyPolicy)
+			delete(rawMsg, key)
+		case "connections":
+				err = unpopulate(val, "Connections", &j.Connections)
+			delete(rawMsg, key)
+		case "container":
+				err = unpopulate(val, "Container", &j.Container)
+			delete(rawMsg, key)
+		case "environment":
+				err = unpopulate(val, "Environment", &j.Environment)
+			delete(rawMsg, key)
+		case "extensions":
+			j.Extensions, err = unmarshalExtensionClassificationArray(val)
+			delete(rawMsg, key)
+		case "identity":
+				err = unpopulate(val, "Identity", &j.Identity)
+			delete(rawMsg, key)
+		case "parallelism":
+				err = unpopulate(val, "Parallelism", &j.Parallelism)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &j.ProvisioningState)
+			delete(rawMsg, key)
+		case "restartPolicy":
+				err = unpopulate(val, "RestartPolicy", &j.RestartPolicy)
+			delete(rawMsg, key)
+		case "runtimes":
+				err = unpopulate(val, "Runtimes", &j.Runtimes)
+			delete(rawMsg, key)
+		case "schedule":
+				err = unpopulate(val, "Schedule", &j.Schedule)
+			delete(rawMsg, key)
+		case "status":
+				err = unpopulate(val, "Status", &j.Status)
+			delete(rawMsg, key)
+		case "ttlSecondsAfterFinished":
+				err = unpopulate(val, "TTLSecondsAfterFinished", &j.TTLSecondsAfterFinished)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", j, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type JobResource.
+func (j JobResource) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "id", j.ID)
+	populate(objectMap, "location", j.Location)
+	populate(objectMap, "name", j.Name)
+	populate(objectMap, "properties", j.Properties)
+	populate(objectMap, "systemData", j.SystemData)
+	populate(objectMap, "tags", j.Tags)
+	populate(objectMap, "type", j.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type JobResource.
+func (j *JobResource) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", j, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "id":
+				err = unpopulate(val, "ID", &j.ID)
+			delete(rawMsg, key)
+		case "location":
+				err = unpopulate(val, "Location", &j.Location)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &j.Name)
+			delete(rawMsg, key)
+		case "properties":
+				err = unpopulate(val, "Properties", &j.Properties)
+			delete(rawMsg, key)
+		case "systemData":
+				err = unpopulate(val, "SystemData", &j.SystemData)
+			delete(rawMsg, key)
+		case "tags":
+				err = unpopulate(val, "Tags", &j.Tags)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &j.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", j, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type JobResourceListResult.
+func (j JobResourceListResult) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "nextLink", j.NextLink)
+	populate(objectMap, "value", j.Value)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type JobResourceListResult.
+func (j *JobResourceListResult) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", j, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "nextLink":
+				err = unpopulate(val, "NextLink", &j.NextLink)
+			delete(rawMsg, key)
+		case "value":
+				err = unpopulate(val, "Value", &j.Value)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", j, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type JobResourceUpdate.
+func (j JobResourceUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "properties", j.Properties)
+	populate(objectMap, "tags", j.Tags)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type JobResourceUpdate.
+func (j *JobResourceUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", j, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "properties":
+				err = unpopulate(val, "Properties", &j.Properties)
+			delete(rawMsg, key)
+		case "tags":
+				err = unpopulate(val, "Tags", &j.Tags)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", j, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type JobResourceUpdateProperties.
+func (j JobResourceUpdateProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "activeDeadlineSeconds", j.ActiveDeadlineSeconds)
+	populate(objectMap, "application", j.Application)
+	populate(objectMap, "backoffLimit", j.BackoffLimit)
+	populate(objectMap, "completionTimeoutSeconds", j.CompletionTimeoutSeconds)
+	populate(objectMap, "completions", j.Completions)
+	populate(objectMap, "concurrencyPolicy", j.ConcurrencyPolicy)
+	populate(objectMap, "connections", j.Connections)
+	populate(objectMap, "container", j.Container)
+	populate(objectMap, "environment", j.Environment)
+	populate(objectMap, "extensions", j.Extensions)
+	populate(objectMap, "identity", j.Identity)
+	populate(objectMap, "parallelism", j.Parallelism)
+	populate(objectMap, "restartPolicy", j.RestartPolicy)
+	populate(objectMap, "runtimes", j.Runtimes)
+	populate(objectMap, "schedule", j.Schedule)
+	populate(objectMap, "ttlSecondsAfterFinished", j.TTLSecondsAfterFinished)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type JobResourceUpdateProperties.
+func (j *JobResourceUpdateProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", j, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "activeDeadlineSeconds":
+				err = unpopulate(val, "ActiveDeadlineSeconds", &j.ActiveDeadlineSeconds)
+			delete(rawMsg, key)
+		case "application":
+				err = unpopulate(val, "Application", &j.Application)
+			delete(rawMsg, key)
+		case "backoffLimit":
+				err = unpopulate(val, "BackoffLimit", &j.BackoffLimit)
+			delete(rawMsg, key)
+		case "completionTimeoutSeconds":
+				err = unpopulate(val, "CompletionTimeoutSeconds", &j.CompletionTimeoutSeconds)
+			delete(rawMsg, key)
+		case "completions":
+				err = unpopulate(val, "Completions", &j.Completions)
+			delete(rawMsg, key)
+		case "concurrencyPolicy":
+				err = unpopulate(val, "ConcurrencyPolicy", &j.ConcurrencyPolicy)
+			delete(rawMsg, key)
+		case "connections":
+				err = unpopulate(val, "Connections", &j.Connections)
+			delete(rawMsg, key)
+		case "container":
+				err = unpopulate(val, "Container", &j.Container)
+			delete(rawMsg, key)
+		case "environment":
+				err = unpopulate(val, "Environment", &j.Environment)
+			delete(rawMsg, key)
+		case "extensions":
+			j.Extensions, err = unmarshalExtensionClassificationArray(val)
+			delete(rawMsg, key)
+		case "identity":
+				err = unpopulate(val, "Identity", &j.Identity)
+			delete(rawMsg, key)
+		case "parallelism":
+				err = unpopulate(val, "Parallelism", &j.Parallelism)
+			delete(rawMsg, key)
+		case "restartPolicy":
+				err = unpopulate(val, "RestartPolicy", &j.RestartPolicy)
+			delete(rawMsg, key)
+		case "runtimes":
+				err = unpopulate(val, "Runtimes", &j.Runtimes)
+			delete(rawMsg, key)
+		case "schedule":
+				err = unpopulate(val, "Schedule", &j.Schedule)
+			delete(rawMsg, key)
+		case "ttlSecondsAfterFinished":
+				err = unpopulate(val, "TTLSecondsAfterFinished", &j.TTLSecondsAfterFinished)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", j, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type KeyObjectProperties.
 func (k KeyObjectProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -2779,6 +3285,65 @@ func (k *KubernetesNamespaceExtension) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type KubernetesPersistentVolumeClaimVolumeProperties.
+func (k KubernetesPersistentVolumeClaimVolumeProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "accessModes", k.AccessModes)
+	populate(objectMap, "application", k.Application)
+	populate(objectMap, "environment", k.Environment)
+	objectMap["kind"] = "kubernetes.persistentVolumeClaim"
+	populate(objectMap, "provisioningState", k.ProvisioningState)
+	populate(objectMap, "retainOnDelete", k.RetainOnDelete)
+	populate(objectMap, "size", k.Size)
+	populate(objectMap, "status", k.Status)
+	populate(objectMap, "storageClass", k.StorageClass)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type KubernetesPersistentVolumeClaimVolumeProperties.
+func (k *KubernetesPersistentVolumeClaimVolumeProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", k, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "accessModes":
+				err = unpopulate(val, "AccessModes", &k.AccessModes)
+			delete(rawMsg, key)
+		case "application":
+				err = unpopulate(val, "Application", &k.Application)
+			delete(rawMsg, key)
+		case "environment":
+				err = unpopulate(val, "Environment", &k.Environment)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &k.Kind)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &k.ProvisioningState)
+			delete(rawMsg, key)
+		case "retainOnDelete":
+				err = unpopulate(val, "RetainOnDelete", &k.RetainOnDelete)
+			delete(rawMsg, key)
+		case "size":
+				err = unpopulate(val, "Size", &k.Size)
+			delete(rawMsg, key)
+		case "status":
+				err = unpopulate(val, "Status", &k.Status)
+			delete(rawMsg, key)
+		case "storageClass":
+				err = unpopulate(val, "StorageClass", &k.StorageClass)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", k, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type KubernetesRuntimeProperties.
 func (k KubernetesRuntimeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -3540,6 +4105,41 @@ func (r *ResourceStatus) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type RolloutStrategy.
+func (r RolloutStrategy) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "kind", r.Kind)
+	populate(objectMap, "maxSurge", r.MaxSurge)
+	populate(objectMap, "maxUnavailable", r.MaxUnavailable)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RolloutStrategy.
+func (r *RolloutStrategy) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "kind":
+				err = unpopulate(val, "Kind", &r.Kind)
+			delete(rawMsg, key)
+		case "maxSurge":
+				err = unpopulate(val, "MaxSurge", &r.MaxSurge)
+			delete(rawMsg, key)
+		case "maxUnavailable":
+				err = unpopulate(val, "MaxUnavailable", &r.MaxUnavailable)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type RuntimesProperties.
 func (r RuntimesProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
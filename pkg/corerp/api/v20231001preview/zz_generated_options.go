@@ -88,6 +88,12 @@ type EnvironmentsClientGetOptions struct {
 
 // EnvironmentsClientListByScopeOptions contains the optional parameters for the EnvironmentsClient.NewListByScopePager method.
 type EnvironmentsClientListByScopeOptions struct {
+	// Include the soft-deleted environments in the list.
+	IncludeDeleted *bool
+}
+
+// EnvironmentsClientRestoreOptions contains the optional parameters for the EnvironmentsClient.Restore method.
+type EnvironmentsClientRestoreOptions struct {
 	// placeholder for future optional parameters
 }
 
@@ -187,6 +193,34 @@ type HTTPRoutesClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// JobsClientBeginCreateOrUpdateOptions contains the optional parameters for the JobsClient.BeginCreateOrUpdate method.
+type JobsClientBeginCreateOrUpdateOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
+// JobsClientBeginDeleteOptions contains the optional parameters for the JobsClient.BeginDelete method.
+type JobsClientBeginDeleteOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
+// JobsClientBeginUpdateOptions contains the optional parameters for the JobsClient.BeginUpdate method.
+type JobsClientBeginUpdateOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
+// JobsClientGetOptions contains the optional parameters for the JobsClient.Get method.
+type JobsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// JobsClientListByScopeOptions contains the optional parameters for the JobsClient.NewListByScopePager method.
+type JobsClientListByScopeOptions struct {
+	// placeholder for future optional parameters
+}
+
 // OperationsClientListOptions contains the optional parameters for the OperationsClient.NewListPager method.
 type OperationsClientListOptions struct {
 	// placeholder for future optional parameters
@@ -260,6 +260,8 @@ func unmarshalVolumePropertiesClassification(rawMsg json.RawMessage) (VolumeProp
 	switch m["kind"] {
 	case "azure.com.keyvault":
 		b = &AzureKeyVaultVolumeProperties{}
+	case "kubernetes.persistentVolumeClaim":
+		b = &KubernetesPersistentVolumeClaimVolumeProperties{}
 	default:
 		b = &VolumeProperties{}
 	}
@@ -100,6 +100,12 @@ type EnvironmentsClientListByScopeResponse struct {
 	EnvironmentResourceListResult
 }
 
+// EnvironmentsClientRestoreResponse contains the response from method EnvironmentsClient.Restore.
+type EnvironmentsClientRestoreResponse struct {
+	// The environment resource
+	EnvironmentResource
+}
+
 // EnvironmentsClientUpdateResponse contains the response from method EnvironmentsClient.Update.
 type EnvironmentsClientUpdateResponse struct {
 	// The environment resource
@@ -199,6 +205,35 @@ type HTTPRoutesClientUpdateResponse struct {
 	HTTPRouteResource
 }
 
+// JobsClientCreateOrUpdateResponse contains the response from method JobsClient.BeginCreateOrUpdate.
+type JobsClientCreateOrUpdateResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	JobResource
+}
+
+// JobsClientDeleteResponse contains the response from method JobsClient.BeginDelete.
+type JobsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// JobsClientGetResponse contains the response from method JobsClient.Get.
+type JobsClientGetResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	JobResource
+}
+
+// JobsClientListByScopeResponse contains the response from method JobsClient.NewListByScopePager.
+type JobsClientListByScopeResponse struct {
+	// The response of a JobResource list operation.
+	JobResourceListResult
+}
+
+// JobsClientUpdateResponse contains the response from method JobsClient.BeginUpdate.
+type JobsClientUpdateResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	JobResource
+}
+
 // OperationsClientListResponse contains the response from method OperationsClient.NewListPager.
 type OperationsClientListResponse struct {
 	// A list of REST API operations supported by an Azure Resource Provider. It contains an URL link to get the next set of results.
@@ -29,6 +29,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/renderers/container"
 	"github.com/radius-project/radius/pkg/corerp/renderers/gateway"
 	"github.com/radius-project/radius/pkg/corerp/renderers/httproute"
+	"github.com/radius-project/radius/pkg/corerp/renderers/job"
 	"github.com/radius-project/radius/pkg/corerp/renderers/volume"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -56,6 +57,8 @@ func getDataModel(id resources.ID) (v1.DataModelInterface, error) {
 		return &datamodel.Gateway{}, nil
 	case strings.ToLower(httproute.ResourceType):
 		return &datamodel.HTTPRoute{}, nil
+	case strings.ToLower(job.ResourceType):
+		return &datamodel.JobResource{}, nil
 	case strings.ToLower(volume.ResourceType):
 		return &datamodel.VolumeResource{}, nil
 	default:
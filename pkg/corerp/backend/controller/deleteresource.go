@@ -23,6 +23,7 @@ import (
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
 )
 
 var _ ctrl.Controller = (*DeleteResource)(nil)
@@ -38,8 +39,8 @@ func NewDeleteResource(opts ctrl.Options) (ctrl.Controller, error) {
 }
 
 // Run retrieves a resource from storage, parses its ID, gets its data model, converts it to a deployment
-// data model, deletes the resource from the deployment processor, and deletes the resource from storage.
-// It returns an error if any of these steps fail.
+// data model, deletes the resource from the deployment processor, and deletes the resource from storage. Soft-deleted
+// resources are kept in storage without their output resources. It returns an error if any of these steps fail.
 func (c *DeleteResource) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
 	obj, err := c.StorageClient().Get(ctx, request.ResourceID)
 	if err != nil {
@@ -71,6 +72,13 @@ func (c *DeleteResource) Run(ctx context.Context, request *ctrl.Request) (ctrl.R
 		return ctrl.Result{}, err
 	}
 
+	// Soft-deleted resources are kept so that they can be restored, only their output resources are torn down.
+	if radiusResource, ok := dataModel.(rpv1.RadiusResourceModel); ok && radiusResource.GetBaseResource().DeletedTime != nil {
+		radiusResource.ResourceMetadata().Status.OutputResources = nil
+		err = c.StorageClient().Save(ctx, &store.Object{Metadata: store.Metadata{ID: request.ResourceID}, Data: radiusResource}, store.WithETag(obj.ETag))
+		return ctrl.Result{}, err
+	}
+
 	err = c.StorageClient().Delete(ctx, request.ResourceID)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -21,11 +21,15 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	deployment "github.com/radius-project/radius/pkg/corerp/backend/deployment"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/stretchr/testify/require"
 )
@@ -110,3 +114,58 @@ func TestDeleteResourceRun_20231001Preview(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteResourceRun_SoftDeleted(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	msc := store.NewMockStorageClient(mctrl)
+	mdp := deployment.NewMockDeploymentProcessor(mctrl)
+
+	resourceID := "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/containers/test-container"
+	req := &ctrl.Request{
+		OperationID:      uuid.New(),
+		OperationType:    "APPLICATIONS.CORE/CONTAINERS|DELETE",
+		ResourceID:       resourceID,
+		CorrelationID:    uuid.NewString(),
+		OperationTimeout: &ctrl.DefaultAsyncOperationTimeout,
+	}
+
+	deletedTime := time.Now().UTC()
+	container := &datamodel.ContainerResource{}
+	container.ID = resourceID
+	container.DeletedTime = &deletedTime
+	container.Properties.Status.OutputResources = []rpv1.OutputResource{
+		{LocalID: rpv1.LocalIDDeployment, ID: resources.MustParse("/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/test-container")},
+	}
+
+	msc.EXPECT().
+		Get(gomock.Any(), resourceID).
+		Return(&store.Object{Metadata: store.Metadata{ID: resourceID, ETag: "etag"}, Data: container}, nil)
+	mdp.EXPECT().
+		Delete(gomock.Any(), gomock.Any(), container.Properties.Status.OutputResources).
+		Return(nil)
+
+	// The soft-deleted resource is kept without its output resources, so that it can be restored.
+	msc.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(0)
+	msc.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			saved := obj.Data.(*datamodel.ContainerResource)
+			require.NotNil(t, saved.DeletedTime)
+			require.Empty(t, saved.Properties.Status.OutputResources)
+			require.Len(t, options, 1)
+			return nil
+		})
+
+	opts := ctrl.Options{
+		StorageClient: msc,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return mdp
+		},
+	}
+
+	controller, err := NewDeleteResource(opts)
+	require.NoError(t, err)
+
+	_, err = controller.Run(context.Background(), req)
+	require.NoError(t, err)
+}
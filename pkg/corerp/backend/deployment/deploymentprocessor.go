@@ -296,8 +296,9 @@ func (dp *deploymentProcessor) Deploy(ctx context.Context, id resources.ID, rend
 
 		// Build database resource - copy updated properties to Resource field
 		outputResource := rpv1.OutputResource{
-			LocalID: outputResource.LocalID,
-			ID:      outputResource.ID,
+			LocalID:        outputResource.LocalID,
+			ID:             outputResource.ID,
+			RetainOnDelete: outputResource.RetainOnDelete,
 		}
 		deployedOutputResources = append(deployedOutputResources, outputResource)
 	}
@@ -333,6 +334,12 @@ func (dp *deploymentProcessor) Delete(ctx context.Context, id resources.ID, depl
 	for i := len(deployedOutputResources) - 1; i >= 0; i-- {
 		outputResource := deployedOutputResources[i]
 		resourceType := outputResource.GetResourceType()
+
+		// Retained resources, such as the claims of retained volumes, must outlive the Radius resource.
+		if outputResource.RetainOnDelete {
+			logger.Info(fmt.Sprintf("Skipping deletion of retained output resource: LocalID: %s, resource type: %q\n", outputResource.LocalID, resourceType))
+			continue
+		}
 		outputResourceModel, err := dp.appmodel.LookupOutputResourceModel(resourceType)
 		if err != nil {
 			return err
@@ -502,6 +509,12 @@ func (dp *deploymentProcessor) getResourceDataByID(ctx context.Context, resource
 			return ResourceData{}, fmt.Errorf(errMsg, resourceID.String(), err)
 		}
 		return dp.buildResourceDependency(resourceID, obj.Properties.Application, obj, obj.Properties.Status.OutputResources, obj.ComputedValues, obj.SecretValues, portableresources.RecipeData{})
+	case strings.ToLower(corerp_dm.JobResourceType):
+		obj := &corerp_dm.JobResource{}
+		if err = resource.As(obj); err != nil {
+			return ResourceData{}, fmt.Errorf(errMsg, resourceID.String(), err)
+		}
+		return dp.buildResourceDependency(resourceID, obj.Properties.Application, obj, obj.Properties.Status.OutputResources, obj.ComputedValues, obj.SecretValues, portableresources.RecipeData{})
 	case strings.ToLower(corerp_dm.GatewayResourceType):
 		obj := &corerp_dm.Gateway{}
 		if err = resource.As(obj); err != nil {
@@ -1097,6 +1097,28 @@ func Test_Delete(t *testing.T) {
 		err := dp.Delete(ctx, resourceID, testResource.Properties.Status.OutputResources)
 		require.NoError(t, err)
 	})
+
+	t.Run("Verify delete skips retained resources", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		mocks := setup(t)
+		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil}
+
+		testResource := getTestResource()
+		resourceID := getTestResourceID(testResource.ID)
+		outputResources := testResource.Properties.Status.OutputResources
+		require.NotEmpty(t, outputResources)
+
+		// Resources that are not managed by Radius are still deleted, only the retained ones are kept.
+		outputResources[0].RetainOnDelete = true
+		for i := 1; i < len(outputResources); i++ {
+			outputResources[i].RadiusManaged = to.Ptr(false)
+		}
+
+		mocks.resourceHandler.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(len(outputResources) - 1).Return(nil)
+
+		err := dp.Delete(ctx, resourceID, testResource.Properties.Status.OutputResources)
+		require.NoError(t, err)
+	})
 }
 
 func Test_getEnvOptions_PublicEndpointOverride(t *testing.T) {
@@ -79,6 +79,8 @@ type ContainerProperties struct {
 	Resources            []ResourceReference             `json:"resources,omitempty"`
 	ResourceProvisioning ContainerResourceProvisioning   `json:"resourceProvisioning,omitempty"`
 	RestartPolicy        string                          `json:"restartPolicy,omitempty"`
+	RolloutStrategy      *RolloutStrategy                `json:"rolloutStrategy,omitempty"`
+	DisruptionBudget     *DisruptionBudget               `json:"disruptionBudget,omitempty"`
 }
 
 // ContainerResourceProvisioning specifies how resources should be created for the container.
@@ -92,6 +94,43 @@ const (
 	ContainerResourceProvisioningManual ContainerResourceProvisioning = "manual"
 )
 
+// RolloutStrategyKind specifies how the running replicas of a container are replaced by new ones.
+type RolloutStrategyKind string
+
+const (
+	// RolloutStrategyKindRollingUpdate replaces the replicas gradually, honoring MaxSurge and MaxUnavailable.
+	RolloutStrategyKindRollingUpdate RolloutStrategyKind = "RollingUpdate"
+
+	// RolloutStrategyKindRecreate terminates all existing replicas before new ones are created.
+	RolloutStrategyKindRecreate RolloutStrategyKind = "Recreate"
+)
+
+// RolloutStrategy represents the update strategy for the replicas of a container.
+type RolloutStrategy struct {
+	// Kind is the kind of the rollout strategy.
+	Kind RolloutStrategyKind `json:"kind,omitempty"`
+
+	// MaxSurge is the maximum number of replicas that can be created over the desired number of replicas
+	// during a rolling update. The value can be an absolute number (e.g. "1") or a percentage (e.g. "25%").
+	MaxSurge string `json:"maxSurge,omitempty"`
+
+	// MaxUnavailable is the maximum number of replicas that can be unavailable during a rolling update.
+	// The value can be an absolute number (e.g. "1") or a percentage (e.g. "25%").
+	MaxUnavailable string `json:"maxUnavailable,omitempty"`
+}
+
+// DisruptionBudget represents the availability guarantees for the replicas of a container during voluntary disruptions.
+// Only one of MinAvailable and MaxUnavailable can be specified.
+type DisruptionBudget struct {
+	// MinAvailable is the number of replicas that must remain available. The value can be an absolute number
+	// (e.g. "1") or a percentage (e.g. "50%").
+	MinAvailable string `json:"minAvailable,omitempty"`
+
+	// MaxUnavailable is the number of replicas that can be unavailable. The value can be an absolute number
+	// (e.g. "1") or a percentage (e.g. "50%").
+	MaxUnavailable string `json:"maxUnavailable,omitempty"`
+}
+
 // KubernetesRuntime represents the Kubernetes runtime configuration.
 type KubernetesRuntime struct {
 	// Base represents the Kubernetes resource definition in the serialized YAML format
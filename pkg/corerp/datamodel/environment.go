@@ -38,11 +38,22 @@ func (e *Environment) ResourceTypeName() string {
 
 // EnvironmentProperties represents the properties of Environment.
 type EnvironmentProperties struct {
-	Compute    rpv1.EnvironmentCompute                           `json:"compute,omitempty"`
-	Recipes    map[string]map[string]EnvironmentRecipeProperties `json:"recipes,omitempty"`
-	Providers  Providers                                         `json:"providers,omitempty"`
-	Extensions []Extension                                       `json:"extensions,omitempty"`
-	Simulated  bool                                              `json:"simulated,omitempty"`
+	Compute       rpv1.EnvironmentCompute                           `json:"compute,omitempty"`
+	Recipes       map[string]map[string]EnvironmentRecipeProperties `json:"recipes,omitempty"`
+	Providers     Providers                                         `json:"providers,omitempty"`
+	Extensions    []Extension                                       `json:"extensions,omitempty"`
+	Simulated     bool                                              `json:"simulated,omitempty"`
+	ExtenderKinds map[string]ExtenderKindProperties                 `json:"extenderKinds,omitempty"`
+}
+
+// ExtenderKindProperties represents an extender kind registered with the environment.
+type ExtenderKindProperties struct {
+	// Schema is the JSON schema used to validate the properties of extenders of this kind.
+	Schema map[string]any `json:"schema,omitempty"`
+	// RequiredOutputs is the list of output values that extenders of this kind must provide.
+	RequiredOutputs []string `json:"requiredOutputs,omitempty"`
+	// RequiredSecrets is the list of secrets that extenders of this kind must provide.
+	RequiredSecrets []string `json:"requiredSecrets,omitempty"`
 }
 
 // EnvironmentRecipeProperties represents the properties of environment's recipe.
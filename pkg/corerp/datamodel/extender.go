@@ -69,6 +69,8 @@ func (r *Extender) Recipe() *portableresources.ResourceRecipe {
 // ExtenderProperties represents the properties of Extender resource.
 type ExtenderProperties struct {
 	rpv1.BasicResourceProperties
+	// Kind is the extender kind registered with the environment
+	Kind string `json:"kind,omitempty"`
 	// Additional properties for the resource
 	AdditionalProperties map[string]any `json:"additionalProperties,omitempty"`
 	// Secrets values provided for the resource
@@ -75,6 +75,50 @@ type GatewayRoute struct {
 	Destination   string `json:"destination,omitempty"`
 	Path          string `json:"path,omitempty"`
 	ReplacePrefix string `json:"replacePrefix,omitempty"`
+
+	// Destinations is the list of weighted destinations to split the traffic of the route between.
+	Destinations []GatewayRouteDestination `json:"destinations,omitempty"`
+	// Headers is the list of header conditions that must all match for a request to use the route.
+	Headers []GatewayRouteHeaderMatch `json:"headers,omitempty"`
+	// Cookies is the list of cookie conditions that must all match for a request to use the route.
+	Cookies []GatewayRouteCookieMatch `json:"cookies,omitempty"`
+	// Mirror is the destination to mirror the traffic of the route to.
+	Mirror string `json:"mirror,omitempty"`
+}
+
+// GatewayRouteDestination represents a weighted destination of a Gateway route.
+type GatewayRouteDestination struct {
+	Destination string `json:"destination,omitempty"`
+	Weight      int32  `json:"weight,omitempty"`
+}
+
+// GatewayRouteHeaderMatch represents a header condition of a Gateway route.
+type GatewayRouteHeaderMatch struct {
+	Name     string `json:"name,omitempty"`
+	Exact    string `json:"exact,omitempty"`
+	Contains string `json:"contains,omitempty"`
+	Present  bool   `json:"present,omitempty"`
+}
+
+// GatewayRouteCookieMatch represents a cookie condition of a Gateway route.
+type GatewayRouteCookieMatch struct {
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// RouteDestinations returns the destinations of the route, including the single destination if specified.
+func (r GatewayRoute) RouteDestinations() []string {
+	destinations := []string{}
+	if r.Destination != "" {
+		destinations = append(destinations, r.Destination)
+	}
+	for _, d := range r.Destinations {
+		destinations = append(destinations, d.Destination)
+	}
+	if r.Mirror != "" {
+		destinations = append(destinations, r.Mirror)
+	}
+	return destinations
 }
 
 // GatewayPropertiesHostname - Declare hostname information for the Gateway.
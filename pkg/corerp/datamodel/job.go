@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+const JobResourceType = "Applications.Core/jobs"
+
+// JobResource represents Job resource.
+type JobResource struct {
+	v1.BaseResource
+
+	// TODO: remove this from CoreRP
+	PortableResourceMetadata
+
+	// Properties is the properties of the resource.
+	Properties JobProperties `json:"properties"`
+}
+
+// ResourceTypeName returns the qualified name of the resource.
+func (j JobResource) ResourceTypeName() string {
+	return JobResourceType
+}
+
+// ApplyDeploymentOutput updates the JobResource's Properties, ComputedValues and SecretValues with
+// the DeploymentOutput's DeployedOutputResources, ComputedValues and SecretValues respectively and returns no error.
+func (j *JobResource) ApplyDeploymentOutput(do rpv1.DeploymentOutput) error {
+	j.Properties.Status.OutputResources = do.DeployedOutputResources
+	j.ComputedValues = do.ComputedValues
+	j.SecretValues = do.SecretValues
+	return nil
+}
+
+// OutputResources returns the OutputResources from the JobResource's Properties Status.
+func (j *JobResource) OutputResources() []rpv1.OutputResource {
+	return j.Properties.Status.OutputResources
+}
+
+// ResourceMetadata returns the BasicResourceProperties of the JobResource instance.
+func (j *JobResource) ResourceMetadata() *rpv1.BasicResourceProperties {
+	return &j.Properties.BasicResourceProperties
+}
+
+// IsScheduled returns true if the job runs on a schedule (CronJob) instead of running once.
+func (j *JobResource) IsScheduled() bool {
+	return j.Properties.Schedule != ""
+}
+
+// JobProperties represents the properties of Job. A job shares the container, connections and runtimes
+// definition with ContainerProperties, but runs its container to completion instead of keeping it running.
+type JobProperties struct {
+	rpv1.BasicResourceProperties
+	Connections map[string]ConnectionProperties `json:"connections,omitempty"`
+	Container   Container                       `json:"container,omitempty"`
+	Extensions  []Extension                     `json:"extensions,omitempty"`
+	Identity    *rpv1.IdentitySettings          `json:"identity,omitempty"`
+	Runtimes    *RuntimeProperties              `json:"runtimes,omitempty"`
+
+	// RestartPolicy is the restart policy of the pods of the job. It must be either OnFailure or Never.
+	RestartPolicy string `json:"restartPolicy,omitempty"`
+
+	// Schedule is the cron expression of a scheduled job. The job runs once when the schedule is empty.
+	Schedule string `json:"schedule,omitempty"`
+
+	// ConcurrencyPolicy specifies how concurrent executions of a scheduled job are treated.
+	ConcurrencyPolicy JobConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// Completions is the number of pods that must complete successfully for the job to complete.
+	Completions *int32 `json:"completions,omitempty"`
+
+	// Parallelism is the maximum number of pods of the job running at the same time.
+	Parallelism *int32 `json:"parallelism,omitempty"`
+
+	// BackoffLimit is the number of retries before the job is marked as failed.
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// ActiveDeadlineSeconds is the duration in seconds the job may be active before it is terminated.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// TTLSecondsAfterFinished is the duration in seconds after which a finished job is cleaned up.
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// CompletionTimeoutSeconds is the duration in seconds the deployment waits for the job to complete. The deployment
+	// doesn't wait for the job when it is not set.
+	CompletionTimeoutSeconds *int32 `json:"completionTimeoutSeconds,omitempty"`
+}
+
+// JobConcurrencyPolicy specifies how concurrent executions of a scheduled job are treated.
+type JobConcurrencyPolicy string
+
+const (
+	// JobConcurrencyPolicyAllow allows the executions of a scheduled job to run concurrently.
+	JobConcurrencyPolicyAllow JobConcurrencyPolicy = "Allow"
+
+	// JobConcurrencyPolicyForbid skips the next execution if the previous one hasn't finished yet.
+	JobConcurrencyPolicyForbid JobConcurrencyPolicy = "Forbid"
+
+	// JobConcurrencyPolicyReplace replaces the currently running execution with the new one.
+	JobConcurrencyPolicyReplace JobConcurrencyPolicy = "Replace"
+)
@@ -26,6 +26,9 @@ const VolumeResourceType = "Applications.Core/volumes"
 const (
 	// AzureKeyVaultVolume represents the resource of azure keyvault volume.
 	AzureKeyVaultVolume string = "azure.com.keyvault"
+
+	// KubernetesPersistentVolumeClaimVolume represents the resource of Kubernetes PersistentVolumeClaim volume.
+	KubernetesPersistentVolumeClaimVolume string = "kubernetes.persistentVolumeClaim"
 )
 
 // VolumeResource represents VolumeResource resource.
@@ -70,8 +73,32 @@ type VolumeResourceProperties struct {
 	Kind string `json:"kind,omitempty"`
 	// AzureKeyVault represents Azure Keyvault volume properties
 	AzureKeyVault *AzureKeyVaultVolumeProperties `json:"azureKeyVault,omitempty"`
+	// KubernetesPersistentVolumeClaim represents Kubernetes PersistentVolumeClaim volume properties
+	KubernetesPersistentVolumeClaim *KubernetesPersistentVolumeClaimVolumeProperties `json:"kubernetesPersistentVolumeClaim,omitempty"`
+}
+
+// KubernetesPersistentVolumeClaimVolumeProperties represents the volume backed by a Kubernetes PersistentVolumeClaim.
+type KubernetesPersistentVolumeClaimVolumeProperties struct {
+	// The name of the storage class used to provision the volume
+	StorageClass string `json:"storageClass,omitempty"`
+	// The requested size of the volume, as a Kubernetes quantity
+	Size string `json:"size,omitempty"`
+	// The access modes of the volume
+	AccessModes []PersistentVolumeClaimAccessMode `json:"accessModes,omitempty"`
+	// Keep the PersistentVolumeClaim when the volume resource is deleted
+	RetainOnDelete bool `json:"retainOnDelete,omitempty"`
 }
 
+// PersistentVolumeClaimAccessMode is the access mode of a Kubernetes PersistentVolumeClaim.
+type PersistentVolumeClaimAccessMode string
+
+const (
+	PersistentVolumeClaimAccessModeReadWriteOnce    PersistentVolumeClaimAccessMode = "ReadWriteOnce"
+	PersistentVolumeClaimAccessModeReadOnlyMany     PersistentVolumeClaimAccessMode = "ReadOnlyMany"
+	PersistentVolumeClaimAccessModeReadWriteMany    PersistentVolumeClaimAccessMode = "ReadWriteMany"
+	PersistentVolumeClaimAccessModeReadWriteOncePod PersistentVolumeClaimAccessMode = "ReadWriteOncePod"
+)
+
 // AzureKeyVaultVolumeProperties represents the volume for Azure Keyvault.
 type AzureKeyVaultVolumeProperties struct {
 	// The KeyVault certificates that this volume exposes
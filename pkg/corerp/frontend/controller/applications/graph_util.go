@@ -256,6 +256,8 @@ func computeGraph(applicationName string, applicationResources []generated.Gener
 		connections := resolveConnections(resource, connectionsPath, connectionsResolver(resources))
 		// Resolve Outbound connections based on 'routes'.
 		connections = append(connections, resolveConnections(resource, routesPath, routesPathResolver(resources))...)
+		// Resolve Outbound connections based on the weighted destinations and mirrors of 'routes'.
+		connections = append(connections, resolveRouteDestinations(resource, resources)...)
 		// Resolve Inbound connections based on 'provides'.
 		connections = append(connections, resolveConnections(resource, portsPath, providersResolver)...)
 
@@ -591,6 +593,51 @@ func routesPathResolver(resources []generated.GenericResource) resolver {
 	}
 }
 
+// resolveRouteDestinations resolves the outbound connections of Applications.Core/gateway resource to the weighted
+// destinations and mirrors of its routes.
+func resolveRouteDestinations(resource generated.GenericResource, resources []generated.GenericResource) []*corerpv20231001preview.ApplicationGraphConnection {
+	p, err := jsonpointer.New(routesPath)
+	if err != nil {
+		// This should never fail since we're hard-coding the path.
+		panic("parsing JSON pointer should not fail: " + err.Error())
+	}
+
+	raw, _, err := p.Get(&resource)
+	if err != nil {
+		// Not found, this is fine.
+		return []*corerpv20231001preview.ApplicationGraphConnection{}
+	}
+
+	routes := []corerpv20231001preview.GatewayRoute{}
+	if err := toStronglyTypedData(raw, &routes); err != nil {
+		// Not a list of routes, this is fine.
+		return []*corerpv20231001preview.ApplicationGraphConnection{}
+	}
+
+	entries := []*corerpv20231001preview.ApplicationGraphConnection{}
+	for _, route := range routes {
+		destinations := []string{}
+		for _, d := range route.Destinations {
+			destinations = append(destinations, to.String(d.Destination))
+		}
+		if route.Mirror != nil {
+			destinations = append(destinations, *route.Mirror)
+		}
+
+		for _, destination := range destinations {
+			sourceID, err := findSourceResource(destination, resources)
+			if err == nil && sourceID != "" {
+				entries = append(entries, &corerpv20231001preview.ApplicationGraphConnection{
+					ID:        to.Ptr(sourceID),
+					Direction: to.Ptr(corerpv20231001preview.DirectionOutbound),
+				})
+			}
+		}
+	}
+
+	return entries
+}
+
 // providersResolver is specifically to support HTTPRoute.
 // Any Radius resource type that exposes a port uses the following property path to return them.
 // The port may have a 'provides' attribute that specifies a httproute.
@@ -161,6 +161,13 @@ func Test_computeGraph(t *testing.T) {
 			envResourceDataFile: "",
 			expectedDataFile:    "graph-app-gw-out.json",
 		},
+		{
+			name:                "with gateway weighted destinations and mirror",
+			applicationName:     "myapp",
+			appResourceDataFile: "graph-app-gw-split-in.json",
+			envResourceDataFile: "",
+			expectedDataFile:    "graph-app-gw-split-out.json",
+		},
 	}
 
 	for _, tt := range tests {
@@ -21,6 +21,7 @@ import (
 	ext_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/extenders"
 	gtwy_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/gateways"
 	hrt_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/httproutes"
+	job_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/jobs"
 	sstr_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/secretstores"
 	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
 	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
@@ -44,6 +45,7 @@ var (
 		gtwy_ctrl.ResourceTypeName,
 		hrt_ctrl.ResourceTypeName,
 		cntr_ctrl.ResourceTypeName,
+		job_ctrl.ResourceTypeName,
 		sstr_ctrl.ResourceTypeName,
 	}
 )
@@ -20,6 +20,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -34,8 +36,10 @@ import (
 )
 
 const (
-	manifestTargetProperty = "$.properties.runtimes.kubernetes.base"
-	podTargetProperty      = "$.properties.runtimes.kubernetes.pod"
+	manifestTargetProperty         = "$.properties.runtimes.kubernetes.base"
+	podTargetProperty              = "$.properties.runtimes.kubernetes.pod"
+	rolloutStrategyTargetProperty  = "$.properties.rolloutStrategy"
+	disruptionBudgetTargetProperty = "$.properties.disruptionBudget"
 )
 
 // ValidateAndMutateRequest checks if the newResource has a user-defined identity and if so, returns a bad request
@@ -52,6 +56,20 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 		newResource.Properties.Identity = oldResource.Properties.Identity
 	}
 
+	if newResource.Properties.RolloutStrategy != nil {
+		err := validateRolloutStrategy(newResource.Properties.RolloutStrategy)
+		if err != nil {
+			return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+		}
+	}
+
+	if newResource.Properties.DisruptionBudget != nil {
+		err := validateDisruptionBudget(newResource.Properties.DisruptionBudget)
+		if err != nil {
+			return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+		}
+	}
+
 	runtimes := newResource.Properties.Runtimes
 	if runtimes != nil && runtimes.Kubernetes != nil {
 		if runtimes.Kubernetes.Base != "" {
@@ -88,6 +106,65 @@ func validatePodSpec(patch []byte) error {
 	return nil
 }
 
+// validateRolloutStrategy validates that maxSurge and maxUnavailable are only set for the RollingUpdate
+// strategy and that their values are either non-negative integers or percentages.
+func validateRolloutStrategy(strategy *datamodel.RolloutStrategy) error {
+	if strategy.Kind == datamodel.RolloutStrategyKindRecreate && (strategy.MaxSurge != "" || strategy.MaxUnavailable != "") {
+		return v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  rolloutStrategyTargetProperty,
+			Message: "maxSurge and maxUnavailable are not allowed for the Recreate rollout strategy.",
+		}
+	}
+
+	for name, value := range map[string]string{"maxSurge": strategy.MaxSurge, "maxUnavailable": strategy.MaxUnavailable} {
+		if value != "" && !isIntOrPercent(value) {
+			return v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  rolloutStrategyTargetProperty + "." + name,
+				Message: fmt.Sprintf("%s must be a non-negative integer or a percentage, but got %q.", name, value),
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateDisruptionBudget validates that exactly one of minAvailable and maxUnavailable is set and that
+// the value is either a non-negative integer or a percentage.
+func validateDisruptionBudget(budget *datamodel.DisruptionBudget) error {
+	if (budget.MinAvailable == "") == (budget.MaxUnavailable == "") {
+		return v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  disruptionBudgetTargetProperty,
+			Message: "exactly one of minAvailable and maxUnavailable must be specified.",
+		}
+	}
+
+	for name, value := range map[string]string{"minAvailable": budget.MinAvailable, "maxUnavailable": budget.MaxUnavailable} {
+		if value != "" && !isIntOrPercent(value) {
+			return v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  disruptionBudgetTargetProperty + "." + name,
+				Message: fmt.Sprintf("%s must be a non-negative integer or a percentage, but got %q.", name, value),
+			}
+		}
+	}
+
+	return nil
+}
+
+// isIntOrPercent returns true if the value is a non-negative integer (e.g. "1") or a percentage (e.g. "25%").
+func isIntOrPercent(value string) bool {
+	number, isPercent := strings.CutSuffix(value, "%")
+	n, err := strconv.Atoi(number)
+	if err != nil || n < 0 {
+		return false
+	}
+
+	return !isPercent || n <= 100
+}
+
 func errMultipleResources(typeName string, num int) v1.ErrorDetails {
 	return v1.ErrorDetails{
 		Code:    v1.CodeInvalidRequestContent,
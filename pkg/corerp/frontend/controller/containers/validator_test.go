@@ -444,3 +444,118 @@ func TestValidatePodSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRolloutStrategy(t *testing.T) {
+	strategyTests := []struct {
+		name     string
+		strategy *datamodel.RolloutStrategy
+		err      error
+	}{
+		{
+			name: "valid rolling update",
+			strategy: &datamodel.RolloutStrategy{
+				Kind:           datamodel.RolloutStrategyKindRollingUpdate,
+				MaxSurge:       "1",
+				MaxUnavailable: "25%",
+			},
+			err: nil,
+		},
+		{
+			name:     "valid recreate",
+			strategy: &datamodel.RolloutStrategy{Kind: datamodel.RolloutStrategyKindRecreate},
+			err:      nil,
+		},
+		{
+			name: "recreate with maxSurge",
+			strategy: &datamodel.RolloutStrategy{
+				Kind:     datamodel.RolloutStrategyKindRecreate,
+				MaxSurge: "1",
+			},
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  rolloutStrategyTargetProperty,
+				Message: "maxSurge and maxUnavailable are not allowed for the Recreate rollout strategy.",
+			},
+		},
+		{
+			name: "invalid maxUnavailable",
+			strategy: &datamodel.RolloutStrategy{
+				Kind:           datamodel.RolloutStrategyKindRollingUpdate,
+				MaxUnavailable: "half",
+			},
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  rolloutStrategyTargetProperty + ".maxUnavailable",
+				Message: "maxUnavailable must be a non-negative integer or a percentage, but got \"half\".",
+			},
+		},
+	}
+
+	for _, tc := range strategyTests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateRolloutStrategy(tc.strategy)
+			if tc.err != nil {
+				require.Equal(t, tc.err, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDisruptionBudget(t *testing.T) {
+	budgetTests := []struct {
+		name   string
+		budget *datamodel.DisruptionBudget
+		err    error
+	}{
+		{
+			name:   "valid minAvailable",
+			budget: &datamodel.DisruptionBudget{MinAvailable: "50%"},
+			err:    nil,
+		},
+		{
+			name:   "valid maxUnavailable",
+			budget: &datamodel.DisruptionBudget{MaxUnavailable: "1"},
+			err:    nil,
+		},
+		{
+			name:   "both values set",
+			budget: &datamodel.DisruptionBudget{MinAvailable: "1", MaxUnavailable: "1"},
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  disruptionBudgetTargetProperty,
+				Message: "exactly one of minAvailable and maxUnavailable must be specified.",
+			},
+		},
+		{
+			name:   "no values set",
+			budget: &datamodel.DisruptionBudget{},
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  disruptionBudgetTargetProperty,
+				Message: "exactly one of minAvailable and maxUnavailable must be specified.",
+			},
+		},
+		{
+			name:   "percentage over 100",
+			budget: &datamodel.DisruptionBudget{MinAvailable: "150%"},
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  disruptionBudgetTargetProperty + ".minAvailable",
+				Message: "minAvailable must be a non-negative integer or a percentage, but got \"150%\".",
+			},
+		},
+	}
+
+	for _, tc := range budgetTests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateDisruptionBudget(tc.budget)
+			if tc.err != nil {
+				require.Equal(t, tc.err, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/corerp/frontend/controller/util"
+	"github.com/radius-project/radius/pkg/corerp/processors/extenders"
 )
 
 var _ ctrl.Controller = (*CreateOrUpdateEnvironment)(nil)
@@ -49,7 +50,9 @@ func NewCreateOrUpdateEnvironment(opts ctrl.Options) (ctrl.Controller, error) {
 }
 
 // Run checks if a resource with the same namespace already exists, and if not, updates the resource with the new values.
-// If a resource with the same namespace already exists, a conflict response is returned.
+// If a resource with the same namespace already exists, or the environment is locked, a conflict response is returned. If creating
+// the environment exceeds the resource quota of its resource group, a QuotaExceeded response is returned. If the schema of an
+// extender kind is not a valid JSON schema, a BadRequest response is returned.
 func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	newResource, err := e.GetResourceFromRequest(ctx, req)
@@ -65,10 +68,22 @@ func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWrit
 		return r, err
 	}
 
+	if r, err := e.ValidateLocks(ctx, req, newResource, old); r != nil || err != nil {
+		return r, err
+	}
+
+	if r, err := e.ValidateQuota(ctx, newResource, old); r != nil || err != nil {
+		return r, err
+	}
+
 	if err := newResource.Properties.Compute.Identity.Validate(); err != nil {
 		return rest.NewBadRequestResponse(err.Error()), nil
 	}
 
+	if err := extenders.ValidateKinds(newResource.Properties.ExtenderKinds); err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
 	// Create Query filter to query kubernetes namespace used by the other environment resources.
 	namespace := newResource.Properties.Compute.KubernetesCompute.Namespace
 	result, err := util.FindResources(ctx, serviceCtx.ResourceID.RootScope(), serviceCtx.ResourceID.Type(), "properties.compute.kubernetes.namespace", namespace, e.StorageClient())
@@ -95,5 +110,7 @@ func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWrit
 		return nil, err
 	}
 
+	e.PublishResourceEvent(ctx, ctrl.ResourceEventType(old), newResource)
+
 	return e.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
 }
@@ -408,4 +408,32 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 			require.Equal(t, tt.expectedStatusCode, w.Result().StatusCode)
 		})
 	}
+
+	t.Run("invalid-extender-kind-schema", func(t *testing.T) {
+		envInput, _, _ := getTestModels20231001preview()
+		envInput.Properties.ExtenderKinds = map[string]*v20231001preview.ExtenderKindProperties{
+			"postgres": {
+				Schema: map[string]any{"type": 5},
+			},
+		}
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodPut, testHeaderfile, envInput)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		mStorageClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+				return nil, &store.ErrNotFound{ID: id}
+			})
+
+		ctl, err := NewCreateOrUpdateEnvironment(ctrl.Options{StorageClient: mStorageClient})
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+		require.Contains(t, w.Body.String(), "postgres")
+	})
 }
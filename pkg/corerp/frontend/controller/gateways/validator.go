@@ -18,16 +18,35 @@ package gateways
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 )
 
-// ValidateAndMutateRequest checks if the TLS configuration is valid and sets the TLS protocol version to 1.2 if it is not
-// specified. It returns a BadRequestResponse error if SSL Passthrough and TLS termination are both configured or if TLS
-// protocol version is set but certificateFrom is not.
+const (
+	// maxCookieMatches is the maximum number of cookie conditions of a route. Each cookie condition is rendered as three
+	// alternative match conditions, so the number of includes of the root HTTPProxy grows as 3^n with n cookie conditions.
+	maxCookieMatches = 3
+)
+
+// ValidateAndMutateRequest checks if the TLS configuration and the routes are valid and sets the TLS protocol version to 1.2
+// if it is not specified. It returns a BadRequestResponse error if SSL Passthrough and TLS termination are both configured,
+// if TLS protocol version is set but certificateFrom is not, or if a route has invalid destinations or match conditions.
 func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *datamodel.Gateway, options *controller.Options) (rest.Response, error) {
+	for i, route := range newResource.Properties.Routes {
+		if msg := validateRoute(i, route); msg != "" {
+			return rest.NewBadRequestResponse(msg), nil
+		}
+
+		sslPassthrough := newResource.Properties.TLS != nil && newResource.Properties.TLS.SSLPassthrough
+		if sslPassthrough && (len(route.Destinations) > 0 || len(route.Headers) > 0 || len(route.Cookies) > 0 || route.Mirror != "") {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Fields destinations, headers, cookies and mirror of $.properties.routes[%d] cannot be specified when $.properties.tls.sslPassthrough is set.", i)), nil
+		}
+	}
+
 	if newResource.Properties.TLS != nil {
 		// If SSL Passthrough and TLS termination are both configured, then report an error
 		if newResource.Properties.TLS.SSLPassthrough && newResource.Properties.TLS.CertificateFrom != "" {
@@ -47,3 +66,66 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 
 	return nil, nil
 }
+
+// validateRoute returns an error message if the route is invalid, or an empty string otherwise.
+func validateRoute(index int, route datamodel.GatewayRoute) string {
+	if route.Destination != "" && len(route.Destinations) > 0 {
+		return fmt.Sprintf("Only one of $.properties.routes[%d].destination and $.properties.routes[%d].destinations can be specified at a time.", index, index)
+	}
+
+	// A mirror only receives a copy of the traffic, the route still needs a destination to respond to the requests.
+	if route.Destination == "" && len(route.Destinations) == 0 {
+		return fmt.Sprintf("One of $.properties.routes[%d].destination and $.properties.routes[%d].destinations is required.", index, index)
+	}
+
+	total := int32(0)
+	for j, d := range route.Destinations {
+		if d.Destination == "" {
+			return fmt.Sprintf("Field $.properties.routes[%d].destinations[%d].destination is required.", index, j)
+		}
+		if d.Weight < 0 {
+			return fmt.Sprintf("Field $.properties.routes[%d].destinations[%d].weight must not be negative.", index, j)
+		}
+		total += d.Weight
+	}
+	if len(route.Destinations) > 0 && total == 0 {
+		return fmt.Sprintf("At least one of $.properties.routes[%d].destinations must have a weight greater than 0.", index)
+	}
+
+	for j, h := range route.Headers {
+		if h.Name == "" {
+			return fmt.Sprintf("Field $.properties.routes[%d].headers[%d].name is required.", index, j)
+		}
+
+		count := 0
+		if h.Exact != "" {
+			count++
+		}
+		if h.Contains != "" {
+			count++
+		}
+		if h.Present {
+			count++
+		}
+		if count != 1 {
+			return fmt.Sprintf("Exactly one of exact, contains and present must be specified for $.properties.routes[%d].headers[%d].", index, j)
+		}
+	}
+
+	if len(route.Cookies) > maxCookieMatches {
+		return fmt.Sprintf("At most %d cookie conditions can be specified for $.properties.routes[%d].", maxCookieMatches, index)
+	}
+
+	for j, c := range route.Cookies {
+		if c.Name == "" || c.Value == "" {
+			return fmt.Sprintf("Fields name and value of $.properties.routes[%d].cookies[%d] are required.", index, j)
+		}
+
+		// Cookies are matched on the separators of the Cookie header, which must not be part of the condition.
+		if strings.ContainsAny(c.Name, "=; \t") || strings.ContainsAny(c.Value, "; \t") {
+			return fmt.Sprintf("Fields name and value of $.properties.routes[%d].cookies[%d] must not contain separators or whitespace.", index, j)
+		}
+	}
+
+	return ""
+}
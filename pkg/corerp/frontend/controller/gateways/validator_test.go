@@ -108,6 +108,195 @@ func TestValidateAndMutateRequest_Gateway(t *testing.T) {
 			},
 			resp: nil,
 		},
+		{
+			desc: "weighted destinations and match conditions",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Path: "/",
+							Destinations: []datamodel.GatewayRouteDestination{
+								{Destination: "frontendv1", Weight: 90},
+								{Destination: "frontendv2", Weight: 10},
+							},
+							Mirror: "frontendshadow",
+						},
+						{
+							Path:        "/",
+							Destination: "frontendv2",
+							Headers:     []datamodel.GatewayRouteHeaderMatch{{Name: "x-canary", Exact: "true"}},
+							Cookies:     []datamodel.GatewayRouteCookieMatch{{Name: "version", Value: "v2"}},
+						},
+					},
+				},
+			},
+			mutatedResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Path: "/",
+							Destinations: []datamodel.GatewayRouteDestination{
+								{Destination: "frontendv1", Weight: 90},
+								{Destination: "frontendv2", Weight: 10},
+							},
+							Mirror: "frontendshadow",
+						},
+						{
+							Path:        "/",
+							Destination: "frontendv2",
+							Headers:     []datamodel.GatewayRouteHeaderMatch{{Name: "x-canary", Exact: "true"}},
+							Cookies:     []datamodel.GatewayRouteCookieMatch{{Name: "version", Value: "v2"}},
+						},
+					},
+				},
+			},
+			resp: nil,
+		},
+		{
+			desc: "specify both destination and destinations",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination:  "frontendv1",
+							Destinations: []datamodel.GatewayRouteDestination{{Destination: "frontendv2", Weight: 10}},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Only one of $.properties.routes[0].destination and $.properties.routes[0].destinations can be specified at a time."),
+		},
+		{
+			desc: "route with only a mirror",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Path:   "/",
+							Mirror: "frontendshadow",
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("One of $.properties.routes[0].destination and $.properties.routes[0].destinations is required."),
+		},
+		{
+			desc: "route without destination",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Path: "/",
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("One of $.properties.routes[0].destination and $.properties.routes[0].destinations is required."),
+		},
+		{
+			desc: "negative destination weight",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destinations: []datamodel.GatewayRouteDestination{{Destination: "frontendv1", Weight: -1}},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].destinations[0].weight must not be negative."),
+		},
+		{
+			desc: "all destination weights are zero",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destinations: []datamodel.GatewayRouteDestination{{Destination: "frontendv1"}, {Destination: "frontendv2"}},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("At least one of $.properties.routes[0].destinations must have a weight greater than 0."),
+		},
+		{
+			desc: "header match without condition",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination: "frontendv1",
+							Headers:     []datamodel.GatewayRouteHeaderMatch{{Name: "x-canary"}},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Exactly one of exact, contains and present must be specified for $.properties.routes[0].headers[0]."),
+		},
+		{
+			desc: "cookie match without value",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination: "frontendv1",
+							Cookies:     []datamodel.GatewayRouteCookieMatch{{Name: "version"}},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Fields name and value of $.properties.routes[0].cookies[0] are required."),
+		},
+		{
+			desc: "too many cookie matches",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination: "frontendv1",
+							Cookies: []datamodel.GatewayRouteCookieMatch{
+								{Name: "a", Value: "1"},
+								{Name: "b", Value: "2"},
+								{Name: "c", Value: "3"},
+								{Name: "d", Value: "4"},
+							},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("At most 3 cookie conditions can be specified for $.properties.routes[0]."),
+		},
+		{
+			desc: "cookie match with separator",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination: "frontendv1",
+							Cookies:     []datamodel.GatewayRouteCookieMatch{{Name: "version", Value: "v2; admin=true"}},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Fields name and value of $.properties.routes[0].cookies[0] must not contain separators or whitespace."),
+		},
+		{
+			desc: "match conditions with SSL Passthrough",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					TLS: &datamodel.GatewayPropertiesTLS{
+						SSLPassthrough: true,
+					},
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination: "frontendv1",
+							Headers:     []datamodel.GatewayRouteHeaderMatch{{Name: "x-canary", Present: true}},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Fields destinations, headers, cookies and mirror of $.properties.routes[0] cannot be specified when $.properties.tls.sslPassthrough is set."),
+		},
 	}
 
 	for _, tc := range requestTests {
@@ -0,0 +1,241 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+)
+
+const (
+	// maxNameLength is the maximum length of the name of a job. The name is used in the names and labels of the
+	// Kubernetes resources, which can't be longer than 63 characters.
+	maxNameLength = 63
+
+	// maxCompletionTimeoutSeconds is the maximum duration in seconds the deployment of a job can wait for the job to
+	// complete. It is shorter than AsyncCreateOrUpdateJobTimeout.
+	maxCompletionTimeoutSeconds = 30 * 60
+
+	// maxScheduledNameLength is the maximum length of the name of a scheduled job. The CronJob controller appends
+	// an 11 character suffix to the name of the CronJob for the names of the Jobs it creates.
+	maxScheduledNameLength = 52
+
+	nameTargetProperty              = "$.name"
+	manifestTargetProperty          = "$.properties.runtimes.kubernetes.base"
+	podTargetProperty               = "$.properties.runtimes.kubernetes.pod"
+	restartPolicyTargetProperty     = "$.properties.restartPolicy"
+	scheduleTargetProperty          = "$.properties.schedule"
+	concurrencyPolicyTargetProperty = "$.properties.concurrencyPolicy"
+	extensionsTargetProperty        = "$.properties.extensions"
+	completionTimeoutTargetProperty = "$.properties.completionTimeoutSeconds"
+)
+
+var (
+	// cronMacros is the set of predefined schedules supported by Kubernetes CronJobs.
+	cronMacros = map[string]bool{
+		"@yearly":   true,
+		"@annually": true,
+		"@monthly":  true,
+		"@weekly":   true,
+		"@daily":    true,
+		"@midnight": true,
+		"@hourly":   true,
+	}
+
+	// cronFieldRegex matches a single field of a cron expression, e.g. "*", "*/5", "1-5", "1,15" or "MON-FRI".
+	cronFieldRegex = regexp.MustCompile(`^[0-9A-Za-z*?/,\-]+$`)
+)
+
+// ValidateAndMutateRequest validates the job resource and preserves the identity populated during the deployment
+// of the old resource. Only the validation which can be done without the cluster is done here, the semantic validation
+// of the job is done by Kubernetes API server when the job is deployed.
+func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *datamodel.JobResource, options *controller.Options) (rest.Response, error) {
+	if newResource.Properties.Identity != nil {
+		return rest.NewBadRequestResponse("User-defined identity in Applications.Core/jobs is not supported."), nil
+	}
+
+	if oldResource != nil {
+		// Identity property is populated during deployment. This will populate the existing identity to new resource
+		// to keep the identity info.
+		newResource.Properties.Identity = oldResource.Properties.Identity
+	}
+
+	if err := validateName(newResource.Name, newResource.IsScheduled()); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+	}
+
+	if err := validateJobProperties(&newResource.Properties); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+	}
+
+	return nil, nil
+}
+
+// validateName validates that the name of the job fits in the names of the Kubernetes Job or CronJob.
+func validateName(name string, scheduled bool) error {
+	if scheduled && len(name) > maxScheduledNameLength {
+		return v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  nameTargetProperty,
+			Message: fmt.Sprintf("name of a scheduled job must be no more than %d characters, but got %d characters.", maxScheduledNameLength, len(name)),
+		}
+	}
+
+	if len(name) > maxNameLength {
+		return v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  nameTargetProperty,
+			Message: fmt.Sprintf("name of a job must be no more than %d characters, but got %d characters.", maxNameLength, len(name)),
+		}
+	}
+
+	return nil
+}
+
+func validateJobProperties(properties *datamodel.JobProperties) error {
+	switch corev1.RestartPolicy(properties.RestartPolicy) {
+	case "", corev1.RestartPolicyOnFailure, corev1.RestartPolicyNever:
+	default:
+		return v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  restartPolicyTargetProperty,
+			Message: fmt.Sprintf("restartPolicy must be either OnFailure or Never for jobs, but got %q.", properties.RestartPolicy),
+		}
+	}
+
+	if properties.Schedule != "" && !isValidSchedule(properties.Schedule) {
+		return v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  scheduleTargetProperty,
+			Message: fmt.Sprintf("schedule must be a cron expression with 5 fields or a predefined schedule such as @hourly, but got %q.", properties.Schedule),
+		}
+	}
+
+	if properties.Schedule == "" && properties.ConcurrencyPolicy != "" {
+		return v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  concurrencyPolicyTargetProperty,
+			Message: "concurrencyPolicy is only allowed for scheduled jobs.",
+		}
+	}
+
+	for name, value := range map[string]*int32{
+		"completions":             properties.Completions,
+		"parallelism":             properties.Parallelism,
+		"backoffLimit":            properties.BackoffLimit,
+		"ttlSecondsAfterFinished": properties.TTLSecondsAfterFinished,
+	} {
+		if value != nil && *value < 0 {
+			return v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties." + name,
+				Message: fmt.Sprintf("%s must be a non-negative integer, but got %d.", name, *value),
+			}
+		}
+	}
+
+	if properties.ActiveDeadlineSeconds != nil && *properties.ActiveDeadlineSeconds <= 0 {
+		return v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  "$.properties.activeDeadlineSeconds",
+			Message: fmt.Sprintf("activeDeadlineSeconds must be a positive integer, but got %d.", *properties.ActiveDeadlineSeconds),
+		}
+	}
+
+	if properties.CompletionTimeoutSeconds != nil {
+		if properties.Schedule != "" {
+			return v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  completionTimeoutTargetProperty,
+				Message: "completionTimeoutSeconds is not allowed for scheduled jobs.",
+			}
+		}
+
+		if timeout := *properties.CompletionTimeoutSeconds; timeout <= 0 || timeout > maxCompletionTimeoutSeconds {
+			return v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  completionTimeoutTargetProperty,
+				Message: fmt.Sprintf("completionTimeoutSeconds must be between 1 and %d, but got %d.", maxCompletionTimeoutSeconds, timeout),
+			}
+		}
+	}
+
+	for _, e := range properties.Extensions {
+		if e.Kind != datamodel.KubernetesMetadata {
+			return v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  extensionsTargetProperty,
+				Message: fmt.Sprintf("extension %q is not supported for jobs.", e.Kind),
+			}
+		}
+	}
+
+	runtimes := properties.Runtimes
+	if runtimes != nil && runtimes.Kubernetes != nil {
+		if runtimes.Kubernetes.Base != "" {
+			return v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  manifestTargetProperty,
+				Message: "base manifest is not supported for jobs.",
+			}
+		}
+
+		if runtimes.Kubernetes.Pod != "" {
+			// Only the syntactic validation is done here, the same as Applications.Core/containers.
+			if err := json.Unmarshal([]byte(runtimes.Kubernetes.Pod), &corev1.PodSpec{}); err != nil {
+				return v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  podTargetProperty,
+					Message: fmt.Sprintf("Invalid PodSpec for patching: %s.", err.Error()),
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// isValidSchedule returns true if the schedule is a predefined schedule or a cron expression with 5 fields. The
+// values of the fields are validated by Kubernetes when the CronJob is created.
+func isValidSchedule(schedule string) bool {
+	if strings.HasPrefix(schedule, "@") {
+		return cronMacros[strings.ToLower(schedule)]
+	}
+
+	fields := strings.Fields(schedule)
+	if len(fields) != 5 {
+		return false
+	}
+
+	for _, f := range fields {
+		if !cronFieldRegex.MatchString(f) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobs
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAndMutateRequest_IdentityProperty(t *testing.T) {
+	identity := &rpv1.IdentitySettings{
+		Kind:       rpv1.AzureIdentityWorkload,
+		OIDCIssuer: "https://oidcurl/id",
+		Resource:   "identity-resource-id",
+	}
+
+	t.Run("user defined identity not supported", func(t *testing.T) {
+		newResource := &datamodel.JobResource{Properties: datamodel.JobProperties{Identity: identity}}
+		resp, err := ValidateAndMutateRequest(context.Background(), newResource, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, rest.NewBadRequestResponse("User-defined identity in Applications.Core/jobs is not supported."), resp)
+	})
+
+	t.Run("identity of old resource is preserved", func(t *testing.T) {
+		newResource := &datamodel.JobResource{}
+		oldResource := &datamodel.JobResource{Properties: datamodel.JobProperties{Identity: identity}}
+		resp, err := ValidateAndMutateRequest(context.Background(), newResource, oldResource, nil)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+		require.Equal(t, identity, newResource.Properties.Identity)
+	})
+}
+
+func TestValidateName(t *testing.T) {
+	tests := []struct {
+		desc      string
+		name      string
+		scheduled bool
+		err       *v1.ErrorDetails
+	}{
+		{
+			desc: "valid",
+			name: "test-job",
+		},
+		{
+			desc: "maximum length",
+			name: strings.Repeat("a", 63),
+		},
+		{
+			desc:      "maximum length of scheduled job",
+			name:      strings.Repeat("a", 52),
+			scheduled: true,
+		},
+		{
+			desc: "too long",
+			name: strings.Repeat("a", 64),
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  nameTargetProperty,
+				Message: "name of a job must be no more than 63 characters, but got 64 characters.",
+			},
+		},
+		{
+			desc:      "too long for scheduled job",
+			name:      strings.Repeat("a", 53),
+			scheduled: true,
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  nameTargetProperty,
+				Message: "name of a scheduled job must be no more than 52 characters, but got 53 characters.",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := validateName(tc.name, tc.scheduled)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, *tc.err, err)
+			}
+		})
+	}
+
+	t.Run("request is rejected", func(t *testing.T) {
+		newResource := &datamodel.JobResource{Properties: datamodel.JobProperties{Schedule: "@hourly"}}
+		newResource.Name = strings.Repeat("a", 53)
+		resp, err := ValidateAndMutateRequest(context.Background(), newResource, nil, nil)
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, resp)
+	})
+}
+
+func TestValidateJobProperties(t *testing.T) {
+	tests := []struct {
+		desc       string
+		properties datamodel.JobProperties
+		err        *v1.ErrorDetails
+	}{
+		{
+			desc:       "empty",
+			properties: datamodel.JobProperties{},
+		},
+		{
+			desc: "valid scheduled job",
+			properties: datamodel.JobProperties{
+				RestartPolicy:           "Never",
+				Schedule:                "*/5 * * * MON-FRI",
+				ConcurrencyPolicy:       datamodel.JobConcurrencyPolicyForbid,
+				Completions:             to.Ptr(int32(2)),
+				Parallelism:             to.Ptr(int32(2)),
+				BackoffLimit:            to.Ptr(int32(0)),
+				ActiveDeadlineSeconds:   to.Ptr(int64(60)),
+				TTLSecondsAfterFinished: to.Ptr(int32(0)),
+				Extensions:              []datamodel.Extension{{Kind: datamodel.KubernetesMetadata}},
+				Runtimes:                &datamodel.RuntimeProperties{Kubernetes: &datamodel.KubernetesRuntime{Pod: `{"nodeSelector":{"pool":"batch"}}`}},
+			},
+		},
+		{
+			desc:       "predefined schedule",
+			properties: datamodel.JobProperties{Schedule: "@hourly"},
+		},
+		{
+			desc:       "invalid restart policy",
+			properties: datamodel.JobProperties{RestartPolicy: "Always"},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  restartPolicyTargetProperty,
+				Message: "restartPolicy must be either OnFailure or Never for jobs, but got \"Always\".",
+			},
+		},
+		{
+			desc:       "schedule with too few fields",
+			properties: datamodel.JobProperties{Schedule: "* * *"},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  scheduleTargetProperty,
+				Message: "schedule must be a cron expression with 5 fields or a predefined schedule such as @hourly, but got \"* * *\".",
+			},
+		},
+		{
+			desc:       "unknown predefined schedule",
+			properties: datamodel.JobProperties{Schedule: "@sometimes"},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  scheduleTargetProperty,
+				Message: "schedule must be a cron expression with 5 fields or a predefined schedule such as @hourly, but got \"@sometimes\".",
+			},
+		},
+		{
+			desc:       "concurrency policy without schedule",
+			properties: datamodel.JobProperties{ConcurrencyPolicy: datamodel.JobConcurrencyPolicyReplace},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  concurrencyPolicyTargetProperty,
+				Message: "concurrencyPolicy is only allowed for scheduled jobs.",
+			},
+		},
+		{
+			desc:       "negative completions",
+			properties: datamodel.JobProperties{Completions: to.Ptr(int32(-1))},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.completions",
+				Message: "completions must be a non-negative integer, but got -1.",
+			},
+		},
+		{
+			desc:       "zero active deadline",
+			properties: datamodel.JobProperties{ActiveDeadlineSeconds: to.Ptr(int64(0))},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.activeDeadlineSeconds",
+				Message: "activeDeadlineSeconds must be a positive integer, but got 0.",
+			},
+		},
+		{
+			desc:       "completion timeout",
+			properties: datamodel.JobProperties{CompletionTimeoutSeconds: to.Ptr(int32(600))},
+		},
+		{
+			desc:       "completion timeout of scheduled job",
+			properties: datamodel.JobProperties{Schedule: "@hourly", CompletionTimeoutSeconds: to.Ptr(int32(600))},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  completionTimeoutTargetProperty,
+				Message: "completionTimeoutSeconds is not allowed for scheduled jobs.",
+			},
+		},
+		{
+			desc:       "too long completion timeout",
+			properties: datamodel.JobProperties{CompletionTimeoutSeconds: to.Ptr(int32(3600))},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  completionTimeoutTargetProperty,
+				Message: "completionTimeoutSeconds must be between 1 and 1800, but got 3600.",
+			},
+		},
+		{
+			desc:       "unsupported extension",
+			properties: datamodel.JobProperties{Extensions: []datamodel.Extension{{Kind: datamodel.ManualScaling}}},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  extensionsTargetProperty,
+				Message: "extension \"manualScaling\" is not supported for jobs.",
+			},
+		},
+		{
+			desc:       "base manifest",
+			properties: datamodel.JobProperties{Runtimes: &datamodel.RuntimeProperties{Kubernetes: &datamodel.KubernetesRuntime{Base: "apiVersion: v1"}}},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  manifestTargetProperty,
+				Message: "base manifest is not supported for jobs.",
+			},
+		},
+		{
+			desc:       "invalid pod spec",
+			properties: datamodel.JobProperties{Runtimes: &datamodel.RuntimeProperties{Kubernetes: &datamodel.KubernetesRuntime{Pod: `{"containers": "invalid"}`}}},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  podTargetProperty,
+				Message: "Invalid PodSpec for patching: json: cannot unmarshal string into Go struct field PodSpec.containers of type []v1.Container.",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := validateJobProperties(&tc.properties)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, *tc.err, err)
+			}
+		})
+	}
+}
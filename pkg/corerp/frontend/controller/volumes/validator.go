@@ -26,6 +26,7 @@ import (
 
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -41,6 +42,10 @@ func ValidateRequest(ctx context.Context, newResource *datamodel.VolumeResource,
 	switch newResource.Properties.Kind {
 	case datamodel.AzureKeyVaultVolume:
 		csiCRDValidationRequired = true
+	case datamodel.KubernetesPersistentVolumeClaimVolume:
+		if resp := validatePersistentVolumeClaim(newResource, oldResource); resp != nil {
+			return resp, nil
+		}
 	default:
 		return rest.NewBadRequestResponse(fmt.Sprintf("invalid resource kind: %s", newResource.Properties.Kind)), nil
 	}
@@ -59,3 +64,59 @@ func ValidateRequest(ctx context.Context, newResource *datamodel.VolumeResource,
 
 	return nil, nil
 }
+
+// validatePersistentVolumeClaim validates the properties of a kubernetes.persistentVolumeClaim volume. The storage class
+// and access modes of a PersistentVolumeClaim are immutable and the volume can't be shrunk once it has been created.
+func validatePersistentVolumeClaim(newResource *datamodel.VolumeResource, oldResource *datamodel.VolumeResource) rest.Response {
+	properties := newResource.Properties.KubernetesPersistentVolumeClaim
+	if properties == nil {
+		return rest.NewBadRequestResponse("kubernetes.persistentVolumeClaim volume properties must be specified")
+	}
+
+	size, err := resource.ParseQuantity(properties.Size)
+	if err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("invalid volume size %q: %s", properties.Size, err.Error()))
+	}
+
+	for _, mode := range properties.AccessModes {
+		switch mode {
+		case datamodel.PersistentVolumeClaimAccessModeReadWriteOnce, datamodel.PersistentVolumeClaimAccessModeReadOnlyMany,
+			datamodel.PersistentVolumeClaimAccessModeReadWriteMany, datamodel.PersistentVolumeClaimAccessModeReadWriteOncePod:
+		default:
+			return rest.NewBadRequestResponse(fmt.Sprintf("invalid volume access mode: %s", mode))
+		}
+	}
+
+	if oldResource == nil || oldResource.Properties.KubernetesPersistentVolumeClaim == nil {
+		return nil
+	}
+
+	old := oldResource.Properties.KubernetesPersistentVolumeClaim
+	if old.StorageClass != properties.StorageClass {
+		return rest.NewBadRequestResponse("storageClass of a kubernetes.persistentVolumeClaim volume cannot be changed")
+	}
+
+	if !equalAccessModes(old.AccessModes, properties.AccessModes) {
+		return rest.NewBadRequestResponse("accessModes of a kubernetes.persistentVolumeClaim volume cannot be changed")
+	}
+
+	if oldSize, err := resource.ParseQuantity(old.Size); err == nil && size.Cmp(oldSize) < 0 {
+		return rest.NewBadRequestResponse(fmt.Sprintf("size of a kubernetes.persistentVolumeClaim volume cannot be decreased from %s to %s", old.Size, properties.Size))
+	}
+
+	return nil
+}
+
+func equalAccessModes(a, b []datamodel.PersistentVolumeClaimAccessMode) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
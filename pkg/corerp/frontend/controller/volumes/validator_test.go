@@ -151,6 +151,245 @@ func TestValidateRequest(t *testing.T) {
 			want:    nil,
 			wantErr: nil,
 		},
+		{
+			name: "pvc-valid",
+			args: args{
+				ctx: v1.WithARMRequestContext(
+					context.Background(), &v1.ARMRequestContext{
+						ResourceID: mustParseResourceID(resourceID),
+						HTTPMethod: http.MethodPut,
+					}),
+				newResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind: datamodel.KubernetesPersistentVolumeClaimVolume,
+						KubernetesPersistentVolumeClaim: &datamodel.KubernetesPersistentVolumeClaimVolumeProperties{
+							StorageClass: "standard",
+							Size:         "10Gi",
+							AccessModes:  []datamodel.PersistentVolumeClaimAccessMode{datamodel.PersistentVolumeClaimAccessModeReadWriteOnce},
+						},
+					},
+				},
+				oldResource: &datamodel.VolumeResource{},
+				options: &controller.Options{
+					KubeClient: defaultFakeClient,
+				},
+			},
+			want:    nil,
+			wantErr: nil,
+		},
+		{
+			name: "pvc-missing-properties",
+			args: args{
+				ctx: v1.WithARMRequestContext(
+					context.Background(), &v1.ARMRequestContext{
+						ResourceID: mustParseResourceID(resourceID),
+						HTTPMethod: http.MethodPut,
+					}),
+				newResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind: datamodel.KubernetesPersistentVolumeClaimVolume,
+					},
+				},
+				oldResource: &datamodel.VolumeResource{},
+				options: &controller.Options{
+					KubeClient: defaultFakeClient,
+				},
+			},
+			want:    rest.NewBadRequestResponse("kubernetes.persistentVolumeClaim volume properties must be specified"),
+			wantErr: nil,
+		},
+		{
+			name: "pvc-invalid-size",
+			args: args{
+				ctx: v1.WithARMRequestContext(
+					context.Background(), &v1.ARMRequestContext{
+						ResourceID: mustParseResourceID(resourceID),
+						HTTPMethod: http.MethodPut,
+					}),
+				newResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind: datamodel.KubernetesPersistentVolumeClaimVolume,
+						KubernetesPersistentVolumeClaim: &datamodel.KubernetesPersistentVolumeClaimVolumeProperties{
+							StorageClass: "standard",
+							Size:         "ten-gigs",
+							AccessModes:  []datamodel.PersistentVolumeClaimAccessMode{datamodel.PersistentVolumeClaimAccessModeReadWriteOnce},
+						},
+					},
+				},
+				oldResource: &datamodel.VolumeResource{},
+				options: &controller.Options{
+					KubeClient: defaultFakeClient,
+				},
+			},
+			want:    rest.NewBadRequestResponse("invalid volume size \"ten-gigs\": quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'"),
+			wantErr: nil,
+		},
+		{
+			name: "pvc-invalid-access-mode",
+			args: args{
+				ctx: v1.WithARMRequestContext(
+					context.Background(), &v1.ARMRequestContext{
+						ResourceID: mustParseResourceID(resourceID),
+						HTTPMethod: http.MethodPut,
+					}),
+				newResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind: datamodel.KubernetesPersistentVolumeClaimVolume,
+						KubernetesPersistentVolumeClaim: &datamodel.KubernetesPersistentVolumeClaimVolumeProperties{
+							StorageClass: "standard",
+							Size:         "10Gi",
+							AccessModes:  []datamodel.PersistentVolumeClaimAccessMode{"WriteEverywhere"},
+						},
+					},
+				},
+				oldResource: &datamodel.VolumeResource{},
+				options: &controller.Options{
+					KubeClient: defaultFakeClient,
+				},
+			},
+			want:    rest.NewBadRequestResponse("invalid volume access mode: WriteEverywhere"),
+			wantErr: nil,
+		},
+		{
+			name: "pvc-expand",
+			args: args{
+				ctx: v1.WithARMRequestContext(
+					context.Background(), &v1.ARMRequestContext{
+						ResourceID: mustParseResourceID(resourceID),
+						HTTPMethod: http.MethodPut,
+					}),
+				newResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind: datamodel.KubernetesPersistentVolumeClaimVolume,
+						KubernetesPersistentVolumeClaim: &datamodel.KubernetesPersistentVolumeClaimVolumeProperties{
+							StorageClass: "standard",
+							Size:         "20Gi",
+							AccessModes:  []datamodel.PersistentVolumeClaimAccessMode{datamodel.PersistentVolumeClaimAccessModeReadWriteOnce},
+						},
+					},
+				},
+				oldResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind: datamodel.KubernetesPersistentVolumeClaimVolume,
+						KubernetesPersistentVolumeClaim: &datamodel.KubernetesPersistentVolumeClaimVolumeProperties{
+							StorageClass: "standard",
+							Size:         "10Gi",
+							AccessModes:  []datamodel.PersistentVolumeClaimAccessMode{datamodel.PersistentVolumeClaimAccessModeReadWriteOnce},
+						},
+					},
+				},
+				options: &controller.Options{
+					KubeClient: defaultFakeClient,
+				},
+			},
+			want:    nil,
+			wantErr: nil,
+		},
+		{
+			name: "pvc-shrink",
+			args: args{
+				ctx: v1.WithARMRequestContext(
+					context.Background(), &v1.ARMRequestContext{
+						ResourceID: mustParseResourceID(resourceID),
+						HTTPMethod: http.MethodPut,
+					}),
+				newResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind: datamodel.KubernetesPersistentVolumeClaimVolume,
+						KubernetesPersistentVolumeClaim: &datamodel.KubernetesPersistentVolumeClaimVolumeProperties{
+							StorageClass: "standard",
+							Size:         "5Gi",
+							AccessModes:  []datamodel.PersistentVolumeClaimAccessMode{datamodel.PersistentVolumeClaimAccessModeReadWriteOnce},
+						},
+					},
+				},
+				oldResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind: datamodel.KubernetesPersistentVolumeClaimVolume,
+						KubernetesPersistentVolumeClaim: &datamodel.KubernetesPersistentVolumeClaimVolumeProperties{
+							StorageClass: "standard",
+							Size:         "10Gi",
+							AccessModes:  []datamodel.PersistentVolumeClaimAccessMode{datamodel.PersistentVolumeClaimAccessModeReadWriteOnce},
+						},
+					},
+				},
+				options: &controller.Options{
+					KubeClient: defaultFakeClient,
+				},
+			},
+			want:    rest.NewBadRequestResponse("size of a kubernetes.persistentVolumeClaim volume cannot be decreased from 10Gi to 5Gi"),
+			wantErr: nil,
+		},
+		{
+			name: "pvc-change-storage-class",
+			args: args{
+				ctx: v1.WithARMRequestContext(
+					context.Background(), &v1.ARMRequestContext{
+						ResourceID: mustParseResourceID(resourceID),
+						HTTPMethod: http.MethodPut,
+					}),
+				newResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind: datamodel.KubernetesPersistentVolumeClaimVolume,
+						KubernetesPersistentVolumeClaim: &datamodel.KubernetesPersistentVolumeClaimVolumeProperties{
+							StorageClass: "premium",
+							Size:         "10Gi",
+							AccessModes:  []datamodel.PersistentVolumeClaimAccessMode{datamodel.PersistentVolumeClaimAccessModeReadWriteOnce},
+						},
+					},
+				},
+				oldResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind: datamodel.KubernetesPersistentVolumeClaimVolume,
+						KubernetesPersistentVolumeClaim: &datamodel.KubernetesPersistentVolumeClaimVolumeProperties{
+							StorageClass: "standard",
+							Size:         "10Gi",
+							AccessModes:  []datamodel.PersistentVolumeClaimAccessMode{datamodel.PersistentVolumeClaimAccessModeReadWriteOnce},
+						},
+					},
+				},
+				options: &controller.Options{
+					KubeClient: defaultFakeClient,
+				},
+			},
+			want:    rest.NewBadRequestResponse("storageClass of a kubernetes.persistentVolumeClaim volume cannot be changed"),
+			wantErr: nil,
+		},
+		{
+			name: "pvc-change-access-modes",
+			args: args{
+				ctx: v1.WithARMRequestContext(
+					context.Background(), &v1.ARMRequestContext{
+						ResourceID: mustParseResourceID(resourceID),
+						HTTPMethod: http.MethodPut,
+					}),
+				newResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind: datamodel.KubernetesPersistentVolumeClaimVolume,
+						KubernetesPersistentVolumeClaim: &datamodel.KubernetesPersistentVolumeClaimVolumeProperties{
+							StorageClass: "standard",
+							Size:         "10Gi",
+							AccessModes:  []datamodel.PersistentVolumeClaimAccessMode{datamodel.PersistentVolumeClaimAccessModeReadWriteMany},
+						},
+					},
+				},
+				oldResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind: datamodel.KubernetesPersistentVolumeClaimVolume,
+						KubernetesPersistentVolumeClaim: &datamodel.KubernetesPersistentVolumeClaimVolumeProperties{
+							StorageClass: "standard",
+							Size:         "10Gi",
+							AccessModes:  []datamodel.PersistentVolumeClaimAccessMode{datamodel.PersistentVolumeClaimAccessModeReadWriteOnce},
+						},
+					},
+				},
+				options: &controller.Options{
+					KubeClient: defaultFakeClient,
+				},
+			},
+			want:    rest.NewBadRequestResponse("accessModes of a kubernetes.persistentVolumeClaim volume cannot be changed"),
+			wantErr: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
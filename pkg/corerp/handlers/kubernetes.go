@@ -63,6 +63,7 @@ func NewKubernetesHandler(client client.Client, clientSet k8s.Interface, discove
 		k8sDiscoveryClient: discoveryClient,
 		httpProxyWaiter:    NewHTTPProxyWaiter(dynamicClientSet),
 		deploymentWaiter:   NewDeploymentWaiter(clientSet),
+		jobWaiter:          NewJobWaiter(clientSet),
 	}
 }
 
@@ -72,10 +73,12 @@ type kubernetesHandler struct {
 	k8sDiscoveryClient discovery.ServerResourcesInterface
 	httpProxyWaiter    ResourceWaiter
 	deploymentWaiter   ResourceWaiter
+	jobWaiter          ResourceWaiter
 }
 
 // Put stores the Kubernetes resource in the cluster and returns the properties of the resource. If the resource is a
-// deployment, it also waits until the deployment is ready.
+// deployment, it also waits until the deployment is ready. If the resource is a job with a completion timeout, it waits
+// until the job has completed.
 func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions) (map[string]string, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -130,6 +133,19 @@ func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions)
 		}
 		logger.Info(fmt.Sprintf("Deployment %s in namespace %s is ready", item.GetName(), item.GetNamespace()))
 		return properties, nil
+	case "job":
+		// A job is ready once it is created, unless the deployment waits for it to complete.
+		if _, ok := item.GetAnnotations()[kubernetes.AnnotationJobCompletionTimeout]; !ok {
+			return properties, nil
+		}
+
+		// Monitor the job until it has completed or failed.
+		err = handler.jobWaiter.waitUntilReady(ctx, &item)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info(fmt.Sprintf("Job %s in namespace %s has completed", item.GetName(), item.GetNamespace()))
+		return properties, nil
 	case "httpproxy":
 		err = handler.httpProxyWaiter.waitUntilReady(ctx, &item)
 		if err != nil {
@@ -22,6 +22,10 @@ const (
 	// MaxDeploymentTimeout is the max timeout for waiting for a deployment to be ready.
 	// Deployment duration should not reach to this timeout since async operation worker will time out context before MaxDeploymentTimeout.
 	MaxDeploymentTimeout = time.Minute * time.Duration(10)
+
+	// deploymentProgressDeadlineExceeded is the reason of the Progressing condition when the rollout has failed to make progress
+	// within the progress deadline of the deployment.
+	deploymentProgressDeadlineExceeded = "ProgressDeadlineExceeded"
 )
 
 type deploymentWaiter struct {
@@ -90,7 +94,7 @@ func (handler *deploymentWaiter) waitUntilReady(ctx context.Context, item client
 		if len(dep.Status.Conditions) > 0 {
 			status = dep.Status.Conditions[len(dep.Status.Conditions)-1]
 		}
-		return fmt.Errorf("deployment timed out, name: %s, namespace %s, status: %s, reason: %s", item.GetName(), item.GetNamespace(), status.Message, status.Reason)
+		return fmt.Errorf("deployment timed out, name: %s, namespace %s, status: %s, reason: %s, rollout progress: %s", item.GetName(), item.GetNamespace(), status.Message, status.Reason, getRolloutProgress(dep))
 
 	case err := <-doneCh:
 		if err == nil {
@@ -111,6 +115,20 @@ func (handler *deploymentWaiter) checkDeploymentStatus(ctx context.Context, info
 		return false
 	}
 
+	logger.Info(fmt.Sprintf("Deployment rollout progress: %s", getRolloutProgress(deployment)))
+
+	// Fail fast when Kubernetes reports that the rollout can no longer make progress. The conditions are only current
+	// once the controller has observed the latest generation, conditions left by an earlier rollout are ignored.
+	// Reference https://kubernetes.io/docs/concepts/workloads/controllers/deployment/#failed-deployment
+	if deployment.Status.ObservedGeneration == deployment.Generation {
+		for _, c := range deployment.Status.Conditions {
+			if c.Type == v1.DeploymentProgressing && c.Status == corev1.ConditionFalse && strings.EqualFold(c.Reason, deploymentProgressDeadlineExceeded) {
+				doneCh <- fmt.Errorf("deployment rollout failed, name: %s, namespace %s, status: %s, reason: %s, rollout progress: %s", deployment.Name, deployment.Namespace, c.Message, c.Reason, getRolloutProgress(deployment))
+				return false
+			}
+		}
+	}
+
 	deploymentReplicaSet := handler.getCurrentReplicaSetForDeployment(ctx, informerFactory, deployment)
 	if deploymentReplicaSet == nil {
 		logger.Info("Unable to find replica set for deployment")
@@ -144,6 +162,21 @@ func (handler *deploymentWaiter) checkDeploymentStatus(ctx context.Context, info
 	return false
 }
 
+// getRolloutProgress returns the human readable progress of the deployment rollout, based on the number of
+// updated, ready and available replicas compared to the desired number of replicas.
+func getRolloutProgress(deployment *v1.Deployment) string {
+	// Kubernetes defaults the number of replicas to 1 when it is not specified.
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+
+	return fmt.Sprintf("updated %d/%d, ready %d/%d, available %d/%d",
+		deployment.Status.UpdatedReplicas, desired,
+		deployment.Status.ReadyReplicas, desired,
+		deployment.Status.AvailableReplicas, desired)
+}
+
 func (handler *deploymentWaiter) startInformers(ctx context.Context, item client.Object, doneCh chan<- error) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -190,7 +190,7 @@ func TestWaitUntilReady_Timeout(t *testing.T) {
 
 	err := handler.deploymentWaiter.waitUntilReady(ctx, deployment)
 	require.Error(t, err)
-	require.Equal(t, "deployment timed out, name: test-deployment, namespace test-namespace, status: Deployment has minimum availability, reason: NewReplicaSetAvailable", err.Error())
+	require.Equal(t, "deployment timed out, name: test-deployment, namespace test-namespace, status: Deployment has minimum availability, reason: NewReplicaSetAvailable, rollout progress: updated 0/1, ready 0/1, available 0/1", err.Error())
 }
 
 func TestWaitUntilReady_DifferentResourceName(t *testing.T) {
@@ -1106,6 +1106,113 @@ func TestCheckDeploymentStatus_DeploymentNotProgressing(t *testing.T) {
 	require.False(t, ready)
 }
 
+func TestCheckDeploymentStatus_ProgressDeadlineExceeded(t *testing.T) {
+	// Create a fake Kubernetes fakeClient
+	fakeClient := fake.NewSimpleClientset()
+
+	deploymentFailed := testDeployment.DeepCopy()
+	deploymentFailed.Spec.Replicas = to.Ptr(int32(3))
+	deploymentFailed.Status = v1.DeploymentStatus{
+		UpdatedReplicas:   2,
+		ReadyReplicas:     1,
+		AvailableReplicas: 1,
+		Conditions: []v1.DeploymentCondition{
+			{
+				Type:    v1.DeploymentProgressing,
+				Status:  corev1.ConditionFalse,
+				Reason:  "ProgressDeadlineExceeded",
+				Message: "ReplicaSet \"test-replicaset-1\" has timed out progressing.",
+			},
+		},
+	}
+
+	ctx := context.Background()
+	_, err := fakeClient.AppsV1().Deployments("test-namespace").Create(ctx, deploymentFailed, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	informerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	err = informerFactory.Apps().V1().Deployments().Informer().GetIndexer().Add(deploymentFailed)
+	require.NoError(t, err)
+
+	item := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":      "test-deployment",
+				"namespace": "test-namespace",
+			},
+		},
+	}
+
+	doneCh := make(chan error, 1)
+	deploymentWaiter := &deploymentWaiter{
+		clientSet: fakeClient,
+	}
+
+	ready := deploymentWaiter.checkDeploymentStatus(ctx, informerFactory, item, doneCh)
+	require.False(t, ready)
+
+	err = <-doneCh
+	require.EqualError(t, err, "deployment rollout failed, name: test-deployment, namespace test-namespace, status: ReplicaSet \"test-replicaset-1\" has timed out progressing., reason: ProgressDeadlineExceeded, rollout progress: updated 2/3, ready 1/3, available 1/3")
+}
+
+func TestCheckDeploymentStatus_StaleProgressDeadlineExceeded(t *testing.T) {
+	// Create a fake Kubernetes fakeClient
+	fakeClient := fake.NewSimpleClientset()
+
+	// The condition is left by an earlier rollout, the controller has not observed the new generation yet.
+	deploymentRedeployed := testDeployment.DeepCopy()
+	deploymentRedeployed.Generation = 2
+	deploymentRedeployed.Status = v1.DeploymentStatus{
+		ObservedGeneration: 1,
+		Conditions: []v1.DeploymentCondition{
+			{
+				Type:    v1.DeploymentProgressing,
+				Status:  corev1.ConditionFalse,
+				Reason:  "ProgressDeadlineExceeded",
+				Message: "ReplicaSet \"test-replicaset-1\" has timed out progressing.",
+			},
+		},
+	}
+
+	ctx := context.Background()
+	_, err := fakeClient.AppsV1().Deployments("test-namespace").Create(ctx, deploymentRedeployed, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	informerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	err = informerFactory.Apps().V1().Deployments().Informer().GetIndexer().Add(deploymentRedeployed)
+	require.NoError(t, err)
+
+	item := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":      "test-deployment",
+				"namespace": "test-namespace",
+			},
+		},
+	}
+
+	doneCh := make(chan error, 1)
+	deploymentWaiter := &deploymentWaiter{
+		clientSet: fakeClient,
+	}
+
+	ready := deploymentWaiter.checkDeploymentStatus(ctx, informerFactory, item, doneCh)
+	require.False(t, ready)
+	require.Empty(t, doneCh)
+}
+
+func TestGetRolloutProgress(t *testing.T) {
+	deployment := testDeployment.DeepCopy()
+	deployment.Spec.Replicas = to.Ptr(int32(4))
+	deployment.Status.UpdatedReplicas = 3
+	deployment.Status.ReadyReplicas = 2
+	deployment.Status.AvailableReplicas = 1
+	require.Equal(t, "updated 3/4, ready 2/4, available 1/4", getRolloutProgress(deployment))
+
+	deployment.Spec.Replicas = nil
+	require.Equal(t, "updated 3/1, ready 2/1, available 1/1", getRolloutProgress(deployment))
+}
+
 func addTestObjects(t *testing.T, fakeClient *fake.Clientset, informerFactory informers.SharedInformerFactory, deployment *v1.Deployment, replicaSet *v1.ReplicaSet, pod *corev1.Pod) {
 	err := informerFactory.Apps().V1().Deployments().Informer().GetIndexer().Add(deployment)
 	require.NoError(t, err, "Failed to add deployment to informer cache")
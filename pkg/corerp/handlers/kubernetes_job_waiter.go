@@ -0,0 +1,179 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultJobTimeout is the timeout for waiting for a job to complete when the job doesn't specify one with the
+	// kubernetes.AnnotationJobCompletionTimeout annotation.
+	DefaultJobTimeout = time.Minute * time.Duration(10)
+)
+
+type jobWaiter struct {
+	clientSet           k8s.Interface
+	jobTimeOut          time.Duration
+	cacheResyncInterval time.Duration
+}
+
+// NewJobWaiter creates a new ResourceWaiter which waits until a Kubernetes job has completed or failed, or until
+// the completion timeout of the job has elapsed.
+func NewJobWaiter(clientSet k8s.Interface) *jobWaiter {
+	return &jobWaiter{
+		clientSet:           clientSet,
+		jobTimeOut:          DefaultJobTimeout,
+		cacheResyncInterval: DefaultCacheResyncInterval,
+	}
+}
+
+func (handler *jobWaiter) addEventHandler(ctx context.Context, informerFactory informers.SharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			handler.checkJobStatus(ctx, informerFactory, item, doneCh)
+		},
+		UpdateFunc: func(_, newObj any) {
+			handler.checkJobStatus(ctx, informerFactory, item, doneCh)
+		},
+	})
+
+	if err != nil {
+		logger.Error(err, "failed to add event handler")
+	}
+}
+
+// addDynamicEventHandler is not implemented for jobWaiter
+func (handler *jobWaiter) addDynamicEventHandler(ctx context.Context, informerFactory dynamicinformer.DynamicSharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+}
+
+func (handler *jobWaiter) waitUntilReady(ctx context.Context, item client.Object) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// When the job has completed, an error nil will be sent
+	// When the job has failed, the error will be sent
+	doneCh := make(chan error, 1)
+
+	timeout, err := handler.timeout(item)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	// This ensures that the informer is stopped when this function is returned.
+	defer cancel()
+
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(handler.clientSet, handler.cacheResyncInterval, informers.WithNamespace(item.GetNamespace()))
+	handler.addEventHandler(ctx, informerFactory, informerFactory.Batch().V1().Jobs().Informer(), item, doneCh)
+
+	informerFactory.Start(ctx.Done())
+	informerFactory.WaitForCacheSync(ctx.Done())
+
+	select {
+	case <-ctx.Done():
+		// Get the final job status
+		job, err := handler.clientSet.BatchV1().Jobs(item.GetNamespace()).Get(ctx, item.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("job timed out, name: %s, namespace %s, error occurred while fetching latest status: %w", item.GetName(), item.GetNamespace(), err)
+		}
+
+		return fmt.Errorf("job timed out, name: %s, namespace %s, progress: %s", item.GetName(), item.GetNamespace(), getJobProgress(job))
+
+	case err := <-doneCh:
+		if err == nil {
+			logger.Info(fmt.Sprintf("Marking job %s in namespace %s as complete", item.GetName(), item.GetNamespace()))
+		}
+		return err
+	}
+}
+
+// timeout returns the duration to wait for the job to complete, which is specified by the
+// kubernetes.AnnotationJobCompletionTimeout annotation of the job.
+func (handler *jobWaiter) timeout(item client.Object) (time.Duration, error) {
+	value, ok := item.GetAnnotations()[kubernetes.AnnotationJobCompletionTimeout]
+	if !ok {
+		return handler.jobTimeOut, nil
+	}
+
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds <= 0 {
+		return 0, fmt.Errorf("invalid completion timeout %q of job %s in namespace %s", value, item.GetName(), item.GetNamespace())
+	}
+
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// checkJobStatus checks if the job has reached a terminal state. It sends nil to doneCh when the job has
+// completed and an error when the job has failed.
+func (handler *jobWaiter) checkJobStatus(ctx context.Context, informerFactory informers.SharedInformerFactory, item client.Object, doneCh chan<- error) bool {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("jobName", item.GetName(), "namespace", item.GetNamespace())
+
+	job, err := informerFactory.Batch().V1().Jobs().Lister().Jobs(item.GetNamespace()).Get(item.GetName())
+	if err != nil {
+		logger.Info("Unable to find job")
+		return false
+	}
+
+	logger.Info(fmt.Sprintf("Job progress: %s", getJobProgress(job)))
+
+	// Reference https://kubernetes.io/docs/concepts/workloads/controllers/job/#terminal-job-conditions
+	for _, c := range job.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		switch c.Type {
+		case batchv1.JobComplete:
+			logger.Info("Job has completed")
+			doneCh <- nil
+			return true
+		case batchv1.JobFailed:
+			doneCh <- fmt.Errorf("job failed, name: %s, namespace %s, status: %s, reason: %s, progress: %s", job.Name, job.Namespace, c.Message, c.Reason, getJobProgress(job))
+			return false
+		}
+	}
+
+	return false
+}
+
+// getJobProgress returns the human readable progress of the job, based on the number of succeeded, failed and
+// active pods compared to the desired number of completions.
+func getJobProgress(job *batchv1.Job) string {
+	// Kubernetes defaults the number of completions to 1 when it is not specified.
+	completions := int32(1)
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+
+	return fmt.Sprintf("succeeded %d/%d, failed %d, active %d", job.Status.Succeeded, completions, job.Status.Failed, job.Status.Active)
+}
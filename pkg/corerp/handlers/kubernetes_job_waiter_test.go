@@ -0,0 +1,189 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestJob(conditions ...batchv1.JobCondition) *batchv1.Job {
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Job",
+			APIVersion: "batch/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-job",
+			Namespace: "test-namespace",
+		},
+		Spec: batchv1.JobSpec{
+			Completions: to.Ptr(int32(2)),
+		},
+		Status: batchv1.JobStatus{
+			Succeeded:  1,
+			Failed:     1,
+			Active:     1,
+			Conditions: conditions,
+		},
+	}
+}
+
+func TestJobWaitUntilReady_Complete(t *testing.T) {
+	ctx := context.Background()
+
+	job := newTestJob(batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionTrue})
+	waiter := &jobWaiter{
+		clientSet:           fake.NewSimpleClientset(job),
+		jobTimeOut:          time.Duration(50) * time.Second,
+		cacheResyncInterval: time.Duration(10) * time.Second,
+	}
+
+	err := waiter.waitUntilReady(ctx, job)
+	require.NoError(t, err)
+}
+
+func TestJobWaitUntilReady_Failed(t *testing.T) {
+	ctx := context.Background()
+
+	job := newTestJob(batchv1.JobCondition{
+		Type:    batchv1.JobFailed,
+		Status:  corev1.ConditionTrue,
+		Reason:  "BackoffLimitExceeded",
+		Message: "Job has reached the specified backoff limit",
+	})
+	waiter := &jobWaiter{
+		clientSet:           fake.NewSimpleClientset(job),
+		jobTimeOut:          time.Duration(50) * time.Second,
+		cacheResyncInterval: time.Duration(10) * time.Second,
+	}
+
+	err := waiter.waitUntilReady(ctx, job)
+	require.Error(t, err)
+	require.Equal(t, "job failed, name: test-job, namespace test-namespace, status: Job has reached the specified backoff limit, reason: BackoffLimitExceeded, progress: succeeded 1/2, failed 1, active 1", err.Error())
+}
+
+func TestJobWaitUntilReady_Timeout(t *testing.T) {
+	ctx := context.Background()
+
+	job := newTestJob()
+	waiter := &jobWaiter{
+		clientSet:           fake.NewSimpleClientset(job),
+		jobTimeOut:          time.Duration(1) * time.Second,
+		cacheResyncInterval: time.Duration(10) * time.Second,
+	}
+
+	err := waiter.waitUntilReady(ctx, job)
+	require.Error(t, err)
+	require.Equal(t, "job timed out, name: test-job, namespace test-namespace, progress: succeeded 1/2, failed 1, active 1", err.Error())
+}
+
+func TestJobWaitUntilReady_CompletionTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	job := newTestJob()
+	job.Annotations = map[string]string{kubernetes.AnnotationJobCompletionTimeout: "1"}
+	waiter := &jobWaiter{
+		clientSet:           fake.NewSimpleClientset(job),
+		jobTimeOut:          time.Duration(50) * time.Second,
+		cacheResyncInterval: time.Duration(10) * time.Second,
+	}
+
+	start := time.Now()
+	err := waiter.waitUntilReady(ctx, job)
+	require.Error(t, err)
+	require.Equal(t, "job timed out, name: test-job, namespace test-namespace, progress: succeeded 1/2, failed 1, active 1", err.Error())
+	require.Less(t, time.Since(start), time.Duration(50)*time.Second)
+}
+
+func TestJobWaitUntilReady_InvalidCompletionTimeout(t *testing.T) {
+	job := newTestJob()
+	job.Annotations = map[string]string{kubernetes.AnnotationJobCompletionTimeout: "-1"}
+	waiter := NewJobWaiter(fake.NewSimpleClientset(job))
+
+	err := waiter.waitUntilReady(context.Background(), job)
+	require.EqualError(t, err, `invalid completion timeout "-1" of job test-job in namespace test-namespace`)
+}
+
+func TestCheckJobStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []batchv1.JobCondition
+		done       bool
+		err        string
+	}{
+		{
+			name: "running",
+		},
+		{
+			name:       "complete",
+			conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}},
+			done:       true,
+		},
+		{
+			name:       "complete condition is not true",
+			conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionFalse}},
+		},
+		{
+			name:       "failed",
+			conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "DeadlineExceeded", Message: "Job was active longer than specified deadline"}},
+			err:        "job failed, name: test-job, namespace test-namespace, status: Job was active longer than specified deadline, reason: DeadlineExceeded, progress: succeeded 1/2, failed 1, active 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			job := newTestJob(tc.conditions...)
+			clientset := fake.NewSimpleClientset(job)
+
+			informerFactory := informers.NewSharedInformerFactory(clientset, 0)
+			informerFactory.Batch().V1().Jobs().Informer()
+			informerFactory.Start(context.Background().Done())
+			informerFactory.WaitForCacheSync(ctx.Done())
+
+			doneCh := make(chan error, 1)
+			waiter := NewJobWaiter(clientset)
+			done := waiter.checkJobStatus(ctx, informerFactory, job, doneCh)
+			require.Equal(t, tc.done, done)
+
+			if tc.err != "" {
+				err := <-doneCh
+				require.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestGetJobProgress(t *testing.T) {
+	job := newTestJob()
+	require.Equal(t, "succeeded 1/2, failed 1, active 1", getJobProgress(job))
+
+	job.Spec.Completions = nil
+	require.Equal(t, "succeeded 1/1, failed 1, active 1", getJobProgress(job))
+}
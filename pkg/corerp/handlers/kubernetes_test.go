@@ -28,6 +28,7 @@ import (
 	"github.com/radius-project/radius/test/k8sutil"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -90,6 +91,36 @@ func TestPut(t *testing.T) {
 				"resourcename":         "test-deployment",
 			},
 		},
+		{
+			name: "job resource",
+			in: &PutOptions{
+				Resource: &rpv1.OutputResource{
+					CreateResource: &rpv1.Resource{
+						ResourceType: resourcemodel.ResourceType{
+							Provider: resourcemodel.ProviderKubernetes,
+							Type:     "batch/Job",
+						},
+						// The job has no completion timeout, so it is ready once it is created.
+						Data: &batchv1.Job{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "Job",
+								APIVersion: "batch/v1",
+							},
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "test-job",
+								Namespace: "test-namespace",
+							},
+						},
+					},
+				},
+			},
+			out: map[string]string{
+				"kubernetesapiversion": "batch/v1",
+				"kuberneteskind":       "Job",
+				"kubernetesnamespace":  "test-namespace",
+				"resourcename":         "test-job",
+			},
+		},
 	}
 
 	for _, tc := range putTests {
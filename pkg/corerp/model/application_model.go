@@ -27,6 +27,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/renderers/daprextension"
 	"github.com/radius-project/radius/pkg/corerp/renderers/gateway"
 	"github.com/radius-project/radius/pkg/corerp/renderers/httproute"
+	"github.com/radius-project/radius/pkg/corerp/renderers/job"
 	"github.com/radius-project/radius/pkg/corerp/renderers/kubernetesmetadata"
 	"github.com/radius-project/radius/pkg/corerp/renderers/manualscale"
 	"github.com/radius-project/radius/pkg/corerp/renderers/volume"
@@ -97,6 +98,16 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 				},
 			},
 		},
+		{
+			ResourceType: job.ResourceType,
+			Renderer: &job.Renderer{
+				Inner: &kubernetesmetadata.Renderer{
+					Inner: &container.Renderer{
+						RoleAssignmentMap: roleAssignmentMap,
+					},
+				},
+			},
+		},
 		{
 			ResourceType: httproute.ResourceType,
 			Renderer:     &httproute.Renderer{},
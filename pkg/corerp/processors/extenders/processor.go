@@ -2,21 +2,43 @@ package extenders
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
 )
 
+// metaSchema is the JSON schema draft 4 meta-schema the schemas of extender kinds are validated against.
+var metaSchema = spec.MustLoadJSONSchemaDraft04()
+
 // Processor is a processor for Extender resources.
 type Processor struct {
 }
 
 // Process implements the processors.Processor interface for Extender resources. It validates and merges output values from
-// the recipe output with the existing values in the resource. It returns an error if the secret values are not of type string
-// or if any of the other validations fail.
+// the recipe output with the existing values in the resource. When the extender specifies a kind, the properties are validated
+// against the schema of the kind registered with the environment and the merged values must include the required outputs and
+// secrets of the kind. It returns an error if the secret values are not of type string or if any of the other validations fail.
 func (p *Processor) Process(ctx context.Context, resource *datamodel.Extender, options processors.Options) error {
+	kind, err := getExtenderKind(resource, options.ExtenderKinds)
+	if err != nil {
+		return err
+	}
+
+	if kind != nil {
+		err = validateSchema(resource.Properties.Kind, kind.Schema, resource.Properties.AdditionalProperties)
+		if err != nil {
+			return err
+		}
+	}
+
 	validator := processors.NewValidator(&resource.ComputedValues, &resource.SecretValues, &resource.Properties.Status.OutputResources, resource.Properties.Status.Recipe)
 
 	computedValues := mergeOutputValues(resource.Properties.AdditionalProperties, options.RecipeOutput, false)
@@ -35,7 +57,19 @@ func (p *Processor) Process(ctx context.Context, resource *datamodel.Extender, o
 		}
 	}
 
-	err := validator.SetAndValidate(options.RecipeOutput)
+	if kind != nil {
+		err = validateRequiredValues(resource.Properties.Kind, "output", kind.RequiredOutputs, computedValues)
+		if err != nil {
+			return err
+		}
+
+		err = validateRequiredValues(resource.Properties.Kind, "secret", kind.RequiredSecrets, secretValues)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = validator.SetAndValidate(options.RecipeOutput)
 	if err != nil {
 		return err
 	}
@@ -53,6 +87,110 @@ func (p *Processor) Delete(ctx context.Context, resource *datamodel.Extender, op
 	return nil
 }
 
+// getExtenderKind returns the extender kind registered with the environment for the given resource, or nil if the
+// resource does not specify a kind.
+func getExtenderKind(resource *datamodel.Extender, kinds map[string]datamodel.ExtenderKindProperties) (*datamodel.ExtenderKindProperties, error) {
+	if resource.Properties.Kind == "" {
+		return nil, nil
+	}
+
+	kind, ok := kinds[resource.Properties.Kind]
+	if !ok {
+		return nil, &processors.ValidationError{Message: fmt.Sprintf("extender kind '%s' is not registered with the environment", resource.Properties.Kind)}
+	}
+
+	return &kind, nil
+}
+
+// ValidateKinds checks that the schema of each extender kind is a valid JSON schema, so that invalid kinds are rejected
+// when the environment is created or updated rather than when an extender of the kind is deployed.
+func ValidateKinds(kinds map[string]datamodel.ExtenderKindProperties) error {
+	names := []string{}
+	for name := range kinds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, err := compileSchema(name, kinds[name].Schema); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// compileSchema validates the schema of the extender kind against the JSON schema draft 4 meta-schema and returns it,
+// or nil if the kind has no schema.
+func compileSchema(kindName string, schema map[string]any) (*spec.Schema, error) {
+	if len(schema) == 0 {
+		return nil, nil
+	}
+
+	result := validate.NewSchemaValidator(metaSchema, metaSchema, "schema", strfmt.Default).Validate(schema)
+	if !result.IsValid() {
+		return nil, &processors.ValidationError{Message: fmt.Sprintf("extender kind '%s' has an invalid schema: %s", kindName, joinErrors(result.Errors))}
+	}
+
+	b, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &spec.Schema{}
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, &processors.ValidationError{Message: fmt.Sprintf("extender kind '%s' has an invalid schema: %s", kindName, err.Error())}
+	}
+
+	return s, nil
+}
+
+// validateSchema validates the extender properties against the JSON schema of the extender kind.
+func validateSchema(kindName string, schema map[string]any, properties map[string]any) error {
+	s, err := compileSchema(kindName, schema)
+	if err != nil || s == nil {
+		return err
+	}
+
+	if properties == nil {
+		properties = map[string]any{}
+	}
+
+	result := validate.NewSchemaValidator(s, nil, "properties", strfmt.Default).Validate(properties)
+	if result.IsValid() {
+		return nil
+	}
+
+	return &processors.ValidationError{Message: fmt.Sprintf("extender properties do not match the schema of kind '%s': %s", kindName, joinErrors(result.Errors))}
+}
+
+// joinErrors returns the sorted messages of the validation errors separated by semicolons.
+func joinErrors(errs []error) string {
+	messages := []string{}
+	for _, e := range errs {
+		messages = append(messages, e.Error())
+	}
+	sort.Strings(messages)
+
+	return strings.Join(messages, "; ")
+}
+
+// validateRequiredValues checks that each of the required names is present in values.
+func validateRequiredValues(kindName string, valueType string, required []string, values map[string]any) error {
+	missing := []string{}
+	for _, name := range required {
+		if _, ok := values[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return &processors.ValidationError{Message: fmt.Sprintf("extender kind '%s' requires %s values %s which were not provided", kindName, valueType, strings.Join(missing, ", "))}
+	}
+
+	return nil
+}
+
 func mergeOutputValues(properties map[string]any, recipeOutput *recipes.RecipeOutput, secret bool) map[string]any {
 	values := make(map[string]any)
 	for k, val := range properties {
@@ -182,6 +182,179 @@ func Test_Process(t *testing.T) {
 	})
 }
 
+func Test_Process_ExtenderKind(t *testing.T) {
+	processor := Processor{}
+	kinds := map[string]datamodel.ExtenderKindProperties{
+		"postgres": {
+			Schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"database": map[string]any{"type": "string"},
+				},
+				"required": []any{"database"},
+			},
+			RequiredOutputs: []string{"host", "port"},
+			RequiredSecrets: []string{"password"},
+		},
+	}
+
+	t.Run("success - recipe provides required values", func(t *testing.T) {
+		resource := &datamodel.Extender{
+			Properties: datamodel.ExtenderProperties{
+				Kind:                 "postgres",
+				AdditionalProperties: map[string]any{"database": "orders"},
+			},
+		}
+		options := processors.Options{
+			ExtenderKinds: kinds,
+			RecipeOutput: &recipes.RecipeOutput{
+				Values:  map[string]any{"host": "localhost", "port": float64(5432)},
+				Secrets: map[string]any{"password": password},
+			},
+		}
+
+		err := processor.Process(context.Background(), resource, options)
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]any{"database": "orders", "host": "localhost", "port": float64(5432)}, resource.ComputedValues)
+		require.Equal(t, map[string]rpv1.SecretValueReference{"password": {Value: password}}, resource.SecretValues)
+	})
+
+	t.Run("failure - kind not registered", func(t *testing.T) {
+		resource := &datamodel.Extender{
+			Properties: datamodel.ExtenderProperties{
+				Kind: "mysql",
+			},
+		}
+
+		err := processor.Process(context.Background(), resource, processors.Options{ExtenderKinds: kinds})
+		require.Error(t, err)
+		require.IsType(t, &processors.ValidationError{}, err)
+		require.Equal(t, "extender kind 'mysql' is not registered with the environment", err.Error())
+	})
+
+	t.Run("failure - properties do not match schema", func(t *testing.T) {
+		resource := &datamodel.Extender{
+			Properties: datamodel.ExtenderProperties{
+				Kind:                 "postgres",
+				AdditionalProperties: map[string]any{"database": float64(3)},
+			},
+		}
+
+		err := processor.Process(context.Background(), resource, processors.Options{ExtenderKinds: kinds})
+		require.Error(t, err)
+		require.IsType(t, &processors.ValidationError{}, err)
+		require.Equal(t, "extender properties do not match the schema of kind 'postgres': properties.database in body must be of type string: \"number\"", err.Error())
+	})
+
+	t.Run("failure - missing required property", func(t *testing.T) {
+		resource := &datamodel.Extender{
+			Properties: datamodel.ExtenderProperties{
+				Kind: "postgres",
+			},
+		}
+
+		err := processor.Process(context.Background(), resource, processors.Options{ExtenderKinds: kinds})
+		require.Error(t, err)
+		require.IsType(t, &processors.ValidationError{}, err)
+		require.Equal(t, "extender properties do not match the schema of kind 'postgres': properties.database in body is required", err.Error())
+	})
+
+	t.Run("failure - missing required outputs", func(t *testing.T) {
+		resource := &datamodel.Extender{
+			Properties: datamodel.ExtenderProperties{
+				Kind:                 "postgres",
+				AdditionalProperties: map[string]any{"database": "orders"},
+			},
+		}
+		options := processors.Options{
+			ExtenderKinds: kinds,
+			RecipeOutput: &recipes.RecipeOutput{
+				Values:  map[string]any{"host": "localhost"},
+				Secrets: map[string]any{"password": password},
+			},
+		}
+
+		err := processor.Process(context.Background(), resource, options)
+		require.Error(t, err)
+		require.IsType(t, &processors.ValidationError{}, err)
+		require.Equal(t, "extender kind 'postgres' requires output values port which were not provided", err.Error())
+	})
+
+	t.Run("failure - missing required secrets", func(t *testing.T) {
+		resource := &datamodel.Extender{
+			Properties: datamodel.ExtenderProperties{
+				Kind:                 "postgres",
+				AdditionalProperties: map[string]any{"database": "orders"},
+			},
+		}
+		options := processors.Options{
+			ExtenderKinds: kinds,
+			RecipeOutput: &recipes.RecipeOutput{
+				Values: map[string]any{"host": "localhost", "port": float64(5432)},
+			},
+		}
+
+		err := processor.Process(context.Background(), resource, options)
+		require.Error(t, err)
+		require.IsType(t, &processors.ValidationError{}, err)
+		require.Equal(t, "extender kind 'postgres' requires secret values password which were not provided", err.Error())
+	})
+}
+
+func Test_ValidateKinds(t *testing.T) {
+	tests := []struct {
+		name  string
+		kinds map[string]datamodel.ExtenderKindProperties
+		err   string
+	}{
+		{
+			name:  "no kinds",
+			kinds: nil,
+		},
+		{
+			name: "valid schemas",
+			kinds: map[string]datamodel.ExtenderKindProperties{
+				"queue": {
+					Schema: map[string]any{
+						"type":       "object",
+						"required":   []any{"size"},
+						"properties": map[string]any{"size": map[string]any{"type": "integer", "minimum": 1}},
+					},
+				},
+				"cache": {RequiredOutputs: []string{"host"}},
+			},
+		},
+		{
+			name: "invalid type",
+			kinds: map[string]datamodel.ExtenderKindProperties{
+				"queue": {Schema: map[string]any{"type": 5}},
+			},
+			err: "extender kind 'queue' has an invalid schema",
+		},
+		{
+			name: "invalid required",
+			kinds: map[string]datamodel.ExtenderKindProperties{
+				"queue": {Schema: map[string]any{"type": "object", "required": "size"}},
+			},
+			err: "extender kind 'queue' has an invalid schema",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateKinds(tt.kinds)
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tt.err)
+			require.IsType(t, &processors.ValidationError{}, err)
+		})
+	}
+}
+
 func Test_MergeOutputValues(t *testing.T) {
 	resource := &datamodel.Extender{
 		Properties: datamodel.ExtenderProperties{
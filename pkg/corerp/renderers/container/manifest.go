@@ -88,7 +88,7 @@ func getDeploymentBase(manifest kubeutil.ObjectManifest, appName string, r *data
 		defaultDeployment = resource.(*appsv1.Deployment)
 	}
 
-	defaultDeployment.ObjectMeta = getObjectMeta(defaultDeployment.ObjectMeta, appName, r.Name, r.ResourceTypeName(), *options)
+	defaultDeployment.ObjectMeta = getObjectMeta(defaultDeployment.ObjectMeta, appName, r.Name, r.ResourceTypeName(), r.Tags, *options)
 	if defaultDeployment.Spec.Selector == nil {
 		defaultDeployment.Spec.Selector = &metav1.LabelSelector{}
 	}
@@ -137,7 +137,7 @@ func getServiceBase(manifest kubeutil.ObjectManifest, appName string, r *datamod
 	if resource := manifest.GetFirst(corev1.SchemeGroupVersion.WithKind("Service")); resource != nil {
 		defaultService = resource.(*corev1.Service)
 	}
-	defaultService.ObjectMeta = getObjectMeta(defaultService.ObjectMeta, appName, r.Name, r.ResourceTypeName(), *options)
+	defaultService.ObjectMeta = getObjectMeta(defaultService.ObjectMeta, appName, r.Name, r.ResourceTypeName(), r.Tags, *options)
 	return defaultService
 }
 
@@ -156,7 +156,8 @@ func getServiceAccountBase(manifest kubeutil.ObjectManifest, appName string, r *
 		defaultAccount = resource.(*corev1.ServiceAccount)
 	}
 
-	defaultAccount.ObjectMeta = getObjectMeta(defaultAccount.ObjectMeta, appName, r.Name, r.ResourceTypeName(), *options)
+	// Only the Deployment and the Service of a container are labeled with its tags.
+	defaultAccount.ObjectMeta = getObjectMeta(defaultAccount.ObjectMeta, appName, r.Name, r.ResourceTypeName(), nil, *options)
 
 	return defaultAccount
 }
@@ -250,11 +251,13 @@ func mergeObjectMeta(base metav1.ObjectMeta, cur metav1.ObjectMeta) metav1.Objec
 	}
 }
 
-func getObjectMeta(base metav1.ObjectMeta, appName, resourceName, resourceType string, options renderers.RenderOptions) metav1.ObjectMeta {
+// getObjectMeta returns the object metadata merged with the base metadata. The valid tags are added as labels, tags is nil
+// for the objects that are not labeled with the resource tags.
+func getObjectMeta(base metav1.ObjectMeta, appName, resourceName, resourceType string, tags map[string]string, options renderers.RenderOptions) metav1.ObjectMeta {
 	cur := metav1.ObjectMeta{
 		Name:        kubernetes.NormalizeResourceName(resourceName),
 		Namespace:   options.Environment.Namespace,
-		Labels:      renderers.GetLabels(options, appName, resourceName, resourceType),
+		Labels:      renderers.GetLabelsWithTags(options, appName, resourceName, resourceType, tags),
 		Annotations: renderers.GetAnnotations(options),
 	}
 
@@ -37,6 +37,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/renderers"
 	azrenderer "github.com/radius-project/radius/pkg/corerp/renderers/container/azure"
 	azvolrenderer "github.com/radius-project/radius/pkg/corerp/renderers/volume/azure"
+	k8svolrenderer "github.com/radius-project/radius/pkg/corerp/renderers/volume/kubernetes"
 	"github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/resourcemodel"
@@ -64,6 +65,7 @@ const (
 func GetSupportedKinds() []string {
 	keys := []string{}
 	keys = append(keys, datamodel.AzureKeyVaultVolume)
+	keys = append(keys, datamodel.KubernetesPersistentVolumeClaimVolume)
 	return keys
 }
 
@@ -257,6 +259,11 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 		outputResources = append(outputResources, r.makeSecret(ctx, *resource, appId.Name(), secretData, options))
 	}
 
+	// If the user has specified a disruption budget, create a PodDisruptionBudget which protects the pods of the deployment.
+	if properties.DisruptionBudget != nil {
+		outputResources = append(outputResources, *makePodDisruptionBudget(appId.Name(), options.Environment.Namespace, resource))
+	}
+
 	var servicePorts []corev1.ServicePort
 
 	// If the container has an exposed port and uses DNS-SD, generate a service for it.
@@ -507,6 +514,18 @@ func (r Renderer) makeDeployment(
 				if err != nil {
 					return []rpv1.OutputResource{}, nil, fmt.Errorf("unable to create secretstore volume spec for volume: %s - %w", volumeName, err)
 				}
+			case datamodel.KubernetesPersistentVolumeClaimVolume:
+				claimName, err := handlers.GetMapValue[string](properties.ComputedValues, k8svolrenderer.PersistentVolumeClaimNameKey)
+				if err != nil {
+					return []rpv1.OutputResource{}, nil, err
+				}
+
+				volumeSpec, volumeMountSpec = makePersistentVolumeClaimVolume(volumeName, volumeProperties.Persistent, claimName)
+
+				// The claim name is not a secret, so it doesn't need to be added to the secret of the container.
+				container.VolumeMounts = append(container.VolumeMounts, volumeMountSpec)
+				volumes = append(volumes, volumeSpec)
+				continue
 			default:
 				return []rpv1.OutputResource{}, nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("Unsupported volume kind: %s for volume: %s. Supported kinds are: %v", vol.Properties.Kind, volumeName, GetSupportedKinds()))
 			}
@@ -647,6 +666,11 @@ func (r Renderer) makeDeployment(
 		podSpec.RestartPolicy = corev1.RestartPolicy(properties.RestartPolicy)
 	}
 
+	// If the user has specified a rollout strategy, use it. Else, it will use the Kubernetes default.
+	if properties.RolloutStrategy != nil {
+		deployment.Spec.Strategy = makeDeploymentStrategy(properties.RolloutStrategy)
+	}
+
 	// If we have a secret to reference we need to ensure that the deployment will trigger a new revision
 	// when the secret changes. Normally referencing an environment variable from a secret will **NOT** cause
 	// a new revision when the secret changes.
@@ -714,9 +738,35 @@ func getEnvVarsAndSecretData(resource *datamodel.ContainerResource, applicationN
 				continue
 			}
 
+			// Extenders with a kind have their outputs validated against the kind registered with the environment.
+			// Normalize the names of their environment variables so that consumers can rely on them.
+			normalize := false
+			if extender, ok := properties.Resource.(*datamodel.Extender); ok && extender.Properties.Kind != "" {
+				normalize = true
+			}
+
 			// handles case where container has source field structured as a resourceID.
-			for key, value := range properties.ComputedValues {
+			// The keys are visited in sorted order so that the error for colliding names is stable.
+			keys := make([]string, 0, len(properties.ComputedValues))
+			for key := range properties.ComputedValues {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			connectionName := name
+			originalKeys := map[string]string{}
+			for _, key := range keys {
+				value := properties.ComputedValues[key]
 				name := fmt.Sprintf("%s_%s_%s", "CONNECTION", strings.ToUpper(name), strings.ToUpper(key))
+				if normalize {
+					name = toEnvVarName(name)
+				}
+
+				if existing, ok := originalKeys[name]; ok {
+					return map[string]corev1.EnvVar{}, map[string][]byte{}, v1.NewClientErrInvalidRequest(
+						fmt.Sprintf("the values %q and %q of connection %q both map to the environment variable %s", existing, key, connectionName, name))
+				}
+				originalKeys[name] = key
 
 				source := corev1.EnvVarSource{
 					SecretKeyRef: &corev1.SecretKeySelector{
@@ -744,6 +794,19 @@ func getEnvVarsAndSecretData(resource *datamodel.ContainerResource, applicationN
 	return env, secretData, nil
 }
 
+// toEnvVarName converts name to an environment variable name made of upper case letters, digits and underscores.
+func toEnvVarName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		if r >= 'a' && r <= 'z' {
+			return r - 'a' + 'A'
+		}
+		return '_'
+	}, name)
+}
+
 func (r Renderer) makeHealthProbe(p datamodel.HealthProbeProperties) (*corev1.Probe, error) {
 	probeSpec := corev1.Probe{}
 
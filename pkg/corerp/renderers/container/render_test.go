@@ -27,6 +27,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/renderers"
 	azrenderer "github.com/radius-project/radius/pkg/corerp/renderers/container/azure"
 	azvolrenderer "github.com/radius-project/radius/pkg/corerp/renderers/volume/azure"
+	k8svolrenderer "github.com/radius-project/radius/pkg/corerp/renderers/volume/kubernetes"
 	"github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/resourcemodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
@@ -41,6 +42,7 @@ import (
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -761,6 +763,95 @@ func Test_Render_Connections_SecretsGetHashed(t *testing.T) {
 	require.NotEqual(t, hash1, hash2)
 }
 
+func Test_Render_ExtenderKindConnection(t *testing.T) {
+	extenderID := makeRadiusResourceID(t, "Applications.Core/extenders", "db")
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Connections: map[string]datamodel.ConnectionProperties{
+			"db": {
+				Source: extenderID.String(),
+			},
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+		},
+	}
+	resource := makeResource(t, properties)
+	dependencies := map[string]renderers.RendererDependency{
+		extenderID.String(): {
+			ResourceID: extenderID,
+			Resource: &datamodel.Extender{
+				Properties: datamodel.ExtenderProperties{
+					Kind: "postgres",
+				},
+			},
+			ComputedValues: map[string]any{
+				"connection-string": "Host=localhost",
+				"port":              float64(5432),
+			},
+		},
+	}
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+	require.NoError(t, err)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+
+	names := []string{}
+	for _, env := range deployment.Spec.Template.Spec.Containers[0].Env {
+		names = append(names, env.Name)
+	}
+	require.Equal(t, []string{"CONNECTION_DB_CONNECTION_STRING", "CONNECTION_DB_PORT"}, names)
+
+	secret, _ := kubernetes.FindSecret(output.Resources)
+	require.NotNil(t, secret)
+	require.Equal(t, []byte("Host=localhost"), secret.Data["CONNECTION_DB_CONNECTION_STRING"])
+	require.Equal(t, []byte("5432"), secret.Data["CONNECTION_DB_PORT"])
+}
+
+func Test_Render_ExtenderKindConnection_CollidingNames(t *testing.T) {
+	extenderID := makeRadiusResourceID(t, "Applications.Core/extenders", "db")
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Connections: map[string]datamodel.ConnectionProperties{
+			"db": {
+				Source: extenderID.String(),
+			},
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+		},
+	}
+	resource := makeResource(t, properties)
+	dependencies := map[string]renderers.RendererDependency{
+		extenderID.String(): {
+			ResourceID: extenderID,
+			Resource: &datamodel.Extender{
+				Properties: datamodel.ExtenderProperties{
+					Kind: "postgres",
+				},
+			},
+			ComputedValues: map[string]any{
+				"foo-bar": "a",
+				"foo.bar": "b",
+				"FOO_BAR": "c",
+			},
+		},
+	}
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	_, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+	require.ErrorContains(t, err, `the values "FOO_BAR" and "foo-bar" of connection "db" both map to the environment variable CONNECTION_DB_FOO_BAR`)
+}
+
 func Test_Render_ConnectionWithRoleAssignment(t *testing.T) {
 	properties := datamodel.ContainerProperties{
 		BasicResourceProperties: rpv1.BasicResourceProperties{
@@ -1251,6 +1342,85 @@ func Test_Render_PersistentAzureKeyVaultVolumes(t *testing.T) {
 	require.Equal(t, true, volumeMounts[0].ReadOnly)
 }
 
+func Test_Render_PersistentVolumeClaimVolumes(t *testing.T) {
+	const volumeResourceID = "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/volumes/data0"
+
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Volumes: map[string]datamodel.VolumeProperties{
+				tempVolName: {
+					Kind: datamodel.Persistent,
+					Persistent: &datamodel.PersistentVolume{
+						VolumeBase: datamodel.VolumeBase{
+							MountPath: tempVolMountPath,
+						},
+						Source:     volumeResourceID,
+						Permission: datamodel.VolumePermissionRead,
+					},
+				},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+	resourceID, _ := resources.ParseResource(volumeResourceID)
+	dependencies := map[string]renderers.RendererDependency{
+		volumeResourceID: {
+			ResourceID: resourceID,
+			Resource: &datamodel.VolumeResource{
+				BaseResource: apiv1.BaseResource{
+					TrackedResource: apiv1.TrackedResource{
+						Name: "data0",
+					},
+				},
+				Properties: datamodel.VolumeResourceProperties{
+					BasicResourceProperties: rpv1.BasicResourceProperties{
+						Application: applicationResourceID,
+					},
+					Kind: datamodel.KubernetesPersistentVolumeClaimVolume,
+					KubernetesPersistentVolumeClaim: &datamodel.KubernetesPersistentVolumeClaimVolumeProperties{
+						Size: "10Gi",
+					},
+				},
+			},
+			ComputedValues: map[string]any{
+				k8svolrenderer.PersistentVolumeClaimNameKey: "data0",
+			},
+		},
+	}
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	renderOutput, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+	require.NoError(t, err)
+
+	// The claim name must not be stored in the secret of the container.
+	for _, r := range renderOutput.Resources {
+		require.NotEqual(t, rpv1.LocalIDSecret, r.LocalID)
+	}
+
+	deployment, _ := kubernetes.FindDeployment(renderOutput.Resources)
+	require.NotNil(t, deployment)
+
+	// Verify volume spec
+	volumes := deployment.Spec.Template.Spec.Volumes
+	require.Lenf(t, volumes, 1, "expected 1 volume, instead got %+v", len(volumes))
+	require.Equal(t, tempVolName, volumes[0].Name)
+	require.NotNil(t, volumes[0].VolumeSource.PersistentVolumeClaim)
+	require.Equal(t, "data0", volumes[0].VolumeSource.PersistentVolumeClaim.ClaimName)
+	require.True(t, volumes[0].VolumeSource.PersistentVolumeClaim.ReadOnly)
+
+	// Verify volume mount spec
+	volumeMounts := deployment.Spec.Template.Spec.Containers[0].VolumeMounts
+	require.Lenf(t, volumeMounts, 1, "expected 1 volume mount, instead got %+v", len(volumeMounts))
+	require.Equal(t, tempVolMountPath, volumeMounts[0].MountPath)
+	require.Equal(t, tempVolName, volumeMounts[0].Name)
+	require.True(t, volumeMounts[0].ReadOnly)
+}
+
 func outputResourcesToResourceTypeMap(resources []rpv1.OutputResource) map[string][]rpv1.OutputResource {
 	results := map[string][]rpv1.OutputResource{}
 	for _, resource := range resources {
@@ -1295,6 +1465,159 @@ func Test_Render_RestartPolicy(t *testing.T) {
 	})
 }
 
+func Test_Render_Tags(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Ports: map[string]datamodel.ContainerPort{
+				"web": {
+					ContainerPort: 5000,
+				},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+	resource.Tags = map[string]string{
+		"team":                   "payments",
+		"app.kubernetes.io/name": "tagged-name",
+		"radapp.io/resource":     "tagged-resource",
+		"invalid key":            "value",
+	}
+	dependencies := map[string]renderers.RendererDependency{}
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies})
+	require.NoError(t, err)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+
+	require.Equal(t, "payments", deployment.Labels["team"])
+	require.Equal(t, resourceName, deployment.Labels[kubernetes.LabelName])
+	require.Equal(t, resourceName, deployment.Labels[kubernetes.LabelRadiusResource])
+	require.NotContains(t, deployment.Labels, "invalid key")
+
+	service, _ := kubernetes.FindService(output.Resources)
+	require.NotNil(t, service)
+	require.Equal(t, "payments", service.Labels["team"])
+
+	// The pod template is not labeled with the tags, so that changing a tag doesn't restart the pods.
+	require.NotContains(t, deployment.Spec.Template.Labels, "team")
+	require.Equal(t, resourceName, deployment.Spec.Template.Labels[kubernetes.LabelName])
+
+	for _, r := range output.Resources {
+		if sa, ok := r.CreateResource.Data.(*corev1.ServiceAccount); ok {
+			require.NotContains(t, sa.Labels, "team")
+		}
+	}
+}
+
+func Test_Render_RolloutStrategy(t *testing.T) {
+	strategyTests := []struct {
+		name     string
+		strategy *datamodel.RolloutStrategy
+		expected appsv1.DeploymentStrategy
+	}{
+		{
+			name:     "no rollout strategy",
+			strategy: nil,
+			expected: appsv1.DeploymentStrategy{},
+		},
+		{
+			name: "rolling update",
+			strategy: &datamodel.RolloutStrategy{
+				Kind:           datamodel.RolloutStrategyKindRollingUpdate,
+				MaxSurge:       "1",
+				MaxUnavailable: "25%",
+			},
+			expected: appsv1.DeploymentStrategy{
+				Type: appsv1.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDeployment{
+					MaxSurge:       to.Ptr(intstr.FromInt(1)),
+					MaxUnavailable: to.Ptr(intstr.FromString("25%")),
+				},
+			},
+		},
+		{
+			name:     "recreate",
+			strategy: &datamodel.RolloutStrategy{Kind: datamodel.RolloutStrategyKindRecreate},
+			expected: appsv1.DeploymentStrategy{
+				Type: appsv1.RecreateDeploymentStrategyType,
+			},
+		},
+	}
+
+	for _, tc := range strategyTests {
+		t.Run(tc.name, func(t *testing.T) {
+			properties := datamodel.ContainerProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Application: applicationResourceID,
+				},
+				Container: datamodel.Container{
+					Image: "someimage:latest",
+				},
+				RolloutStrategy: tc.strategy,
+			}
+			resource := makeResource(t, properties)
+
+			ctx := testcontext.New(t)
+			renderer := Renderer{}
+			output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+			require.NoError(t, err)
+
+			deployment, _ := kubernetes.FindDeployment(output.Resources)
+			require.NotNil(t, deployment)
+			require.Equal(t, tc.expected, deployment.Spec.Strategy)
+		})
+	}
+}
+
+func Test_Render_DisruptionBudget(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+		},
+		DisruptionBudget: &datamodel.DisruptionBudget{
+			MinAvailable: "50%",
+		},
+	}
+	resource := makeResource(t, properties)
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderers.RenderOptions{
+		Dependencies: map[string]renderers.RendererDependency{},
+		Environment:  renderers.EnvironmentOptions{Namespace: "default"},
+	})
+	require.NoError(t, err)
+
+	var pdbResource *rpv1.OutputResource
+	for i, r := range output.Resources {
+		if r.LocalID == rpv1.LocalIDPodDisruptionBudget {
+			pdbResource = &output.Resources[i]
+		}
+	}
+	require.NotNil(t, pdbResource)
+	require.Equal(t, resources_kubernetes.ResourceTypePodDisruptionBudget, pdbResource.GetResourceType().Type)
+	require.Equal(t, []string{rpv1.LocalIDDeployment}, pdbResource.CreateResource.Dependencies)
+
+	pdb, ok := pdbResource.CreateResource.Data.(*policyv1.PodDisruptionBudget)
+	require.True(t, ok)
+	require.Equal(t, kubernetes.NormalizeResourceName(resourceName), pdb.Name)
+	require.Equal(t, "default", pdb.Namespace)
+	require.Equal(t, kubernetes.MakeDescriptiveLabels(applicationName, resourceName, ResourceType), pdb.Labels)
+	require.Equal(t, kubernetes.MakeSelectorLabels(applicationName, resourceName), pdb.Spec.Selector.MatchLabels)
+	require.Equal(t, to.Ptr(intstr.FromString("50%")), pdb.Spec.MinAvailable)
+	require.Nil(t, pdb.Spec.MaxUnavailable)
+}
+
 func Test_Render_ReadinessProbeHttpGet(t *testing.T) {
 	properties := datamodel.ContainerProperties{
 		BasicResourceProperties: rpv1.BasicResourceProperties{
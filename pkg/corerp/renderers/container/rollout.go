@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+// makeDeploymentStrategy converts the rollout strategy of the container to the Deployment strategy.
+func makeDeploymentStrategy(strategy *datamodel.RolloutStrategy) appsv1.DeploymentStrategy {
+	if strategy.Kind == datamodel.RolloutStrategyKindRecreate {
+		return appsv1.DeploymentStrategy{
+			Type: appsv1.RecreateDeploymentStrategyType,
+		}
+	}
+
+	rollingUpdate := &appsv1.RollingUpdateDeployment{}
+	if strategy.MaxSurge != "" {
+		maxSurge := intstr.Parse(strategy.MaxSurge)
+		rollingUpdate.MaxSurge = &maxSurge
+	}
+	if strategy.MaxUnavailable != "" {
+		maxUnavailable := intstr.Parse(strategy.MaxUnavailable)
+		rollingUpdate.MaxUnavailable = &maxUnavailable
+	}
+
+	return appsv1.DeploymentStrategy{
+		Type:          appsv1.RollingUpdateDeploymentStrategyType,
+		RollingUpdate: rollingUpdate,
+	}
+}
+
+// makePodDisruptionBudget creates the PodDisruptionBudget which selects the pods of the container deployment.
+func makePodDisruptionBudget(appName, namespace string, resource *datamodel.ContainerResource) *rpv1.OutputResource {
+	budget := resource.Properties.DisruptionBudget
+
+	pdb := &policyv1.PodDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PodDisruptionBudget",
+			APIVersion: "policy/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      kubernetes.NormalizeResourceName(resource.Name),
+			Namespace: namespace,
+			Labels:    kubernetes.MakeDescriptiveLabels(appName, resource.Name, resource.ResourceTypeName()),
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: kubernetes.MakeSelectorLabels(appName, resource.Name),
+			},
+		},
+	}
+
+	if budget.MinAvailable != "" {
+		minAvailable := intstr.Parse(budget.MinAvailable)
+		pdb.Spec.MinAvailable = &minAvailable
+	}
+	if budget.MaxUnavailable != "" {
+		maxUnavailable := intstr.Parse(budget.MaxUnavailable)
+		pdb.Spec.MaxUnavailable = &maxUnavailable
+	}
+
+	or := rpv1.NewKubernetesOutputResource(rpv1.LocalIDPodDisruptionBudget, pdb, pdb.ObjectMeta)
+	or.CreateResource.Dependencies = []string{rpv1.LocalIDDeployment}
+	return &or
+}
@@ -41,3 +41,26 @@ func makeEphemeralVolume(volumeName string, volume *datamodel.EphemeralVolume) (
 
 	return volumeSpec, volumeMountSpec, nil
 }
+
+// makePersistentVolumeClaimVolume creates the volume specs for a persistent volume backed by the given PersistentVolumeClaim.
+func makePersistentVolumeClaimVolume(volumeName string, volume *datamodel.PersistentVolume, claimName string) (corev1.Volume, corev1.VolumeMount) {
+	readOnly := volume.Permission == datamodel.VolumePermissionRead
+
+	volumeSpec := corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: claimName,
+				ReadOnly:  readOnly,
+			},
+		},
+	}
+
+	volumeMountSpec := corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: volume.MountPath,
+		ReadOnly:  readOnly,
+	}
+
+	return volumeSpec, volumeMountSpec
+}
@@ -51,17 +51,19 @@ func (r Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface
 
 	// Get all httpRoutes that are used by this gateway
 	for _, route := range gtwyProperties.Routes {
-		// Skip if destination is a URL. DNS-SD will resolve the route.
-		if isURL(route.Destination) {
-			continue
-		}
+		for _, destination := range route.RouteDestinations() {
+			// Skip if destination is a URL. DNS-SD will resolve the route.
+			if isURL(destination) {
+				continue
+			}
 
-		resourceID, err := resources.ParseResource(route.Destination)
-		if err != nil {
-			return nil, nil, v1.NewClientErrInvalidRequest(err.Error())
-		}
+			resourceID, err := resources.ParseResource(destination)
+			if err != nil {
+				return nil, nil, v1.NewClientErrInvalidRequest(err.Error())
+			}
 
-		radiusResourceIDs = append(radiusResourceIDs, resourceID)
+			radiusResourceIDs = append(radiusResourceIDs, resourceID)
+		}
 	}
 
 	// Get secretStore resource ID from certificateFrom property
@@ -204,11 +206,12 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 	}
 
 	var route datamodel.GatewayRoute //route will hold the one sslPassthrough route, if sslPassthrough is true
-	for _, route = range gateway.Properties.Routes {
+	for i := range gateway.Properties.Routes {
+		route = gateway.Properties.Routes[i]
 		if sslPassthrough && (route.Path != "" || route.ReplacePrefix != "") {
 			return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("cannot support `path` or `replacePrefix` in routes with sslPassthrough set to true")
 		}
-		routeName, err := getRouteName(&route)
+		routeName, err := getIncludeName(resourceName, i, &route)
 		if err != nil {
 			return rpv1.OutputResource{}, err
 		}
@@ -218,14 +221,12 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 		if sslPassthrough {
 			prefix = "/"
 		}
-		includes = append(includes, contourv1.Include{
-			Name: routeResourceName,
-			Conditions: []contourv1.MatchCondition{
-				{
-					Prefix: prefix,
-				},
-			},
-		})
+		for _, conditions := range makeMatchConditions(prefix, &route) {
+			includes = append(includes, contourv1.Include{
+				Name:       routeResourceName,
+				Conditions: conditions,
+			})
+		}
 	}
 
 	virtualHostname := hostname
@@ -277,7 +278,7 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        kubernetes.NormalizeResourceName(resourceName),
 			Namespace:   options.Environment.Namespace,
-			Labels:      renderers.GetLabels(options, applicationName, resourceName, gateway.ResourceTypeName()),
+			Labels:      renderers.GetLabelsWithTags(options, applicationName, resourceName, gateway.ResourceTypeName(), gateway.Tags),
 			Annotations: renderers.GetAnnotations(options),
 		},
 		Spec: contourv1.HTTPProxySpec{
@@ -298,26 +299,14 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 	dependencies := options.Dependencies
 	objects := make(map[string]*contourv1.HTTPProxy)
 
-	for _, route := range gateway.Routes {
-		port := renderers.DefaultPort
-
-		if isURL(route.Destination) {
-			_, _, urlPort, err := parseURL(route.Destination)
-			if err != nil {
-				return []rpv1.OutputResource{}, err
-			}
-			port = urlPort
-
-		} else {
-			routeProperties := dependencies[route.Destination]
-			routePort, ok := routeProperties.ComputedValues["port"].(float64)
-			if ok {
-				port = int32(routePort)
-			}
-
+	for i := range gateway.Routes {
+		route := gateway.Routes[i]
+		routeName, err := getIncludeName(gatewayName, i, &route)
+		if err != nil {
+			return []rpv1.OutputResource{}, err
 		}
 
-		routeName, err := getRouteName(&route)
+		services, err := makeServices(dependencies, &route)
 		if err != nil {
 			return []rpv1.OutputResource{}, err
 		}
@@ -368,18 +357,13 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 			ObjectMeta: metav1.ObjectMeta{
 				Name:        routeResourceName,
 				Namespace:   options.Environment.Namespace,
-				Labels:      renderers.GetLabels(options, applicationName, routeName, resource.ResourceTypeName()),
+				Labels:      renderers.GetLabelsWithTags(options, applicationName, routeName, resource.ResourceTypeName(), resource.Tags),
 				Annotations: renderers.GetAnnotations(options),
 			},
 			Spec: contourv1.HTTPProxySpec{
 				Routes: []contourv1.Route{
 					{
-						Services: []contourv1.Service{
-							{
-								Name: routeResourceName,
-								Port: int(port),
-							},
-						},
+						Services:          services,
 						PathRewritePolicy: pathRewritePolicy,
 					},
 				},
@@ -401,9 +385,24 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 }
 
 func getRouteName(route *datamodel.GatewayRoute) (string, error) {
+	return getDestinationName(route.Destination)
+}
+
+// getIncludeName returns the name of the HTTPProxy included by the root HTTPProxy for the route at the given index.
+// Routes that split or mirror traffic get a HTTPProxy of their own because the weights of their services are specific
+// to the route. Other routes share the HTTPProxy named after their destination.
+func getIncludeName(gatewayName string, index int, route *datamodel.GatewayRoute) (string, error) {
+	if isTrafficSplitRoute(route) {
+		return fmt.Sprintf("%s-route-%d", gatewayName, index), nil
+	}
+
+	return getRouteName(route)
+}
+
+func getDestinationName(destination string) (string, error) {
 	// if isURL, then name is hostname (DNS-SD case)
-	if isURL(route.Destination) {
-		u, err := url.Parse(route.Destination)
+	if isURL(destination) {
+		u, err := url.Parse(destination)
 		if err != nil {
 			return "", v1.NewClientErrInvalidRequest(err.Error())
 		}
@@ -412,7 +411,7 @@ func getRouteName(route *datamodel.GatewayRoute) (string, error) {
 	}
 
 	// if not URL, then name is the resourceID (HTTProute case)
-	resourceID, err := resources.ParseResource(route.Destination)
+	resourceID, err := resources.ParseResource(destination)
 	if err != nil {
 		return "", v1.NewClientErrInvalidRequest(err.Error())
 	}
@@ -420,6 +419,129 @@ func getRouteName(route *datamodel.GatewayRoute) (string, error) {
 	return resourceID.Name(), nil
 }
 
+func getDestinationPort(dependencies map[string]renderers.RendererDependency, destination string) (int32, error) {
+	port := renderers.DefaultPort
+
+	if isURL(destination) {
+		_, _, urlPort, err := parseURL(destination)
+		if err != nil {
+			return 0, err
+		}
+		port = urlPort
+	} else {
+		routeProperties := dependencies[destination]
+		routePort, ok := routeProperties.ComputedValues["port"].(float64)
+		if ok {
+			port = int32(routePort)
+		}
+	}
+
+	return port, nil
+}
+
+func isTrafficSplitRoute(route *datamodel.GatewayRoute) bool {
+	return len(route.Destinations) > 0 || route.Mirror != ""
+}
+
+// makeMatchConditions returns the sets of conditions used to include the HTTPProxy of the route in the root HTTPProxy.
+// The route is included once per set, so a request uses the route if it matches all the conditions of any set.
+func makeMatchConditions(prefix string, route *datamodel.GatewayRoute) [][]contourv1.MatchCondition {
+	conditions := []contourv1.MatchCondition{
+		{
+			Prefix: prefix,
+		},
+	}
+
+	for _, h := range route.Headers {
+		conditions = append(conditions, contourv1.MatchCondition{
+			Header: &contourv1.HeaderMatchCondition{
+				Name:     h.Name,
+				Exact:    h.Exact,
+				Contains: h.Contains,
+				Present:  h.Present,
+			},
+		})
+	}
+
+	sets := [][]contourv1.MatchCondition{conditions}
+	for _, c := range route.Cookies {
+		expanded := [][]contourv1.MatchCondition{}
+		for _, set := range sets {
+			for _, cookie := range makeCookieMatchConditions(c) {
+				next := make([]contourv1.MatchCondition, 0, len(set)+1)
+				next = append(next, set...)
+				expanded = append(expanded, append(next, cookie))
+			}
+		}
+		sets = expanded
+	}
+
+	return sets
+}
+
+// makeCookieMatchConditions returns the alternative conditions on the Cookie header that match the cookie, in which
+// the cookies are separated by "; ".
+//
+// The header conditions of the HTTPProxy API of Contour 1.25 have no regular expression match, so the cookie can't be
+// anchored on both sides. Instead the header must be the cookie alone, or contain the cookie followed or preceded by a
+// separator. A cookie whose name ends with the name of the condition is rejected when it is alone or last, and a
+// cookie whose value starts with the value of the condition is rejected when it is alone or first.
+func makeCookieMatchConditions(c datamodel.GatewayRouteCookieMatch) []contourv1.MatchCondition {
+	cookie := fmt.Sprintf("%s=%s", c.Name, c.Value)
+
+	return []contourv1.MatchCondition{
+		{Header: &contourv1.HeaderMatchCondition{Name: "Cookie", Exact: cookie}},
+		{Header: &contourv1.HeaderMatchCondition{Name: "Cookie", Contains: cookie + ";"}},
+		{Header: &contourv1.HeaderMatchCondition{Name: "Cookie", Contains: "; " + cookie}},
+	}
+}
+
+// makeServices returns the services that the traffic of the route is sent to, including the weighted destinations and
+// the mirror of the route.
+func makeServices(dependencies map[string]renderers.RendererDependency, route *datamodel.GatewayRoute) ([]contourv1.Service, error) {
+	services := []contourv1.Service{}
+
+	addService := func(destination string, weight int64, mirror bool) error {
+		name, err := getDestinationName(destination)
+		if err != nil {
+			return err
+		}
+
+		port, err := getDestinationPort(dependencies, destination)
+		if err != nil {
+			return err
+		}
+
+		services = append(services, contourv1.Service{
+			Name:   kubernetes.NormalizeResourceName(name),
+			Port:   int(port),
+			Weight: weight,
+			Mirror: mirror,
+		})
+		return nil
+	}
+
+	if route.Destination != "" {
+		if err := addService(route.Destination, 0, false); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, d := range route.Destinations {
+		if err := addService(d.Destination, int64(d.Weight), false); err != nil {
+			return nil, err
+		}
+	}
+
+	if route.Mirror != "" {
+		if err := addService(route.Mirror, 0, true); err != nil {
+			return nil, err
+		}
+	}
+
+	return services, nil
+}
+
 // getHostname returns the hostname of the public endpoint of the Gateway.
 // This sometimes involves transforming the external IP of the cluster into
 // a hostname that's unique to this Gateway and Application.
@@ -736,6 +736,44 @@ func Test_Render_Single_Route(t *testing.T) {
 	validateHttpRoute(t, output.Resources, routeName, 80, nil, "")
 }
 
+func Test_Render_Tags(t *testing.T) {
+	r := &Renderer{}
+
+	routeName := "routename"
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destination: makeRouteResourceID(routeName),
+				Path:        "/",
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+	resource.Tags = map[string]string{
+		"team":               "payments",
+		"radapp.io/resource": "tagged-resource",
+		"invalid key":        "value",
+	}
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+
+	// Both the root HTTPProxy and the route HTTPProxies are labeled with the tags of the gateway.
+	httpProxy, _ := kubernetes.FindContourHTTPProxy(output.Resources)
+	require.Equal(t, "payments", httpProxy.Labels["team"])
+	require.Equal(t, resourceName, httpProxy.Labels[kubernetes.LabelRadiusResource])
+	require.NotContains(t, httpProxy.Labels, "invalid key")
+
+	httpRoute, _ := kubernetes.FindContourHTTPProxyByLocalID(output.Resources, fmt.Sprintf("%s-%s", rpv1.LocalIDHttpRoute, routeName))
+	require.Equal(t, "payments", httpRoute.Labels["team"])
+	require.Equal(t, routeName, httpRoute.Labels[kubernetes.LabelRadiusResource])
+	require.NotContains(t, httpRoute.Labels, "invalid key")
+}
+
 func Test_Render_SSLPassthrough(t *testing.T) {
 	r := &Renderer{}
 
@@ -1120,6 +1158,140 @@ func Test_Render_WithDependencies(t *testing.T) {
 	validateHttpRoute(t, output.Resources, routeName, httpRoutePort, nil, "")
 }
 
+func Test_Render_TrafficSplit(t *testing.T) {
+	r := &Renderer{}
+
+	v1Destination := makeRouteResourceID("frontendv1")
+	v2Destination := makeRouteResourceID("frontendv2")
+	shadowDestination := makeRouteResourceID("frontendshadow")
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Path:        "/",
+				Destination: v2Destination,
+				Headers: []datamodel.GatewayRouteHeaderMatch{
+					{Name: "x-canary", Exact: "true"},
+				},
+				Cookies: []datamodel.GatewayRouteCookieMatch{
+					{Name: "version", Value: "v2"},
+				},
+			},
+			{
+				Path: "/",
+				Destinations: []datamodel.GatewayRouteDestination{
+					{Destination: v1Destination, Weight: 90},
+					{Destination: v2Destination, Weight: 10},
+				},
+				Mirror: shadowDestination,
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+	dependencies := map[string]renderers.RendererDependency{
+		(makeResourceID(t, v1Destination).String()): {
+			ResourceID:     makeResourceID(t, v1Destination),
+			ComputedValues: map[string]any{"port": float64(81)},
+		},
+		(makeResourceID(t, v2Destination).String()): {
+			ResourceID:     makeResourceID(t, v2Destination),
+			ComputedValues: map[string]any{"port": float64(82)},
+		},
+	}
+
+	ctx := testcontext.New(t)
+	radiusResourceIDs, _, err := r.GetDependencyIDs(ctx, resource)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []resources.ID{
+		makeResourceID(t, v2Destination),
+		makeResourceID(t, v1Destination),
+		makeResourceID(t, v2Destination),
+		makeResourceID(t, shadowDestination),
+	}, radiusResourceIDs)
+
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+
+	output, err := r.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 3)
+
+	splitRouteName := fmt.Sprintf("%s-route-1", resourceName)
+	expectedGatewaySpec := &contourv1.HTTPProxySpec{
+		VirtualHost: &contourv1.VirtualHost{
+			Fqdn: expectedHostname,
+		},
+		Includes: []contourv1.Include{
+			{
+				Name: "frontendv2",
+				Conditions: []contourv1.MatchCondition{
+					{
+						Prefix: "/",
+					},
+					{
+						Header: &contourv1.HeaderMatchCondition{Name: "x-canary", Exact: "true"},
+					},
+					{
+						Header: &contourv1.HeaderMatchCondition{Name: "Cookie", Exact: "version=v2"},
+					},
+				},
+			},
+			{
+				Name: "frontendv2",
+				Conditions: []contourv1.MatchCondition{
+					{
+						Prefix: "/",
+					},
+					{
+						Header: &contourv1.HeaderMatchCondition{Name: "x-canary", Exact: "true"},
+					},
+					{
+						Header: &contourv1.HeaderMatchCondition{Name: "Cookie", Contains: "version=v2;"},
+					},
+				},
+			},
+			{
+				Name: "frontendv2",
+				Conditions: []contourv1.MatchCondition{
+					{
+						Prefix: "/",
+					},
+					{
+						Header: &contourv1.HeaderMatchCondition{Name: "x-canary", Exact: "true"},
+					},
+					{
+						Header: &contourv1.HeaderMatchCondition{Name: "Cookie", Contains: "; version=v2"},
+					},
+				},
+			},
+			{
+				Name: kubernetes.NormalizeResourceName(splitRouteName),
+				Conditions: []contourv1.MatchCondition{
+					{
+						Prefix: "/",
+					},
+				},
+			},
+		},
+	}
+	validateHTTPProxy(t, output.Resources, expectedGatewaySpec, "")
+	validateHttpRoute(t, output.Resources, "frontendv2", 82, nil, "")
+
+	splitRoute, _ := kubernetes.FindContourHTTPProxyByLocalID(output.Resources, fmt.Sprintf("%s-%s", rpv1.LocalIDHttpRoute, splitRouteName))
+	require.NotNil(t, splitRoute)
+	require.Equal(t, []contourv1.Route{
+		{
+			Services: []contourv1.Service{
+				{Name: "frontendv1", Port: 81, Weight: 90},
+				{Name: "frontendv2", Port: 82, Weight: 10},
+				{Name: "frontendshadow", Port: int(renderers.DefaultPort), Mirror: true},
+			},
+		},
+	}, splitRoute.Spec.Routes)
+}
+
 func Test_Render_WithEnvironment_KubernetesMetadata(t *testing.T) {
 	r := &Renderer{}
 
@@ -1714,3 +1886,58 @@ func getExpectedMaps(isGateway bool, kmeOption string) *expectedMaps {
 		metaLbl: metaLbl,
 	}
 }
+
+func Test_MakeMatchConditions_Cookies(t *testing.T) {
+	route := &datamodel.GatewayRoute{
+		Path: "/",
+		Cookies: []datamodel.GatewayRouteCookieMatch{
+			{Name: "version", Value: "v2"},
+			{Name: "region", Value: "eu"},
+		},
+	}
+	sets := makeMatchConditions("/", route)
+	require.Len(t, sets, 9)
+
+	// matches evaluates the conditions the way Contour does: all the conditions of a set must match, and any set can match.
+	matches := func(cookie string) bool {
+		for _, set := range sets {
+			matched := true
+			for _, condition := range set {
+				if condition.Header == nil {
+					continue
+				}
+				if condition.Header.Exact != "" && cookie != condition.Header.Exact {
+					matched = false
+				}
+				if condition.Header.Contains != "" && !strings.Contains(cookie, condition.Header.Contains) {
+					matched = false
+				}
+			}
+			if matched {
+				return true
+			}
+		}
+		return false
+	}
+
+	tests := []struct {
+		cookie  string
+		matches bool
+	}{
+		{cookie: "version=v2; region=eu", matches: true},
+		{cookie: "region=eu; version=v2", matches: true},
+		{cookie: "a=1; version=v2; b=2; region=eu; c=3", matches: true},
+		{cookie: "version=v2", matches: false},
+		{cookie: "version=v20; region=eu", matches: false},
+		{cookie: "region=eu; xversion=v2", matches: false},
+		{cookie: "version=v2; region=eu; b=2", matches: true},
+		{cookie: "version=v2x", matches: false},
+		{cookie: "", matches: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cookie, func(t *testing.T) {
+			require.Equal(t, tt.matches, matches(tt.cookie))
+		})
+	}
+}
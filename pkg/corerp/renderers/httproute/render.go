@@ -99,7 +99,7 @@ func (r *Renderer) makeService(ctx context.Context, route *datamodel.HTTPRoute,
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        kubernetes.NormalizeResourceName(route.Name),
 			Namespace:   options.Environment.Namespace,
-			Labels:      renderers.GetLabels(options, appId.Name(), route.Name, route.ResourceTypeName()),
+			Labels:      renderers.GetLabelsWithTags(options, appId.Name(), route.Name, route.ResourceTypeName(), route.Tags),
 			Annotations: renderers.GetAnnotations(options),
 		},
 		Spec: corev1.ServiceSpec{
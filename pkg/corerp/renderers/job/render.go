@@ -0,0 +1,287 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/resourcemodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	ResourceType = "Applications.Core/jobs"
+
+	// jobNameHashLength is the length of the spec hash appended to the name of a Job.
+	jobNameHashLength = 8
+
+	// maxJobNameLength is the maximum length of the name of a Job. The name is used as the value of the job-name
+	// label of its pods, which can't be longer than 63 characters.
+	maxJobNameLength = 63
+)
+
+// Renderer is the renderers.Renderer implementation for Applications.Core/jobs.
+//
+// A job shares the pod definition with a container, so the rendering of the pod is delegated to the
+// inner container renderer. The Deployment rendered by the inner renderer is then replaced by a
+// Kubernetes Job, or a CronJob when the job has a schedule.
+type Renderer struct {
+	Inner renderers.Renderer
+}
+
+// GetDependencyIDs gets the IDs of the resources that the given job depends on.
+func (r *Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	resource, ok := dm.(*datamodel.JobResource)
+	if !ok {
+		return nil, nil, v1.ErrInvalidModelConversion
+	}
+
+	return r.Inner.GetDependencyIDs(ctx, toContainerResource(resource))
+}
+
+// Render renders the job as a container using the inner renderer and converts the resulting Deployment into
+// a Kubernetes Job or CronJob.
+func (r *Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	resource, ok := dm.(*datamodel.JobResource)
+	if !ok {
+		return renderers.RendererOutput{}, v1.ErrInvalidModelConversion
+	}
+
+	output, err := r.Inner.Render(ctx, toContainerResource(resource), options)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	outputResources := []rpv1.OutputResource{}
+	for _, ores := range output.Resources {
+		if ores.LocalID == rpv1.LocalIDDeployment && ores.CreateResource != nil {
+			deployment, ok := ores.CreateResource.Data.(*appsv1.Deployment)
+			if !ok {
+				return renderers.RendererOutput{}, errors.New("deployment resource must be a Kubernetes Deployment")
+			}
+
+			jobOutput, err := makeJobOutputResource(resource, deployment)
+			if err != nil {
+				return renderers.RendererOutput{}, err
+			}
+			jobOutput.CreateResource.Dependencies = ores.CreateResource.Dependencies
+			ores = jobOutput
+		}
+
+		if err := setResourceTypeLabel(ores); err != nil {
+			return renderers.RendererOutput{}, err
+		}
+
+		outputResources = append(outputResources, ores)
+	}
+
+	output.Resources = outputResources
+	output.ComputedValues = wrapComputedValues(output.ComputedValues)
+
+	return output, nil
+}
+
+// toContainerResource converts a job to the container resource which is rendered by the inner renderer.
+func toContainerResource(resource *datamodel.JobResource) *datamodel.ContainerResource {
+	return &datamodel.ContainerResource{
+		BaseResource:             resource.BaseResource,
+		PortableResourceMetadata: resource.PortableResourceMetadata,
+		Properties: datamodel.ContainerProperties{
+			BasicResourceProperties: resource.Properties.BasicResourceProperties,
+			Connections:             resource.Properties.Connections,
+			Container:               resource.Properties.Container,
+			Extensions:              resource.Properties.Extensions,
+			Identity:                resource.Properties.Identity,
+			Runtimes:                resource.Properties.Runtimes,
+		},
+	}
+}
+
+// makeJobOutputResource creates the Job or CronJob output resource from the pod template of the deployment.
+func makeJobOutputResource(resource *datamodel.JobResource, deployment *appsv1.Deployment) (rpv1.OutputResource, error) {
+	properties := resource.Properties
+
+	template := *deployment.Spec.Template.DeepCopy()
+
+	// Pods of a job can't use the 'Always' restart policy, which is the default for a Deployment.
+	template.Spec.RestartPolicy = corev1.RestartPolicyOnFailure
+	if properties.RestartPolicy != "" {
+		template.Spec.RestartPolicy = corev1.RestartPolicy(properties.RestartPolicy)
+	}
+
+	jobSpec := batchv1.JobSpec{
+		Template:                template,
+		Completions:             properties.Completions,
+		Parallelism:             properties.Parallelism,
+		BackoffLimit:            properties.BackoffLimit,
+		ActiveDeadlineSeconds:   properties.ActiveDeadlineSeconds,
+		TTLSecondsAfterFinished: properties.TTLSecondsAfterFinished,
+	}
+
+	if resource.IsScheduled() {
+		cronJob := &batchv1.CronJob{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "CronJob",
+				APIVersion: "batch/v1",
+			},
+			ObjectMeta: deployment.ObjectMeta,
+			Spec: batchv1.CronJobSpec{
+				Schedule:          properties.Schedule,
+				ConcurrencyPolicy: batchv1.ConcurrencyPolicy(properties.ConcurrencyPolicy),
+				JobTemplate: batchv1.JobTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels:      template.Labels,
+						Annotations: template.Annotations,
+					},
+					Spec: jobSpec,
+				},
+			},
+		}
+
+		return rpv1.NewKubernetesOutputResource(rpv1.LocalIDCronJob, cronJob, cronJob.ObjectMeta), nil
+	}
+
+	job := &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Job",
+			APIVersion: "batch/v1",
+		},
+		ObjectMeta: deployment.ObjectMeta,
+		Spec:       jobSpec,
+	}
+
+	// The pod template of a Job is immutable. The name of the Job includes the hash of its spec so that a change
+	// creates a new Job, and the Job rendered by the previous deployment is garbage collected.
+	hash, err := hashJobSpec(&job.Spec)
+	if err != nil {
+		return rpv1.OutputResource{}, err
+	}
+	job.ObjectMeta.Name = fmt.Sprintf("%s-%s", truncateJobName(deployment.Name), hash)
+
+	// The annotation is not part of the spec hash, so changing the timeout doesn't run the job again.
+	if properties.CompletionTimeoutSeconds != nil {
+		annotations := map[string]string{}
+		for k, v := range job.ObjectMeta.Annotations {
+			annotations[k] = v
+		}
+		annotations[kubernetes.AnnotationJobCompletionTimeout] = strconv.Itoa(int(*properties.CompletionTimeoutSeconds))
+		job.ObjectMeta.Annotations = annotations
+	}
+
+	return rpv1.NewKubernetesOutputResource(rpv1.LocalIDJob, job, job.ObjectMeta), nil
+}
+
+// truncateJobName truncates the name so that the name with the appended spec hash fits in the maximum length of
+// the name of a Job.
+func truncateJobName(name string) string {
+	if maxLength := maxJobNameLength - jobNameHashLength - 1; len(name) > maxLength {
+		name = strings.TrimRight(name[:maxLength], "-.")
+	}
+
+	return name
+}
+
+// hashJobSpec returns a short hash of the given job spec.
+func hashJobSpec(spec *batchv1.JobSpec) (string, error) {
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize job spec: %w", err)
+	}
+
+	sum := sha1.Sum(b)
+	return hex.EncodeToString(sum[:])[:jobNameHashLength], nil
+}
+
+// setResourceTypeLabel replaces the resource type label set by the container renderer on the Kubernetes
+// resources with the job resource type.
+func setResourceTypeLabel(ores rpv1.OutputResource) error {
+	resourceType := ores.GetResourceType()
+	if resourceType.Provider != resourcemodel.ProviderKubernetes || ores.CreateResource == nil {
+		return nil
+	}
+
+	obj, err := meta.Accessor(ores.CreateResource.Data)
+	if err != nil {
+		return errors.New("found Kubernetes resource with non-Kubernetes payload")
+	}
+
+	labelValue := strings.ToLower(kubernetes.ConvertResourceTypeToLabelValue(ResourceType))
+	setLabel(obj.GetLabels(), labelValue)
+
+	switch o := ores.CreateResource.Data.(type) {
+	case *batchv1.Job:
+		setLabel(o.Spec.Template.Labels, labelValue)
+	case *batchv1.CronJob:
+		setLabel(o.Spec.JobTemplate.Labels, labelValue)
+		setLabel(o.Spec.JobTemplate.Spec.Template.Labels, labelValue)
+	}
+
+	return nil
+}
+
+func setLabel(labels map[string]string, value string) {
+	if _, ok := labels[kubernetes.LabelRadiusResourceType]; ok {
+		labels[kubernetes.LabelRadiusResourceType] = value
+	}
+}
+
+// wrapComputedValues wraps the transformers of the computed values rendered by the inner renderer, which operate
+// on a container resource, so that they can be applied to the job resource.
+func wrapComputedValues(computedValues map[string]rpv1.ComputedValueReference) map[string]rpv1.ComputedValueReference {
+	for key, value := range computedValues {
+		if value.Transformer == nil {
+			continue
+		}
+
+		inner := value.Transformer
+		value.Transformer = func(dm v1.DataModelInterface, cv map[string]any) error {
+			resource, ok := dm.(*datamodel.JobResource)
+			if !ok {
+				return errors.New("resource must be JobResource")
+			}
+
+			container := toContainerResource(resource)
+			if err := inner(container, cv); err != nil {
+				return err
+			}
+
+			resource.Properties.Identity = container.Properties.Identity
+			return nil
+		}
+		computedValues[key] = value
+	}
+
+	return computedValues
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"strings"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/handlers"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/corerp/renderers/container"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testcontext"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	applicationResourceID = "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-app"
+	resourceName          = "test-job"
+)
+
+var jobLabelValue = strings.ToLower(kubernetes.ConvertResourceTypeToLabelValue(ResourceType))
+
+func makeResource(properties datamodel.JobProperties) *datamodel.JobResource {
+	return &datamodel.JobResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/jobs/test-job",
+				Name: resourceName,
+				Type: ResourceType,
+			},
+		},
+		Properties: properties,
+	}
+}
+
+func makeProperties() datamodel.JobProperties {
+	return datamodel.JobProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+		},
+	}
+}
+
+func findOutputResource(t *testing.T, resources []rpv1.OutputResource, localID string) *rpv1.OutputResource {
+	for i := range resources {
+		if resources[i].LocalID == localID {
+			return &resources[i]
+		}
+	}
+	require.Failf(t, "output resource not found", "localID: %s", localID)
+	return nil
+}
+
+func Test_GetDependencyIDs_InvalidModel(t *testing.T) {
+	renderer := &Renderer{Inner: &container.Renderer{}}
+	_, _, err := renderer.GetDependencyIDs(testcontext.New(t), &datamodel.ContainerResource{})
+	require.ErrorIs(t, err, v1.ErrInvalidModelConversion)
+}
+
+func Test_Render_Job(t *testing.T) {
+	properties := makeProperties()
+	properties.Completions = to.Ptr(int32(3))
+	properties.Parallelism = to.Ptr(int32(2))
+	properties.BackoffLimit = to.Ptr(int32(4))
+	properties.ActiveDeadlineSeconds = to.Ptr(int64(600))
+	properties.TTLSecondsAfterFinished = to.Ptr(int32(100))
+
+	renderer := &Renderer{Inner: &container.Renderer{}}
+	output, err := renderer.Render(testcontext.New(t), makeResource(properties), renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+	require.NoError(t, err)
+
+	for _, ores := range output.Resources {
+		require.NotEqual(t, rpv1.LocalIDDeployment, ores.LocalID)
+	}
+
+	jobOutput := findOutputResource(t, output.Resources, rpv1.LocalIDJob)
+	job, ok := jobOutput.CreateResource.Data.(*batchv1.Job)
+	require.True(t, ok)
+
+	require.True(t, strings.HasPrefix(job.Name, resourceName+"-"))
+	require.Len(t, job.Name, len(resourceName)+1+jobNameHashLength)
+	require.Equal(t, jobLabelValue, job.Labels[kubernetes.LabelRadiusResourceType])
+	require.Equal(t, jobLabelValue, job.Spec.Template.Labels[kubernetes.LabelRadiusResourceType])
+	require.Equal(t, corev1.RestartPolicyOnFailure, job.Spec.Template.Spec.RestartPolicy)
+	require.Equal(t, "someimage:latest", job.Spec.Template.Spec.Containers[0].Image)
+	require.Equal(t, properties.Completions, job.Spec.Completions)
+	require.Equal(t, properties.Parallelism, job.Spec.Parallelism)
+	require.Equal(t, properties.BackoffLimit, job.Spec.BackoffLimit)
+	require.Equal(t, properties.ActiveDeadlineSeconds, job.Spec.ActiveDeadlineSeconds)
+	require.Equal(t, properties.TTLSecondsAfterFinished, job.Spec.TTLSecondsAfterFinished)
+	require.Contains(t, jobOutput.CreateResource.Dependencies, rpv1.LocalIDServiceAccount)
+	require.NotContains(t, job.Annotations, kubernetes.AnnotationJobCompletionTimeout)
+
+	// Other Kubernetes resources are labeled with the job resource type.
+	sa := findOutputResource(t, output.Resources, rpv1.LocalIDServiceAccount)
+	require.Equal(t, jobLabelValue, sa.CreateResource.Data.(*corev1.ServiceAccount).Labels[kubernetes.LabelRadiusResourceType])
+}
+
+func Test_Render_Job_NameChangesWithSpec(t *testing.T) {
+	renderer := &Renderer{Inner: &container.Renderer{}}
+	options := renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}}
+
+	properties := makeProperties()
+	output, err := renderer.Render(testcontext.New(t), makeResource(properties), options)
+	require.NoError(t, err)
+	first := findOutputResource(t, output.Resources, rpv1.LocalIDJob).CreateResource.Data.(*batchv1.Job)
+
+	output, err = renderer.Render(testcontext.New(t), makeResource(properties), options)
+	require.NoError(t, err)
+	same := findOutputResource(t, output.Resources, rpv1.LocalIDJob).CreateResource.Data.(*batchv1.Job)
+	require.Equal(t, first.Name, same.Name)
+
+	properties.Container.Image = "someimage:v2"
+	output, err = renderer.Render(testcontext.New(t), makeResource(properties), options)
+	require.NoError(t, err)
+	changed := findOutputResource(t, output.Resources, rpv1.LocalIDJob).CreateResource.Data.(*batchv1.Job)
+	require.NotEqual(t, first.Name, changed.Name)
+}
+
+func Test_Render_Job_CompletionTimeout(t *testing.T) {
+	renderer := &Renderer{Inner: &container.Renderer{}}
+	options := renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}}
+
+	properties := makeProperties()
+	output, err := renderer.Render(testcontext.New(t), makeResource(properties), options)
+	require.NoError(t, err)
+	first := findOutputResource(t, output.Resources, rpv1.LocalIDJob).CreateResource.Data.(*batchv1.Job)
+
+	properties.CompletionTimeoutSeconds = to.Ptr(int32(300))
+	output, err = renderer.Render(testcontext.New(t), makeResource(properties), options)
+	require.NoError(t, err)
+	job := findOutputResource(t, output.Resources, rpv1.LocalIDJob).CreateResource.Data.(*batchv1.Job)
+	require.Equal(t, "300", job.Annotations[kubernetes.AnnotationJobCompletionTimeout])
+	require.NotContains(t, job.Spec.Template.Annotations, kubernetes.AnnotationJobCompletionTimeout)
+
+	// Changing the timeout doesn't run the job again.
+	require.Equal(t, first.Name, job.Name)
+}
+
+func Test_Render_Job_LongName(t *testing.T) {
+	renderer := &Renderer{Inner: &container.Renderer{}}
+	options := renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}}
+
+	// The application name is short so that the names of the other rendered resources are valid.
+	properties := makeProperties()
+	properties.Application = "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/a"
+	resource := makeResource(properties)
+	resource.Name = strings.Repeat("a", 53) + "-" + strings.Repeat("b", 6)
+	output, err := renderer.Render(testcontext.New(t), resource, options)
+	require.NoError(t, err)
+
+	job := findOutputResource(t, output.Resources, rpv1.LocalIDJob).CreateResource.Data.(*batchv1.Job)
+	require.Len(t, job.Name, maxJobNameLength-1)
+	require.True(t, strings.HasPrefix(job.Name, strings.Repeat("a", 53)+"-"))
+	require.NotContains(t, job.Name, "--")
+}
+
+func Test_TruncateJobName(t *testing.T) {
+	require.Equal(t, "test-job", truncateJobName("test-job"))
+	require.Equal(t, strings.Repeat("a", 54), truncateJobName(strings.Repeat("a", 63)))
+	require.Equal(t, strings.Repeat("a", 53), truncateJobName(strings.Repeat("a", 53)+"-"+strings.Repeat("b", 9)))
+}
+
+func Test_Render_Job_RestartPolicy(t *testing.T) {
+	properties := makeProperties()
+	properties.RestartPolicy = "Never"
+
+	renderer := &Renderer{Inner: &container.Renderer{}}
+	output, err := renderer.Render(testcontext.New(t), makeResource(properties), renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+	require.NoError(t, err)
+
+	job := findOutputResource(t, output.Resources, rpv1.LocalIDJob).CreateResource.Data.(*batchv1.Job)
+	require.Equal(t, corev1.RestartPolicyNever, job.Spec.Template.Spec.RestartPolicy)
+}
+
+func Test_Render_CronJob(t *testing.T) {
+	properties := makeProperties()
+	properties.Schedule = "*/5 * * * *"
+	properties.ConcurrencyPolicy = datamodel.JobConcurrencyPolicyForbid
+	properties.BackoffLimit = to.Ptr(int32(2))
+
+	renderer := &Renderer{Inner: &container.Renderer{}}
+	output, err := renderer.Render(testcontext.New(t), makeResource(properties), renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+	require.NoError(t, err)
+
+	cronJob, ok := findOutputResource(t, output.Resources, rpv1.LocalIDCronJob).CreateResource.Data.(*batchv1.CronJob)
+	require.True(t, ok)
+
+	require.Equal(t, resourceName, cronJob.Name)
+	require.Equal(t, properties.Schedule, cronJob.Spec.Schedule)
+	require.Equal(t, batchv1.ForbidConcurrent, cronJob.Spec.ConcurrencyPolicy)
+	require.Equal(t, properties.BackoffLimit, cronJob.Spec.JobTemplate.Spec.BackoffLimit)
+	require.Equal(t, jobLabelValue, cronJob.Labels[kubernetes.LabelRadiusResourceType])
+	require.Equal(t, jobLabelValue, cronJob.Spec.JobTemplate.Labels[kubernetes.LabelRadiusResourceType])
+	require.Equal(t, jobLabelValue, cronJob.Spec.JobTemplate.Spec.Template.Labels[kubernetes.LabelRadiusResourceType])
+	require.Equal(t, corev1.RestartPolicyOnFailure, cronJob.Spec.JobTemplate.Spec.Template.Spec.RestartPolicy)
+}
+
+func Test_Render_ComputedValueTransformers(t *testing.T) {
+	properties := makeProperties()
+	properties.Connections = map[string]datamodel.ConnectionProperties{
+		"kv": {
+			Source: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/SomeProvider/ResourceType/test-azure-resource",
+			IAM: datamodel.IAMProperties{
+				Kind:  datamodel.KindAzure,
+				Roles: []string{"administrator"},
+			},
+		},
+	}
+
+	options := renderers.RenderOptions{
+		Dependencies: map[string]renderers.RendererDependency{},
+		Environment: renderers.EnvironmentOptions{
+			Namespace: "default",
+			CloudProviders: &datamodel.Providers{
+				Azure: datamodel.ProvidersAzure{
+					Scope: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup",
+				},
+			},
+			Identity: &rpv1.IdentitySettings{
+				Kind:       rpv1.AzureIdentityWorkload,
+				OIDCIssuer: "https://radiusoidc/00000000-0000-0000-0000-000000000000",
+			},
+		},
+	}
+
+	renderer := &Renderer{
+		Inner: &container.Renderer{
+			RoleAssignmentMap: map[datamodel.IAMKind]container.RoleAssignmentData{
+				datamodel.KindAzure: {},
+			},
+		},
+	}
+	resource := makeResource(properties)
+	output, err := renderer.Render(testcontext.New(t), resource, options)
+	require.NoError(t, err)
+
+	cv := output.ComputedValues[handlers.IdentityProperties]
+	require.NotNil(t, cv.Transformer)
+	err = cv.Transformer(resource, map[string]any{handlers.IdentityProperties: options.Environment.Identity})
+	require.NoError(t, err)
+	require.Equal(t, rpv1.AzureIdentityWorkload, resource.Properties.Identity.Kind)
+	require.Equal(t, options.Environment.Identity.OIDCIssuer, resource.Properties.Identity.OIDCIssuer)
+
+	cv = output.ComputedValues[handlers.UserAssignedIdentityIDKey]
+	err = cv.Transformer(resource, map[string]any{handlers.UserAssignedIdentityIDKey: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/test-job"})
+	require.NoError(t, err)
+	require.Equal(t, "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/test-job", resource.Properties.Identity.Resource)
+
+	err = cv.Transformer(&datamodel.ContainerResource{}, map[string]any{})
+	require.EqualError(t, err, "resource must be JobResource")
+}
@@ -19,6 +19,7 @@ package renderers
 import (
 	"github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/rp/kube"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 // GetLabels merges cumulative label values from Environment, Application, Container and InputExt kubernetes metadata and
@@ -47,6 +48,13 @@ func GetLabels(options RenderOptions, applicationName string, resourceName strin
 	return nil
 }
 
+// GetLabelsWithTags returns the labels of GetLabels together with the resource tags that are valid labels. Resource tags
+// never override the other labels. Only the top-level objects rendered for a resource are labeled with its tags, pod
+// templates are not, so that changing a tag doesn't restart the pods.
+func GetLabelsWithTags(options RenderOptions, applicationName string, resourceName string, resourceTypeName string, tags map[string]string) map[string]string {
+	return labels.Merge(kubernetes.MakeTagLabels(tags), GetLabels(options, applicationName, resourceName, resourceTypeName))
+}
+
 // GetAnnotations returns the merged annotations from Environment and Application KubernetesMetadata.
 func GetAnnotations(options RenderOptions) map[string]string {
 	// Create KubernetesMetadata struct to merge annotations
@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	k8slabels "github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// PersistentVolumeClaimNameKey represents the key of volume resource computedValues to keep the name of the PersistentVolumeClaim.
+	PersistentVolumeClaimNameKey = "claimname"
+)
+
+// PersistentVolumeClaimRenderer is a renderer for Kubernetes PersistentVolumeClaim volume.
+type PersistentVolumeClaimRenderer struct {
+}
+
+// Render creates a PersistentVolumeClaim from the VolumeResource and returns a RendererOutput with the
+// PersistentVolumeClaim and the name of the claim as computed value.
+func (r *PersistentVolumeClaimRenderer) Render(ctx context.Context, dm v1.DataModelInterface, options *renderers.RenderOptions) (*renderers.RendererOutput, error) {
+	resource, ok := dm.(*datamodel.VolumeResource)
+	if !ok {
+		return nil, v1.ErrInvalidModelConversion
+	}
+
+	properties := resource.Properties.KubernetesPersistentVolumeClaim
+	if properties == nil {
+		return nil, v1.NewClientErrInvalidRequest("kubernetes.persistentVolumeClaim volume properties must be specified")
+	}
+
+	appID, err := resources.ParseResource(resource.Properties.Application)
+	if err != nil {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid application id: %s", err.Error()))
+	}
+
+	pvc, err := MakePersistentVolumeClaim(appID.Name(), resource, options.Environment.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	or := rpv1.NewKubernetesOutputResource(rpv1.LocalIDPersistentVolumeClaim, pvc, pvc.ObjectMeta)
+	// The claim and the data of a retained volume outlive the volume resource.
+	or.RetainOnDelete = properties.RetainOnDelete
+
+	return &renderers.RendererOutput{
+		Resources: []rpv1.OutputResource{or},
+		ComputedValues: map[string]rpv1.ComputedValueReference{
+			PersistentVolumeClaimNameKey: {
+				Value: pvc.Name,
+			},
+		},
+		SecretValues: map[string]rpv1.SecretValueReference{},
+	}, nil
+}
+
+// MakePersistentVolumeClaim creates the PersistentVolumeClaim for the given volume resource in the given namespace.
+func MakePersistentVolumeClaim(applicationName string, resource *datamodel.VolumeResource, namespace string) (*corev1.PersistentVolumeClaim, error) {
+	properties := resource.Properties.KubernetesPersistentVolumeClaim
+
+	size, err := k8sresource.ParseQuantity(properties.Size)
+	if err != nil {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid volume size %q: %s", properties.Size, err.Error()))
+	}
+
+	accessModes := []corev1.PersistentVolumeAccessMode{}
+	for _, mode := range properties.AccessModes {
+		accessModes = append(accessModes, corev1.PersistentVolumeAccessMode(mode))
+	}
+	if len(accessModes) == 0 {
+		accessModes = append(accessModes, corev1.ReadWriteOnce)
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PersistentVolumeClaim",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      k8slabels.NormalizeResourceName(resource.Name),
+			Namespace: namespace,
+			Labels:    labels.Merge(k8slabels.MakeTagLabels(resource.Tags), k8slabels.MakeDescriptiveLabels(applicationName, resource.Name, resource.ResourceTypeName())),
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: accessModes,
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: size,
+				},
+			},
+		},
+	}
+
+	if properties.StorageClass != "" {
+		pvc.Spec.StorageClassName = to.Ptr(properties.StorageClass)
+	}
+
+	return pvc, nil
+}
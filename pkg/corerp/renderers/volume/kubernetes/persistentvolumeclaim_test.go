@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	k8slabels "github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+func makeVolumeResource(properties *datamodel.KubernetesPersistentVolumeClaimVolumeProperties) *datamodel.VolumeResource {
+	return &datamodel.VolumeResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/volumes/Data0",
+				Name: "Data0",
+				Type: "Applications.Core/volumes",
+			},
+		},
+		Properties: datamodel.VolumeResourceProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-app",
+			},
+			Kind:                            datamodel.KubernetesPersistentVolumeClaimVolume,
+			KubernetesPersistentVolumeClaim: properties,
+		},
+	}
+}
+
+func TestPersistentVolumeClaimRender(t *testing.T) {
+	r := &PersistentVolumeClaimRenderer{}
+	resource := makeVolumeResource(&datamodel.KubernetesPersistentVolumeClaimVolumeProperties{
+		StorageClass: "standard",
+		Size:         "10Gi",
+		AccessModes: []datamodel.PersistentVolumeClaimAccessMode{
+			datamodel.PersistentVolumeClaimAccessModeReadWriteMany,
+		},
+	})
+
+	output, err := r.Render(context.Background(), resource, &renderers.RenderOptions{
+		Environment: renderers.EnvironmentOptions{Namespace: "test-ns"},
+	})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 1)
+	require.Equal(t, rpv1.LocalIDPersistentVolumeClaim, output.Resources[0].LocalID)
+	require.Nil(t, output.Resources[0].RadiusManaged)
+	require.Equal(t, "data0", output.ComputedValues[PersistentVolumeClaimNameKey].Value)
+
+	pvc, ok := output.Resources[0].CreateResource.Data.(*corev1.PersistentVolumeClaim)
+	require.True(t, ok)
+	require.Equal(t, "data0", pvc.Name)
+	require.Equal(t, "test-ns", pvc.Namespace)
+	require.Equal(t, k8slabels.MakeDescriptiveLabels("test-app", "Data0", "Applications.Core/volumes"), pvc.Labels)
+	require.Equal(t, to.Ptr("standard"), pvc.Spec.StorageClassName)
+	require.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}, pvc.Spec.AccessModes)
+	require.Equal(t, k8sresource.MustParse("10Gi"), pvc.Spec.Resources.Requests[corev1.ResourceStorage])
+}
+
+func TestPersistentVolumeClaimRender_Defaults(t *testing.T) {
+	r := &PersistentVolumeClaimRenderer{}
+	resource := makeVolumeResource(&datamodel.KubernetesPersistentVolumeClaimVolumeProperties{
+		Size:           "1Gi",
+		RetainOnDelete: true,
+	})
+
+	output, err := r.Render(context.Background(), resource, &renderers.RenderOptions{
+		Environment: renderers.EnvironmentOptions{Namespace: "test-ns"},
+	})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 1)
+
+	require.True(t, output.Resources[0].RetainOnDelete)
+	require.Nil(t, output.Resources[0].RadiusManaged)
+
+	pvc := output.Resources[0].CreateResource.Data.(*corev1.PersistentVolumeClaim)
+	require.Nil(t, pvc.Spec.StorageClassName)
+	require.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}, pvc.Spec.AccessModes)
+}
+
+func TestPersistentVolumeClaimRender_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties *datamodel.KubernetesPersistentVolumeClaimVolumeProperties
+		err        string
+	}{
+		{
+			name: "missing properties",
+			err:  "kubernetes.persistentVolumeClaim volume properties must be specified",
+		},
+		{
+			name:       "invalid size",
+			properties: &datamodel.KubernetesPersistentVolumeClaimVolumeProperties{Size: "large"},
+			err:        "invalid volume size \"large\": quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &PersistentVolumeClaimRenderer{}
+			_, err := r.Render(context.Background(), makeVolumeResource(tc.properties), &renderers.RenderOptions{})
+			require.Error(t, err)
+			require.Equal(t, tc.err, err.(*v1.ErrClientRP).Message)
+		})
+	}
+}
@@ -219,6 +219,36 @@ var operationList = []v1.Operation{
 		},
 		IsDataAction: false,
 	},
+	{
+		Name: "Applications.Core/jobs/read",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "jobs",
+			Operation:   "List jobs",
+			Description: "Get the list of jobs.",
+		},
+		IsDataAction: false,
+	},
+	{
+		Name: "Applications.Core/jobs/write",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "jobs",
+			Operation:   "Create/Update job",
+			Description: "Create or update a job.",
+		},
+		IsDataAction: false,
+	},
+	{
+		Name: "Applications.Core/jobs/delete",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "jobs",
+			Operation:   "Delete job",
+			Description: "Delete a job.",
+		},
+		IsDataAction: false,
+	},
 	{
 		Name: "Applications.Core/extenders/read",
 		Display: &v1.OperationDisplayProperties{
@@ -22,6 +22,8 @@ import (
 	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
@@ -32,6 +34,7 @@ import (
 	env_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/environments"
 	ext_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/extenders"
 	gw_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/gateways"
+	job_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/jobs"
 	secret_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/secretstores"
 	vol_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/volumes"
 	rp_frontend "github.com/radius-project/radius/pkg/rp/frontend"
@@ -49,6 +52,11 @@ const (
 func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerConfig) *builder.Namespace {
 	ns := builder.NewNamespace("Applications.Core")
 
+	envResourceOptions := apictrl.ResourceOptions[datamodel.Environment]{
+		RequestConverter:  converter.EnvironmentDataModelFromVersioned,
+		ResponseConverter: converter.EnvironmentDataModelToVersioned,
+	}
+
 	_ = ns.AddResource("environments", &builder.ResourceOption[*datamodel.Environment, datamodel.Environment]{
 		RequestConverter:  converter.EnvironmentDataModelFromVersioned,
 		ResponseConverter: converter.EnvironmentDataModelToVersioned,
@@ -59,12 +67,22 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.Environment]{
 			APIController: env_ctrl.NewCreateOrUpdateEnvironment,
 		},
+		Delete: builder.Operation[datamodel.Environment]{
+			APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+				return defaultoperation.NewSoftDelete(opt, envResourceOptions, softdelete.EnvironmentChildren, softdelete.NewDeleter(*recipeControllerConfig.UCPConnection))
+			},
+		},
 		Custom: map[string]builder.Operation[datamodel.Environment]{
 			"getmetadata": {
 				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
 					return env_ctrl.NewGetRecipeMetadata(opt, recipeControllerConfig.Engine)
 				},
 			},
+			"restore": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return defaultoperation.NewRestore(opt, envResourceOptions, softdelete.EnvironmentChildren)
+				},
+			},
 		},
 	})
 
@@ -137,6 +155,34 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		},
 	})
 
+	_ = ns.AddResource("jobs", &builder.ResourceOption[*datamodel.JobResource, datamodel.JobResource]{
+		RequestConverter:  converter.JobDataModelFromVersioned,
+		ResponseConverter: converter.JobDataModelToVersioned,
+
+		Put: builder.Operation[datamodel.JobResource]{
+			UpdateFilters: []apictrl.UpdateFilter[datamodel.JobResource]{
+				rp_frontend.PrepareRadiusResource[*datamodel.JobResource],
+				job_ctrl.ValidateAndMutateRequest,
+			},
+			AsyncJobController:       backend_ctrl.NewCreateOrUpdateResource,
+			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationTimeout:    job_ctrl.AsyncCreateOrUpdateJobTimeout,
+		},
+		Patch: builder.Operation[datamodel.JobResource]{
+			UpdateFilters: []apictrl.UpdateFilter[datamodel.JobResource]{
+				rp_frontend.PrepareRadiusResource[*datamodel.JobResource],
+				job_ctrl.ValidateAndMutateRequest,
+			},
+			AsyncJobController:       backend_ctrl.NewCreateOrUpdateResource,
+			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationTimeout:    job_ctrl.AsyncCreateOrUpdateJobTimeout,
+		},
+		Delete: builder.Operation[datamodel.JobResource]{
+			AsyncJobController:       backend_ctrl.NewDeleteResource,
+			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+		},
+	})
+
 	_ = ns.AddResource("gateways", &builder.ResourceOption[*datamodel.Gateway, datamodel.Gateway]{
 		RequestConverter:  converter.GatewayDataModelFromVersioned,
 		ResponseConverter: converter.GatewayDataModelToVersioned,
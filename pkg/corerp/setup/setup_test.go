@@ -39,6 +39,7 @@ import (
 	env_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/environments"
 	gtwy_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/gateways"
 	hrt_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/httproutes"
+	job_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/jobs"
 	secret_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/secretstores"
 	vol_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/volumes"
 )
@@ -92,6 +93,30 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: ctr_ctrl.ResourceTypeName, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.core/containers/ctr0",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: job_ctrl.ResourceTypeName, Method: v1.OperationPlaneScopeList},
+		Path:          "/providers/applications.core/jobs",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: job_ctrl.ResourceTypeName, Method: v1.OperationList},
+		Path:          "/resourcegroups/testrg/providers/applications.core/jobs",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: job_ctrl.ResourceTypeName, Method: v1.OperationGet},
+		Path:          "/resourcegroups/testrg/providers/applications.core/jobs/job0",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: job_ctrl.ResourceTypeName, Method: v1.OperationPut},
+		Path:          "/resourcegroups/testrg/providers/applications.core/jobs/job0",
+		Method:        http.MethodPut,
+	}, {
+		OperationType: v1.OperationType{Type: job_ctrl.ResourceTypeName, Method: v1.OperationPatch},
+		Path:          "/resourcegroups/testrg/providers/applications.core/jobs/job0",
+		Method:        http.MethodPatch,
+	}, {
+		OperationType: v1.OperationType{Type: job_ctrl.ResourceTypeName, Method: v1.OperationDelete},
+		Path:          "/resourcegroups/testrg/providers/applications.core/jobs/job0",
+		Method:        http.MethodDelete,
 	}, {
 		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: v1.OperationPlaneScopeList},
 		Path:          "/providers/applications.core/environments",
@@ -120,6 +145,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: "ACTIONGETMETADATA"},
 		Path:          "/resourcegroups/testrg/providers/applications.core/environments/env0/getmetadata",
 		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: "ACTIONRESTORE"},
+		Path:          "/resourcegroups/testrg/providers/applications.core/environments/env0/restore",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: gtwy_ctrl.ResourceTypeName, Method: v1.OperationPlaneScopeList},
 		Path:          "/providers/applications.core/gateways",
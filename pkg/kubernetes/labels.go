@@ -19,6 +19,8 @@ package kubernetes
 import (
 	"fmt"
 	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // Commonly-used and Radius-Specific labels for Kubernetes
@@ -45,6 +47,10 @@ const (
 
 	// AnnotationIdentityType is the annotation for supported identity.
 	AnnotationIdentityType = "radapp.io/identity-type"
+
+	// AnnotationJobCompletionTimeout is the annotation for the duration in seconds the deployment of a job waits for
+	// the job to complete. The deployment doesn't wait for jobs without it.
+	AnnotationJobCompletionTimeout = "radapp.io/job-completion-timeout-seconds"
 )
 
 // NOTE: the difference between descriptive labels and selector labels
@@ -76,6 +82,23 @@ func MakeDescriptiveLabels(application string, resource string, resourceType str
 	}
 }
 
+// MakeTagLabels returns the tags of a Radius resource that can be applied as labels to the Kubernetes objects
+// created for the resource. Tags whose name or value is not a valid Kubernetes label, and tags that use the
+// Radius reserved prefix, are skipped.
+func MakeTagLabels(tags map[string]string) map[string]string {
+	labels := map[string]string{}
+	for name, value := range tags {
+		if strings.HasPrefix(name, RadiusDevPrefix) {
+			continue
+		}
+		if len(validation.IsQualifiedName(name)) > 0 || len(validation.IsValidLabelValue(value)) > 0 {
+			continue
+		}
+		labels[name] = value
+	}
+	return labels
+}
+
 // MakeDescriptiveLabels returns a map of the descriptive labels for a Kubernetes Dapr resource associated with a Radius resource.
 // The descriptive labels are a superset of the selector labels.
 func MakeDescriptiveDaprLabels(application string, resource string, resourceType string) map[string]any {
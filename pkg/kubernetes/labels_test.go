@@ -149,3 +149,22 @@ func TestNormalizeResourceNameDapr(t *testing.T) {
 		})
 	}
 }
+
+func TestMakeTagLabels(t *testing.T) {
+	tags := map[string]string{
+		"team":                      "payments",
+		"app.kubernetes.io/part-of": "shop",
+		"empty":                     "",
+		"radapp.io/application":     "other-app",
+		"invalid key":               "value",
+		"owner":                     "invalid value!",
+	}
+
+	expected := map[string]string{
+		"team":                      "payments",
+		"app.kubernetes.io/part-of": "shop",
+		"empty":                     "",
+	}
+	require.Equal(t, expected, MakeTagLabels(tags))
+	require.Empty(t, MakeTagLabels(nil))
+}
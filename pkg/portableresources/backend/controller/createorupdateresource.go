@@ -120,7 +120,7 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 		logger.Info("The recipe was executed in simulation mode. No resources were deployed.")
 	} else {
 		// Now we're ready to process the resource. This will handle the updates to any user-visible state.
-		err = c.processor.Process(ctx, data, processors.Options{RecipeOutput: recipeOutput, RuntimeConfiguration: config.Runtime})
+		err = c.processor.Process(ctx, data, processors.Options{RecipeOutput: recipeOutput, RuntimeConfiguration: config.Runtime, ExtenderKinds: config.ExtenderKinds})
 		if err != nil {
 			return ctrl.Result{}, err
 		}
@@ -29,6 +29,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes/util"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
 )
 
 // DeleteResource is the async operation controller to delete a portable resource.
@@ -56,7 +57,8 @@ func NewDeleteResource[P interface {
 }
 
 // Run retrieves a resource from storage, parses the resource ID, gets the data model, deletes the output
-// resources, and deletes the resource from storage. It returns an error if any of these steps fail.
+// resources, and deletes the resource from storage. Soft-deleted resources are kept in storage without their output
+// resources. It returns an error if any of these steps fail.
 func (c *DeleteResource[P, T]) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
 	obj, err := c.StorageClient().Get(ctx, request.ResourceID)
 	if err != nil {
@@ -114,6 +116,13 @@ func (c *DeleteResource[P, T]) Run(ctx context.Context, request *ctrl.Request) (
 		return ctrl.Result{}, err
 	}
 
+	// Soft-deleted resources are kept so that they can be restored, only their output resources are torn down.
+	if data.GetBaseResource().DeletedTime != nil {
+		data.ResourceMetadata().Status.OutputResources = nil
+		err = c.StorageClient().Save(ctx, &store.Object{Metadata: store.Metadata{ID: request.ResourceID}, Data: data}, store.WithETag(obj.ETag))
+		return ctrl.Result{}, err
+	}
+
 	err = c.StorageClient().Delete(ctx, request.ResourceID)
 	if err != nil {
 		return ctrl.Result{}, err
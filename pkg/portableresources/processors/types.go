@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 )
@@ -46,6 +47,9 @@ type Options struct {
 
 	// RecipeOutput represents the output of executing a recipe (may be nil).
 	RecipeOutput *recipes.RecipeOutput
+
+	// ExtenderKinds represents the extender kinds registered with the environment, keyed by kind name.
+	ExtenderKinds map[string]datamodel.ExtenderKindProperties
 }
 
 // ValidationError represents a user-facing validation message reported by the processor.
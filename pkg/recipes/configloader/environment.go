@@ -115,9 +115,32 @@ func getConfiguration(environment *v20231001preview.EnvironmentResource, applica
 		config.Simulated = true
 	}
 
+	if environment.Properties.ExtenderKinds != nil {
+		config.ExtenderKinds = map[string]datamodel.ExtenderKindProperties{}
+		for name, kind := range environment.Properties.ExtenderKinds {
+			if kind == nil {
+				config.ExtenderKinds[name] = datamodel.ExtenderKindProperties{}
+				continue
+			}
+			config.ExtenderKinds[name] = datamodel.ExtenderKindProperties{
+				Schema:          kind.Schema,
+				RequiredOutputs: toStringSlice(kind.RequiredOutputs),
+				RequiredSecrets: toStringSlice(kind.RequiredSecrets),
+			}
+		}
+	}
+
 	return &config, nil
 }
 
+func toStringSlice(values []*string) []string {
+	var result []string
+	for _, v := range values {
+		result = append(result, to.String(v))
+	}
+	return result
+}
+
 // LoadRecipe fetches the recipe information from the environment. It returns an error if the environment cannot be fetched.
 func (e *environmentLoader) LoadRecipe(ctx context.Context, recipe *recipes.ResourceMetadata) (*recipes.EnvironmentDefinition, error) {
 	environment, err := util.FetchEnvironment(ctx, recipe.EnvironmentID, e.ArmClientOptions)
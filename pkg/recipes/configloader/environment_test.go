@@ -140,6 +140,42 @@ func TestGetConfiguration(t *testing.T) {
 				Providers: createAWSProvider(),
 			},
 		},
+		{
+			name: "extender kinds with env resource",
+			envResource: &model.EnvironmentResource{
+				Properties: &model.EnvironmentProperties{
+					Compute: &model.KubernetesCompute{
+						Kind:       to.Ptr(kind),
+						Namespace:  to.Ptr(envNamespace),
+						ResourceID: to.Ptr(envResourceId),
+					},
+					ExtenderKinds: map[string]*model.ExtenderKindProperties{
+						"postgres": {
+							Schema:          map[string]any{"type": "object"},
+							RequiredOutputs: []*string{to.Ptr("host"), to.Ptr("port")},
+							RequiredSecrets: []*string{to.Ptr("password")},
+						},
+					},
+				},
+			},
+			appResource: nil,
+			expectedConfig: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            envNamespace,
+						EnvironmentNamespace: envNamespace,
+					},
+				},
+				Providers: datamodel.Providers{},
+				ExtenderKinds: map[string]datamodel.ExtenderKindProperties{
+					"postgres": {
+						Schema:          map[string]any{"type": "object"},
+						RequiredOutputs: []string{"host", "port"},
+						RequiredSecrets: []string{"password"},
+					},
+				},
+			},
+		},
 		{
 			name: "invalid app resource",
 			envResource: &model.EnvironmentResource{
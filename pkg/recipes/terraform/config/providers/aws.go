@@ -25,7 +25,9 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/sdk"
+	ucp_aws "github.com/radius-project/radius/pkg/ucp/aws"
 	"github.com/radius-project/radius/pkg/ucp/credentials"
+	ucp_datamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_aws "github.com/radius-project/radius/pkg/ucp/resources/aws"
 	"github.com/radius-project/radius/pkg/ucp/secret"
@@ -38,9 +40,18 @@ import (
 const (
 	AWSProviderName = "aws"
 
-	awsRegionParam    = "region"
-	awsAccessKeyParam = "access_key"
-	awsSecretKeyParam = "secret_key"
+	awsRegionParam                    = "region"
+	awsAccessKeyParam                 = "access_key"
+	awsSecretKeyParam                 = "secret_key"
+	awsAssumeRoleParam                = "assume_role"
+	awsAssumeRoleWithWebIdentityParam = "assume_role_with_web_identity"
+	awsRoleARNParam                   = "role_arn"
+	awsExternalIDParam                = "external_id"
+	awsSessionNameParam               = "session_name"
+	awsWebIdentityTokenFileParam      = "web_identity_token_file"
+
+	// awsWebIdentityTokenFileEnv is the environment variable set by EKS Pod Identity webhook for IRSA.
+	awsWebIdentityTokenFileEnv = "AWS_WEB_IDENTITY_TOKEN_FILE"
 )
 
 var _ Provider = (*awsProvider)(nil)
@@ -75,7 +86,17 @@ func (p *awsProvider) BuildConfig(ctx context.Context, envConfig *recipes.Config
 		return nil, err
 	}
 
-	return p.generateProviderConfigMap(credentials, region), nil
+	config := p.generateProviderConfigMap(credentials, region)
+
+	// IRSA and AssumeRole credentials are configured as the roles for Terraform to assume, so that Terraform
+	// obtains and refreshes the session credentials itself.
+	if credentials != nil && credentials.GetKind() != ucp_datamodel.AWSCredentialKind {
+		if err := setRoleCredentials(ctx, credentialsProvider, config, credentials, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	return config, nil
 }
 
 // parseScope parses an AWS provider scope and returns the associated region
@@ -118,7 +139,7 @@ func fetchAWSCredentials(ctx context.Context, awsCredentialsProvider credentials
 		return nil, err
 	}
 
-	if credentials == nil || credentials.AccessKeyID == "" || credentials.SecretAccessKey == "" {
+	if credentials == nil || !isRegistered(credentials) {
 		logger.Info("AWS credentials are not registered, skipping credentials configuration.")
 		return nil, nil
 	}
@@ -139,3 +160,79 @@ func (p *awsProvider) generateProviderConfigMap(credentials *credentials.AWSCred
 
 	return config
 }
+
+// isRegistered returns true if the credential has the values required by its kind.
+func isRegistered(credentials *credentials.AWSCredential) bool {
+	if credentials.GetKind() == ucp_datamodel.AWSCredentialKind {
+		return credentials.AccessKeyID != "" && credentials.SecretAccessKey != ""
+	}
+
+	return credentials.RoleARN != ""
+}
+
+// setRoleCredentials sets the provider configuration for Terraform to assume the role of an IRSA or AssumeRole
+// credential. The source credentials of AssumeRole credentials are resolved first, so that the roles are assumed
+// in order starting from the source credential.
+func setRoleCredentials(ctx context.Context, awsCredentialsProvider credentials.CredentialProvider[credentials.AWSCredential], config map[string]any, cred *credentials.AWSCredential, depth int) error {
+	if depth > ucp_aws.MaxSourceCredentialDepth {
+		return fmt.Errorf("AWS credentials exceed the maximum source credential depth of %d", ucp_aws.MaxSourceCredentialDepth)
+	}
+
+	switch cred.GetKind() {
+	case ucp_datamodel.AWSCredentialKind:
+		if cred.AccessKeyID == "" || cred.SecretAccessKey == "" {
+			return errors.New("invalid AWS access key credentials")
+		}
+		config[awsAccessKeyParam] = cred.AccessKeyID
+		config[awsSecretKeyParam] = cred.SecretAccessKey
+
+	case ucp_datamodel.AWSIRSACredentialKind:
+		role := map[string]any{
+			awsRoleARNParam: cred.RoleARN,
+		}
+
+		tokenFile, err := credentials.ResolveTokenFile(cred.TokenFile, awsWebIdentityTokenFileEnv)
+		if err != nil {
+			return err
+		}
+		if tokenFile != "" {
+			role[awsWebIdentityTokenFileParam] = tokenFile
+		}
+		if cred.SessionName != "" {
+			role[awsSessionNameParam] = cred.SessionName
+		}
+		config[awsAssumeRoleWithWebIdentityParam] = role
+
+	case ucp_datamodel.AWSAssumeRoleCredentialKind:
+		// Without a source credential, Terraform assumes the role using the ambient credentials of the recipe engine.
+		if cred.SourceCredential != "" {
+			source, err := awsCredentialsProvider.Fetch(ctx, credentials.AWSPublic, cred.SourceCredential)
+			if err != nil {
+				return err
+			}
+
+			if err := setRoleCredentials(ctx, awsCredentialsProvider, config, source, depth+1); err != nil {
+				return err
+			}
+		}
+
+		role := map[string]any{
+			awsRoleARNParam: cred.RoleARN,
+		}
+		if cred.ExternalID != "" {
+			role[awsExternalIDParam] = cred.ExternalID
+		}
+		if cred.SessionName != "" {
+			role[awsSessionNameParam] = cred.SessionName
+		}
+
+		// Terraform assumes the roles in the order of the assume_role blocks.
+		roles, _ := config[awsAssumeRoleParam].([]map[string]any)
+		config[awsAssumeRoleParam] = append(roles, role)
+
+	default:
+		return fmt.Errorf("unsupported AWS credential kind %q", cred.GetKind())
+	}
+
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/sdk"
 	ucp_credentials "github.com/radius-project/radius/pkg/ucp/credentials"
+	ucp_datamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/secret"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
@@ -209,6 +210,20 @@ func TestAWSProvider_FetchCredentials(t *testing.T) {
 			expectedCreds: nil,
 			expectedErr:   false,
 		},
+		{
+			desc: "assume role credentials",
+			credentialsProvider: &mockAWSCredentialsProvider{
+				&ucp_credentials.AWSCredential{
+					Kind:    ucp_datamodel.AWSAssumeRoleCredentialKind,
+					RoleARN: "arn:aws:iam::000000000000:role/radius",
+				},
+			},
+			expectedCreds: &ucp_credentials.AWSCredential{
+				Kind:    ucp_datamodel.AWSAssumeRoleCredentialKind,
+				RoleARN: "arn:aws:iam::000000000000:role/radius",
+			},
+			expectedErr: false,
+		},
 		{
 			desc: "fetch credential error",
 			credentialsProvider: &mockAWSCredentialsProvider{
@@ -291,3 +306,172 @@ func TestAWSProvider_generateProviderConfigMap(t *testing.T) {
 		})
 	}
 }
+
+type namedAWSCredentialsProvider map[string]*ucp_credentials.AWSCredential
+
+func (p namedAWSCredentialsProvider) Fetch(ctx context.Context, planeName, name string) (*ucp_credentials.AWSCredential, error) {
+	c, ok := p[name]
+	if !ok {
+		return nil, &secret.ErrNotFound{}
+	}
+	return c, nil
+}
+
+func TestAWSProvider_setRoleCredentials(t *testing.T) {
+	t.Setenv(awsWebIdentityTokenFileEnv, "/var/run/secrets/token")
+	t.Setenv(ucp_credentials.AllowedTokenFilesEnv, "")
+
+	roleARN := "arn:aws:iam::000000000000:role/radius"
+	sourceRoleARN := "arn:aws:iam::000000000000:role/source"
+
+	tests := []struct {
+		desc        string
+		credential  *ucp_credentials.AWSCredential
+		provider    namedAWSCredentialsProvider
+		expected    map[string]any
+		expectedErr string
+	}{
+		{
+			desc: "irsa",
+			credential: &ucp_credentials.AWSCredential{
+				Kind:        ucp_datamodel.AWSIRSACredentialKind,
+				RoleARN:     roleARN,
+				TokenFile:   "/var/run/secrets/token",
+				SessionName: "radius",
+			},
+			expected: map[string]any{
+				awsRegionParam: testRegion,
+				awsAssumeRoleWithWebIdentityParam: map[string]any{
+					awsRoleARNParam:              roleARN,
+					awsWebIdentityTokenFileParam: "/var/run/secrets/token",
+					awsSessionNameParam:          "radius",
+				},
+			},
+		},
+		{
+			desc: "irsa with the token file of the webhook",
+			credential: &ucp_credentials.AWSCredential{
+				Kind:    ucp_datamodel.AWSIRSACredentialKind,
+				RoleARN: roleARN,
+			},
+			expected: map[string]any{
+				awsRegionParam: testRegion,
+				awsAssumeRoleWithWebIdentityParam: map[string]any{
+					awsRoleARNParam:              roleARN,
+					awsWebIdentityTokenFileParam: "/var/run/secrets/token",
+				},
+			},
+		},
+		{
+			desc: "irsa with a token file that is not allowed",
+			credential: &ucp_credentials.AWSCredential{
+				Kind:      ucp_datamodel.AWSIRSACredentialKind,
+				RoleARN:   roleARN,
+				TokenFile: "/etc/shadow",
+			},
+			expectedErr: "the token file \"/etc/shadow\" is not allowed",
+		},
+		{
+			desc: "assume role without source credential",
+			credential: &ucp_credentials.AWSCredential{
+				Kind:       ucp_datamodel.AWSAssumeRoleCredentialKind,
+				RoleARN:    roleARN,
+				ExternalID: "external",
+			},
+			expected: map[string]any{
+				awsRegionParam: testRegion,
+				awsAssumeRoleParam: []map[string]any{
+					{awsRoleARNParam: roleARN, awsExternalIDParam: "external"},
+				},
+			},
+		},
+		{
+			desc: "assume role with access key source credential",
+			credential: &ucp_credentials.AWSCredential{
+				Kind:             ucp_datamodel.AWSAssumeRoleCredentialKind,
+				RoleARN:          roleARN,
+				SourceCredential: "source",
+			},
+			provider: namedAWSCredentialsProvider{
+				"source": &testAWSCredentials,
+			},
+			expected: map[string]any{
+				awsRegionParam:     testRegion,
+				awsAccessKeyParam:  testAWSCredentials.AccessKeyID,
+				awsSecretKeyParam:  testAWSCredentials.SecretAccessKey,
+				awsAssumeRoleParam: []map[string]any{{awsRoleARNParam: roleARN}},
+			},
+		},
+		{
+			desc: "chained assume role credentials",
+			credential: &ucp_credentials.AWSCredential{
+				Kind:             ucp_datamodel.AWSAssumeRoleCredentialKind,
+				RoleARN:          roleARN,
+				SourceCredential: "source",
+			},
+			provider: namedAWSCredentialsProvider{
+				"source": &ucp_credentials.AWSCredential{
+					Kind:             ucp_datamodel.AWSAssumeRoleCredentialKind,
+					RoleARN:          sourceRoleARN,
+					SourceCredential: "irsa",
+				},
+				"irsa": &ucp_credentials.AWSCredential{
+					Kind:      ucp_datamodel.AWSIRSACredentialKind,
+					RoleARN:   sourceRoleARN,
+					TokenFile: "/var/run/secrets/token",
+				},
+			},
+			expected: map[string]any{
+				awsRegionParam: testRegion,
+				awsAssumeRoleWithWebIdentityParam: map[string]any{
+					awsRoleARNParam:              sourceRoleARN,
+					awsWebIdentityTokenFileParam: "/var/run/secrets/token",
+				},
+				awsAssumeRoleParam: []map[string]any{
+					{awsRoleARNParam: sourceRoleARN},
+					{awsRoleARNParam: roleARN},
+				},
+			},
+		},
+		{
+			desc: "source credential cycle",
+			credential: &ucp_credentials.AWSCredential{
+				Kind:             ucp_datamodel.AWSAssumeRoleCredentialKind,
+				RoleARN:          roleARN,
+				SourceCredential: "default",
+			},
+			provider: namedAWSCredentialsProvider{
+				"default": &ucp_credentials.AWSCredential{
+					Kind:             ucp_datamodel.AWSAssumeRoleCredentialKind,
+					RoleARN:          roleARN,
+					SourceCredential: "default",
+				},
+			},
+			expectedErr: "AWS credentials exceed the maximum source credential depth of 5",
+		},
+		{
+			desc: "source credential not found",
+			credential: &ucp_credentials.AWSCredential{
+				Kind:             ucp_datamodel.AWSAssumeRoleCredentialKind,
+				RoleARN:          roleARN,
+				SourceCredential: "missing",
+			},
+			provider:    namedAWSCredentialsProvider{},
+			expectedErr: "the resource was not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			config := map[string]any{awsRegionParam: testRegion}
+			err := setRoleCredentials(testcontext.New(t), tt.provider, config, tt.credential, 0)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, config)
+		})
+	}
+}
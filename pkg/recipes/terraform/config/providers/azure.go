@@ -26,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/ucp/credentials"
+	ucp_datamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
 	"github.com/radius-project/radius/pkg/ucp/secret"
@@ -43,6 +44,13 @@ const (
 	azureClientIDParam     = "client_id"
 	azureClientSecretParam = "client_secret"
 	azureTenantIDParam     = "tenant_id"
+	azureUseOIDCParam      = "use_oidc"
+	azureOIDCTokenParam    = "oidc_token_file_path"
+	azureUseMSIParam       = "use_msi"
+
+	// azureFederatedTokenFileEnv is the environment variable set by the Azure workload identity webhook
+	// with the path of the projected service account token.
+	azureFederatedTokenFileEnv = "AZURE_FEDERATED_TOKEN_FILE"
 )
 
 var _ Provider = (*azureProvider)(nil)
@@ -83,7 +91,7 @@ func (p *azureProvider) BuildConfig(ctx context.Context, envConfig *recipes.Conf
 		return nil, err
 	}
 
-	return p.generateProviderConfigMap(config, credentials, subscriptionID), nil
+	return p.generateProviderConfigMap(config, credentials, subscriptionID)
 }
 
 // parseScope parses an Azure provider scope and returns the associated subscription id
@@ -119,14 +127,14 @@ func fetchAzureCredentials(ctx context.Context, azureCredentialsProvider credent
 	credentials, err := azureCredentialsProvider.Fetch(ctx, credentials.AzureCloud, "default")
 	if err != nil {
 		if errors.Is(err, &secret.ErrNotFound{}) {
-			logger.Info("AWS credentials are not registered, skipping credentials configuration.")
+			logger.Info("Azure credentials are not registered, skipping credentials configuration.")
 			return nil, nil
 		}
 
 		return nil, err
 	}
 
-	if credentials == nil || credentials.ClientID == "" || credentials.TenantID == "" || credentials.ClientSecret == "" {
+	if credentials == nil || !isAzureRegistered(credentials) {
 		logger.Info("Azure credentials are not registered, skipping credentials configuration.")
 		return nil, nil
 	}
@@ -134,16 +142,49 @@ func fetchAzureCredentials(ctx context.Context, azureCredentialsProvider credent
 	return credentials, nil
 }
 
-func (p *azureProvider) generateProviderConfigMap(configMap map[string]any, credentials *credentials.AzureCredential, subscriptionID string) map[string]any {
+func (p *azureProvider) generateProviderConfigMap(configMap map[string]any, cred *credentials.AzureCredential, subscriptionID string) (map[string]any, error) {
 	if subscriptionID != "" {
 		configMap[azureSubIDParam] = subscriptionID
 	}
 
-	if credentials != nil && credentials.ClientID != "" && credentials.TenantID != "" && credentials.ClientSecret != "" {
-		configMap[azureClientIDParam] = credentials.ClientID
-		configMap[azureClientSecretParam] = credentials.ClientSecret
-		configMap[azureTenantIDParam] = credentials.TenantID
+	if cred == nil || !isAzureRegistered(cred) {
+		return configMap, nil
 	}
 
-	return configMap
+	switch cred.GetKind() {
+	case ucp_datamodel.AzureWorkloadIdentityCredentialKind:
+		configMap[azureUseOIDCParam] = true
+		configMap[azureClientIDParam] = cred.ClientID
+		configMap[azureTenantIDParam] = cred.TenantID
+		tokenFile, err := credentials.ResolveTokenFile(cred.TokenFile, azureFederatedTokenFileEnv)
+		if err != nil {
+			return nil, err
+		}
+		if tokenFile != "" {
+			configMap[azureOIDCTokenParam] = tokenFile
+		}
+	case ucp_datamodel.AzureManagedIdentityCredentialKind:
+		configMap[azureUseMSIParam] = true
+		if cred.ClientID != "" {
+			configMap[azureClientIDParam] = cred.ClientID
+		}
+	default:
+		configMap[azureClientIDParam] = cred.ClientID
+		configMap[azureClientSecretParam] = cred.ClientSecret
+		configMap[azureTenantIDParam] = cred.TenantID
+	}
+
+	return configMap, nil
+}
+
+// isAzureRegistered returns true if the credential has the values required by its kind.
+func isAzureRegistered(credentials *credentials.AzureCredential) bool {
+	switch credentials.GetKind() {
+	case ucp_datamodel.AzureWorkloadIdentityCredentialKind:
+		return credentials.ClientID != "" && credentials.TenantID != ""
+	case ucp_datamodel.AzureManagedIdentityCredentialKind:
+		return true
+	default:
+		return credentials.ClientID != "" && credentials.TenantID != "" && credentials.ClientSecret != ""
+	}
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/sdk"
 	ucp_credentials "github.com/radius-project/radius/pkg/ucp/credentials"
+	ucp_datamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/secret"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
@@ -243,11 +244,15 @@ func TestAzureProvider_FetchCredentials(t *testing.T) {
 }
 
 func TestAzureProvider_generateProviderConfigMap(t *testing.T) {
+	t.Setenv(azureFederatedTokenFileEnv, "/var/run/secrets/azure/tokens/azure-identity-token")
+	t.Setenv(ucp_credentials.AllowedTokenFilesEnv, "")
+
 	tests := []struct {
 		desc           string
 		subscription   string
 		credentials    ucp_credentials.AzureCredential
 		expectedConfig map[string]any
+		expectedErr    string
 	}{
 		{
 			desc:         "valid config",
@@ -279,6 +284,64 @@ func TestAzureProvider_generateProviderConfigMap(t *testing.T) {
 				azureSubIDParam:    testSubscription,
 			},
 		},
+		{
+			desc:         "workload identity credentials",
+			subscription: testSubscription,
+			credentials: ucp_credentials.AzureCredential{
+				Kind:      ucp_datamodel.AzureWorkloadIdentityCredentialKind,
+				TenantID:  testAzureCredentials.TenantID,
+				ClientID:  testAzureCredentials.ClientID,
+				TokenFile: "/var/run/secrets/azure/tokens/azure-identity-token",
+			},
+			expectedConfig: map[string]any{
+				azureFeaturesParam:  map[string]any{},
+				azureSubIDParam:     testSubscription,
+				azureTenantIDParam:  testAzureCredentials.TenantID,
+				azureClientIDParam:  testAzureCredentials.ClientID,
+				azureUseOIDCParam:   true,
+				azureOIDCTokenParam: "/var/run/secrets/azure/tokens/azure-identity-token",
+			},
+		},
+		{
+			desc:         "workload identity credentials with the token file of the webhook",
+			subscription: testSubscription,
+			credentials: ucp_credentials.AzureCredential{
+				Kind:     ucp_datamodel.AzureWorkloadIdentityCredentialKind,
+				TenantID: testAzureCredentials.TenantID,
+				ClientID: testAzureCredentials.ClientID,
+			},
+			expectedConfig: map[string]any{
+				azureFeaturesParam:  map[string]any{},
+				azureSubIDParam:     testSubscription,
+				azureTenantIDParam:  testAzureCredentials.TenantID,
+				azureClientIDParam:  testAzureCredentials.ClientID,
+				azureUseOIDCParam:   true,
+				azureOIDCTokenParam: "/var/run/secrets/azure/tokens/azure-identity-token",
+			},
+		},
+		{
+			desc:         "workload identity credentials with a token file that is not allowed",
+			subscription: testSubscription,
+			credentials: ucp_credentials.AzureCredential{
+				Kind:      ucp_datamodel.AzureWorkloadIdentityCredentialKind,
+				TenantID:  testAzureCredentials.TenantID,
+				ClientID:  testAzureCredentials.ClientID,
+				TokenFile: "/etc/shadow",
+			},
+			expectedErr: "the token file \"/etc/shadow\" is not allowed",
+		},
+		{
+			desc:         "managed identity credentials",
+			subscription: testSubscription,
+			credentials: ucp_credentials.AzureCredential{
+				Kind: ucp_datamodel.AzureManagedIdentityCredentialKind,
+			},
+			expectedConfig: map[string]any{
+				azureFeaturesParam: map[string]any{},
+				azureSubIDParam:    testSubscription,
+				azureUseMSIParam:   true,
+			},
+		},
 		{
 			desc: "invalid credentials",
 			credentials: ucp_credentials.AzureCredential{
@@ -298,13 +361,22 @@ func TestAzureProvider_generateProviderConfigMap(t *testing.T) {
 			azConfig := map[string]any{
 				azureFeaturesParam: map[string]any{},
 			}
-			config := p.generateProviderConfigMap(azConfig, &tt.credentials, tt.subscription)
+			config, err := p.generateProviderConfigMap(azConfig, &tt.credentials, tt.subscription)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
 			require.Equal(t, len(tt.expectedConfig), len(config))
 			require.Equal(t, tt.expectedConfig[azureFeaturesParam], config[azureFeaturesParam])
 			require.Equal(t, tt.expectedConfig[azureSubIDParam], config[azureSubIDParam])
 			require.Equal(t, tt.expectedConfig[azureClientIDParam], config[azureClientIDParam])
 			require.Equal(t, tt.expectedConfig[azureClientSecretParam], config[azureClientSecretParam])
 			require.Equal(t, tt.expectedConfig[azureTenantIDParam], config[azureTenantIDParam])
+			require.Equal(t, tt.expectedConfig[azureUseOIDCParam], config[azureUseOIDCParam])
+			require.Equal(t, tt.expectedConfig[azureOIDCTokenParam], config[azureOIDCTokenParam])
+			require.Equal(t, tt.expectedConfig[azureUseMSIParam], config[azureUseMSIParam])
 		})
 	}
 }
@@ -32,6 +32,8 @@ type Configuration struct {
 	Providers datamodel.Providers
 	// Simulated represents whether the environment is simulated or not.
 	Simulated bool
+	// ExtenderKinds represents the extender kinds registered with the environment, keyed by kind name.
+	ExtenderKinds map[string]datamodel.ExtenderKindProperties
 }
 
 // RuntimeConfiguration represents Kubernetes Runtime configuration for the environment.
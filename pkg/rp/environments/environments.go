@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// environments package finds the resources of Radius environments in the store of a resource provider.
+package environments
+
+import (
+	"context"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// ResourceType is the resource type of Radius environments.
+	ResourceType = "Applications.Core/environments"
+
+	// ApplicationResourceType is the resource type of Radius applications.
+	ApplicationResourceType = "Applications.Core/applications"
+)
+
+// Resources returns the stored applications of the environment with the given ID and the resources of the environment
+// and of its applications. The environment itself is not returned. The resources of an environment can be in any
+// resource group of its plane, so they are found by querying the plane for the resources that reference the
+// environment or one of its applications.
+func Resources(ctx context.Context, client store.StorageClient, environmentID string) ([]store.Object, error) {
+	id, err := resources.ParseResource(environmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	linked, err := queryLinked(ctx, client, id.PlaneScope(), "environment", environmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	children := []store.Object{}
+	seen := map[string]bool{strings.ToLower(environmentID): true}
+	add := func(items []store.Object) {
+		for _, item := range items {
+			if !seen[strings.ToLower(item.ID)] {
+				seen[strings.ToLower(item.ID)] = true
+				children = append(children, item)
+			}
+		}
+	}
+
+	add(linked)
+	for _, item := range linked {
+		if !isResourceType(item.ID, ApplicationResourceType) {
+			continue
+		}
+
+		applicationResources, err := queryLinked(ctx, client, id.PlaneScope(), "application", item.ID)
+		if err != nil {
+			return nil, err
+		}
+		add(applicationResources)
+	}
+
+	return children, nil
+}
+
+// queryLinked returns the resources of the plane whose given property references the resource with the given ID.
+func queryLinked(ctx context.Context, client store.StorageClient, planeScope string, property string, id string) ([]store.Object, error) {
+	result, err := client.Query(ctx, store.Query{
+		RootScope:      planeScope,
+		ScopeRecursive: true,
+		Filters:        []store.QueryFilter{{Field: "properties." + property, Value: id}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Items, nil
+}
+
+// LinkedScopes returns the application and environment stored in the properties of the object. Objects without
+// properties are treated as not belonging to an application or environment.
+func LinkedScopes(obj *store.Object) (application string, environment string, err error) {
+	data := map[string]any{}
+	if err := obj.As(&data); err != nil {
+		return "", "", err
+	}
+
+	properties, ok := data["properties"].(map[string]any)
+	if !ok {
+		return "", "", nil
+	}
+
+	application, _ = properties["application"].(string)
+	environment, _ = properties["environment"].(string)
+	return application, environment, nil
+}
+
+// isResourceType returns true if the object ID is a resource of the given type.
+func isResourceType(objectID string, resourceType string) bool {
+	parsed, err := resources.ParseResource(objectID)
+	return err == nil && strings.EqualFold(parsed.Type(), resourceType)
+}
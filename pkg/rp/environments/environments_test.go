@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnvironmentID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/test-env"
+	testApplicationID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/test-app"
+	testOtherAppID    = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/other-app"
+	testContainerID   = "/planes/radius/local/resourceGroups/other-rg/providers/Applications.Core/containers/test-container"
+	testRedisID       = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/test-redis"
+)
+
+func object(id string, data map[string]any) store.Object {
+	data["id"] = id
+	return store.Object{Metadata: store.Metadata{ID: id}, Data: data}
+}
+
+func Test_Resources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := store.NewMockStorageClient(ctrl)
+
+	objects := []store.Object{
+		object(testEnvironmentID, map[string]any{}),
+		object(testApplicationID, map[string]any{"properties": map[string]any{"environment": testEnvironmentID}}),
+		object(testOtherAppID, map[string]any{"properties": map[string]any{"environment": "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/other-env"}}),
+		object(testContainerID, map[string]any{"properties": map[string]any{"application": testApplicationID}}),
+		object(testRedisID, map[string]any{"properties": map[string]any{"application": testApplicationID, "environment": testEnvironmentID}}),
+	}
+
+	queries := []store.Query{}
+	client.EXPECT().
+		Query(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+			queries = append(queries, query)
+
+			result := &store.ObjectQueryResult{}
+			for _, obj := range objects {
+				match, err := obj.MatchesFilters(query.Filters)
+				require.NoError(t, err)
+				if match {
+					result.Items = append(result.Items, obj)
+				}
+			}
+			return result, nil
+		}).
+		Times(2)
+
+	items, err := Resources(context.Background(), client, testEnvironmentID)
+	require.NoError(t, err)
+
+	ids := []string{}
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	require.Equal(t, []string{testApplicationID, testRedisID, testContainerID}, ids)
+
+	require.Equal(t, []store.Query{
+		{
+			RootScope:      "/planes/radius/local",
+			ScopeRecursive: true,
+			Filters:        []store.QueryFilter{{Field: "properties.environment", Value: testEnvironmentID}},
+		},
+		{
+			RootScope:      "/planes/radius/local",
+			ScopeRecursive: true,
+			Filters:        []store.QueryFilter{{Field: "properties.application", Value: testApplicationID}},
+		},
+	}, queries)
+}
+
+func Test_Resources_InvalidID(t *testing.T) {
+	_, err := Resources(context.Background(), nil, "invalid")
+	require.Error(t, err)
+}
+
+func Test_LinkedScopes(t *testing.T) {
+	obj := object(testContainerID, map[string]any{"properties": map[string]any{"application": testApplicationID, "environment": testEnvironmentID}})
+	application, environment, err := LinkedScopes(&obj)
+	require.NoError(t, err)
+	require.Equal(t, testApplicationID, application)
+	require.Equal(t, testEnvironmentID, environment)
+
+	obj = object(testEnvironmentID, map[string]any{})
+	application, environment, err = LinkedScopes(&obj)
+	require.NoError(t, err)
+	require.Empty(t, application)
+	require.Empty(t, environment)
+}
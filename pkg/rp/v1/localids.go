@@ -65,6 +65,10 @@ const (
 	LocalIDUserAssignedManagedIdentity  = "UserAssignedManagedIdentity"
 	LocalIDFederatedIdentity            = "FederatedIdentity"
 	LocalIDRoleAssignmentPrefix         = "RoleAssignment"
+	LocalIDPodDisruptionBudget          = "PodDisruptionBudget"
+	LocalIDJob                          = "Job"
+	LocalIDCronJob                      = "CronJob"
+	LocalIDPersistentVolumeClaim        = "PersistentVolumeClaim"
 
 	// Obsolete when we remove AppModelV1
 	LocalIDRoleAssignmentKVKeys = "RoleAssignment-KVKeys"
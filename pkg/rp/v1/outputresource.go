@@ -40,6 +40,9 @@ type OutputResource struct {
 	// RadiusManaged determines whether Radius manages the lifecycle of the underlying resource.
 	RadiusManaged *bool `json:"radiusManaged"`
 
+	// RetainOnDelete determines whether the underlying resource is kept when it is deleted from the Radius resource.
+	RetainOnDelete bool `json:"retainOnDelete,omitempty"`
+
 	// CreateResource describes data that will be used to create a resource. This is never saved to the database.
 	CreateResource *Resource `json:"-"`
 }
@@ -65,10 +65,13 @@ func (s *APIService) Run(ctx context.Context) error {
 		Configure: func(r chi.Router) error {
 			for _, b := range s.handlerBuilder {
 				opts := apictrl.Options{
-					PathBase:      s.Options.Config.Server.PathBase,
-					DataProvider:  s.StorageProvider,
-					KubeClient:    s.KubeClient,
-					StatusManager: s.OperationStatusManager,
+					PathBase:       s.Options.Config.Server.PathBase,
+					DataProvider:   s.StorageProvider,
+					KubeClient:     s.KubeClient,
+					StatusManager:  s.OperationStatusManager,
+					EventPublisher: s.EventPublisher.AsPublisher(),
+					Quotas:         s.Options.Config.Server.Quotas,
+					SoftDelete:     s.Options.Config.Server.SoftDelete,
 				}
 
 				validator, err := builder.NewOpenAPIValidator(ctx, opts.PathBase, b.Namespace())
@@ -85,5 +88,10 @@ func (s *APIService) Run(ctx context.Context) error {
 		// set the arm cert manager for managing client certificate
 		ArmCertMgr:    s.ARMCertManager,
 		EnableArmAuth: s.Options.Config.Server.EnableArmAuth, // when enabled the client cert validation will be done
+		// set when the server is configured for OIDC bearer token authentication
+		OIDCValidator: s.OIDCValidator,
+		Authorizer:    s.Authorizer,
+		Auditor:       s.Auditor,
+		Limiter:       s.Limiter,
 	})
 }
@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"errors"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+// ConvertTo converts from the versioned AuditRecord resource to version-agnostic datamodel.
+func (src *AuditRecordResource) ConvertTo() (v1.DataModelInterface, error) {
+	return nil, errors.New("the AuditRecord type does not support conversion from versioned models")
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned AuditRecord resource.
+func (dst *AuditRecordResource) ConvertFrom(src v1.DataModelInterface) error {
+	record, ok := src.(*audit.Record)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(record.ID)
+	dst.Name = to.Ptr(record.Name)
+	dst.Type = to.Ptr(audit.ResourceType)
+	dst.Properties = &AuditRecordProperties{
+		Timestamp:     to.Ptr(record.Timestamp),
+		ResourceID:    to.Ptr(record.ResourceID),
+		Method:        to.Ptr(record.Method),
+		StatusCode:    to.Ptr(int32(record.StatusCode)),
+		Result:        to.Ptr(AuditResult(record.Result)),
+		Caller:        fromStringPtr(record.Caller),
+		OperationType: fromStringPtr(record.OperationType),
+		APIVersion:    fromStringPtr(record.APIVersion),
+		CorrelationID: fromStringPtr(record.CorrelationID),
+		OperationID:   fromStringPtr(record.OperationID),
+		EtagBefore:    fromStringPtr(record.ETagBefore),
+		EtagAfter:     fromStringPtr(record.ETagAfter),
+	}
+
+	return nil
+}
+
+// fromStringPtr returns nil for an empty string so that unset fields are omitted from the response.
+func fromStringPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return to.Ptr(s)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditRecordConvertDataModelToVersioned(t *testing.T) {
+	timestamp := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	record := &audit.Record{
+		ID:            "/planes/radius/local/providers/System.Resources/auditRecords/record-1",
+		Name:          "record-1",
+		Timestamp:     timestamp,
+		Caller:        "alice",
+		ResourceID:    "/planes/radius/local/resourceGroups/rg1",
+		Method:        "PUT",
+		OperationType: "SYSTEM.RESOURCES/RESOURCEGROUPS|PUT",
+		APIVersion:    Version,
+		StatusCode:    200,
+		Result:        audit.ResultSucceeded,
+		ETagAfter:     "etag",
+	}
+
+	versioned := &AuditRecordResource{}
+	err := versioned.ConvertFrom(record)
+	require.NoError(t, err)
+
+	expected := &AuditRecordResource{
+		ID:   to.Ptr("/planes/radius/local/providers/System.Resources/auditRecords/record-1"),
+		Name: to.Ptr("record-1"),
+		Type: to.Ptr(audit.ResourceType),
+		Properties: &AuditRecordProperties{
+			Timestamp:     to.Ptr(timestamp),
+			Caller:        to.Ptr("alice"),
+			ResourceID:    to.Ptr("/planes/radius/local/resourceGroups/rg1"),
+			Method:        to.Ptr("PUT"),
+			OperationType: to.Ptr("SYSTEM.RESOURCES/RESOURCEGROUPS|PUT"),
+			APIVersion:    to.Ptr(Version),
+			StatusCode:    to.Ptr(int32(200)),
+			Result:        to.Ptr(AuditResultSucceeded),
+			EtagAfter:     to.Ptr("etag"),
+		},
+	}
+	require.Equal(t, expected, versioned)
+}
+
+func TestAuditRecordConvertFromValidation(t *testing.T) {
+	versioned := &AuditRecordResource{}
+	err := versioned.ConvertFrom(&datamodel.ResourceGroup{})
+	require.ErrorIs(t, err, v1.ErrInvalidModelConversion)
+}
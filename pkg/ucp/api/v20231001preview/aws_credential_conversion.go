@@ -35,6 +35,7 @@ func (cr *AwsCredentialResource) ConvertTo() (v1.DataModelInterface, error) {
 	if err != nil {
 		return nil, err
 	}
+	prop.ExpiresAt = cr.Properties.GetAwsCredentialProperties().ExpiresAt
 
 	converted := &datamodel.AWSCredential{
 		BaseResource: v1.BaseResource{
@@ -62,23 +63,9 @@ func (cr *AwsCredentialResource) getDataModelCredentialProperties() (*datamodel.
 
 	switch p := cr.Properties.(type) {
 	case *AwsAccessKeyCredentialProperties:
-		var storage *datamodel.CredentialStorageProperties
-
-		switch c := p.Storage.(type) {
-		case *InternalCredentialStorageProperties:
-			if c.Kind == nil {
-				return nil, &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"}
-			}
-			storage = &datamodel.CredentialStorageProperties{
-				Kind: datamodel.InternalStorageKind,
-				InternalCredential: &datamodel.InternalCredentialStorageProperties{
-					SecretName: to.String(c.SecretName),
-				},
-			}
-		case nil:
-			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage", ValidValue: "not nil"}
-		default:
-			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage.kind", ValidValue: fmt.Sprintf("one of %q", PossibleCredentialStorageKindValues())}
+		storage, err := toCredentialStorageDataModel(p.Storage)
+		if err != nil {
+			return nil, err
 		}
 
 		return &datamodel.AWSCredentialResourceProperties{
@@ -89,6 +76,37 @@ func (cr *AwsCredentialResource) getDataModelCredentialProperties() (*datamodel.
 			},
 			Storage: storage,
 		}, nil
+	case *AwsIRSACredentialProperties:
+		storage, err := toCredentialStorageDataModel(p.Storage)
+		if err != nil {
+			return nil, err
+		}
+
+		return &datamodel.AWSCredentialResourceProperties{
+			Kind: datamodel.AWSIRSACredentialKind,
+			AWSCredential: &datamodel.AWSCredentialProperties{
+				RoleARN:     to.String(p.RoleARN),
+				TokenFile:   to.String(p.TokenFile),
+				SessionName: to.String(p.SessionName),
+			},
+			Storage: storage,
+		}, nil
+	case *AwsAssumeRoleCredentialProperties:
+		storage, err := toCredentialStorageDataModel(p.Storage)
+		if err != nil {
+			return nil, err
+		}
+
+		return &datamodel.AWSCredentialResourceProperties{
+			Kind: datamodel.AWSAssumeRoleCredentialKind,
+			AWSCredential: &datamodel.AWSCredentialProperties{
+				RoleARN:          to.String(p.RoleARN),
+				ExternalID:       to.String(p.ExternalID),
+				SessionName:      to.String(p.SessionName),
+				SourceCredential: to.String(p.SourceCredential),
+			},
+			Storage: storage,
+		}, nil
 	default:
 		return nil, v1.ErrInvalidModelConversion
 	}
@@ -107,16 +125,11 @@ func (dst *AwsCredentialResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Location = &dm.Location
 	dst.Tags = *to.StringMapPtr(dm.Tags)
 
-	var storage CredentialStoragePropertiesClassification
-	switch dm.Properties.Storage.Kind {
-	case datamodel.InternalStorageKind:
-		storage = &InternalCredentialStorageProperties{
-			Kind:       to.Ptr(CredentialStorageKindInternal),
-			SecretName: to.Ptr(dm.Properties.Storage.InternalCredential.SecretName),
-		}
-	default:
-		return v1.ErrInvalidModelConversion
+	storage, err := fromCredentialStorageDataModel(dm.Properties.Storage)
+	if err != nil {
+		return err
 	}
+	status := fromCredentialStatusDataModel(dm.Properties.Status)
 
 	// DO NOT convert any secret values to versioned model.
 	switch dm.Properties.Kind {
@@ -125,6 +138,29 @@ func (dst *AwsCredentialResource) ConvertFrom(src v1.DataModelInterface) error {
 			Kind:        to.Ptr(AWSCredentialKind(dm.Properties.Kind)),
 			AccessKeyID: to.Ptr(dm.Properties.AWSCredential.AccessKeyID),
 			Storage:     storage,
+			ExpiresAt:   dm.Properties.ExpiresAt,
+			Status:      status,
+		}
+	case datamodel.AWSIRSACredentialKind:
+		dst.Properties = &AwsIRSACredentialProperties{
+			Kind:        to.Ptr(AWSCredentialKind(dm.Properties.Kind)),
+			RoleARN:     to.Ptr(dm.Properties.AWSCredential.RoleARN),
+			TokenFile:   toStringPtr(dm.Properties.AWSCredential.TokenFile),
+			SessionName: toStringPtr(dm.Properties.AWSCredential.SessionName),
+			Storage:     storage,
+			ExpiresAt:   dm.Properties.ExpiresAt,
+			Status:      status,
+		}
+	case datamodel.AWSAssumeRoleCredentialKind:
+		dst.Properties = &AwsAssumeRoleCredentialProperties{
+			Kind:             to.Ptr(AWSCredentialKind(dm.Properties.Kind)),
+			RoleARN:          to.Ptr(dm.Properties.AWSCredential.RoleARN),
+			ExternalID:       toStringPtr(dm.Properties.AWSCredential.ExternalID),
+			SessionName:      toStringPtr(dm.Properties.AWSCredential.SessionName),
+			SourceCredential: toStringPtr(dm.Properties.AWSCredential.SourceCredential),
+			Storage:          storage,
+			ExpiresAt:        dm.Properties.ExpiresAt,
+			Status:           status,
 		}
 	default:
 		return v1.ErrInvalidModelConversion
@@ -132,3 +168,64 @@ func (dst *AwsCredentialResource) ConvertFrom(src v1.DataModelInterface) error {
 
 	return nil
 }
+
+// toCredentialStorageDataModel converts the versioned credential storage properties to the datamodel.
+func toCredentialStorageDataModel(storage CredentialStoragePropertiesClassification) (*datamodel.CredentialStorageProperties, error) {
+	switch c := storage.(type) {
+	case *InternalCredentialStorageProperties:
+		if c.Kind == nil {
+			return nil, &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"}
+		}
+		return &datamodel.CredentialStorageProperties{
+			Kind: datamodel.InternalStorageKind,
+			InternalCredential: &datamodel.InternalCredentialStorageProperties{
+				SecretName: to.String(c.SecretName),
+			},
+		}, nil
+	case nil:
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage", ValidValue: "not nil"}
+	default:
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage.kind", ValidValue: fmt.Sprintf("one of %q", PossibleCredentialStorageKindValues())}
+	}
+}
+
+// fromCredentialStorageDataModel converts the credential storage properties of the datamodel to the versioned model.
+func fromCredentialStorageDataModel(storage *datamodel.CredentialStorageProperties) (CredentialStoragePropertiesClassification, error) {
+	if storage == nil {
+		return nil, v1.ErrInvalidModelConversion
+	}
+
+	switch storage.Kind {
+	case datamodel.InternalStorageKind:
+		return &InternalCredentialStorageProperties{
+			Kind:       to.Ptr(CredentialStorageKindInternal),
+			SecretName: to.Ptr(storage.InternalCredential.SecretName),
+		}, nil
+	default:
+		return nil, v1.ErrInvalidModelConversion
+	}
+}
+
+// fromCredentialStatusDataModel converts the credential status of the datamodel to the versioned model.
+func fromCredentialStatusDataModel(status *datamodel.CredentialStatus) *CredentialStatus {
+	if status == nil {
+		return nil
+	}
+
+	converted := &CredentialStatus{
+		State:   to.Ptr(CredentialState(status.State)),
+		Message: toStringPtr(status.Message),
+	}
+	if !status.LastValidatedTime.IsZero() {
+		converted.LastValidatedTime = to.Ptr(status.LastValidatedTime)
+	}
+	return converted
+}
+
+// toStringPtr returns a pointer to the string, or nil if the string is empty.
+func toStringPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
@@ -65,6 +66,70 @@ func TestAWSCredentialConvertVersionedToDataModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "credentialresource-aws-irsa.json",
+			expected: &datamodel.AWSCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/aws/aws/providers/System.AWS/credentials/default",
+						Name:     "default",
+						Type:     "System.AWS/credentials",
+						Location: "west-us-2",
+						Tags: map[string]string{
+							"env": "dev",
+						},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: &datamodel.AWSCredentialResourceProperties{
+					Kind: datamodel.AWSIRSACredentialKind,
+					AWSCredential: &datamodel.AWSCredentialProperties{
+						RoleARN:     "arn:aws:iam::000000000000:role/radius",
+						TokenFile:   "/var/run/secrets/eks.amazonaws.com/serviceaccount/token",
+						SessionName: "radius",
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind:               datamodel.InternalStorageKind,
+						InternalCredential: &datamodel.InternalCredentialStorageProperties{},
+					},
+					ExpiresAt: to.Ptr(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			filename: "credentialresource-aws-assumerole.json",
+			expected: &datamodel.AWSCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/aws/aws/providers/System.AWS/credentials/default",
+						Name:     "default",
+						Type:     "System.AWS/credentials",
+						Location: "west-us-2",
+						Tags: map[string]string{
+							"env": "dev",
+						},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: &datamodel.AWSCredentialResourceProperties{
+					Kind: datamodel.AWSAssumeRoleCredentialKind,
+					AWSCredential: &datamodel.AWSCredentialProperties{
+						RoleARN:          "arn:aws:iam::000000000000:role/radius",
+						ExternalID:       "external",
+						SessionName:      "radius",
+						SourceCredential: "base",
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind:               datamodel.InternalStorageKind,
+						InternalCredential: &datamodel.InternalCredentialStorageProperties{},
+					},
+				},
+			},
+		},
 		{
 			filename: "credentialresource-other.json",
 			err:      v1.ErrInvalidModelConversion,
@@ -132,6 +197,51 @@ func TestAWSCredentialConvertDataModelToVersioned(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "credentialresourcedatamodel-aws-irsa.json",
+			expected: &AwsCredentialResource{
+				ID:       to.Ptr("/planes/aws/aws/providers/System.AWS/credentials/default"),
+				Name:     to.Ptr("default"),
+				Type:     to.Ptr("System.AWS/credentials"),
+				Location: to.Ptr("west-us-2"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &AwsIRSACredentialProperties{
+					Kind:        to.Ptr(AWSCredentialKindIRSA),
+					RoleARN:     to.Ptr("arn:aws:iam::000000000000:role/radius"),
+					TokenFile:   to.Ptr("/var/run/secrets/eks.amazonaws.com/serviceaccount/token"),
+					SessionName: to.Ptr("radius"),
+					Storage: &InternalCredentialStorageProperties{
+						Kind:       to.Ptr(CredentialStorageKindInternal),
+						SecretName: to.Ptr("aws-awscloud-default"),
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresourcedatamodel-aws-assumerole.json",
+			expected: &AwsCredentialResource{
+				ID:       to.Ptr("/planes/aws/aws/providers/System.AWS/credentials/default"),
+				Name:     to.Ptr("default"),
+				Type:     to.Ptr("System.AWS/credentials"),
+				Location: to.Ptr("west-us-2"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &AwsAssumeRoleCredentialProperties{
+					Kind:             to.Ptr(AWSCredentialKindAssumeRole),
+					RoleARN:          to.Ptr("arn:aws:iam::000000000000:role/radius"),
+					ExternalID:       to.Ptr("external"),
+					SessionName:      to.Ptr("radius"),
+					SourceCredential: to.Ptr("base"),
+					Storage: &InternalCredentialStorageProperties{
+						Kind:       to.Ptr(CredentialStorageKindInternal),
+						SecretName: to.Ptr("aws-awscloud-default"),
+					},
+				},
+			},
+		},
 		{
 			filename: "credentialresourcedatamodel-default.json",
 			err:      v1.ErrInvalidModelConversion,
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/to"
@@ -66,6 +67,67 @@ func TestAzureCredentialConvertVersionedToDataModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "credentialresource-azure-workloadidentity.json",
+			expected: &datamodel.AzureCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/azure/azurecloud/providers/System.Azure/credentials/default",
+						Name:     "default",
+						Type:     "System.Azure/credentials",
+						Location: "west-us-2",
+						Tags: map[string]string{
+							"env": "dev",
+						},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: &datamodel.AzureCredentialResourceProperties{
+					Kind: datamodel.AzureWorkloadIdentityCredentialKind,
+					AzureCredential: &datamodel.AzureCredentialProperties{
+						TenantID:  "00000000-0000-0000-0000-000000000000",
+						ClientID:  "00000000-0000-0000-0000-000000000000",
+						TokenFile: "/var/run/secrets/azure/tokens/azure-identity-token",
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind:               datamodel.InternalStorageKind,
+						InternalCredential: &datamodel.InternalCredentialStorageProperties{},
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresource-azure-managedidentity.json",
+			expected: &datamodel.AzureCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/azure/azurecloud/providers/System.Azure/credentials/default",
+						Name:     "default",
+						Type:     "System.Azure/credentials",
+						Location: "west-us-2",
+						Tags: map[string]string{
+							"env": "dev",
+						},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: &datamodel.AzureCredentialResourceProperties{
+					Kind: datamodel.AzureManagedIdentityCredentialKind,
+					AzureCredential: &datamodel.AzureCredentialProperties{
+						ClientID: "00000000-0000-0000-0000-000000000000",
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind:               datamodel.InternalStorageKind,
+						InternalCredential: &datamodel.InternalCredentialStorageProperties{},
+					},
+					ExpiresAt: to.Ptr(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
 		{
 			filename: "credentialresource-other.json",
 			err:      v1.ErrInvalidModelConversion,
@@ -134,6 +196,53 @@ func TestAzureCredentialConvertDataModelToVersioned(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "credentialresourcedatamodel-azure-workloadidentity.json",
+			expected: &AzureCredentialResource{
+				ID:       to.Ptr("/planes/azure/azurecloud/providers/System.Azure/credentials/default"),
+				Name:     to.Ptr("default"),
+				Type:     to.Ptr("System.Azure/credentials"),
+				Location: to.Ptr("west-us-2"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &AzureWorkloadIdentityProperties{
+					Kind:      to.Ptr(AzureCredentialKindWorkloadIdentity),
+					ClientID:  to.Ptr("00000000-0000-0000-0000-000000000000"),
+					TenantID:  to.Ptr("00000000-0000-0000-0000-000000000000"),
+					TokenFile: to.Ptr("/var/run/secrets/azure/tokens/azure-identity-token"),
+					Storage: &InternalCredentialStorageProperties{
+						Kind:       to.Ptr(CredentialStorageKindInternal),
+						SecretName: to.Ptr("azure-azurecloud-default"),
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresourcedatamodel-azure-managedidentity.json",
+			expected: &AzureCredentialResource{
+				ID:       to.Ptr("/planes/azure/azurecloud/providers/System.Azure/credentials/default"),
+				Name:     to.Ptr("default"),
+				Type:     to.Ptr("System.Azure/credentials"),
+				Location: to.Ptr("west-us-2"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &AzureManagedIdentityProperties{
+					Kind: to.Ptr(AzureCredentialKindManagedIdentity),
+					Storage: &InternalCredentialStorageProperties{
+						Kind:       to.Ptr(CredentialStorageKindInternal),
+						SecretName: to.Ptr("azure-azurecloud-default"),
+					},
+					ExpiresAt: to.Ptr(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+					Status: &CredentialStatus{
+						State:             to.Ptr(CredentialStateExpiring),
+						Message:           to.Ptr("the credential expires at 2024-06-01T00:00:00Z"),
+						LastValidatedTime: to.Ptr(time.Date(2024, 5, 28, 0, 0, 0, 0, time.UTC)),
+					},
+				},
+			},
+		},
 		{
 			filename: "credentialresourcedatamodel-default.json",
 			err:      v1.ErrInvalidModelConversion,
@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned EventSubscription resource to version-agnostic datamodel.
+func (src *EventSubscriptionResource) ConvertTo() (v1.DataModelInterface, error) {
+	// Note: SystemData conversion isn't required since this property comes ARM and datastore.
+	if src.Properties == nil {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"}
+	}
+
+	if to.String(src.Properties.URL) == "" {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.url", ValidValue: "not empty"}
+	}
+
+	converted := &datamodel.EventSubscription{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   to.String(src.ID),
+				Name: to.String(src.Name),
+				Type: to.String(src.Type),
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: datamodel.EventSubscriptionProperties{
+			URL:    to.String(src.Properties.URL),
+			Secret: to.String(src.Properties.Secret),
+		},
+	}
+
+	if src.Properties.Filter != nil {
+		converted.Properties.Filter = datamodel.EventSubscriptionFilter{
+			Scope:         to.String(src.Properties.Filter.Scope),
+			ResourceTypes: toStringSlice(src.Properties.Filter.ResourceTypes),
+			EventTypes:    toStringSlice(src.Properties.Filter.EventTypes),
+		}
+	}
+
+	return converted, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned EventSubscription resource. The secret of the
+// subscription is never returned.
+func (dst *EventSubscriptionResource) ConvertFrom(src v1.DataModelInterface) error {
+	subscription, ok := src.(*datamodel.EventSubscription)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(subscription.ID)
+	dst.Name = to.Ptr(subscription.Name)
+	dst.Type = to.Ptr(subscription.Type)
+	dst.Properties = &EventSubscriptionProperties{
+		URL:               to.Ptr(subscription.Properties.URL),
+		ProvisioningState: fromSyncProvisioningStateDataModel(subscription.InternalMetadata.AsyncProvisioningState),
+	}
+
+	filter := subscription.Properties.Filter
+	if filter.Scope != "" || len(filter.ResourceTypes) > 0 || len(filter.EventTypes) > 0 {
+		dst.Properties.Filter = &EventSubscriptionFilter{
+			Scope:         fromStringPtr(filter.Scope),
+			ResourceTypes: fromStringSlice(filter.ResourceTypes),
+			EventTypes:    fromStringSlice(filter.EventTypes),
+		}
+	}
+
+	if status := subscription.Properties.DeliveryStatus; status != nil {
+		dst.Properties.DeliveryStatus = &EventDeliveryStatus{
+			State:               to.Ptr(EventDeliveryState(status.State)),
+			Attempts:            to.Ptr(int32(status.Attempts)),
+			ConsecutiveFailures: to.Ptr(int32(status.ConsecutiveFailures)),
+			Error:               fromStringPtr(status.Error),
+			LastEventID:         fromStringPtr(status.LastEventID),
+			LastEventType:       fromStringPtr(status.LastEventType),
+		}
+		if !status.LastAttemptTime.IsZero() {
+			dst.Properties.DeliveryStatus.LastAttemptTime = to.Ptr(status.LastAttemptTime)
+		}
+	}
+
+	return nil
+}
+
+func toStringSlice(values []*string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != nil {
+			result = append(result, *v)
+		}
+	}
+	return result
+}
+
+func fromStringSlice(values []string) []*string {
+	if len(values) == 0 {
+		return nil
+	}
+	return to.SliceOfPtrs(values...)
+}
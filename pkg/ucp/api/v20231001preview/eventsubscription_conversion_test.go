@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventSubscriptionConvertVersionedToDataModel(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *datamodel.EventSubscription
+		err      error
+	}{
+		{
+			filename: "eventsubscriptionresource.json",
+			expected: &datamodel.EventSubscription{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/providers/System.Resources/eventSubscriptions/rg-hook",
+						Name: "rg-hook",
+						Type: datamodel.EventSubscriptionResourceType,
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.EventSubscriptionProperties{
+					URL:    "https://hooks.contoso.com/radius",
+					Secret: "s3cr3t",
+					Filter: datamodel.EventSubscriptionFilter{
+						Scope:         "/planes/radius/local/resourceGroups/test-rg",
+						ResourceTypes: []string{"Applications.Core/containers"},
+						EventTypes:    []string{"Radius.Resource.*"},
+					},
+				},
+			},
+		},
+		{
+			filename: "eventsubscriptionresource-missing-url.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.url", ValidValue: "not empty"},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			r := &EventSubscriptionResource{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+
+			dm, err := r.ConvertTo()
+
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, dm.(*datamodel.EventSubscription))
+			}
+		})
+	}
+}
+
+func TestEventSubscriptionConvertDataModelToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("eventsubscriptionresourcedatamodel.json")
+	r := &datamodel.EventSubscription{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	versioned := &EventSubscriptionResource{}
+	err = versioned.ConvertFrom(r)
+	require.NoError(t, err)
+
+	expected := &EventSubscriptionResource{
+		ID:   to.Ptr("/planes/radius/local/providers/System.Resources/eventSubscriptions/rg-hook"),
+		Name: to.Ptr("rg-hook"),
+		Type: to.Ptr(datamodel.EventSubscriptionResourceType),
+		Properties: &EventSubscriptionProperties{
+			URL: to.Ptr("https://hooks.contoso.com/radius"),
+			Filter: &EventSubscriptionFilter{
+				EventTypes: []*string{to.Ptr("Radius.Resource.Deleted")},
+			},
+			DeliveryStatus: &EventDeliveryStatus{
+				State:               to.Ptr(EventDeliveryStateFailed),
+				Attempts:            to.Ptr(int32(5)),
+				ConsecutiveFailures: to.Ptr(int32(2)),
+				Error:               to.Ptr("webhook responded with status code 503"),
+				LastAttemptTime:     to.Ptr(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				LastEventID:         to.Ptr("6d5f1c0e-1f6b-4c5a-9d0a-3f1f7c9b2e11"),
+				LastEventType:       to.Ptr("Radius.Resource.Deleted"),
+			},
+			ProvisioningState: to.Ptr(ProvisioningStateSucceeded),
+		},
+	}
+	require.Equal(t, expected, versioned)
+	require.Nil(t, versioned.Properties.Secret)
+}
+
+func TestEventSubscriptionConvertFromValidation(t *testing.T) {
+	versioned := &EventSubscriptionResource{}
+	err := versioned.ConvertFrom(&datamodel.ResourceGroup{})
+	require.ErrorIs(t, err, v1.ErrInvalidModelConversion)
+}
@@ -47,6 +47,9 @@ func Test_GenericResource_DataModelToVersioned(t *testing.T) {
 				ID:   to.Ptr("/planes/radius/local/resourcegroups/rg1/providers/Applications.Core/applications/test-app"),
 				Type: to.Ptr("Applications.Core/applications"),
 				Name: to.Ptr("test-app"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
 			},
 		},
 	}
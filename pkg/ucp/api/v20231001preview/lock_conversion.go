@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned Lock resource to version-agnostic datamodel.
+func (src *LockResource) ConvertTo() (v1.DataModelInterface, error) {
+	// Note: SystemData conversion isn't required since this property comes ARM and datastore.
+	if src.Properties == nil {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"}
+	}
+
+	level, err := toLockLevelDataModel(src.Properties.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	if to.String(src.Properties.Scope) == "" {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.scope", ValidValue: "not empty"}
+	}
+
+	converted := &datamodel.Lock{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   to.String(src.ID),
+				Name: to.String(src.Name),
+				Type: to.String(src.Type),
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: datamodel.LockProperties{
+			Level: level,
+			Scope: to.String(src.Properties.Scope),
+			Notes: to.String(src.Properties.Notes),
+		},
+	}
+
+	return converted, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned Lock resource.
+func (dst *LockResource) ConvertFrom(src v1.DataModelInterface) error {
+	lock, ok := src.(*datamodel.Lock)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(lock.ID)
+	dst.Name = to.Ptr(lock.Name)
+	dst.Type = to.Ptr(lock.Type)
+	dst.Properties = &LockProperties{
+		Level:             to.Ptr(LockLevel(lock.Properties.Level)),
+		Scope:             to.Ptr(lock.Properties.Scope),
+		ProvisioningState: fromSyncProvisioningStateDataModel(lock.InternalMetadata.AsyncProvisioningState),
+	}
+	if lock.Properties.Notes != "" {
+		dst.Properties.Notes = to.Ptr(lock.Properties.Notes)
+	}
+
+	return nil
+}
+
+func toLockLevelDataModel(level *LockLevel) (datamodel.LockLevel, error) {
+	if level != nil {
+		for _, v := range PossibleLockLevelValues() {
+			if v == *level {
+				return datamodel.LockLevel(v), nil
+			}
+		}
+	}
+
+	return "", &v1.ErrModelConversion{PropertyName: "$.properties.level", ValidValue: fmt.Sprintf("one of %q", PossibleLockLevelValues())}
+}
+
+func fromSyncProvisioningStateDataModel(state v1.ProvisioningState) *ProvisioningState {
+	if state == "" {
+		return to.Ptr(ProvisioningStateSucceeded)
+	}
+
+	return to.Ptr(ProvisioningState(state))
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockConvertVersionedToDataModel(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *datamodel.Lock
+		err      error
+	}{
+		{
+			filename: "lockresource.json",
+			expected: &datamodel.Lock{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/providers/System.Resources/locks/rg-lock",
+						Name: "rg-lock",
+						Type: datamodel.LockResourceType,
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.LockProperties{
+					Level: datamodel.LockLevelCanNotDelete,
+					Scope: "/planes/radius/local/resourceGroups/test-rg",
+					Notes: "Production resources",
+				},
+			},
+		},
+		{
+			filename: "lockresource-invalid-level.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.level", ValidValue: "one of [\"CanNotDelete\" \"ReadOnly\"]"},
+		},
+		{
+			filename: "lockresource-missing-scope.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.scope", ValidValue: "not empty"},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			r := &LockResource{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+
+			dm, err := r.ConvertTo()
+
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, dm.(*datamodel.Lock))
+			}
+		})
+	}
+}
+
+func TestLockConvertDataModelToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("lockresourcedatamodel.json")
+	r := &datamodel.Lock{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	versioned := &LockResource{}
+	err = versioned.ConvertFrom(r)
+	require.NoError(t, err)
+
+	expected := &LockResource{
+		ID:   to.Ptr("/planes/radius/local/providers/System.Resources/locks/rg-lock"),
+		Name: to.Ptr("rg-lock"),
+		Type: to.Ptr(datamodel.LockResourceType),
+		Properties: &LockProperties{
+			Level:             to.Ptr(LockLevelReadOnly),
+			Scope:             to.Ptr("/planes/radius/local/resourceGroups/test-rg"),
+			ProvisioningState: to.Ptr(ProvisioningStateSucceeded),
+		},
+	}
+	require.Equal(t, expected, versioned)
+}
+
+func TestLockConvertFromValidation(t *testing.T) {
+	versioned := &LockResource{}
+	err := versioned.ConvertFrom(&datamodel.ResourceGroup{})
+	require.ErrorIs(t, err, v1.ErrInvalidModelConversion)
+}
@@ -58,5 +58,11 @@ func (dst *ResourceGroupResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Location = to.Ptr(rg.Location)
 	dst.Tags = *to.StringMapPtr(rg.Tags)
 
+	if rg.DeletedTime != nil {
+		dst.Properties = &ResourceGroupProperties{
+			DeletedTime: rg.DeletedTime,
+		}
+	}
+
 	return nil
 }
@@ -19,6 +19,7 @@ package v20231001preview
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
@@ -89,6 +90,27 @@ func TestResourceGroupConvertDataModelToVersioned(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "/planes/radius/local/resourceGroups/test-rg", r.TrackedResource.ID)
 	require.Equal(t, "test-rg", r.TrackedResource.Name)
+	require.Nil(t, versioned.Properties)
+}
+
+func TestResourceGroupConvertDataModelToVersioned_Deleted(t *testing.T) {
+	deletedTime := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	r := &datamodel.ResourceGroup{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/planes/radius/local/resourceGroups/test-rg",
+				Name: "test-rg",
+			},
+			InternalMetadata: v1.InternalMetadata{
+				DeletedTime: &deletedTime,
+			},
+		},
+	}
+
+	versioned := &ResourceGroupResource{}
+	err := versioned.ConvertFrom(r)
+	require.NoError(t, err)
+	require.Equal(t, &deletedTime, versioned.Properties.DeletedTime)
 }
 
 func TestResourceGroupConvertFromValidation(t *testing.T) {
@@ -250,6 +250,64 @@ func (client *AwsCredentialsClient) listHandleResponse(resp *http.Response) (Aws
 	return result, nil
 }
 
+// Rotate - Rotate the secret of an AWS credential. The secret is replaced in place so that it is never missing while
+// in use
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The name of AWS plane
+//   - credentialName - The AWS credential name.
+//   - body - The content of the action request
+//   - options - AwsCredentialsClientRotateOptions contains the optional parameters for the AwsCredentialsClient.Rotate method.
+func (client *AwsCredentialsClient) Rotate(ctx context.Context, planeName string, credentialName string, body AwsCredentialResource, options *AwsCredentialsClientRotateOptions) (AwsCredentialsClientRotateResponse, error) {
+	var err error
+	req, err := client.rotateCreateRequest(ctx, planeName, credentialName, body, options)
+	if err != nil {
+		return AwsCredentialsClientRotateResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return AwsCredentialsClientRotateResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return AwsCredentialsClientRotateResponse{}, err
+	}
+	resp, err := client.rotateHandleResponse(httpResp)
+	return resp, err
+}
+
+// rotateCreateRequest creates the Rotate request.
+func (client *AwsCredentialsClient) rotateCreateRequest(ctx context.Context, planeName string, credentialName string, body AwsCredentialResource, options *AwsCredentialsClientRotateOptions) (*policy.Request, error) {
+	urlPath := "/planes/aws/{planeName}/providers/System.AWS/credentials/{credentialName}/rotate"
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", planeName)
+	if credentialName == "" {
+		return nil, errors.New("parameter credentialName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{credentialName}", url.PathEscape(credentialName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// rotateHandleResponse handles the Rotate response.
+func (client *AwsCredentialsClient) rotateHandleResponse(resp *http.Response) (AwsCredentialsClientRotateResponse, error) {
+	result := AwsCredentialsClientRotateResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.AwsCredentialResource); err != nil {
+		return AwsCredentialsClientRotateResponse{}, err
+	}
+	return result, nil
+}
+
 // Update - Update an AWS credential
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -251,6 +251,64 @@ func (client *AzureCredentialsClient) listHandleResponse(resp *http.Response) (A
 	return result, nil
 }
 
+// Rotate - Rotate the secret of an Azure credential. The secret is replaced in place so that it is never missing while
+// in use
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The name of the plane
+//   - credentialName - The Azure credential name.
+//   - body - The content of the action request
+//   - options - AzureCredentialsClientRotateOptions contains the optional parameters for the AzureCredentialsClient.Rotate method.
+func (client *AzureCredentialsClient) Rotate(ctx context.Context, planeName string, credentialName string, body AzureCredentialResource, options *AzureCredentialsClientRotateOptions) (AzureCredentialsClientRotateResponse, error) {
+	var err error
+	req, err := client.rotateCreateRequest(ctx, planeName, credentialName, body, options)
+	if err != nil {
+		return AzureCredentialsClientRotateResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return AzureCredentialsClientRotateResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return AzureCredentialsClientRotateResponse{}, err
+	}
+	resp, err := client.rotateHandleResponse(httpResp)
+	return resp, err
+}
+
+// rotateCreateRequest creates the Rotate request.
+func (client *AzureCredentialsClient) rotateCreateRequest(ctx context.Context, planeName string, credentialName string, body AzureCredentialResource, options *AzureCredentialsClientRotateOptions) (*policy.Request, error) {
+	urlPath := "/planes/azure/{planeName}/providers/System.Azure/credentials/{credentialName}/rotate"
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", planeName)
+	if credentialName == "" {
+		return nil, errors.New("parameter credentialName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{credentialName}", url.PathEscape(credentialName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// rotateHandleResponse handles the Rotate response.
+func (client *AzureCredentialsClient) rotateHandleResponse(resp *http.Response) (AzureCredentialsClientRotateResponse, error) {
+	result := AzureCredentialsClientRotateResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.AzureCredentialResource); err != nil {
+		return AzureCredentialsClientRotateResponse{}, err
+	}
+	return result, nil
+}
+
 // Update - Update an Azure credential
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -34,6 +34,11 @@ func NewClientFactory( credential azcore.TokenCredential, options *arm.ClientOpt
 	}, nil
 }
 
+func (c *ClientFactory) NewAuditRecordsClient() *AuditRecordsClient {
+	subClient, _ := NewAuditRecordsClient(c.credential, c.options)
+	return subClient
+}
+
 func (c *ClientFactory) NewAwsCredentialsClient() *AwsCredentialsClient {
 	subClient, _ := NewAwsCredentialsClient(c.credential, c.options)
 	return subClient
@@ -44,6 +49,16 @@ func (c *ClientFactory) NewAzureCredentialsClient() *AzureCredentialsClient {
 	return subClient
 }
 
+func (c *ClientFactory) NewEventSubscriptionsClient() *EventSubscriptionsClient {
+	subClient, _ := NewEventSubscriptionsClient(c.credential, c.options)
+	return subClient
+}
+
+func (c *ClientFactory) NewLocksClient() *LocksClient {
+	subClient, _ := NewLocksClient(c.credential, c.options)
+	return subClient
+}
+
 func (c *ClientFactory) NewPlanesClient() *PlanesClient {
 	subClient, _ := NewPlanesClient(c.credential, c.options)
 	return subClient
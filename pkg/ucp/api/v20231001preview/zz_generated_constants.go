@@ -18,12 +18,40 @@ type AWSCredentialKind string
 const (
 	// AWSCredentialKindAccessKey - The AWS Access Key credential
 	AWSCredentialKindAccessKey AWSCredentialKind = "AccessKey"
+	// AWSCredentialKindAssumeRole - The AWS assume role credential, which assumes an IAM role to obtain short-lived credentials
+	AWSCredentialKindAssumeRole AWSCredentialKind = "AssumeRole"
+	// AWSCredentialKindIRSA - The AWS IAM Roles for Service Accounts (IRSA) credential, which exchanges a web identity token for
+// short-lived credentials
+	AWSCredentialKindIRSA AWSCredentialKind = "IRSA"
 )
 
 // PossibleAWSCredentialKindValues returns the possible values for the AWSCredentialKind const type.
 func PossibleAWSCredentialKindValues() []AWSCredentialKind {
 	return []AWSCredentialKind{	
 		AWSCredentialKindAccessKey,
+		AWSCredentialKindAssumeRole,
+		AWSCredentialKindIRSA,
+	}
+}
+
+// AuditResult - The outcome of an audited request
+type AuditResult string
+
+const (
+	// AuditResultAccepted - The request was accepted and is completed by an asynchronous operation
+	AuditResultAccepted AuditResult = "Accepted"
+	// AuditResultFailed - The request was rejected or failed
+	AuditResultFailed AuditResult = "Failed"
+	// AuditResultSucceeded - The request completed successfully
+	AuditResultSucceeded AuditResult = "Succeeded"
+)
+
+// PossibleAuditResultValues returns the possible values for the AuditResult const type.
+func PossibleAuditResultValues() []AuditResult {
+	return []AuditResult{	
+		AuditResultAccepted,
+		AuditResultFailed,
+		AuditResultSucceeded,
 	}
 }
 
@@ -31,14 +59,21 @@ func PossibleAWSCredentialKindValues() []AWSCredentialKind {
 type AzureCredentialKind string
 
 const (
+	// AzureCredentialKindManagedIdentity - The Managed Identity Credential
+	AzureCredentialKindManagedIdentity AzureCredentialKind = "ManagedIdentity"
 	// AzureCredentialKindServicePrincipal - The Service Principal Credential
 	AzureCredentialKindServicePrincipal AzureCredentialKind = "ServicePrincipal"
+	// AzureCredentialKindWorkloadIdentity - The Workload Identity Credential, which exchanges a federated token for an access
+// token
+	AzureCredentialKindWorkloadIdentity AzureCredentialKind = "WorkloadIdentity"
 )
 
 // PossibleAzureCredentialKindValues returns the possible values for the AzureCredentialKind const type.
 func PossibleAzureCredentialKindValues() []AzureCredentialKind {
 	return []AzureCredentialKind{	
+		AzureCredentialKindManagedIdentity,
 		AzureCredentialKindServicePrincipal,
+		AzureCredentialKindWorkloadIdentity,
 	}
 }
 
@@ -62,6 +97,27 @@ func PossibleCreatedByTypeValues() []CreatedByType {
 	}
 }
 
+// CredentialState - The validation state of a credential
+type CredentialState string
+
+const (
+	// CredentialStateExpiring - The credential was verified but expires soon and should be rotated
+	CredentialStateExpiring CredentialState = "Expiring"
+	// CredentialStateInvalid - The credential could not be verified or has expired
+	CredentialStateInvalid CredentialState = "Invalid"
+	// CredentialStateValid - The credential was verified and does not expire soon
+	CredentialStateValid CredentialState = "Valid"
+)
+
+// PossibleCredentialStateValues returns the possible values for the CredentialState const type.
+func PossibleCredentialStateValues() []CredentialState {
+	return []CredentialState{	
+		CredentialStateExpiring,
+		CredentialStateInvalid,
+		CredentialStateValid,
+	}
+}
+
 // CredentialStorageKind - Credential store kinds supported.
 type CredentialStorageKind string
 
@@ -77,6 +133,42 @@ func PossibleCredentialStorageKindValues() []CredentialStorageKind {
 	}
 }
 
+// EventDeliveryState - The state of the last delivery to an event subscription
+type EventDeliveryState string
+
+const (
+	// EventDeliveryStateFailed - The event could not be delivered
+	EventDeliveryStateFailed EventDeliveryState = "Failed"
+	// EventDeliveryStateSucceeded - The event was delivered
+	EventDeliveryStateSucceeded EventDeliveryState = "Succeeded"
+)
+
+// PossibleEventDeliveryStateValues returns the possible values for the EventDeliveryState const type.
+func PossibleEventDeliveryStateValues() []EventDeliveryState {
+	return []EventDeliveryState{	
+		EventDeliveryStateFailed,
+		EventDeliveryStateSucceeded,
+	}
+}
+
+// LockLevel - The level of a management lock
+type LockLevel string
+
+const (
+	// LockLevelCanNotDelete - Resources in the scope of the lock can be read and updated but not deleted
+	LockLevelCanNotDelete LockLevel = "CanNotDelete"
+	// LockLevelReadOnly - Resources in the scope of the lock can only be read
+	LockLevelReadOnly LockLevel = "ReadOnly"
+)
+
+// PossibleLockLevelValues returns the possible values for the LockLevel const type.
+func PossibleLockLevelValues() []LockLevel {
+	return []LockLevel{	
+		LockLevelCanNotDelete,
+		LockLevelReadOnly,
+	}
+}
+
 // PlaneKind - Plane kinds supported.
 type PlaneKind string
 
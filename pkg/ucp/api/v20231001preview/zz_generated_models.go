@@ -9,6 +9,72 @@ package v20231001preview
 
 import "time"
 
+// AuditRecordProperties - The audit record properties
+type AuditRecordProperties struct {
+	// READ-ONLY; The HTTP method of the request
+	Method *string
+
+	// READ-ONLY; The ID of the resource targeted by the request
+	ResourceID *string
+
+	// READ-ONLY; The outcome of the request
+	Result *AuditResult
+
+	// READ-ONLY; The HTTP status code of the response
+	StatusCode *int32
+
+	// READ-ONLY; The time the request was received
+	Timestamp *time.Time
+
+	// READ-ONLY; The api-version of the request
+	APIVersion *string
+
+	// READ-ONLY; The identity of the caller
+	Caller *string
+
+	// READ-ONLY; The correlation ID of the request
+	CorrelationID *string
+
+	// READ-ONLY; The ETag of the resource after the request
+	EtagAfter *string
+
+	// READ-ONLY; The ETag of the resource before the request
+	EtagBefore *string
+
+	// READ-ONLY; The operation ID assigned to the request
+	OperationID *string
+
+	// READ-ONLY; The operation type of the request
+	OperationType *string
+}
+
+// AuditRecordResource - The audit record of a mutating request
+type AuditRecordResource struct {
+	// The resource-specific properties for this resource.
+	Properties *AuditRecordProperties
+
+	// READ-ONLY; The name of the audit record
+	Name *string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// AuditRecordResourceListResult - The response of a AuditRecordResource list operation.
+type AuditRecordResourceListResult struct {
+	// REQUIRED; The AuditRecordResource items on this page
+	Value []*AuditRecordResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
 // AwsAccessKeyCredentialProperties - AWS credential storage properties
 type AwsAccessKeyCredentialProperties struct {
 	// REQUIRED; Access key ID for AWS identity
@@ -23,15 +89,64 @@ type AwsAccessKeyCredentialProperties struct {
 	// REQUIRED; The storage properties
 	Storage CredentialStoragePropertiesClassification
 
+	// The time the credential expires. The credential is reported as Expiring ahead of this time so that it can be rotated
+	ExpiresAt *time.Time
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; The status of the last validation of the credential
+	Status *CredentialStatus
 }
 
 // GetAwsCredentialProperties implements the AwsCredentialPropertiesClassification interface for type AwsAccessKeyCredentialProperties.
 func (a *AwsAccessKeyCredentialProperties) GetAwsCredentialProperties() *AwsCredentialProperties {
 	return &AwsCredentialProperties{
+		ExpiresAt: a.ExpiresAt,
+		Kind: a.Kind,
+		ProvisioningState: a.ProvisioningState,
+		Status: a.Status,
+	}
+}
+
+// AwsAssumeRoleCredentialProperties - AWS assume role credential properties
+type AwsAssumeRoleCredentialProperties struct {
+	// REQUIRED; The AWS credential kind
+	Kind *AWSCredentialKind
+
+	// REQUIRED; The ARN of the IAM role to assume
+	RoleARN *string
+
+	// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+	// The time the credential expires. The credential is reported as Expiring ahead of this time so that it can be rotated
+	ExpiresAt *time.Time
+
+	// The external ID required by the trust policy of the role
+	ExternalID *string
+
+	// The name of the role session
+	SessionName *string
+
+	// The name of the AWS credential whose credentials are used to assume the role. The credentials of UCP are used when not
+// set
+	SourceCredential *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; The status of the last validation of the credential
+	Status *CredentialStatus
+}
+
+// GetAwsCredentialProperties implements the AwsCredentialPropertiesClassification interface for type AwsAssumeRoleCredentialProperties.
+func (a *AwsAssumeRoleCredentialProperties) GetAwsCredentialProperties() *AwsCredentialProperties {
+	return &AwsCredentialProperties{
+		ExpiresAt: a.ExpiresAt,
 		Kind: a.Kind,
 		ProvisioningState: a.ProvisioningState,
+		Status: a.Status,
 	}
 }
 
@@ -40,8 +155,14 @@ type AwsCredentialProperties struct {
 	// REQUIRED; The AWS credential kind
 	Kind *AWSCredentialKind
 
+	// The time the credential expires. The credential is reported as Expiring ahead of this time so that it can be rotated
+	ExpiresAt *time.Time
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; The status of the last validation of the credential
+	Status *CredentialStatus
 }
 
 // GetAwsCredentialProperties implements the AwsCredentialPropertiesClassification interface for type AwsCredentialProperties.
@@ -87,13 +208,56 @@ type AwsCredentialResourceTagsUpdate struct {
 	Tags map[string]*string
 }
 
+// AwsIRSACredentialProperties - AWS IRSA credential properties
+type AwsIRSACredentialProperties struct {
+	// REQUIRED; The AWS credential kind
+	Kind *AWSCredentialKind
+
+	// REQUIRED; The ARN of the IAM role assumed with the web identity token
+	RoleARN *string
+
+	// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+	// The time the credential expires. The credential is reported as Expiring ahead of this time so that it can be rotated
+	ExpiresAt *time.Time
+
+	// The name of the role session
+	SessionName *string
+
+	// The path of the web identity token file. Defaults to the value of the AWS_WEB_IDENTITY_TOKEN_FILE environment variable of UCP. Other paths must be in the token files allowed by the operator
+	TokenFile *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; The status of the last validation of the credential
+	Status *CredentialStatus
+}
+
+// GetAwsCredentialProperties implements the AwsCredentialPropertiesClassification interface for type AwsIRSACredentialProperties.
+func (a *AwsIRSACredentialProperties) GetAwsCredentialProperties() *AwsCredentialProperties {
+	return &AwsCredentialProperties{
+		ExpiresAt: a.ExpiresAt,
+		Kind: a.Kind,
+		ProvisioningState: a.ProvisioningState,
+		Status: a.Status,
+	}
+}
+
 // AzureCredentialProperties - The base properties of Azure Credential
 type AzureCredentialProperties struct {
 	// REQUIRED; The kind of Azure credential
 	Kind *AzureCredentialKind
 
+	// The time the credential expires. The credential is reported as Expiring ahead of this time so that it can be rotated
+	ExpiresAt *time.Time
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; The status of the last validation of the credential
+	Status *CredentialStatus
 }
 
 // GetAzureCredentialProperties implements the AzureCredentialPropertiesClassification interface for type AzureCredentialProperties.
@@ -138,6 +302,37 @@ type AzureCredentialResourceTagsUpdate struct {
 	Tags map[string]*string
 }
 
+// AzureManagedIdentityProperties - The properties of Managed Identity credential
+type AzureManagedIdentityProperties struct {
+	// REQUIRED; The kind of Azure credential
+	Kind *AzureCredentialKind
+
+	// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+	// clientId of the user-assigned managed identity. The system-assigned managed identity is used when not set
+	ClientID *string
+
+	// The time the credential expires. The credential is reported as Expiring ahead of this time so that it can be rotated
+	ExpiresAt *time.Time
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; The status of the last validation of the credential
+	Status *CredentialStatus
+}
+
+// GetAzureCredentialProperties implements the AzureCredentialPropertiesClassification interface for type AzureManagedIdentityProperties.
+func (a *AzureManagedIdentityProperties) GetAzureCredentialProperties() *AzureCredentialProperties {
+	return &AzureCredentialProperties{
+		ExpiresAt: a.ExpiresAt,
+		Kind: a.Kind,
+		ProvisioningState: a.ProvisioningState,
+		Status: a.Status,
+	}
+}
+
 // AzureServicePrincipalProperties - The properties of Service Principal credential storage
 type AzureServicePrincipalProperties struct {
 	// REQUIRED; clientId for ServicePrincipal
@@ -155,15 +350,61 @@ type AzureServicePrincipalProperties struct {
 	// REQUIRED; tenantId for ServicePrincipal
 	TenantID *string
 
+	// The time the credential expires. The credential is reported as Expiring ahead of this time so that it can be rotated
+	ExpiresAt *time.Time
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; The status of the last validation of the credential
+	Status *CredentialStatus
 }
 
 // GetAzureCredentialProperties implements the AzureCredentialPropertiesClassification interface for type AzureServicePrincipalProperties.
 func (a *AzureServicePrincipalProperties) GetAzureCredentialProperties() *AzureCredentialProperties {
 	return &AzureCredentialProperties{
+		ExpiresAt: a.ExpiresAt,
+		Kind: a.Kind,
+		ProvisioningState: a.ProvisioningState,
+		Status: a.Status,
+	}
+}
+
+// AzureWorkloadIdentityProperties - The properties of Workload Identity credential
+type AzureWorkloadIdentityProperties struct {
+	// REQUIRED; clientId of the application or user-assigned managed identity with the federated credential
+	ClientID *string
+
+	// REQUIRED; The kind of Azure credential
+	Kind *AzureCredentialKind
+
+	// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+	// REQUIRED; tenantId of the application or user-assigned managed identity
+	TenantID *string
+
+	// The time the credential expires. The credential is reported as Expiring ahead of this time so that it can be rotated
+	ExpiresAt *time.Time
+
+	// The path of the federated token file. Defaults to the value of the AZURE_FEDERATED_TOKEN_FILE environment variable of
+// UCP. Other paths must be in the token files allowed by the operator
+	TokenFile *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; The status of the last validation of the credential
+	Status *CredentialStatus
+}
+
+// GetAzureCredentialProperties implements the AzureCredentialPropertiesClassification interface for type AzureWorkloadIdentityProperties.
+func (a *AzureWorkloadIdentityProperties) GetAzureCredentialProperties() *AzureCredentialProperties {
+	return &AzureCredentialProperties{
+		ExpiresAt: a.ExpiresAt,
 		Kind: a.Kind,
 		ProvisioningState: a.ProvisioningState,
+		Status: a.Status,
 	}
 }
 
@@ -186,6 +427,18 @@ type ComponentsKhmx01SchemasGenericresourceAllof0 struct {
 	Type *string
 }
 
+// CredentialStatus - The status of the last validation of a credential
+type CredentialStatus struct {
+	// REQUIRED; The state of the credential
+	State *CredentialState
+
+	// The time the credential was last validated
+	LastValidatedTime *time.Time
+
+	// The details of the state, for example the reason the credential is invalid
+	Message *string
+}
+
 // CredentialStorageProperties - The base credential storage properties
 type CredentialStorageProperties struct {
 	// REQUIRED; The kind of credential storage
@@ -229,6 +482,87 @@ type ErrorResponse struct {
 	Error *ErrorDetail
 }
 
+// EventDeliveryStatus - The status of the last delivery to an event subscription
+type EventDeliveryStatus struct {
+	// REQUIRED; The state of the last delivery
+	State *EventDeliveryState
+
+	// The number of attempts made to deliver the last event
+	Attempts *int32
+
+	// The number of consecutive events that could not be delivered
+	ConsecutiveFailures *int32
+
+	// The error of the last failed delivery
+	Error *string
+
+	// The time of the last delivery attempt
+	LastAttemptTime *time.Time
+
+	// The ID of the last delivered event
+	LastEventID *string
+
+	// The type of the last delivered event
+	LastEventType *string
+}
+
+// EventSubscriptionFilter - The filter of the events delivered to an event subscription
+type EventSubscriptionFilter struct {
+	// The types of the events, for example Radius.Resource.Deleted or Radius.Operation.*. Defaults to all event types.
+	EventTypes []*string
+
+	// The resource types of the events, for example Applications.Core/containers. Defaults to all resource types.
+	ResourceTypes []*string
+
+	// The ID of the scope the resources must be in, for example /planes/radius/local/resourceGroups/rg1. Defaults to the plane.
+	Scope *string
+}
+
+// EventSubscriptionProperties - The event subscription properties
+type EventSubscriptionProperties struct {
+	// REQUIRED; The URL of the webhook events are delivered to. It must be an absolute http or https URL.
+	URL *string
+
+	// The filter of the events delivered to the webhook
+	Filter *EventSubscriptionFilter
+
+	// The secret used to sign the events with HMAC-SHA256. The signature is sent in the X-Radius-Signature header. The secret is never returned.
+	Secret *string
+
+	// READ-ONLY; The status of the last delivery to the webhook
+	DeliveryStatus *EventDeliveryStatus
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// EventSubscriptionResource - The event subscription resource
+type EventSubscriptionResource struct {
+	// The resource-specific properties for this resource.
+	Properties *EventSubscriptionProperties
+
+	// READ-ONLY; The name of the event subscription
+	Name *string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// EventSubscriptionResourceListResult - The response of a EventSubscriptionResource list operation.
+type EventSubscriptionResourceListResult struct {
+	// REQUIRED; The EventSubscriptionResource items on this page
+	Value []*EventSubscriptionResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
 // GenericResource - Represents resource data.
 type GenericResource struct {
 	// The resource-specific properties for this resource.
@@ -237,6 +571,9 @@ type GenericResource struct {
 	// READ-ONLY; The name of resource
 	Name *string
 
+	// READ-ONLY; The tags of the resource
+	Tags map[string]*string
+
 	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
 	ID *string
 
@@ -272,6 +609,48 @@ func (i *InternalCredentialStorageProperties) GetCredentialStorageProperties() *
 	}
 }
 
+// LockProperties - The management lock properties
+type LockProperties struct {
+	// REQUIRED; The level of the lock
+	Level *LockLevel
+
+	// REQUIRED; The ID of the resource group, environment, application or resource the lock applies to, for example /planes/radius/local/resourceGroups/rg1.
+	Scope *string
+
+	// Notes about the lock
+	Notes *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// LockResource - The management lock resource
+type LockResource struct {
+	// The resource-specific properties for this resource.
+	Properties *LockProperties
+
+	// READ-ONLY; The name of the lock
+	Name *string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// LockResourceListResult - The response of a LockResource list operation.
+type LockResourceListResult struct {
+	// REQUIRED; The LockResource items on this page
+	Value []*LockResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
 // PlaneResource - The plane resource
 type PlaneResource struct {
 	// REQUIRED; The geo-location where the resource lives
@@ -359,6 +738,9 @@ type Resource struct {
 
 // ResourceGroupProperties - The resource group resource properties
 type ResourceGroupProperties struct {
+	// READ-ONLY; The time the resource group was soft deleted. A soft-deleted resource group can be restored until it is purged
+	DeletedTime *time.Time
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
@@ -14,13 +14,160 @@ import (
 	"reflect"
 )
 
+// MarshalJSON implements the json.Marshaller interface for type AuditRecordProperties.
+func (a AuditRecordProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "apiVersion", a.APIVersion)
+	populate(objectMap, "caller", a.Caller)
+	populate(objectMap, "correlationId", a.CorrelationID)
+	populate(objectMap, "etagAfter", a.EtagAfter)
+	populate(objectMap, "etagBefore", a.EtagBefore)
+	populate(objectMap, "method", a.Method)
+	populate(objectMap, "operationId", a.OperationID)
+	populate(objectMap, "operationType", a.OperationType)
+	populate(objectMap, "resourceId", a.ResourceID)
+	populate(objectMap, "result", a.Result)
+	populate(objectMap, "statusCode", a.StatusCode)
+	populateTimeRFC3339(objectMap, "timestamp", a.Timestamp)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AuditRecordProperties.
+func (a *AuditRecordProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "apiVersion":
+				err = unpopulate(val, "APIVersion", &a.APIVersion)
+			delete(rawMsg, key)
+		case "caller":
+				err = unpopulate(val, "Caller", &a.Caller)
+			delete(rawMsg, key)
+		case "correlationId":
+				err = unpopulate(val, "CorrelationID", &a.CorrelationID)
+			delete(rawMsg, key)
+		case "etagAfter":
+				err = unpopulate(val, "EtagAfter", &a.EtagAfter)
+			delete(rawMsg, key)
+		case "etagBefore":
+				err = unpopulate(val, "EtagBefore", &a.EtagBefore)
+			delete(rawMsg, key)
+		case "method":
+				err = unpopulate(val, "Method", &a.Method)
+			delete(rawMsg, key)
+		case "operationId":
+				err = unpopulate(val, "OperationID", &a.OperationID)
+			delete(rawMsg, key)
+		case "operationType":
+				err = unpopulate(val, "OperationType", &a.OperationType)
+			delete(rawMsg, key)
+		case "resourceId":
+				err = unpopulate(val, "ResourceID", &a.ResourceID)
+			delete(rawMsg, key)
+		case "result":
+				err = unpopulate(val, "Result", &a.Result)
+			delete(rawMsg, key)
+		case "statusCode":
+				err = unpopulate(val, "StatusCode", &a.StatusCode)
+			delete(rawMsg, key)
+		case "timestamp":
+				err = unpopulateTimeRFC3339(val, "Timestamp", &a.Timestamp)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type AuditRecordResource.
+func (a AuditRecordResource) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "id", a.ID)
+	populate(objectMap, "name", a.Name)
+	populate(objectMap, "properties", a.Properties)
+	populate(objectMap, "systemData", a.SystemData)
+	populate(objectMap, "type", a.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AuditRecordResource.
+func (a *AuditRecordResource) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "id":
+				err = unpopulate(val, "ID", &a.ID)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &a.Name)
+			delete(rawMsg, key)
+		case "properties":
+				err = unpopulate(val, "Properties", &a.Properties)
+			delete(rawMsg, key)
+		case "systemData":
+				err = unpopulate(val, "SystemData", &a.SystemData)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &a.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type AuditRecordResourceListResult.
+func (a AuditRecordResourceListResult) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "nextLink", a.NextLink)
+	populate(objectMap, "value", a.Value)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AuditRecordResourceListResult.
+func (a *AuditRecordResourceListResult) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "nextLink":
+				err = unpopulate(val, "NextLink", &a.NextLink)
+			delete(rawMsg, key)
+		case "value":
+				err = unpopulate(val, "Value", &a.Value)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type AwsAccessKeyCredentialProperties.
 func (a AwsAccessKeyCredentialProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "accessKeyId", a.AccessKeyID)
+	populateTimeRFC3339(objectMap, "expiresAt", a.ExpiresAt)
 	objectMap["kind"] = AWSCredentialKindAccessKey
 	populate(objectMap, "provisioningState", a.ProvisioningState)
 	populate(objectMap, "secretAccessKey", a.SecretAccessKey)
+	populate(objectMap, "status", a.Status)
 	populate(objectMap, "storage", a.Storage)
 	return json.Marshal(objectMap)
 }
@@ -37,6 +184,9 @@ func (a *AwsAccessKeyCredentialProperties) UnmarshalJSON(data []byte) error {
 		case "accessKeyId":
 				err = unpopulate(val, "AccessKeyID", &a.AccessKeyID)
 			delete(rawMsg, key)
+		case "expiresAt":
+				err = unpopulateTimeRFC3339(val, "ExpiresAt", &a.ExpiresAt)
+			delete(rawMsg, key)
 		case "kind":
 				err = unpopulate(val, "Kind", &a.Kind)
 			delete(rawMsg, key)
@@ -46,6 +196,68 @@ func (a *AwsAccessKeyCredentialProperties) UnmarshalJSON(data []byte) error {
 		case "secretAccessKey":
 				err = unpopulate(val, "SecretAccessKey", &a.SecretAccessKey)
 			delete(rawMsg, key)
+		case "status":
+				err = unpopulate(val, "Status", &a.Status)
+			delete(rawMsg, key)
+		case "storage":
+			a.Storage, err = unmarshalCredentialStoragePropertiesClassification(val)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type AwsAssumeRoleCredentialProperties.
+func (a AwsAssumeRoleCredentialProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populateTimeRFC3339(objectMap, "expiresAt", a.ExpiresAt)
+	populate(objectMap, "externalId", a.ExternalID)
+	objectMap["kind"] = AWSCredentialKindAssumeRole
+	populate(objectMap, "provisioningState", a.ProvisioningState)
+	populate(objectMap, "roleARN", a.RoleARN)
+	populate(objectMap, "sessionName", a.SessionName)
+	populate(objectMap, "sourceCredential", a.SourceCredential)
+	populate(objectMap, "status", a.Status)
+	populate(objectMap, "storage", a.Storage)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AwsAssumeRoleCredentialProperties.
+func (a *AwsAssumeRoleCredentialProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "expiresAt":
+				err = unpopulateTimeRFC3339(val, "ExpiresAt", &a.ExpiresAt)
+			delete(rawMsg, key)
+		case "externalId":
+				err = unpopulate(val, "ExternalID", &a.ExternalID)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &a.Kind)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &a.ProvisioningState)
+			delete(rawMsg, key)
+		case "roleARN":
+				err = unpopulate(val, "RoleARN", &a.RoleARN)
+			delete(rawMsg, key)
+		case "sessionName":
+				err = unpopulate(val, "SessionName", &a.SessionName)
+			delete(rawMsg, key)
+		case "sourceCredential":
+				err = unpopulate(val, "SourceCredential", &a.SourceCredential)
+			delete(rawMsg, key)
+		case "status":
+				err = unpopulate(val, "Status", &a.Status)
+			delete(rawMsg, key)
 		case "storage":
 			a.Storage, err = unmarshalCredentialStoragePropertiesClassification(val)
 			delete(rawMsg, key)
@@ -60,8 +272,10 @@ func (a *AwsAccessKeyCredentialProperties) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type AwsCredentialProperties.
 func (a AwsCredentialProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populateTimeRFC3339(objectMap, "expiresAt", a.ExpiresAt)
 	objectMap["kind"] = a.Kind
 	populate(objectMap, "provisioningState", a.ProvisioningState)
+	populate(objectMap, "status", a.Status)
 	return json.Marshal(objectMap)
 }
 
@@ -74,12 +288,18 @@ func (a *AwsCredentialProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "expiresAt":
+				err = unpopulateTimeRFC3339(val, "ExpiresAt", &a.ExpiresAt)
+			delete(rawMsg, key)
 		case "kind":
 				err = unpopulate(val, "Kind", &a.Kind)
 			delete(rawMsg, key)
 		case "provisioningState":
 				err = unpopulate(val, "ProvisioningState", &a.ProvisioningState)
 			delete(rawMsg, key)
+		case "status":
+				err = unpopulate(val, "Status", &a.Status)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", a, err)
@@ -197,11 +417,68 @@ func (a *AwsCredentialResourceTagsUpdate) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type AwsIRSACredentialProperties.
+func (a AwsIRSACredentialProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populateTimeRFC3339(objectMap, "expiresAt", a.ExpiresAt)
+	objectMap["kind"] = AWSCredentialKindIRSA
+	populate(objectMap, "provisioningState", a.ProvisioningState)
+	populate(objectMap, "roleARN", a.RoleARN)
+	populate(objectMap, "sessionName", a.SessionName)
+	populate(objectMap, "status", a.Status)
+	populate(objectMap, "storage", a.Storage)
+	populate(objectMap, "tokenFile", a.TokenFile)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AwsIRSACredentialProperties.
+func (a *AwsIRSACredentialProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "expiresAt":
+				err = unpopulateTimeRFC3339(val, "ExpiresAt", &a.ExpiresAt)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &a.Kind)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &a.ProvisioningState)
+			delete(rawMsg, key)
+		case "roleARN":
+				err = unpopulate(val, "RoleARN", &a.RoleARN)
+			delete(rawMsg, key)
+		case "sessionName":
+				err = unpopulate(val, "SessionName", &a.SessionName)
+			delete(rawMsg, key)
+		case "status":
+				err = unpopulate(val, "Status", &a.Status)
+			delete(rawMsg, key)
+		case "storage":
+			a.Storage, err = unmarshalCredentialStoragePropertiesClassification(val)
+			delete(rawMsg, key)
+		case "tokenFile":
+				err = unpopulate(val, "TokenFile", &a.TokenFile)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type AzureCredentialProperties.
 func (a AzureCredentialProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populateTimeRFC3339(objectMap, "expiresAt", a.ExpiresAt)
 	objectMap["kind"] = a.Kind
 	populate(objectMap, "provisioningState", a.ProvisioningState)
+	populate(objectMap, "status", a.Status)
 	return json.Marshal(objectMap)
 }
 
@@ -214,12 +491,18 @@ func (a *AzureCredentialProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "expiresAt":
+				err = unpopulateTimeRFC3339(val, "ExpiresAt", &a.ExpiresAt)
+			delete(rawMsg, key)
 		case "kind":
 				err = unpopulate(val, "Kind", &a.Kind)
 			delete(rawMsg, key)
 		case "provisioningState":
 				err = unpopulate(val, "ProvisioningState", &a.ProvisioningState)
 			delete(rawMsg, key)
+		case "status":
+				err = unpopulate(val, "Status", &a.Status)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", a, err)
@@ -337,13 +620,62 @@ func (a *AzureCredentialResourceTagsUpdate) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type AzureManagedIdentityProperties.
+func (a AzureManagedIdentityProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "clientId", a.ClientID)
+	populateTimeRFC3339(objectMap, "expiresAt", a.ExpiresAt)
+	objectMap["kind"] = AzureCredentialKindManagedIdentity
+	populate(objectMap, "provisioningState", a.ProvisioningState)
+	populate(objectMap, "status", a.Status)
+	populate(objectMap, "storage", a.Storage)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AzureManagedIdentityProperties.
+func (a *AzureManagedIdentityProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "clientId":
+				err = unpopulate(val, "ClientID", &a.ClientID)
+			delete(rawMsg, key)
+		case "expiresAt":
+				err = unpopulateTimeRFC3339(val, "ExpiresAt", &a.ExpiresAt)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &a.Kind)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &a.ProvisioningState)
+			delete(rawMsg, key)
+		case "status":
+				err = unpopulate(val, "Status", &a.Status)
+			delete(rawMsg, key)
+		case "storage":
+			a.Storage, err = unmarshalCredentialStoragePropertiesClassification(val)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type AzureServicePrincipalProperties.
 func (a AzureServicePrincipalProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "clientId", a.ClientID)
 	populate(objectMap, "clientSecret", a.ClientSecret)
+	populateTimeRFC3339(objectMap, "expiresAt", a.ExpiresAt)
 	objectMap["kind"] = AzureCredentialKindServicePrincipal
 	populate(objectMap, "provisioningState", a.ProvisioningState)
+	populate(objectMap, "status", a.Status)
 	populate(objectMap, "storage", a.Storage)
 	populate(objectMap, "tenantId", a.TenantID)
 	return json.Marshal(objectMap)
@@ -364,12 +696,18 @@ func (a *AzureServicePrincipalProperties) UnmarshalJSON(data []byte) error {
 		case "clientSecret":
 				err = unpopulate(val, "ClientSecret", &a.ClientSecret)
 			delete(rawMsg, key)
+		case "expiresAt":
+				err = unpopulateTimeRFC3339(val, "ExpiresAt", &a.ExpiresAt)
+			delete(rawMsg, key)
 		case "kind":
 				err = unpopulate(val, "Kind", &a.Kind)
 			delete(rawMsg, key)
 		case "provisioningState":
 				err = unpopulate(val, "ProvisioningState", &a.ProvisioningState)
 			delete(rawMsg, key)
+		case "status":
+				err = unpopulate(val, "Status", &a.Status)
+			delete(rawMsg, key)
 		case "storage":
 			a.Storage, err = unmarshalCredentialStoragePropertiesClassification(val)
 			delete(rawMsg, key)
@@ -384,6 +722,61 @@ func (a *AzureServicePrincipalProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type AzureWorkloadIdentityProperties.
+func (a AzureWorkloadIdentityProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "clientId", a.ClientID)
+	populateTimeRFC3339(objectMap, "expiresAt", a.ExpiresAt)
+	objectMap["kind"] = AzureCredentialKindWorkloadIdentity
+	populate(objectMap, "provisioningState", a.ProvisioningState)
+	populate(objectMap, "status", a.Status)
+	populate(objectMap, "storage", a.Storage)
+	populate(objectMap, "tenantId", a.TenantID)
+	populate(objectMap, "tokenFile", a.TokenFile)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AzureWorkloadIdentityProperties.
+func (a *AzureWorkloadIdentityProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "clientId":
+				err = unpopulate(val, "ClientID", &a.ClientID)
+			delete(rawMsg, key)
+		case "expiresAt":
+				err = unpopulateTimeRFC3339(val, "ExpiresAt", &a.ExpiresAt)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &a.Kind)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &a.ProvisioningState)
+			delete(rawMsg, key)
+		case "status":
+				err = unpopulate(val, "Status", &a.Status)
+			delete(rawMsg, key)
+		case "storage":
+			a.Storage, err = unmarshalCredentialStoragePropertiesClassification(val)
+			delete(rawMsg, key)
+		case "tenantId":
+				err = unpopulate(val, "TenantID", &a.TenantID)
+			delete(rawMsg, key)
+		case "tokenFile":
+				err = unpopulate(val, "TokenFile", &a.TokenFile)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type ComponentsKhmx01SchemasGenericresourceAllof0.
 func (c ComponentsKhmx01SchemasGenericresourceAllof0) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -427,6 +820,41 @@ func (c *ComponentsKhmx01SchemasGenericresourceAllof0) UnmarshalJSON(data []byte
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type CredentialStatus.
+func (c CredentialStatus) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populateTimeRFC3339(objectMap, "lastValidatedTime", c.LastValidatedTime)
+	populate(objectMap, "message", c.Message)
+	populate(objectMap, "state", c.State)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type CredentialStatus.
+func (c *CredentialStatus) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", c, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "lastValidatedTime":
+				err = unpopulateTimeRFC3339(val, "LastValidatedTime", &c.LastValidatedTime)
+			delete(rawMsg, key)
+		case "message":
+				err = unpopulate(val, "Message", &c.Message)
+			delete(rawMsg, key)
+		case "state":
+				err = unpopulate(val, "State", &c.State)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", c, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type CredentialStorageProperties.
 func (c CredentialStorageProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -555,6 +983,209 @@ func (e *ErrorResponse) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type EventDeliveryStatus.
+func (e EventDeliveryStatus) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "attempts", e.Attempts)
+	populate(objectMap, "consecutiveFailures", e.ConsecutiveFailures)
+	populate(objectMap, "error", e.Error)
+	populateTimeRFC3339(objectMap, "lastAttemptTime", e.LastAttemptTime)
+	populate(objectMap, "lastEventId", e.LastEventID)
+	populate(objectMap, "lastEventType", e.LastEventType)
+	populate(objectMap, "state", e.State)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type EventDeliveryStatus.
+func (e *EventDeliveryStatus) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "attempts":
+				err = unpopulate(val, "Attempts", &e.Attempts)
+			delete(rawMsg, key)
+		case "consecutiveFailures":
+				err = unpopulate(val, "ConsecutiveFailures", &e.ConsecutiveFailures)
+			delete(rawMsg, key)
+		case "error":
+				err = unpopulate(val, "Error", &e.Error)
+			delete(rawMsg, key)
+		case "lastAttemptTime":
+				err = unpopulateTimeRFC3339(val, "LastAttemptTime", &e.LastAttemptTime)
+			delete(rawMsg, key)
+		case "lastEventId":
+				err = unpopulate(val, "LastEventID", &e.LastEventID)
+			delete(rawMsg, key)
+		case "lastEventType":
+				err = unpopulate(val, "LastEventType", &e.LastEventType)
+			delete(rawMsg, key)
+		case "state":
+				err = unpopulate(val, "State", &e.State)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type EventSubscriptionFilter.
+func (e EventSubscriptionFilter) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "eventTypes", e.EventTypes)
+	populate(objectMap, "resourceTypes", e.ResourceTypes)
+	populate(objectMap, "scope", e.Scope)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type EventSubscriptionFilter.
+func (e *EventSubscriptionFilter) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "eventTypes":
+				err = unpopulate(val, "EventTypes", &e.EventTypes)
+			delete(rawMsg, key)
+		case "resourceTypes":
+				err = unpopulate(val, "ResourceTypes", &e.ResourceTypes)
+			delete(rawMsg, key)
+		case "scope":
+				err = unpopulate(val, "Scope", &e.Scope)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type EventSubscriptionProperties.
+func (e EventSubscriptionProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "deliveryStatus", e.DeliveryStatus)
+	populate(objectMap, "filter", e.Filter)
+	populate(objectMap, "provisioningState", e.ProvisioningState)
+	populate(objectMap, "secret", e.Secret)
+	populate(objectMap, "url", e.URL)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type EventSubscriptionProperties.
+func (e *EventSubscriptionProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "deliveryStatus":
+				err = unpopulate(val, "DeliveryStatus", &e.DeliveryStatus)
+			delete(rawMsg, key)
+		case "filter":
+				err = unpopulate(val, "Filter", &e.Filter)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &e.ProvisioningState)
+			delete(rawMsg, key)
+		case "secret":
+				err = unpopulate(val, "Secret", &e.Secret)
+			delete(rawMsg, key)
+		case "url":
+				err = unpopulate(val, "URL", &e.URL)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type EventSubscriptionResource.
+func (e EventSubscriptionResource) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "id", e.ID)
+	populate(objectMap, "name", e.Name)
+	populate(objectMap, "properties", e.Properties)
+	populate(objectMap, "systemData", e.SystemData)
+	populate(objectMap, "type", e.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type EventSubscriptionResource.
+func (e *EventSubscriptionResource) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "id":
+				err = unpopulate(val, "ID", &e.ID)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &e.Name)
+			delete(rawMsg, key)
+		case "properties":
+				err = unpopulate(val, "Properties", &e.Properties)
+			delete(rawMsg, key)
+		case "systemData":
+				err = unpopulate(val, "SystemData", &e.SystemData)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &e.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type EventSubscriptionResourceListResult.
+func (e EventSubscriptionResourceListResult) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "nextLink", e.NextLink)
+	populate(objectMap, "value", e.Value)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type EventSubscriptionResourceListResult.
+func (e *EventSubscriptionResourceListResult) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "nextLink":
+				err = unpopulate(val, "NextLink", &e.NextLink)
+			delete(rawMsg, key)
+		case "value":
+				err = unpopulate(val, "Value", &e.Value)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type GenericResource.
 func (g GenericResource) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -562,6 +1193,7 @@ func (g GenericResource) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "name", g.Name)
 	populate(objectMap, "properties", g.Properties)
 	populate(objectMap, "systemData", g.SystemData)
+	populate(objectMap, "tags", g.Tags)
 	populate(objectMap, "type", g.Type)
 	return json.Marshal(objectMap)
 }
@@ -587,6 +1219,9 @@ func (g *GenericResource) UnmarshalJSON(data []byte) error {
 		case "systemData":
 				err = unpopulate(val, "SystemData", &g.SystemData)
 			delete(rawMsg, key)
+		case "tags":
+				err = unpopulate(val, "Tags", &g.Tags)
+			delete(rawMsg, key)
 		case "type":
 				err = unpopulate(val, "Type", &g.Type)
 			delete(rawMsg, key)
@@ -660,6 +1295,119 @@ func (i *InternalCredentialStorageProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type LockProperties.
+func (l LockProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "level", l.Level)
+	populate(objectMap, "notes", l.Notes)
+	populate(objectMap, "provisioningState", l.ProvisioningState)
+	populate(objectMap, "scope", l.Scope)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type LockProperties.
+func (l *LockProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", l, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "level":
+				err = unpopulate(val, "Level", &l.Level)
+			delete(rawMsg, key)
+		case "notes":
+				err = unpopulate(val, "Notes", &l.Notes)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &l.ProvisioningState)
+			delete(rawMsg, key)
+		case "scope":
+				err = unpopulate(val, "Scope", &l.Scope)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", l, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type LockResource.
+func (l LockResource) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "id", l.ID)
+	populate(objectMap, "name", l.Name)
+	populate(objectMap, "properties", l.Properties)
+	populate(objectMap, "systemData", l.SystemData)
+	populate(objectMap, "type", l.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type LockResource.
+func (l *LockResource) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", l, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "id":
+				err = unpopulate(val, "ID", &l.ID)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &l.Name)
+			delete(rawMsg, key)
+		case "properties":
+				err = unpopulate(val, "Properties", &l.Properties)
+			delete(rawMsg, key)
+		case "systemData":
+				err = unpopulate(val, "SystemData", &l.SystemData)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &l.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", l, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type LockResourceListResult.
+func (l LockResourceListResult) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "nextLink", l.NextLink)
+	populate(objectMap, "value", l.Value)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type LockResourceListResult.
+func (l *LockResourceListResult) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", l, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "nextLink":
+				err = unpopulate(val, "NextLink", &l.NextLink)
+			delete(rawMsg, key)
+		case "value":
+				err = unpopulate(val, "Value", &l.Value)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", l, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type PlaneResource.
 func (p PlaneResource) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -889,6 +1637,7 @@ func (r *Resource) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type ResourceGroupProperties.
 func (r ResourceGroupProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populateTimeRFC3339(objectMap, "deletedTime", r.DeletedTime)
 	populate(objectMap, "provisioningState", r.ProvisioningState)
 	return json.Marshal(objectMap)
 }
@@ -902,6 +1651,9 @@ func (r *ResourceGroupProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "deletedTime":
+				err = unpopulateTimeRFC3339(val, "DeletedTime", &r.DeletedTime)
+			delete(rawMsg, key)
 		case "provisioningState":
 				err = unpopulate(val, "ProvisioningState", &r.ProvisioningState)
 			delete(rawMsg, key)
@@ -7,6 +7,20 @@
 
 package v20231001preview
 
+import "time"
+
+// AuditRecordsClientListOptions contains the optional parameters for the AuditRecordsClient.NewListPager method.
+type AuditRecordsClientListOptions struct {
+	// Only return records created at or before this time.
+	EndTime *time.Time
+
+	// Only return records of resources in this scope, for example /planes/radius/local/resourceGroups/rg1.
+	Scope *string
+
+	// Only return records created at or after this time.
+	StartTime *time.Time
+}
+
 // AwsCredentialsClientCreateOrUpdateOptions contains the optional parameters for the AwsCredentialsClient.CreateOrUpdate
 // method.
 type AwsCredentialsClientCreateOrUpdateOptions struct {
@@ -28,6 +42,11 @@ type AwsCredentialsClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// AwsCredentialsClientRotateOptions contains the optional parameters for the AwsCredentialsClient.Rotate method.
+type AwsCredentialsClientRotateOptions struct {
+	// placeholder for future optional parameters
+}
+
 // AwsCredentialsClientUpdateOptions contains the optional parameters for the AwsCredentialsClient.Update method.
 type AwsCredentialsClientUpdateOptions struct {
 	// placeholder for future optional parameters
@@ -54,11 +73,56 @@ type AzureCredentialsClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// AzureCredentialsClientRotateOptions contains the optional parameters for the AzureCredentialsClient.Rotate method.
+type AzureCredentialsClientRotateOptions struct {
+	// placeholder for future optional parameters
+}
+
 // AzureCredentialsClientUpdateOptions contains the optional parameters for the AzureCredentialsClient.Update method.
 type AzureCredentialsClientUpdateOptions struct {
 	// placeholder for future optional parameters
 }
 
+// EventSubscriptionsClientCreateOrUpdateOptions contains the optional parameters for the EventSubscriptionsClient.CreateOrUpdate method.
+type EventSubscriptionsClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// EventSubscriptionsClientDeleteOptions contains the optional parameters for the EventSubscriptionsClient.Delete method.
+type EventSubscriptionsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// EventSubscriptionsClientGetOptions contains the optional parameters for the EventSubscriptionsClient.Get method.
+type EventSubscriptionsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// EventSubscriptionsClientListOptions contains the optional parameters for the EventSubscriptionsClient.NewListPager method.
+type EventSubscriptionsClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientCreateOrUpdateOptions contains the optional parameters for the LocksClient.CreateOrUpdate method.
+type LocksClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientDeleteOptions contains the optional parameters for the LocksClient.Delete method.
+type LocksClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientGetOptions contains the optional parameters for the LocksClient.Get method.
+type LocksClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientListOptions contains the optional parameters for the LocksClient.NewListPager method.
+type LocksClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
 // PlanesClientBeginCreateOrUpdateOptions contains the optional parameters for the PlanesClient.BeginCreateOrUpdate method.
 type PlanesClientBeginCreateOrUpdateOptions struct {
 	// Resumes the LRO from the provided token.
@@ -110,6 +174,12 @@ type ResourceGroupsClientGetOptions struct {
 
 // ResourceGroupsClientListOptions contains the optional parameters for the ResourceGroupsClient.NewListPager method.
 type ResourceGroupsClientListOptions struct {
+	// Include the soft-deleted resource groups in the list.
+	IncludeDeleted *bool
+}
+
+// ResourceGroupsClientRestoreOptions contains the optional parameters for the ResourceGroupsClient.Restore method.
+type ResourceGroupsClientRestoreOptions struct {
 	// placeholder for future optional parameters
 }
 
@@ -120,6 +190,10 @@ type ResourceGroupsClientUpdateOptions struct {
 
 // ResourcesClientListOptions contains the optional parameters for the ResourcesClient.NewListPager method.
 type ResourcesClientListOptions struct {
-	// placeholder for future optional parameters
+	// List only the resources that have a tag with this name.
+	TagName *string
+
+	// List only the resources whose tag named by tagName has this value. Requires tagName.
+	TagValue *string
 }
 
@@ -21,6 +21,10 @@ func unmarshalAwsCredentialPropertiesClassification(rawMsg json.RawMessage) (Aws
 	switch m["kind"] {
 	case string(AWSCredentialKindAccessKey):
 		b = &AwsAccessKeyCredentialProperties{}
+	case string(AWSCredentialKindAssumeRole):
+		b = &AwsAssumeRoleCredentialProperties{}
+	case string(AWSCredentialKindIRSA):
+		b = &AwsIRSACredentialProperties{}
 	default:
 		b = &AwsCredentialProperties{}
 	}
@@ -40,8 +44,12 @@ func unmarshalAzureCredentialPropertiesClassification(rawMsg json.RawMessage) (A
 	}
 	var b AzureCredentialPropertiesClassification
 	switch m["kind"] {
+	case string(AzureCredentialKindManagedIdentity):
+		b = &AzureManagedIdentityProperties{}
 	case string(AzureCredentialKindServicePrincipal):
 		b = &AzureServicePrincipalProperties{}
+	case string(AzureCredentialKindWorkloadIdentity):
+		b = &AzureWorkloadIdentityProperties{}
 	default:
 		b = &AzureCredentialProperties{}
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -256,6 +257,9 @@ func (client *ResourceGroupsClient) listCreateRequest(ctx context.Context, plane
 	}
 	reqQP := req.Raw().URL.Query()
 	reqQP.Set("api-version", "2023-10-01-preview")
+	if options != nil && options.IncludeDeleted != nil {
+		reqQP.Set("includeDeleted", strconv.FormatBool(*options.IncludeDeleted))
+	}
 	req.Raw().URL.RawQuery = reqQP.Encode()
 	req.Raw().Header["Accept"] = []string{"application/json"}
 	return req, nil
@@ -270,6 +274,64 @@ func (client *ResourceGroupsClient) listHandleResponse(resp *http.Response) (Res
 	return result, nil
 }
 
+// Restore - Restore a soft-deleted resource group and the resources that were deleted with it
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeType - The plane type.
+//   - planeName - The name of the plane
+//   - resourceGroupName - The name of resource group
+//   - options - ResourceGroupsClientRestoreOptions contains the optional parameters for the ResourceGroupsClient.Restore method.
+func (client *ResourceGroupsClient) Restore(ctx context.Context, planeType string, planeName string, resourceGroupName string, options *ResourceGroupsClientRestoreOptions) (ResourceGroupsClientRestoreResponse, error) {
+	var err error
+	req, err := client.restoreCreateRequest(ctx, planeType, planeName, resourceGroupName, options)
+	if err != nil {
+		return ResourceGroupsClientRestoreResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return ResourceGroupsClientRestoreResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return ResourceGroupsClientRestoreResponse{}, err
+	}
+	resp, err := client.restoreHandleResponse(httpResp)
+	return resp, err
+}
+
+// restoreCreateRequest creates the Restore request.
+func (client *ResourceGroupsClient) restoreCreateRequest(ctx context.Context, planeType string, planeName string, resourceGroupName string, options *ResourceGroupsClientRestoreOptions) (*policy.Request, error) {
+	urlPath := "/planes/{planeType}/{planeName}/resourcegroups/{resourceGroupName}/restore"
+	if planeType == "" {
+		return nil, errors.New("parameter planeType cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeType}", url.PathEscape(planeType))
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", planeName)
+	if resourceGroupName == "" {
+		return nil, errors.New("parameter resourceGroupName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{resourceGroupName}", url.PathEscape(resourceGroupName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// restoreHandleResponse handles the Restore response.
+func (client *ResourceGroupsClient) restoreHandleResponse(resp *http.Response) (ResourceGroupsClientRestoreResponse, error) {
+	result := ResourceGroupsClientRestoreResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.ResourceGroupResource); err != nil {
+		return ResourceGroupsClientRestoreResponse{}, err
+	}
+	return result, nil
+}
+
 // Update - Update a resource group
 // If the operation fails it returns an *azcore.ResponseError type.
 //
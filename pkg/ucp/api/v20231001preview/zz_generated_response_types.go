@@ -7,6 +7,12 @@
 
 package v20231001preview
 
+// AuditRecordsClientListResponse contains the response from method AuditRecordsClient.NewListPager.
+type AuditRecordsClientListResponse struct {
+	// The response of a AuditRecordResource list operation.
+	AuditRecordResourceListResult
+}
+
 // AwsCredentialsClientCreateOrUpdateResponse contains the response from method AwsCredentialsClient.CreateOrUpdate.
 type AwsCredentialsClientCreateOrUpdateResponse struct {
 	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
@@ -30,6 +36,12 @@ type AwsCredentialsClientListResponse struct {
 	AwsCredentialResourceListResult
 }
 
+// AwsCredentialsClientRotateResponse contains the response from method AwsCredentialsClient.Rotate.
+type AwsCredentialsClientRotateResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	AwsCredentialResource
+}
+
 // AwsCredentialsClientUpdateResponse contains the response from method AwsCredentialsClient.Update.
 type AwsCredentialsClientUpdateResponse struct {
 	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
@@ -59,12 +71,64 @@ type AzureCredentialsClientListResponse struct {
 	AzureCredentialResourceListResult
 }
 
+// AzureCredentialsClientRotateResponse contains the response from method AzureCredentialsClient.Rotate.
+type AzureCredentialsClientRotateResponse struct {
+	// Represents Azure Credential Resource
+	AzureCredentialResource
+}
+
 // AzureCredentialsClientUpdateResponse contains the response from method AzureCredentialsClient.Update.
 type AzureCredentialsClientUpdateResponse struct {
 	// Represents Azure Credential Resource
 	AzureCredentialResource
 }
 
+// EventSubscriptionsClientCreateOrUpdateResponse contains the response from method EventSubscriptionsClient.CreateOrUpdate.
+type EventSubscriptionsClientCreateOrUpdateResponse struct {
+	// The event subscription resource
+	EventSubscriptionResource
+}
+
+// EventSubscriptionsClientDeleteResponse contains the response from method EventSubscriptionsClient.Delete.
+type EventSubscriptionsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// EventSubscriptionsClientGetResponse contains the response from method EventSubscriptionsClient.Get.
+type EventSubscriptionsClientGetResponse struct {
+	// The event subscription resource
+	EventSubscriptionResource
+}
+
+// EventSubscriptionsClientListResponse contains the response from method EventSubscriptionsClient.NewListPager.
+type EventSubscriptionsClientListResponse struct {
+	// The response of a EventSubscriptionResource list operation.
+	EventSubscriptionResourceListResult
+}
+
+// LocksClientCreateOrUpdateResponse contains the response from method LocksClient.CreateOrUpdate.
+type LocksClientCreateOrUpdateResponse struct {
+	// The management lock resource
+	LockResource
+}
+
+// LocksClientDeleteResponse contains the response from method LocksClient.Delete.
+type LocksClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// LocksClientGetResponse contains the response from method LocksClient.Get.
+type LocksClientGetResponse struct {
+	// The management lock resource
+	LockResource
+}
+
+// LocksClientListResponse contains the response from method LocksClient.NewListPager.
+type LocksClientListResponse struct {
+	// The response of a LockResource list operation.
+	LockResourceListResult
+}
+
 // PlanesClientCreateOrUpdateResponse contains the response from method PlanesClient.BeginCreateOrUpdate.
 type PlanesClientCreateOrUpdateResponse struct {
 	// The plane resource
@@ -123,6 +187,12 @@ type ResourceGroupsClientListResponse struct {
 	ResourceGroupResourceListResult
 }
 
+// ResourceGroupsClientRestoreResponse contains the response from method ResourceGroupsClient.Restore.
+type ResourceGroupsClientRestoreResponse struct {
+	// The resource group resource
+	ResourceGroupResource
+}
+
 // ResourceGroupsClientUpdateResponse contains the response from method ResourceGroupsClient.Update.
 type ResourceGroupsClientUpdateResponse struct {
 	// The resource group resource
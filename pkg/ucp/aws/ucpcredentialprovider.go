@@ -20,11 +20,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 
 	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
@@ -33,8 +38,26 @@ var _ aws.CredentialsProvider = (*UCPCredentialProvider)(nil)
 const (
 	// DefaultExpireDuration is the default access key expiry duration.
 	DefaultExpireDuration = time.Minute * time.Duration(15)
+
+	// defaultCredentialName is the name of the UCP credential used by the AWS plane.
+	defaultCredentialName = "default"
+
+	// MaxSourceCredentialDepth is the maximum number of AssumeRole credentials that can be chained together.
+	MaxSourceCredentialDepth = 5
+
+	// webIdentityTokenFileEnv is the environment variable set by EKS Pod Identity webhook for IRSA.
+	webIdentityTokenFileEnv = "AWS_WEB_IDENTITY_TOKEN_FILE"
+
+	// defaultSTSRegion is the region used for STS when AWS_REGION is not set.
+	defaultSTSRegion = "us-east-1"
 )
 
+// STSClient is the subset of the AWS STS API used to exchange UCP credentials for short-lived credentials.
+type STSClient interface {
+	stscreds.AssumeRoleAPIClient
+	stscreds.AssumeRoleWithWebIdentityAPIClient
+}
+
 // UCPCredentialProvider is the implementation of aws.CredentialsProvider
 // to retrieve credentials for AWS SDK via UCP credentials.
 type UCPCredentialProvider struct {
@@ -48,45 +71,178 @@ type UCPCredentialOptions struct {
 
 	// Duration is the duration for the secret keys.
 	Duration time.Duration
+
+	// NewSTSClient creates the STS client used to issue short-lived credentials for IRSA and AssumeRole
+	// credentials. credentials signs the STS requests and is nil for web identity federation.
+	NewSTSClient func(credentials aws.CredentialsProvider) STSClient
+
+	// AllowAmbientCredentials allows AssumeRole credentials without a source credential to assume the role with the
+	// ambient credentials of UCP (environment variables, pod identity, etc.).
+	AllowAmbientCredentials bool
 }
 
 // NewUCPCredentialProvider creates UCPCredentialProvider provider to fetch Secret Access key using UCP credential APIs.
-func NewUCPCredentialProvider(provider sdk_cred.CredentialProvider[sdk_cred.AWSCredential], expireDuration time.Duration) *UCPCredentialProvider {
+// allowAmbientCredentials allows AssumeRole credentials without a source credential to use the identity of UCP.
+func NewUCPCredentialProvider(provider sdk_cred.CredentialProvider[sdk_cred.AWSCredential], expireDuration time.Duration, allowAmbientCredentials bool) *UCPCredentialProvider {
 	if expireDuration == 0 {
 		expireDuration = DefaultExpireDuration
 	}
 
 	o := UCPCredentialOptions{
-		Provider: provider,
-		Duration: expireDuration,
+		Provider:                provider,
+		Duration:                expireDuration,
+		NewSTSClient:            newSTSClient,
+		AllowAmbientCredentials: allowAmbientCredentials,
 	}
 
 	return &UCPCredentialProvider{options: o}
 }
 
-// Retrieve fetches credentials from an external provider, checks if they are valid, logs the AccessKeyID, and returns the
-// credentials with an expiration time set. If the credentials are invalid, an error is returned.
+// Retrieve fetches the default UCP AWS credential and resolves it to AWS credentials. Access keys are returned
+// as-is, while IRSA and AssumeRole credentials are exchanged for short-lived credentials using STS. The
+// credentials are returned with an expiration time set so that the AWS SDK calls Retrieve again to rotate them.
 func (c *UCPCredentialProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
-	logger := ucplog.FromContextOrDiscard(ctx)
-	s, err := c.options.Provider.Fetch(ctx, sdk_cred.AWSPublic, "default")
+	return c.retrieve(ctx, defaultCredentialName, 0)
+}
+
+func (c *UCPCredentialProvider) retrieve(ctx context.Context, name string, depth int) (aws.Credentials, error) {
+	if depth > MaxSourceCredentialDepth {
+		return aws.Credentials{}, fmt.Errorf("credential %q exceeds the maximum source credential depth of %d", name, MaxSourceCredentialDepth)
+	}
+
+	s, err := c.options.Provider.Fetch(ctx, sdk_cred.AWSPublic, name)
 	if err != nil {
 		return aws.Credentials{}, err
 	}
 
-	if s.AccessKeyID == "" || s.SecretAccessKey == "" {
-		return aws.Credentials{}, errors.New("invalid access key info")
+	return c.resolve(ctx, s, depth)
+}
+
+// RetrieveCredential resolves the given UCP AWS credential to AWS credentials in the same way as Retrieve. The
+// source credential of an AssumeRole credential is fetched from the UCP credential provider.
+func (c *UCPCredentialProvider) RetrieveCredential(ctx context.Context, s *sdk_cred.AWSCredential) (aws.Credentials, error) {
+	return c.resolve(ctx, s, 0)
+}
+
+func (c *UCPCredentialProvider) resolve(ctx context.Context, s *sdk_cred.AWSCredential, depth int) (aws.Credentials, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	switch s.GetKind() {
+	case datamodel.AWSCredentialKind:
+		if s.AccessKeyID == "" || s.SecretAccessKey == "" {
+			return aws.Credentials{}, errors.New("invalid access key info")
+		}
+
+		logger.Info(fmt.Sprintf("Retreived AWS Credential - AccessKeyID: %s", s.AccessKeyID))
+
+		value := aws.Credentials{
+			AccessKeyID:     s.AccessKeyID,
+			SecretAccessKey: s.SecretAccessKey,
+			Source:          "radiusucp",
+			CanExpire:       true,
+			// Enables AWS SDK to fetch (rotate) access keys by calling Retrieve() after Expires.
+			Expires: time.Now().UTC().Add(c.options.Duration),
+		}
+
+		return value, nil
+
+	case datamodel.AWSIRSACredentialKind:
+		if s.RoleARN == "" {
+			return aws.Credentials{}, errors.New("invalid IRSA credential info: roleARN is required")
+		}
+
+		tokenFile, err := sdk_cred.ResolveTokenFile(s.TokenFile, webIdentityTokenFileEnv)
+		if err != nil {
+			return aws.Credentials{}, fmt.Errorf("invalid IRSA credential info: %w", err)
+		}
+		if tokenFile == "" {
+			return aws.Credentials{}, fmt.Errorf("invalid IRSA credential info: tokenFile is not set and %s is empty", webIdentityTokenFileEnv)
+		}
+
+		logger.Info(fmt.Sprintf("Retrieving AWS Credential using web identity - RoleARN: %s", s.RoleARN))
+
+		p := stscreds.NewWebIdentityRoleProvider(c.options.NewSTSClient(nil), s.RoleARN, stscreds.IdentityTokenFile(tokenFile), func(o *stscreds.WebIdentityRoleOptions) {
+			if s.SessionName != "" {
+				o.RoleSessionName = s.SessionName
+			}
+		})
+
+		return c.retrieveShortLived(ctx, p)
+
+	case datamodel.AWSAssumeRoleCredentialKind:
+		if s.RoleARN == "" {
+			return aws.Credentials{}, errors.New("invalid AssumeRole credential info: roleARN is required")
+		}
+
+		var source aws.CredentialsProvider
+		if s.SourceCredential != "" {
+			sourceName := s.SourceCredential
+			source = aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+				return c.retrieve(ctx, sourceName, depth+1)
+			})
+		} else {
+			// Without a source credential, fall back to the ambient credentials of UCP (environment, pod identity, etc.).
+			// Any caller of the UCP API can create credentials, so this is only allowed when the operator enables it.
+			if !c.options.AllowAmbientCredentials {
+				return aws.Credentials{}, errors.New("invalid AssumeRole credential info: sourceCredential is required because the ambient credentials of UCP are not allowed")
+			}
+
+			cfg, err := config.LoadDefaultConfig(ctx)
+			if err != nil {
+				return aws.Credentials{}, err
+			}
+			source = cfg.Credentials
+		}
+
+		logger.Info(fmt.Sprintf("Retrieving AWS Credential using assume role - RoleARN: %s", s.RoleARN))
+
+		p := stscreds.NewAssumeRoleProvider(c.options.NewSTSClient(source), s.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			if s.ExternalID != "" {
+				o.ExternalID = aws.String(s.ExternalID)
+			}
+			if s.SessionName != "" {
+				o.RoleSessionName = s.SessionName
+			}
+		})
+
+		return c.retrieveShortLived(ctx, p)
+
+	default:
+		return aws.Credentials{}, fmt.Errorf("unsupported AWS credential kind %q", s.GetKind())
 	}
+}
 
-	logger.Info(fmt.Sprintf("Retreived AWS Credential - AccessKeyID: %s", s.AccessKeyID))
+// retrieveShortLived retrieves credentials from STS and caps their expiry to the configured duration so that
+// a credential change in UCP is picked up even when STS issues longer-lived credentials.
+func (c *UCPCredentialProvider) retrieveShortLived(ctx context.Context, p aws.CredentialsProvider) (aws.Credentials, error) {
+	value, err := p.Retrieve(ctx)
+	if err != nil {
+		return aws.Credentials{}, err
+	}
 
-	value := aws.Credentials{
-		AccessKeyID:     s.AccessKeyID,
-		SecretAccessKey: s.SecretAccessKey,
-		Source:          "radiusucp",
-		CanExpire:       true,
-		// Enables AWS SDK to fetch (rotate) access keys by calling Retrieve() after Expires.
-		Expires: time.Now().UTC().Add(c.options.Duration),
+	expires := time.Now().UTC().Add(c.options.Duration)
+	if !value.CanExpire || value.Expires.After(expires) {
+		value.CanExpire = true
+		value.Expires = expires
 	}
+	value.Source = "radiusucp"
 
 	return value, nil
 }
+
+// newSTSClient creates an STS client in the region configured by AWS_REGION.
+func newSTSClient(credentials aws.CredentialsProvider) STSClient {
+	region := os.Getenv("AWS_REGION")
+	if region == "" {
+		region = defaultSTSRegion
+	}
+
+	options := sts.Options{Region: region}
+	if credentials == nil {
+		options.Credentials = aws.AnonymousCredentials{}
+	} else {
+		options.Credentials = aws.NewCredentialsCache(credentials)
+	}
+
+	return sts.New(options)
+}
@@ -19,21 +19,33 @@ package aws
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
 	"github.com/stretchr/testify/require"
 
 	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 )
 
 type mockProvider struct {
 	fakeCredential *sdk_cred.AWSCredential
+
+	// credentials holds additional credentials by name, used for chained credentials.
+	credentials map[string]*sdk_cred.AWSCredential
 }
 
 // Fetch gets the AWS credentials from secret storage. It takes in a context, planeName and name and returns
 // an AWSCredential or an error if the fakeCredential is nil.
 func (p *mockProvider) Fetch(ctx context.Context, planeName, name string) (*sdk_cred.AWSCredential, error) {
+	if c, ok := p.credentials[name]; ok {
+		return c, nil
+	}
 	if p.fakeCredential == nil {
 		return nil, errors.New("failed to fetch credential")
 	}
@@ -50,14 +62,14 @@ func newMockProvider() *mockProvider {
 }
 
 func TestNewUCPCredentialProvider(t *testing.T) {
-	p := NewUCPCredentialProvider(newMockProvider(), 0)
+	p := NewUCPCredentialProvider(newMockProvider(), 0, false)
 	require.Equal(t, DefaultExpireDuration, p.options.Duration)
 }
 
 func TestRetrieve(t *testing.T) {
 	t.Run("invalid credential", func(t *testing.T) {
 		p := newMockProvider()
-		cp := NewUCPCredentialProvider(p, DefaultExpireDuration)
+		cp := NewUCPCredentialProvider(p, DefaultExpireDuration, false)
 		p.fakeCredential.AccessKeyID = ""
 
 		_, err := cp.Retrieve(context.TODO())
@@ -66,7 +78,7 @@ func TestRetrieve(t *testing.T) {
 
 	t.Run("valid credential", func(t *testing.T) {
 		p := newMockProvider()
-		cp := NewUCPCredentialProvider(p, DefaultExpireDuration)
+		cp := NewUCPCredentialProvider(p, DefaultExpireDuration, false)
 
 		expectedExpiry := time.Now().UTC().Add(DefaultExpireDuration)
 		cred, err := cp.Retrieve(context.TODO())
@@ -80,3 +92,211 @@ func TestRetrieve(t *testing.T) {
 		require.GreaterOrEqual(t, cred.Expires.Unix(), expectedExpiry.Unix())
 	})
 }
+
+type fakeSTSClient struct {
+	credentials aws.CredentialsProvider
+
+	assumeRoleInput   *sts.AssumeRoleInput
+	webIdentityInput  *sts.AssumeRoleWithWebIdentityInput
+	sourceAccessKeyID string
+	expiration        time.Time
+}
+
+func (c *fakeSTSClient) AssumeRole(ctx context.Context, params *sts.AssumeRoleInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+	c.assumeRoleInput = params
+	source, err := c.credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.sourceAccessKeyID = source.AccessKeyID
+
+	return &sts.AssumeRoleOutput{Credentials: c.stsCredentials("assumed")}, nil
+}
+
+func (c *fakeSTSClient) AssumeRoleWithWebIdentity(ctx context.Context, params *sts.AssumeRoleWithWebIdentityInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleWithWebIdentityOutput, error) {
+	c.webIdentityInput = params
+	return &sts.AssumeRoleWithWebIdentityOutput{Credentials: c.stsCredentials("webidentity")}, nil
+}
+
+func (c *fakeSTSClient) stsCredentials(prefix string) *ststypes.Credentials {
+	return &ststypes.Credentials{
+		AccessKeyId:     aws.String(prefix + "id"),
+		SecretAccessKey: aws.String(prefix + "secret"),
+		SessionToken:    aws.String(prefix + "token"),
+		Expiration:      aws.Time(c.expiration),
+	}
+}
+
+func newFakeSTSProvider(p *mockProvider, client *fakeSTSClient) *UCPCredentialProvider {
+	cp := NewUCPCredentialProvider(p, DefaultExpireDuration, false)
+	cp.options.NewSTSClient = func(credentials aws.CredentialsProvider) STSClient {
+		client.credentials = credentials
+		return client
+	}
+	return cp
+}
+
+func TestRetrieve_IRSA(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("jwt"), 0600))
+	t.Setenv(sdk_cred.AllowedTokenFilesEnv, tokenFile)
+
+	p := &mockProvider{
+		fakeCredential: &sdk_cred.AWSCredential{
+			Kind:        datamodel.AWSIRSACredentialKind,
+			RoleARN:     "arn:aws:iam::000000000000:role/radius",
+			TokenFile:   tokenFile,
+			SessionName: "radius",
+		},
+	}
+	client := &fakeSTSClient{expiration: time.Now().UTC().Add(time.Hour)}
+	cp := newFakeSTSProvider(p, client)
+
+	expectedExpiry := time.Now().UTC().Add(DefaultExpireDuration)
+	cred, err := cp.Retrieve(context.TODO())
+	require.NoError(t, err)
+
+	require.Equal(t, "webidentityid", cred.AccessKeyID)
+	require.Equal(t, "webidentitysecret", cred.SecretAccessKey)
+	require.Equal(t, "webidentitytoken", cred.SessionToken)
+	require.Equal(t, "radiusucp", cred.Source)
+	require.True(t, cred.CanExpire)
+	require.GreaterOrEqual(t, cred.Expires.Unix(), expectedExpiry.Unix())
+	require.Less(t, cred.Expires.Unix(), client.expiration.Unix())
+
+	require.Nil(t, client.credentials)
+	require.Equal(t, "arn:aws:iam::000000000000:role/radius", aws.ToString(client.webIdentityInput.RoleArn))
+	require.Equal(t, "radius", aws.ToString(client.webIdentityInput.RoleSessionName))
+	require.Equal(t, "jwt", aws.ToString(client.webIdentityInput.WebIdentityToken))
+}
+
+func TestRetrieve_IRSA_WebhookTokenFile(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("jwt"), 0600))
+	t.Setenv(webIdentityTokenFileEnv, tokenFile)
+
+	for _, credentialTokenFile := range []string{"", tokenFile} {
+		p := &mockProvider{
+			fakeCredential: &sdk_cred.AWSCredential{
+				Kind:      datamodel.AWSIRSACredentialKind,
+				RoleARN:   "arn:aws:iam::000000000000:role/radius",
+				TokenFile: credentialTokenFile,
+			},
+		}
+		client := &fakeSTSClient{expiration: time.Now().UTC().Add(time.Hour)}
+		cp := newFakeSTSProvider(p, client)
+
+		_, err := cp.Retrieve(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, "jwt", aws.ToString(client.webIdentityInput.WebIdentityToken))
+	}
+}
+
+func TestRetrieve_IRSA_TokenFileNotAllowed(t *testing.T) {
+	t.Setenv(webIdentityTokenFileEnv, filepath.Join(t.TempDir(), "token"))
+	t.Setenv(sdk_cred.AllowedTokenFilesEnv, "")
+
+	tokenFile := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("not a token"), 0600))
+
+	p := &mockProvider{
+		fakeCredential: &sdk_cred.AWSCredential{
+			Kind:      datamodel.AWSIRSACredentialKind,
+			RoleARN:   "arn:aws:iam::000000000000:role/radius",
+			TokenFile: tokenFile,
+		},
+	}
+	client := &fakeSTSClient{}
+	cp := newFakeSTSProvider(p, client)
+
+	_, err := cp.Retrieve(context.TODO())
+	require.ErrorContains(t, err, "is not allowed")
+	require.Nil(t, client.webIdentityInput)
+}
+
+func TestRetrieve_IRSA_MissingTokenFile(t *testing.T) {
+	t.Setenv(webIdentityTokenFileEnv, "")
+	p := &mockProvider{
+		fakeCredential: &sdk_cred.AWSCredential{
+			Kind:    datamodel.AWSIRSACredentialKind,
+			RoleARN: "arn:aws:iam::000000000000:role/radius",
+		},
+	}
+	cp := newFakeSTSProvider(p, &fakeSTSClient{})
+
+	_, err := cp.Retrieve(context.TODO())
+	require.ErrorContains(t, err, webIdentityTokenFileEnv)
+}
+
+func TestRetrieve_AssumeRole(t *testing.T) {
+	t.Run("chained source credential", func(t *testing.T) {
+		p := &mockProvider{
+			credentials: map[string]*sdk_cred.AWSCredential{
+				"default": {
+					Kind:             datamodel.AWSAssumeRoleCredentialKind,
+					RoleARN:          "arn:aws:iam::000000000000:role/radius",
+					ExternalID:       "external",
+					SessionName:      "radius",
+					SourceCredential: "base",
+				},
+				"base": {
+					AccessKeyID:     "baseid",
+					SecretAccessKey: "basesecret",
+				},
+			},
+		}
+		client := &fakeSTSClient{expiration: time.Now().UTC().Add(5 * time.Minute)}
+		cp := newFakeSTSProvider(p, client)
+
+		cred, err := cp.Retrieve(context.TODO())
+		require.NoError(t, err)
+
+		require.Equal(t, "assumedid", cred.AccessKeyID)
+		require.Equal(t, "assumedtoken", cred.SessionToken)
+		require.Equal(t, client.expiration.Unix(), cred.Expires.Unix())
+
+		require.Equal(t, "baseid", client.sourceAccessKeyID)
+		require.Equal(t, "arn:aws:iam::000000000000:role/radius", aws.ToString(client.assumeRoleInput.RoleArn))
+		require.Equal(t, "external", aws.ToString(client.assumeRoleInput.ExternalId))
+		require.Equal(t, "radius", aws.ToString(client.assumeRoleInput.RoleSessionName))
+	})
+
+	t.Run("ambient credentials are not allowed", func(t *testing.T) {
+		p := &mockProvider{
+			fakeCredential: &sdk_cred.AWSCredential{
+				Kind:    datamodel.AWSAssumeRoleCredentialKind,
+				RoleARN: "arn:aws:iam::000000000000:role/radius",
+			},
+		}
+		client := &fakeSTSClient{}
+		cp := newFakeSTSProvider(p, client)
+
+		_, err := cp.Retrieve(context.TODO())
+		require.ErrorContains(t, err, "sourceCredential is required")
+		require.Nil(t, client.assumeRoleInput)
+	})
+
+	t.Run("source credential cycle", func(t *testing.T) {
+		p := &mockProvider{
+			credentials: map[string]*sdk_cred.AWSCredential{
+				"default": {
+					Kind:             datamodel.AWSAssumeRoleCredentialKind,
+					RoleARN:          "arn:aws:iam::000000000000:role/a",
+					SourceCredential: "other",
+				},
+				"other": {
+					Kind:             datamodel.AWSAssumeRoleCredentialKind,
+					RoleARN:          "arn:aws:iam::000000000000:role/b",
+					SourceCredential: "default",
+				},
+			},
+		}
+		cp := NewUCPCredentialProvider(p, DefaultExpireDuration, false)
+		cp.options.NewSTSClient = func(credentials aws.CredentialsProvider) STSClient {
+			return &fakeSTSClient{credentials: credentials}
+		}
+
+		_, err := cp.Retrieve(context.TODO())
+		require.ErrorContains(t, err, "maximum source credential depth")
+	})
+}
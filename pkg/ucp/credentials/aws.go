@@ -25,6 +25,7 @@ import (
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/to"
 	ucpapi "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/secret"
 	"github.com/radius-project/radius/pkg/ucp/secret/provider"
 )
@@ -51,7 +52,7 @@ func NewAWSCredentialProvider(provider *provider.SecretProvider, ucpConn sdk.Con
 	}, nil
 }
 
-// Fetch fetches the AWS IAM access keys from UCP and then from an internal storage (e.g.
+// Fetch fetches the AWS credential from UCP and then from an internal storage (e.g.
 // Kubernetes secret store). It returns an AWSCredential struct or an error if the fetch fails.
 func (p *AWSCredentialProvider) Fetch(ctx context.Context, planeName, name string) (*AWSCredential, error) {
 	// 1. Fetch the secret name of AWS IAM access keys from UCP.
@@ -62,15 +63,27 @@ func (p *AWSCredentialProvider) Fetch(ctx context.Context, planeName, name strin
 
 	// We support only kubernetes secret, but we may support multiple secret stores.
 	var storage *ucpapi.InternalCredentialStorageProperties
+	var kind string
 
 	switch p := cred.Properties.(type) {
 	case *ucpapi.AwsAccessKeyCredentialProperties:
-		switch c := p.Storage.(type) {
-		case *ucpapi.InternalCredentialStorageProperties:
-			storage = c
-		default:
+		c, ok := p.Storage.(*ucpapi.InternalCredentialStorageProperties)
+		if !ok {
 			return nil, errors.New("invalid AWSAccessKeyCredentialProperties")
 		}
+		storage, kind = c, ucp_dm.AWSCredentialKind
+	case *ucpapi.AwsIRSACredentialProperties:
+		c, ok := p.Storage.(*ucpapi.InternalCredentialStorageProperties)
+		if !ok {
+			return nil, errors.New("invalid AWSIRSACredentialProperties")
+		}
+		storage, kind = c, ucp_dm.AWSIRSACredentialKind
+	case *ucpapi.AwsAssumeRoleCredentialProperties:
+		c, ok := p.Storage.(*ucpapi.InternalCredentialStorageProperties)
+		if !ok {
+			return nil, errors.New("invalid AWSAssumeRoleCredentialProperties")
+		}
+		storage, kind = c, ucp_dm.AWSAssumeRoleCredentialKind
 	default:
 		return nil, errors.New("invalid InternalCredentialStorageProperties")
 	}
@@ -91,5 +104,10 @@ func (p *AWSCredentialProvider) Fetch(ctx context.Context, planeName, name strin
 		return nil, errors.New("failed to get credential info: " + err.Error())
 	}
 
+	// Secrets saved before credential kinds were introduced do not record the kind.
+	if s.Kind == "" {
+		s.Kind = kind
+	}
+
 	return &s, nil
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/to"
 	ucpapi "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/secret"
 	"github.com/radius-project/radius/pkg/ucp/secret/provider"
 )
@@ -51,10 +52,10 @@ func NewAzureCredentialProvider(provider *provider.SecretProvider, ucpConn sdk.C
 	}, nil
 }
 
-// Fetch fetches the Azure service principal credentials from UCP and the internal storage (e.g.
+// Fetch fetches the Azure credentials from UCP and the internal storage (e.g.
 // Kubernetes secret store) and returns an AzureCredential struct. If an error occurs, an error is returned.
 func (p *AzureCredentialProvider) Fetch(ctx context.Context, planeName, name string) (*AzureCredential, error) {
-	// 1. Fetch the secret name of Azure service principal credentials from UCP.
+	// 1. Fetch the secret name of Azure credentials from UCP.
 	cred, err := p.client.Get(ctx, planeName, name, &ucpapi.AzureCredentialsClientGetOptions{})
 	if err != nil {
 		return nil, err
@@ -62,15 +63,27 @@ func (p *AzureCredentialProvider) Fetch(ctx context.Context, planeName, name str
 
 	// We support only kubernetes secret, but we may support multiple secret stores.
 	var storage *ucpapi.InternalCredentialStorageProperties
+	var kind string
 
 	switch p := cred.Properties.(type) {
 	case *ucpapi.AzureServicePrincipalProperties:
-		switch c := p.Storage.(type) {
-		case *ucpapi.InternalCredentialStorageProperties:
-			storage = c
-		default:
+		c, ok := p.Storage.(*ucpapi.InternalCredentialStorageProperties)
+		if !ok {
 			return nil, errors.New("invalid AzureServicePrincipalProperties")
 		}
+		storage, kind = c, ucp_dm.AzureCredentialKind
+	case *ucpapi.AzureWorkloadIdentityProperties:
+		c, ok := p.Storage.(*ucpapi.InternalCredentialStorageProperties)
+		if !ok {
+			return nil, errors.New("invalid AzureWorkloadIdentityProperties")
+		}
+		storage, kind = c, ucp_dm.AzureWorkloadIdentityCredentialKind
+	case *ucpapi.AzureManagedIdentityProperties:
+		c, ok := p.Storage.(*ucpapi.InternalCredentialStorageProperties)
+		if !ok {
+			return nil, errors.New("invalid AzureManagedIdentityProperties")
+		}
+		storage, kind = c, ucp_dm.AzureManagedIdentityCredentialKind
 	default:
 		return nil, errors.New("invalid InternalCredentialStorageProperties")
 	}
@@ -91,5 +104,10 @@ func (p *AzureCredentialProvider) Fetch(ctx context.Context, planeName, name str
 		return nil, errors.New("failed to get credential info: " + err.Error())
 	}
 
+	// Secrets saved before credential kinds were introduced do not record the kind.
+	if s.Kind == "" {
+		s.Kind = kind
+	}
+
 	return &s, nil
 }
@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// AllowedTokenFilesEnv is the environment variable that lists, separated by the OS path list separator, the token
+// files that UCP credentials can reference in addition to the token file set by the identity webhook.
+const AllowedTokenFilesEnv = "RADIUS_ALLOWED_TOKEN_FILES"
+
+// ResolveTokenFile returns the token file to use for a web identity or workload identity credential. An empty
+// tokenFile resolves to the token file set by the identity webhook in the webhookEnv environment variable, which is
+// empty if the webhook is not configured.
+//
+// Credentials can be created by any caller of the UCP API, so a token file is only accepted if it is the token file
+// of the identity webhook or one of the files in AllowedTokenFilesEnv configured by the operator. Otherwise a
+// credential could make Radius read an arbitrary file and send its content to the cloud provider.
+func ResolveTokenFile(tokenFile string, webhookEnv string) (string, error) {
+	webhookTokenFile := os.Getenv(webhookEnv)
+	if tokenFile == "" {
+		return webhookTokenFile, nil
+	}
+
+	allowed := append([]string{webhookTokenFile}, filepath.SplitList(os.Getenv(AllowedTokenFilesEnv))...)
+	for _, file := range allowed {
+		if file != "" && filepath.Clean(file) == filepath.Clean(tokenFile) {
+			return tokenFile, nil
+		}
+	}
+
+	return "", fmt.Errorf("the token file %q is not allowed: it must be the token file set in %s or be listed in %s", tokenFile, webhookEnv, AllowedTokenFilesEnv)
+}
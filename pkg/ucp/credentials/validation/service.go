@@ -0,0 +1,265 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/hosting"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/secret/provider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	defaultInterval      = time.Hour
+	defaultExpiryWarning = 7 * 24 * time.Hour
+
+	// credentialsScope is the root scope of the query for the credentials of all planes.
+	credentialsScope = "/planes"
+)
+
+// errSecretNotFound is the verification error of a credential whose secret does not exist.
+var errSecretNotFound = errors.New("the secret of the credential was not found")
+
+var _ hosting.Service = (*Service)(nil)
+
+// Service periodically validates the UCP credentials of all planes and records the result in their status.
+type Service struct {
+	storageProvider dataprovider.DataStorageProvider
+	secretProvider  *provider.SecretProvider
+	verifier        Verifier
+	interval        time.Duration
+	expiryWarning   time.Duration
+
+	// now returns the current time. It is replaced in tests.
+	now func() time.Time
+}
+
+// NewService creates a Service that verifies the credentials with the given verifier. The RequiredFieldsVerifier is
+// used if verifier is nil. Non-positive durations in the options are replaced with the defaults.
+func NewService(options Options, storageProvider dataprovider.DataStorageProvider, secretProvider *provider.SecretProvider, verifier Verifier) *Service {
+	if verifier == nil {
+		verifier = &RequiredFieldsVerifier{}
+	}
+
+	interval := options.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+
+	expiryWarning := options.ExpiryWarning
+	if expiryWarning <= 0 {
+		expiryWarning = defaultExpiryWarning
+	}
+
+	return &Service{
+		storageProvider: storageProvider,
+		secretProvider:  secretProvider,
+		verifier:        verifier,
+		interval:        interval,
+		expiryWarning:   expiryWarning,
+		now:             time.Now,
+	}
+}
+
+// Name returns the name of the service.
+func (s *Service) Name() string {
+	return "UCP credential validation"
+}
+
+// Run validates the credentials immediately and then at every interval until the context is cancelled.
+func (s *Service) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.ValidateAll(ctx); err != nil {
+			logger.Error(err, "failed to validate credentials")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// ValidateAll validates the Azure and AWS credentials of all planes. The failure to validate one credential is logged
+// and does not prevent the validation of the others.
+func (s *Service) ValidateAll(ctx context.Context) error {
+	secretClient, err := s.secretProvider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, resourceType := range []string{v20231001preview.AzureCredentialType, v20231001preview.AWSCredentialType} {
+		if err := s.validateType(ctx, secretClient, resourceType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) validateType(ctx context.Context, secretClient secret.Client, resourceType string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	client, err := s.storageProvider.GetStorageClient(ctx, resourceType)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.Query(ctx, store.Query{RootScope: credentialsScope, ScopeRecursive: true, ResourceType: resourceType})
+	if err != nil {
+		return err
+	}
+
+	for i := range result.Items {
+		obj := &result.Items[i]
+		if err := s.validate(ctx, client, secretClient, obj, resourceType); err != nil {
+			logger.Error(err, "failed to validate credential", "resourceId", obj.ID)
+		}
+	}
+
+	return nil
+}
+
+// validate verifies one credential and saves its status.
+func (s *Service) validate(ctx context.Context, client store.StorageClient, secretClient secret.Client, obj *store.Object, resourceType string) error {
+	var data any
+	var properties credentialProperties
+	if resourceType == v20231001preview.AzureCredentialType {
+		credential := &datamodel.AzureCredential{}
+		if err := obj.As(credential); err != nil {
+			return err
+		}
+		data = credential
+		properties = credentialProperties{
+			Kind:      credential.Properties.Kind,
+			Storage:   credential.Properties.Storage,
+			ExpiresAt: credential.Properties.ExpiresAt,
+			SetStatus: func(status *datamodel.CredentialStatus) { credential.Properties.Status = status },
+		}
+	} else {
+		credential := &datamodel.AWSCredential{}
+		if err := obj.As(credential); err != nil {
+			return err
+		}
+		data = credential
+		properties = credentialProperties{
+			Kind:      credential.Properties.Kind,
+			Storage:   credential.Properties.Storage,
+			ExpiresAt: credential.Properties.ExpiresAt,
+			SetStatus: func(status *datamodel.CredentialStatus) { credential.Properties.Status = status },
+		}
+	}
+
+	id, err := resources.ParseResource(obj.ID)
+	if err != nil {
+		return err
+	}
+
+	credential, err := s.readCredential(ctx, secretClient, id, resourceType, properties)
+	var verifyErr error
+	if errors.Is(err, errSecretNotFound) {
+		verifyErr = err
+	} else if err != nil {
+		return err
+	} else {
+		verifyErr = s.verifier.Verify(ctx, credential)
+	}
+
+	properties.SetStatus(s.status(properties.ExpiresAt, verifyErr))
+
+	// A conflict means the credential was rotated or updated while it was validated, so the status computed for the
+	// previous secret is dropped. The next validation records the status of the new secret.
+	err = client.Save(ctx, &store.Object{Metadata: obj.Metadata, Data: data}, store.WithETag(obj.ETag))
+	if errors.Is(err, &store.ErrConcurrency{}) {
+		return nil
+	}
+
+	return err
+}
+
+// credentialProperties are the fields shared by the Azure and AWS credential resources that the validation uses.
+type credentialProperties struct {
+	Kind      string
+	Storage   *datamodel.CredentialStorageProperties
+	ExpiresAt *time.Time
+	SetStatus func(status *datamodel.CredentialStatus)
+}
+
+// readCredential reads the secret of the credential. It returns errSecretNotFound if the credential has no secret.
+func (s *Service) readCredential(ctx context.Context, secretClient secret.Client, id resources.ID, resourceType string, properties credentialProperties) (*Credential, error) {
+	if properties.Storage == nil || properties.Storage.InternalCredential == nil || properties.Storage.InternalCredential.SecretName == "" {
+		return nil, errSecretNotFound
+	}
+
+	credential := &Credential{ID: id, Kind: properties.Kind}
+	secretName := properties.Storage.InternalCredential.SecretName
+
+	var err error
+	if resourceType == v20231001preview.AzureCredentialType {
+		var value datamodel.AzureCredentialProperties
+		value, err = secret.GetSecret[datamodel.AzureCredentialProperties](ctx, secretClient, secretName)
+		credential.Azure = &value
+	} else {
+		var value datamodel.AWSCredentialProperties
+		value, err = secret.GetSecret[datamodel.AWSCredentialProperties](ctx, secretClient, secretName)
+		credential.AWS = &value
+	}
+
+	if errors.Is(err, &secret.ErrNotFound{}) {
+		return nil, errSecretNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return credential, nil
+}
+
+// status returns the status of a credential given its expiry and the result of its verification.
+func (s *Service) status(expiresAt *time.Time, verifyErr error) *datamodel.CredentialStatus {
+	now := s.now().UTC()
+	status := &datamodel.CredentialStatus{State: datamodel.CredentialStateValid, LastValidatedTime: now}
+
+	switch {
+	case verifyErr != nil:
+		status.State = datamodel.CredentialStateInvalid
+		status.Message = verifyErr.Error()
+	case expiresAt != nil && !now.Before(*expiresAt):
+		status.State = datamodel.CredentialStateInvalid
+		status.Message = fmt.Sprintf("the credential expired at %s", expiresAt.UTC().Format(time.RFC3339))
+	case expiresAt != nil && now.Add(s.expiryWarning).After(*expiresAt):
+		status.State = datamodel.CredentialStateExpiring
+		status.Message = fmt.Sprintf("the credential expires at %s", expiresAt.UTC().Format(time.RFC3339))
+	}
+
+	return status
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/secret/provider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	azureCredentialID = "/planes/azure/azurecloud/providers/System.Azure/credentials/default"
+	awsCredentialID   = "/planes/aws/aws/providers/System.AWS/credentials/default"
+)
+
+var testNow = time.Date(2024, 5, 28, 0, 0, 0, 0, time.UTC)
+
+func newAzureCredential(expiresAt *time.Time) *datamodel.AzureCredential {
+	return &datamodel.AzureCredential{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{ID: azureCredentialID, Name: "default", Type: v20231001preview.AzureCredentialType},
+		},
+		Properties: &datamodel.AzureCredentialResourceProperties{
+			Kind:            datamodel.AzureCredentialKind,
+			AzureCredential: &datamodel.AzureCredentialProperties{ClientID: "client", TenantID: "tenant"},
+			Storage: &datamodel.CredentialStorageProperties{
+				Kind:               datamodel.InternalStorageKind,
+				InternalCredential: &datamodel.InternalCredentialStorageProperties{SecretName: "azure-azurecloud-default"},
+			},
+			ExpiresAt: expiresAt,
+		},
+	}
+}
+
+func newAWSCredential() *datamodel.AWSCredential {
+	return &datamodel.AWSCredential{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{ID: awsCredentialID, Name: "default", Type: v20231001preview.AWSCredentialType},
+		},
+		Properties: &datamodel.AWSCredentialResourceProperties{
+			Kind:          datamodel.AWSCredentialKind,
+			AWSCredential: &datamodel.AWSCredentialProperties{AccessKeyID: "key"},
+			Storage: &datamodel.CredentialStorageProperties{
+				Kind:               datamodel.InternalStorageKind,
+				InternalCredential: &datamodel.InternalCredentialStorageProperties{SecretName: "aws-aws-default"},
+			},
+		},
+	}
+}
+
+func setupStorage(t *testing.T, azureItems []store.Object, awsItems []store.Object) (*store.MockStorageClient, *dataprovider.MockDataStorageProvider) {
+	mockCtrl := gomock.NewController(t)
+	storageClient := store.NewMockStorageClient(mockCtrl)
+	storageProvider := dataprovider.NewMockDataStorageProvider(mockCtrl)
+	storageProvider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(storageClient, nil).AnyTimes()
+
+	storageClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: "/planes", ScopeRecursive: true, ResourceType: v20231001preview.AzureCredentialType}).
+		Return(&store.ObjectQueryResult{Items: azureItems}, nil)
+	storageClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: "/planes", ScopeRecursive: true, ResourceType: v20231001preview.AWSCredentialType}).
+		Return(&store.ObjectQueryResult{Items: awsItems}, nil)
+
+	return storageClient, storageProvider
+}
+
+func newSecretProvider(client secret.Client) *provider.SecretProvider {
+	secretProvider := provider.NewSecretProvider(provider.SecretProviderOptions{})
+	secretProvider.SetClient(client)
+	return secretProvider
+}
+
+func mustMarshal(t *testing.T, value any) []byte {
+	b, err := json.Marshal(value)
+	require.NoError(t, err)
+	return b
+}
+
+func Test_NewService_Defaults(t *testing.T) {
+	service := NewService(Options{Enabled: true}, nil, nil, nil)
+	require.Equal(t, defaultInterval, service.interval)
+	require.Equal(t, defaultExpiryWarning, service.expiryWarning)
+	require.IsType(t, &RequiredFieldsVerifier{}, service.verifier)
+}
+
+func Test_Status(t *testing.T) {
+	service := NewService(Options{ExpiryWarning: 7 * 24 * time.Hour}, nil, nil, nil)
+	service.now = func() time.Time { return testNow }
+
+	expired := testNow.Add(-time.Minute)
+	expiring := testNow.Add(4 * 24 * time.Hour)
+	later := testNow.Add(30 * 24 * time.Hour)
+
+	tests := []struct {
+		name      string
+		expiresAt *time.Time
+		verifyErr error
+		state     datamodel.CredentialState
+		message   string
+	}{
+		{name: "valid without expiry", state: datamodel.CredentialStateValid},
+		{name: "valid with expiry", expiresAt: &later, state: datamodel.CredentialStateValid},
+		{name: "expiring", expiresAt: &expiring, state: datamodel.CredentialStateExpiring, message: "the credential expires at 2024-06-01T00:00:00Z"},
+		{name: "expired", expiresAt: &expired, state: datamodel.CredentialStateInvalid, message: "the credential expired at 2024-05-27T23:59:00Z"},
+		{name: "verification failure", expiresAt: &later, verifyErr: errors.New("bad secret"), state: datamodel.CredentialStateInvalid, message: "bad secret"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := service.status(tt.expiresAt, tt.verifyErr)
+			require.Equal(t, tt.state, status.State)
+			require.Equal(t, tt.message, status.Message)
+			require.Equal(t, testNow, status.LastValidatedTime)
+		})
+	}
+}
+
+func Test_ValidateAll(t *testing.T) {
+	expiring := testNow.Add(24 * time.Hour)
+	azureCredential := newAzureCredential(&expiring)
+	awsCredential := newAWSCredential()
+
+	storageClient, storageProvider := setupStorage(t,
+		[]store.Object{{Metadata: store.Metadata{ID: azureCredentialID, ETag: "azure-etag"}, Data: azureCredential}},
+		[]store.Object{{Metadata: store.Metadata{ID: awsCredentialID, ETag: "aws-etag"}, Data: awsCredential}})
+
+	mockCtrl := gomock.NewController(t)
+	secretClient := secret.NewMockClient(mockCtrl)
+	secretClient.EXPECT().Get(gomock.Any(), "azure-azurecloud-default").
+		Return(mustMarshal(t, datamodel.AzureCredentialProperties{ClientID: "client", TenantID: "tenant", ClientSecret: "secret"}), nil)
+	secretClient.EXPECT().Get(gomock.Any(), "aws-aws-default").Return(nil, &secret.ErrNotFound{})
+
+	saved := map[string]*datamodel.CredentialStatus{}
+	storageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			require.Equal(t, store.NewSaveConfig(store.WithETag(obj.ETag)), store.NewSaveConfig(options...))
+			switch data := obj.Data.(type) {
+			case *datamodel.AzureCredential:
+				saved[obj.ID] = data.Properties.Status
+			case *datamodel.AWSCredential:
+				saved[obj.ID] = data.Properties.Status
+			}
+			return nil
+		}).Times(2)
+
+	service := NewService(Options{}, storageProvider, newSecretProvider(secretClient), nil)
+	service.now = func() time.Time { return testNow }
+
+	require.NoError(t, service.ValidateAll(context.Background()))
+	require.Equal(t, &datamodel.CredentialStatus{
+		State:             datamodel.CredentialStateExpiring,
+		Message:           "the credential expires at 2024-05-29T00:00:00Z",
+		LastValidatedTime: testNow,
+	}, saved[azureCredentialID])
+	require.Equal(t, &datamodel.CredentialStatus{
+		State:             datamodel.CredentialStateInvalid,
+		Message:           "the secret of the credential was not found",
+		LastValidatedTime: testNow,
+	}, saved[awsCredentialID])
+}
+
+func Test_ValidateAll_CustomVerifierAndConflict(t *testing.T) {
+	storageClient, storageProvider := setupStorage(t,
+		[]store.Object{{Metadata: store.Metadata{ID: azureCredentialID, ETag: "azure-etag"}, Data: newAzureCredential(nil)}},
+		nil)
+
+	mockCtrl := gomock.NewController(t)
+	secretClient := secret.NewMockClient(mockCtrl)
+	secretClient.EXPECT().Get(gomock.Any(), "azure-azurecloud-default").
+		Return(mustMarshal(t, datamodel.AzureCredentialProperties{ClientID: "client", TenantID: "tenant", ClientSecret: "secret"}), nil)
+
+	// The credential is rotated while it is validated.
+	storageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(&store.ErrConcurrency{})
+
+	verified := []string{}
+	verifier := VerifierFunc(func(ctx context.Context, credential *Credential) error {
+		verified = append(verified, credential.ID.String())
+		require.Equal(t, "secret", credential.Azure.ClientSecret)
+		return nil
+	})
+
+	service := NewService(Options{}, storageProvider, newSecretProvider(secretClient), verifier)
+	require.NoError(t, service.ValidateAll(context.Background()))
+	require.Equal(t, []string{azureCredentialID}, verified)
+}
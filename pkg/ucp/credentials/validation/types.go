@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// Options configures the background validation of UCP credentials.
+type Options struct {
+	// Enabled enables the background validation of credentials.
+	Enabled bool `yaml:"enabled"`
+	// Interval is the interval between two validations of the credentials. Defaults to 1h.
+	Interval time.Duration `yaml:"interval,omitempty"`
+	// ExpiryWarning is how long before its expiry a valid credential is reported as expiring. Defaults to 168h (7 days).
+	ExpiryWarning time.Duration `yaml:"expiryWarning,omitempty"`
+}
+
+// Credential is a credential to verify, including its secret. Exactly one of Azure and AWS is set.
+type Credential struct {
+	// ID is the resource ID of the credential.
+	ID resources.ID
+	// Kind is the kind of the credential, for example ServicePrincipal or AccessKey.
+	Kind string
+	// Azure is the secret of an Azure credential.
+	Azure *datamodel.AzureCredentialProperties
+	// AWS is the secret of an AWS credential.
+	AWS *datamodel.AWSCredentialProperties
+}
+
+// Verifier verifies that a credential can be used.
+type Verifier interface {
+	// Verify returns an error describing why the credential cannot be used, or nil if the credential is usable.
+	Verify(ctx context.Context, credential *Credential) error
+}
+
+// VerifierFunc is an adapter to use an ordinary function as a Verifier.
+type VerifierFunc func(ctx context.Context, credential *Credential) error
+
+// Verify calls f(ctx, credential).
+func (f VerifierFunc) Verify(ctx context.Context, credential *Credential) error {
+	return f(ctx, credential)
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	"github.com/radius-project/radius/pkg/azure/credential"
+	ucp_aws "github.com/radius-project/radius/pkg/ucp/aws"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+const (
+	// azureManagementScope is the scope of the token acquired to verify Azure credentials.
+	azureManagementScope = "https://management.azure.com/.default"
+
+	// defaultSTSRegion is the region of the STS endpoint used to verify AWS credentials when AWS_REGION is not set.
+	defaultSTSRegion = "us-east-1"
+)
+
+var (
+	_ Verifier = (*RequiredFieldsVerifier)(nil)
+	_ Verifier = (*CloudVerifier)(nil)
+)
+
+// RequiredFieldsVerifier is the default Verifier. It checks that the secret of a credential has the fields required by
+// its kind without contacting the cloud provider, so it detects incomplete secrets but not revoked ones. Use the
+// CloudVerifier to also detect revoked credentials.
+type RequiredFieldsVerifier struct{}
+
+// Verify returns an error naming the first missing field of the credential's secret.
+func (v *RequiredFieldsVerifier) Verify(ctx context.Context, credential *Credential) error {
+	switch {
+	case credential.Azure != nil:
+		return verifyAzure(credential.Azure)
+	case credential.AWS != nil:
+		return verifyAWS(credential.AWS)
+	default:
+		return errors.New("the secret of the credential is missing")
+	}
+}
+
+func verifyAzure(properties *datamodel.AzureCredentialProperties) error {
+	switch properties.GetKind() {
+	case datamodel.AzureCredentialKind:
+		return requireFields(properties.GetKind(), "clientId", properties.ClientID, "tenantId", properties.TenantID, "clientSecret", properties.ClientSecret)
+	case datamodel.AzureWorkloadIdentityCredentialKind:
+		return requireFields(properties.GetKind(), "clientId", properties.ClientID, "tenantId", properties.TenantID)
+	case datamodel.AzureManagedIdentityCredentialKind:
+		return nil
+	default:
+		return fmt.Errorf("unsupported Azure credential kind %q", properties.GetKind())
+	}
+}
+
+func verifyAWS(properties *datamodel.AWSCredentialProperties) error {
+	switch properties.GetKind() {
+	case datamodel.AWSCredentialKind:
+		return requireFields(properties.GetKind(), "accessKeyId", properties.AccessKeyID, "secretAccessKey", properties.SecretAccessKey)
+	case datamodel.AWSIRSACredentialKind, datamodel.AWSAssumeRoleCredentialKind:
+		return requireFields(properties.GetKind(), "roleARN", properties.RoleARN)
+	default:
+		return fmt.Errorf("unsupported AWS credential kind %q", properties.GetKind())
+	}
+}
+
+// requireFields returns an error for the first empty value. fields alternates between field names and values.
+func requireFields(kind string, fields ...string) error {
+	for i := 0; i+1 < len(fields); i += 2 {
+		if fields[i+1] == "" {
+			return fmt.Errorf("%s is required for %s credentials", fields[i], kind)
+		}
+	}
+
+	return nil
+}
+
+// CallerIdentityClient is the subset of the AWS STS API used to verify AWS credentials.
+type CallerIdentityClient interface {
+	GetCallerIdentity(ctx context.Context, params *sts.GetCallerIdentityInput, optFns ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error)
+}
+
+// CloudVerifier verifies credentials with their cloud provider: AWS credentials are verified by calling STS
+// GetCallerIdentity and Azure credentials by acquiring a token for Azure Resource Manager. The fields of the secret
+// are checked with the RequiredFieldsVerifier first, so an incomplete secret is reported without contacting the cloud.
+type CloudVerifier struct {
+	required    RequiredFieldsVerifier
+	awsProvider *ucp_aws.UCPCredentialProvider
+
+	// newSTSClient creates the STS client signed with the given credentials. It is replaced in tests.
+	newSTSClient func(credentials aws.CredentialsProvider) CallerIdentityClient
+	// newTokenCredential creates the token credential for an Azure credential. It is replaced in tests.
+	newTokenCredential func(credential *sdk_cred.AzureCredential) (azcore.TokenCredential, error)
+}
+
+// NewCloudVerifier creates a CloudVerifier. awsProvider fetches the source credentials of AWS AssumeRole credentials.
+// allowAmbientCredentials allows AWS AssumeRole credentials without a source credential to use the identity of UCP.
+func NewCloudVerifier(awsProvider sdk_cred.CredentialProvider[sdk_cred.AWSCredential], allowAmbientCredentials bool) *CloudVerifier {
+	return &CloudVerifier{
+		awsProvider:  ucp_aws.NewUCPCredentialProvider(awsProvider, ucp_aws.DefaultExpireDuration, allowAmbientCredentials),
+		newSTSClient: newSTSClient,
+		newTokenCredential: func(c *sdk_cred.AzureCredential) (azcore.TokenCredential, error) {
+			return credential.NewTokenCredential(c, nil)
+		},
+	}
+}
+
+// Verify checks the fields of the credential's secret and then exchanges the credential with its cloud provider.
+func (v *CloudVerifier) Verify(ctx context.Context, credential *Credential) error {
+	if err := v.required.Verify(ctx, credential); err != nil {
+		return err
+	}
+
+	if credential.Azure != nil {
+		return v.verifyAzure(ctx, credential.Azure)
+	}
+
+	return v.verifyAWS(ctx, credential.AWS)
+}
+
+func (v *CloudVerifier) verifyAzure(ctx context.Context, properties *datamodel.AzureCredentialProperties) error {
+	tokenCredential, err := v.newTokenCredential(properties)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tokenCredential.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{azureManagementScope}}); err != nil {
+		return fmt.Errorf("failed to acquire a token with the credential: %w", err)
+	}
+
+	return nil
+}
+
+func (v *CloudVerifier) verifyAWS(ctx context.Context, properties *datamodel.AWSCredentialProperties) error {
+	credentials := aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+		return v.awsProvider.RetrieveCredential(ctx, properties)
+	})
+
+	if _, err := v.newSTSClient(credentials).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}); err != nil {
+		return fmt.Errorf("failed to get the caller identity with the credential: %w", err)
+	}
+
+	return nil
+}
+
+// newSTSClient creates an STS client in the region configured by AWS_REGION.
+func newSTSClient(credentials aws.CredentialsProvider) CallerIdentityClient {
+	region := os.Getenv("AWS_REGION")
+	if region == "" {
+		region = defaultSTSRegion
+	}
+
+	return sts.New(sts.Options{Region: region, Credentials: aws.NewCredentialsCache(credentials)})
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/stretchr/testify/require"
+
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+func Test_RequiredFieldsVerifier(t *testing.T) {
+	tests := []struct {
+		name       string
+		credential *Credential
+		err        string
+	}{
+		{
+			name:       "azure service principal",
+			credential: &Credential{Azure: &datamodel.AzureCredentialProperties{ClientID: "client", TenantID: "tenant", ClientSecret: "secret"}},
+		},
+		{
+			name:       "azure service principal without secret",
+			credential: &Credential{Azure: &datamodel.AzureCredentialProperties{ClientID: "client", TenantID: "tenant"}},
+			err:        "clientSecret is required for ServicePrincipal credentials",
+		},
+		{
+			name:       "azure workload identity without tenant",
+			credential: &Credential{Azure: &datamodel.AzureCredentialProperties{Kind: datamodel.AzureWorkloadIdentityCredentialKind, ClientID: "client"}},
+			err:        "tenantId is required for WorkloadIdentity credentials",
+		},
+		{
+			name:       "azure managed identity",
+			credential: &Credential{Azure: &datamodel.AzureCredentialProperties{Kind: datamodel.AzureManagedIdentityCredentialKind}},
+		},
+		{
+			name:       "aws access key without secret",
+			credential: &Credential{AWS: &datamodel.AWSCredentialProperties{AccessKeyID: "key"}},
+			err:        "secretAccessKey is required for AccessKey credentials",
+		},
+		{
+			name:       "aws irsa",
+			credential: &Credential{AWS: &datamodel.AWSCredentialProperties{Kind: datamodel.AWSIRSACredentialKind, RoleARN: "arn:aws:iam::000000000000:role/radius"}},
+		},
+		{
+			name:       "aws assume role without role",
+			credential: &Credential{AWS: &datamodel.AWSCredentialProperties{Kind: datamodel.AWSAssumeRoleCredentialKind}},
+			err:        "roleARN is required for AssumeRole credentials",
+		},
+		{
+			name:       "missing secret",
+			credential: &Credential{},
+			err:        "the secret of the credential is missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&RequiredFieldsVerifier{}).Verify(context.Background(), tt.credential)
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+type fakeTokenCredential struct {
+	err    error
+	scopes []string
+}
+
+func (c *fakeTokenCredential) GetToken(ctx context.Context, options policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	c.scopes = options.Scopes
+	if c.err != nil {
+		return azcore.AccessToken{}, c.err
+	}
+	return azcore.AccessToken{Token: "token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+// fakeSTSClient retrieves the credentials like the STS client does to sign the request.
+type fakeSTSClient struct {
+	credentials aws.CredentialsProvider
+	retrieved   aws.Credentials
+	err         error
+}
+
+func (c *fakeSTSClient) GetCallerIdentity(ctx context.Context, params *sts.GetCallerIdentityInput, optFns ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
+	value, err := c.credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.retrieved = value
+
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &sts.GetCallerIdentityOutput{Account: aws.String("000000000000")}, nil
+}
+
+func Test_CloudVerifier_Azure(t *testing.T) {
+	tests := []struct {
+		name       string
+		credential *Credential
+		tokenErr   error
+		err        string
+	}{
+		{
+			name:       "valid",
+			credential: &Credential{Azure: &datamodel.AzureCredentialProperties{ClientID: "client", TenantID: "tenant", ClientSecret: "secret"}},
+		},
+		{
+			name:       "missing field is checked first",
+			credential: &Credential{Azure: &datamodel.AzureCredentialProperties{ClientID: "client", TenantID: "tenant"}},
+			err:        "clientSecret is required for ServicePrincipal credentials",
+		},
+		{
+			name:       "token acquisition fails",
+			credential: &Credential{Azure: &datamodel.AzureCredentialProperties{ClientID: "client", TenantID: "tenant", ClientSecret: "revoked"}},
+			tokenErr:   errors.New("AADSTS7000215: Invalid client secret provided"),
+			err:        "failed to acquire a token with the credential: AADSTS7000215: Invalid client secret provided",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokenCredential := &fakeTokenCredential{err: tt.tokenErr}
+			verifier := NewCloudVerifier(nil, false)
+			verifier.newTokenCredential = func(credential *sdk_cred.AzureCredential) (azcore.TokenCredential, error) {
+				require.Equal(t, tt.credential.Azure, credential)
+				return tokenCredential, nil
+			}
+
+			err := verifier.Verify(context.Background(), tt.credential)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, []string{azureManagementScope}, tokenCredential.scopes)
+		})
+	}
+}
+
+func Test_CloudVerifier_AWS(t *testing.T) {
+	tests := []struct {
+		name       string
+		credential *Credential
+		stsErr     error
+		err        string
+	}{
+		{
+			name:       "valid",
+			credential: &Credential{AWS: &datamodel.AWSCredentialProperties{AccessKeyID: "key", SecretAccessKey: "secret"}},
+		},
+		{
+			name:       "missing field is checked first",
+			credential: &Credential{AWS: &datamodel.AWSCredentialProperties{AccessKeyID: "key"}},
+			err:        "secretAccessKey is required for AccessKey credentials",
+		},
+		{
+			name:       "caller identity fails",
+			credential: &Credential{AWS: &datamodel.AWSCredentialProperties{AccessKeyID: "key", SecretAccessKey: "revoked"}},
+			stsErr:     errors.New("InvalidClientTokenId"),
+			err:        "failed to get the caller identity with the credential: InvalidClientTokenId",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeSTSClient{err: tt.stsErr}
+			verifier := NewCloudVerifier(nil, false)
+			verifier.newSTSClient = func(credentials aws.CredentialsProvider) CallerIdentityClient {
+				client.credentials = credentials
+				return client
+			}
+
+			err := verifier.Verify(context.Background(), tt.credential)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.credential.AWS.AccessKeyID, client.retrieved.AccessKeyID)
+			require.Equal(t, tt.credential.AWS.SecretAccessKey, client.retrieved.SecretAccessKey)
+		})
+	}
+}
@@ -16,17 +16,53 @@ limitations under the License.
 
 package datamodel
 
-import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+import (
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
 
 const (
 	// InternalStorageKind represents ucp credential storage type for internal credential type
 	InternalStorageKind = "Internal"
 	// AzureCredentialKind represents ucp credential kind for azure credentials.
 	AzureCredentialKind = "ServicePrincipal"
+	// AzureWorkloadIdentityCredentialKind represents ucp credential kind for azure workload identity, which exchanges
+	// a federated token for an access token.
+	AzureWorkloadIdentityCredentialKind = "WorkloadIdentity"
+	// AzureManagedIdentityCredentialKind represents ucp credential kind for azure managed identity.
+	AzureManagedIdentityCredentialKind = "ManagedIdentity"
 	// AWSCredentialKind represents ucp credential kind for aws credentials.
 	AWSCredentialKind = "AccessKey"
+	// AWSIRSACredentialKind represents ucp credential kind for aws IAM roles for service accounts, which exchange a
+	// web identity token for short-lived credentials.
+	AWSIRSACredentialKind = "IRSA"
+	// AWSAssumeRoleCredentialKind represents ucp credential kind for aws credentials obtained by assuming an IAM role.
+	AWSAssumeRoleCredentialKind = "AssumeRole"
 )
 
+// CredentialState is the validation state of a credential.
+type CredentialState string
+
+const (
+	// CredentialStateValid means the credential was verified and does not expire soon.
+	CredentialStateValid CredentialState = "Valid"
+	// CredentialStateExpiring means the credential was verified but expires soon and should be rotated.
+	CredentialStateExpiring CredentialState = "Expiring"
+	// CredentialStateInvalid means the credential could not be verified or has expired.
+	CredentialStateInvalid CredentialState = "Invalid"
+)
+
+// CredentialStatus is the status of the last validation of a credential.
+type CredentialStatus struct {
+	// State is the validation state of the credential.
+	State CredentialState `json:"state"`
+	// Message describes the state, for example the reason the credential is invalid.
+	Message string `json:"message,omitempty"`
+	// LastValidatedTime is the time the credential was last validated.
+	LastValidatedTime time.Time `json:"lastValidatedTime"`
+}
+
 // Credential represents UCP Credential.
 type AzureCredential struct {
 	v1.BaseResource
@@ -59,6 +95,10 @@ type AzureCredentialResourceProperties struct {
 	AzureCredential *AzureCredentialProperties `json:"azureCredential,omitempty"`
 	// Storage contains the properties of the storage associated with the kind.
 	Storage *CredentialStorageProperties `json:"storage,omitempty"`
+	// ExpiresAt is the optional time the credential expires.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// Status is the status of the last validation of the credential. It is set by the credential validator.
+	Status *CredentialStatus `json:"status,omitempty"`
 }
 
 // AWS Credential Properties represents UCP Credential Properties.
@@ -69,24 +109,60 @@ type AWSCredentialResourceProperties struct {
 	AWSCredential *AWSCredentialProperties `json:"awsCredential,omitempty"`
 	// Storage contains the properties of the storage associated with the kind.
 	Storage *CredentialStorageProperties `json:"storage,omitempty"`
+	// ExpiresAt is the optional time the credential expires.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// Status is the status of the last validation of the credential. It is set by the credential validator.
+	Status *CredentialStatus `json:"status,omitempty"`
 }
 
 // AzureCredentialProperties contains ucp Azure credential properties.
 type AzureCredentialProperties struct {
+	// Kind is the kind of azure credential. An empty kind is treated as AzureCredentialKind.
+	Kind string `json:"kind,omitempty"`
 	// TenantID represents the tenantId of azure service principal.
 	TenantID string `json:"tenantId"`
-	// ClientID represents the clientId of azure service principal.
+	// ClientID represents the clientId of azure service principal or managed identity.
 	ClientID string `json:"clientId"`
 	// ClientSecret represents the client secret of service principal.
 	ClientSecret string `json:"clientSecret,omitempty"`
+	// TokenFile is the path of the federated token file of workload identity credentials.
+	TokenFile string `json:"tokenFile,omitempty"`
+}
+
+// GetKind returns the kind of the azure credential, defaulting to AzureCredentialKind for credentials saved without a kind.
+func (p *AzureCredentialProperties) GetKind() string {
+	if p.Kind == "" {
+		return AzureCredentialKind
+	}
+	return p.Kind
 }
 
 // AWSCredentialProperties contains ucp AWS credential properties.
 type AWSCredentialProperties struct {
+	// Kind is the kind of aws credential. An empty kind is treated as AWSCredentialKind.
+	Kind string `json:"kind,omitempty"`
 	// AccessKeyID contains aws access key for iam.
 	AccessKeyID string `json:"accessKeyId"`
 	// SecretAccessKey contains secret access key for iam.
 	SecretAccessKey string `json:"secretAccessKey,omitempty"`
+	// RoleARN is the ARN of the IAM role assumed by IRSA and AssumeRole credentials.
+	RoleARN string `json:"roleARN,omitempty"`
+	// TokenFile is the path of the web identity token file of IRSA credentials.
+	TokenFile string `json:"tokenFile,omitempty"`
+	// ExternalID is the external ID passed when assuming the role of AssumeRole credentials.
+	ExternalID string `json:"externalId,omitempty"`
+	// SessionName is the name of the role session of IRSA and AssumeRole credentials.
+	SessionName string `json:"sessionName,omitempty"`
+	// SourceCredential is the name of the credential used to assume the role of AssumeRole credentials.
+	SourceCredential string `json:"sourceCredential,omitempty"`
+}
+
+// GetKind returns the kind of the aws credential, defaulting to AWSCredentialKind for credentials saved without a kind.
+func (p *AWSCredentialProperties) GetKind() string {
+	if p.Kind == "" {
+		return AWSCredentialKind
+	}
+	return p.Kind
 }
 
 // CredentialStorageProperties contains ucp credential storage properties.
@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// EventSubscriptionResourceType is the resource type of event subscriptions.
+	EventSubscriptionResourceType = "System.Resources/eventSubscriptions"
+)
+
+// EventDeliveryState is the outcome of the last delivery to an event subscription.
+type EventDeliveryState string
+
+const (
+	// EventDeliveryStateSucceeded means the last event was delivered.
+	EventDeliveryStateSucceeded EventDeliveryState = "Succeeded"
+	// EventDeliveryStateFailed means the last event could not be delivered after all attempts.
+	EventDeliveryStateFailed EventDeliveryState = "Failed"
+)
+
+// EventSubscriptionFilter selects the events delivered to a subscription. Empty fields match every event.
+type EventSubscriptionFilter struct {
+	// Scope is the ID of the resource group or resource whose events are delivered. Events of resources nested in
+	// the scope are delivered as well.
+	Scope string `json:"scope,omitempty"`
+	// ResourceTypes are the resource types whose events are delivered, for example Applications.Core/containers.
+	ResourceTypes []string `json:"resourceTypes,omitempty"`
+	// EventTypes are the event types that are delivered, for example Radius.Resource.Created. A trailing ".*"
+	// matches every event type with the given prefix, for example Radius.Operation.*.
+	EventTypes []string `json:"eventTypes,omitempty"`
+}
+
+// EventDeliveryStatus is the status of the last delivery to an event subscription.
+type EventDeliveryStatus struct {
+	// State is the outcome of the last delivery.
+	State EventDeliveryState `json:"state"`
+	// LastAttemptTime is the time of the last delivery attempt.
+	LastAttemptTime time.Time `json:"lastAttemptTime"`
+	// LastEventID is the ID of the last event delivered or attempted.
+	LastEventID string `json:"lastEventId,omitempty"`
+	// LastEventType is the type of the last event delivered or attempted.
+	LastEventType string `json:"lastEventType,omitempty"`
+	// Attempts is the number of attempts made to deliver the last event.
+	Attempts int `json:"attempts"`
+	// ConsecutiveFailures is the number of events in a row that could not be delivered.
+	ConsecutiveFailures int `json:"consecutiveFailures"`
+	// Error is the error of the last failed attempt.
+	Error string `json:"error,omitempty"`
+}
+
+// EventSubscriptionProperties represents the properties of an event subscription.
+type EventSubscriptionProperties struct {
+	// URL is the webhook endpoint events are delivered to.
+	URL string `json:"url"`
+	// Secret is the key used to sign the events with HMAC-SHA256. It is only set on requests: the key is saved with
+	// the secret client and is never stored with the resource or returned by the API.
+	Secret string `json:"-"`
+	// SecretName is the name of the secret that holds the key used to sign the events. It is empty when events are
+	// not signed.
+	SecretName string `json:"secretName,omitempty"`
+	// Filter selects the events delivered to the subscription.
+	Filter EventSubscriptionFilter `json:"filter"`
+	// DeliveryStatus is the status of the last delivery to the subscription.
+	DeliveryStatus *EventDeliveryStatus `json:"deliveryStatus,omitempty"`
+}
+
+// EventSubscription represents a webhook subscription to resource lifecycle events. Subscriptions are stored in the
+// scope of the plane they belong to.
+type EventSubscription struct {
+	v1.BaseResource
+
+	// Properties is the properties of the resource.
+	Properties EventSubscriptionProperties `json:"properties"`
+}
+
+// ResourceTypeName returns the resource type of the event subscription.
+func (s EventSubscription) ResourceTypeName() string {
+	return EventSubscriptionResourceType
+}
+
+// Matches returns true if an event of the given type about the resource with the given ID and type passes the filter
+// of the subscription.
+func (s *EventSubscription) Matches(resourceID string, resourceType string, eventType string) bool {
+	filter := s.Properties.Filter
+
+	if filter.Scope != "" {
+		scope := strings.ToLower(strings.TrimSuffix(filter.Scope, resources.SegmentSeparator))
+		id := strings.ToLower(resourceID)
+		if id != scope && !strings.HasPrefix(id, scope+resources.SegmentSeparator) {
+			return false
+		}
+	}
+
+	if len(filter.ResourceTypes) > 0 && !matchesAny(filter.ResourceTypes, resourceType) {
+		return false
+	}
+
+	if len(filter.EventTypes) > 0 && !matchesAny(filter.EventTypes, eventType) {
+		return false
+	}
+
+	return true
+}
+
+// matchesAny returns true if the value equals one of the patterns, ignoring case. A pattern ending with ".*" matches
+// every value with the given prefix.
+func matchesAny(patterns []string, value string) bool {
+	value = strings.ToLower(value)
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok && strings.HasSuffix(prefix, ".") {
+			if strings.HasPrefix(value, prefix) {
+				return true
+			}
+		} else if pattern == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EventSubscription_Matches(t *testing.T) {
+	const containerID = "/planes/radius/local/resourceGroups/rg1/providers/Applications.Core/containers/frontend"
+
+	tests := []struct {
+		name      string
+		filter    EventSubscriptionFilter
+		id        string
+		eventType string
+		expected  bool
+	}{
+		{name: "empty filter", id: containerID, eventType: "Radius.Resource.Created", expected: true},
+		{name: "scope match", filter: EventSubscriptionFilter{Scope: "/planes/radius/local/resourcegroups/RG1"}, id: containerID, eventType: "Radius.Resource.Created", expected: true},
+		{name: "scope mismatch", filter: EventSubscriptionFilter{Scope: "/planes/radius/local/resourceGroups/rg10"}, id: containerID, eventType: "Radius.Resource.Created", expected: false},
+		{name: "type match", filter: EventSubscriptionFilter{ResourceTypes: []string{"applications.core/containers"}}, id: containerID, eventType: "Radius.Resource.Created", expected: true},
+		{name: "type mismatch", filter: EventSubscriptionFilter{ResourceTypes: []string{"Applications.Core/gateways"}}, id: containerID, eventType: "Radius.Resource.Created", expected: false},
+		{name: "event match", filter: EventSubscriptionFilter{EventTypes: []string{"Radius.Resource.Deleted", "Radius.Resource.Created"}}, id: containerID, eventType: "Radius.Resource.Created", expected: true},
+		{name: "event wildcard match", filter: EventSubscriptionFilter{EventTypes: []string{"Radius.Operation.*"}}, id: containerID, eventType: "Radius.Operation.Failed", expected: true},
+		{name: "event wildcard mismatch", filter: EventSubscriptionFilter{EventTypes: []string{"Radius.Operation.*"}}, id: containerID, eventType: "Radius.Resource.Created", expected: false},
+		{name: "event mismatch", filter: EventSubscriptionFilter{EventTypes: []string{"Radius.Resource.Deleted"}}, id: containerID, eventType: "Radius.Resource.Created", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscription := &EventSubscription{Properties: EventSubscriptionProperties{Filter: tt.filter}}
+			require.Equal(t, tt.expected, subscription.Matches(tt.id, "Applications.Core/containers", tt.eventType))
+		})
+	}
+}
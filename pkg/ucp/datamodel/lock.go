@@ -0,0 +1,88 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// LockResourceType is the resource type of resource locks.
+	LockResourceType = "System.Resources/locks"
+)
+
+// LockLevel is the level of a resource lock.
+type LockLevel string
+
+const (
+	// LockLevelCanNotDelete prevents the locked resources from being deleted. They can still be updated.
+	LockLevelCanNotDelete LockLevel = "CanNotDelete"
+	// LockLevelReadOnly prevents the locked resources from being updated or deleted.
+	LockLevelReadOnly LockLevel = "ReadOnly"
+)
+
+// LockProperties represents the properties of a resource lock.
+type LockProperties struct {
+	// Level is the level of the lock.
+	Level LockLevel `json:"level"`
+	// Scope is the ID of the plane, resource group or resource the lock applies to. The lock applies to every
+	// resource in the scope as well.
+	Scope string `json:"scope"`
+	// Notes describes why the lock was created.
+	Notes string `json:"notes,omitempty"`
+}
+
+// Lock represents a UCP resource lock. Locks are stored in the scope of the plane they belong to.
+type Lock struct {
+	v1.BaseResource
+
+	// Properties is the properties of the resource.
+	Properties LockProperties `json:"properties"`
+}
+
+// ResourceTypeName returns the resource type of the lock.
+func (l Lock) ResourceTypeName() string {
+	return LockResourceType
+}
+
+// AppliesTo returns true if the lock applies to the given resource ID, either because its scope is the resource
+// itself or one of the scopes or resources the ID is nested in.
+func (l *Lock) AppliesTo(id string) bool {
+	scope := strings.ToLower(strings.TrimSuffix(l.Properties.Scope, resources.SegmentSeparator))
+	if scope == "" {
+		return false
+	}
+
+	id = strings.ToLower(id)
+	return id == scope || strings.HasPrefix(id, scope+resources.SegmentSeparator)
+}
+
+// Blocks returns true if the lock prevents requests with the given HTTP method.
+func (l *Lock) Blocks(method string) bool {
+	switch method {
+	case http.MethodDelete:
+		return l.Properties.Level == LockLevelCanNotDelete || l.Properties.Level == LockLevelReadOnly
+	case http.MethodPut, http.MethodPatch:
+		return l.Properties.Level == LockLevelReadOnly
+	default:
+		return false
+	}
+}
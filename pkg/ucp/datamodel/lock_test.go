@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Lock_AppliesTo(t *testing.T) {
+	lock := &Lock{Properties: LockProperties{Scope: "/planes/radius/local/resourceGroups/rg1"}}
+
+	require.True(t, lock.AppliesTo("/planes/radius/local/resourceGroups/rg1"))
+	require.True(t, lock.AppliesTo("/planes/radius/local/resourcegroups/RG1/providers/Applications.Core/containers/frontend"))
+	require.False(t, lock.AppliesTo("/planes/radius/local/resourceGroups/rg10"))
+	require.False(t, lock.AppliesTo("/planes/radius/local"))
+
+	lock = &Lock{}
+	require.False(t, lock.AppliesTo("/planes/radius/local/resourceGroups/rg1"))
+}
+
+func Test_Lock_Blocks(t *testing.T) {
+	canNotDelete := &Lock{Properties: LockProperties{Level: LockLevelCanNotDelete}}
+	require.True(t, canNotDelete.Blocks(http.MethodDelete))
+	require.False(t, canNotDelete.Blocks(http.MethodPut))
+	require.False(t, canNotDelete.Blocks(http.MethodPatch))
+	require.False(t, canNotDelete.Blocks(http.MethodGet))
+
+	readOnly := &Lock{Properties: LockProperties{Level: LockLevelReadOnly}}
+	require.True(t, readOnly.Blocks(http.MethodDelete))
+	require.True(t, readOnly.Blocks(http.MethodPut))
+	require.True(t, readOnly.Blocks(http.MethodPatch))
+	require.False(t, readOnly.Blocks(http.MethodPost))
+}
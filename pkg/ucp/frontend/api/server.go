@@ -26,8 +26,14 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/events"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	armrpc_hostoptions "github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
 	"github.com/radius-project/radius/pkg/armrpc/servicecontext"
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/sdk"
@@ -85,6 +91,7 @@ type Service struct {
 	storageProvider dataprovider.DataStorageProvider
 	queueProvider   *queueprovider.QueueProvider
 	secretProvider  *secretprovider.SecretProvider
+	eventPublisher  *events.Dispatcher
 }
 
 // DefaultModules returns a list of default modules that will be registered with the router.
@@ -130,7 +137,17 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 		return nil, err
 	}
 
-	statusManager := statusmanager.New(s.storageProvider, queueClient, s.options.Location)
+	var eventOptions *events.Options
+	if s.options.Config != nil {
+		eventOptions = s.options.Config.Events
+	}
+
+	s.eventPublisher, err = events.New(eventOptions, s.storageProvider, s.secretProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	statusManager := statusmanager.NewWithPublisher(s.storageProvider, queueClient, s.options.Location, s.eventPublisher.AsPublisher())
 
 	moduleOptions := modules.Options{
 		Address:        s.options.Address,
@@ -142,6 +159,7 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 		SecretProvider: s.secretProvider,
 		SpecLoader:     specLoader,
 		StatusManager:  statusManager,
+		EventPublisher: s.eventPublisher.AsPublisher(),
 		UCPConnection:  s.options.UCPConnection,
 	}
 
@@ -160,8 +178,50 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 		return nil, err
 	}
 
+	var serverOptions *armrpc_hostoptions.ServerOptions
+	if s.options.Config != nil {
+		serverOptions = s.options.Config.Server
+	}
+
+	oidcValidator, authorizer, err := server.NewOIDCAuth(serverOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	var auditOptions *audit.Options
+	var rateLimitOptions *ratelimit.Options
+	if serverOptions != nil {
+		auditOptions = serverOptions.Audit
+		rateLimitOptions = serverOptions.RateLimit
+	}
+
+	auditor, err := audit.New(auditOptions, s.storageProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	limiter, err := ratelimit.New(rateLimitOptions)
+	if err != nil {
+		return nil, err
+	}
+
 	app := http.Handler(r)
+	if authorizer != nil {
+		app = server.AuthorizationMiddleware(authorizer, s.options.PathBase)(app)
+	}
+	// Auditing wraps authorization so that denied requests are recorded.
+	if auditor != nil {
+		app = auditor.Middleware(app)
+	}
+	// Throttled requests are rejected before they are audited or authorized.
+	if limiter != nil {
+		app = limiter.Middleware(app)
+	}
 	app = servicecontext.ARMRequestCtx(s.options.PathBase, "global")(app)
+	if oidcValidator != nil {
+		// The OpenAPI documents and the API discovery endpoint are read by the Kubernetes API server and stay anonymous.
+		app = authentication.BearerTokenValidator(oidcValidator, "/openapi/v2", "/openapi/v3", s.options.PathBase)(app)
+	}
 	app = middleware.WithLogger(app)
 
 	app = otelhttp.NewHandler(
@@ -261,6 +321,9 @@ func (s *Service) Run(ctx context.Context) error {
 		err = service.ListenAndServeTLS(s.options.TLSCertDir+"/tls.crt", s.options.TLSCertDir+"/tls.key")
 	}
 
+	// Deliver the events of the last requests before the process exits.
+	s.eventPublisher.Shutdown(ctx)
+
 	if err == http.ErrServerClosed {
 		// We expect this, safe to ignore.
 		logger.Info("Server stopped...")
@@ -231,12 +231,22 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 				return aws_credential_ctrl.NewDeleteAWSCredential(o, secretClient)
 			},
 		},
+		{
+			ParentRouter: credentialResourceRouter,
+			Path:         "/rotate",
+			Method:       v1.OperationMethod("ACTIONROTATE"),
+			ResourceType: v20231001preview.AWSCredentialType,
+			ControllerFactory: func(o controller.Options) (controller.Controller, error) {
+				return aws_credential_ctrl.NewRotateAWSCredential(o, secretClient)
+			},
+		},
 	}...)
 
 	ctrlOpts := controller.Options{
-		Address:      m.options.Address,
-		PathBase:     m.options.PathBase,
-		DataProvider: m.options.DataProvider,
+		Address:        m.options.Address,
+		PathBase:       m.options.PathBase,
+		DataProvider:   m.options.DataProvider,
+		EventPublisher: m.options.EventPublisher,
 	}
 
 	for _, h := range handlerOptions {
@@ -258,7 +268,7 @@ func (m *Module) newAWSConfig(ctx context.Context) (aws.Config, error) {
 		if err != nil {
 			return aws.Config{}, err
 		}
-		p := ucp_aws.NewUCPCredentialProvider(provider, ucp_aws.DefaultExpireDuration)
+		p := ucp_aws.NewUCPCredentialProvider(provider, ucp_aws.DefaultExpireDuration, m.options.Config.Identity.AllowAmbientCredentials)
 		credProviders = append(credProviders, config.WithCredentialsProvider(p))
 		logger.Info("Configuring 'UCPCredential' authentication mode using UCP Credential API")
 
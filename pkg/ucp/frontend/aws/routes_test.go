@@ -54,6 +54,10 @@ func Test_Routes(t *testing.T) {
 			OperationType: v1.OperationType{Type: v20231001preview.AWSCredentialType, Method: v1.OperationDelete},
 			Method:        http.MethodDelete,
 			Path:          "/planes/aws/aws/providers/System.AWS/credentials/default",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.AWSCredentialType, Method: "ACTIONROTATE"},
+			Method:        http.MethodPost,
+			Path:          "/planes/aws/aws/providers/System.AWS/credentials/default/rotate",
 		}, {
 			OperationType: v1.OperationType{Type: OperationTypeAWSResource, Method: v1.OperationList},
 			Method:        http.MethodGet,
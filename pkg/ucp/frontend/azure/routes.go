@@ -20,15 +20,21 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	azcred "github.com/radius-project/radius/pkg/azure/credential"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
 	azure_credential_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials/azure"
 	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
+	"github.com/radius-project/radius/pkg/ucp/hostoptions"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"github.com/radius-project/radius/pkg/validator"
 )
 
@@ -47,6 +53,11 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		return nil, err
 	}
 
+	armCredential, err := m.newARMCredential(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	baseRouter := server.NewSubrouter(m.router, m.options.PathBase+planeScope)
 
 	// URL for operations on System.Azure provider.
@@ -101,6 +112,15 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 				return azure_credential_ctrl.NewDeleteAzureCredential(opt, secretClient)
 			},
 		},
+		{
+			ParentRouter: credentialResourceRouter,
+			Path:         "/rotate",
+			Method:       v1.OperationMethod("ACTIONROTATE"),
+			ResourceType: v20231001preview.AzureCredentialType,
+			ControllerFactory: func(opt armrpc_controller.Options) (armrpc_controller.Controller, error) {
+				return azure_credential_ctrl.NewRotateAzureCredential(opt, secretClient)
+			},
+		},
 
 		// Chi router uses radix tree so that it doesn't linear search the matched one. So, to catch all requests,
 		// we need to use CatchAllPath(/*) at the above matched routes path in chi router.
@@ -108,17 +128,20 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		// Note that the API validation is not applied for CatchAllPath(/*).
 		{
 			// Method deliberately omitted. This is a catch-all route for proxying.
-			ParentRouter:      baseRouter,
-			Path:              server.CatchAllPath,
-			OperationType:     &v1.OperationType{Type: OperationTypeUCPAzureProxy, Method: v1.OperationProxy},
-			ControllerFactory: planes_ctrl.NewProxyController,
+			ParentRouter:  baseRouter,
+			Path:          server.CatchAllPath,
+			OperationType: &v1.OperationType{Type: OperationTypeUCPAzureProxy, Method: v1.OperationProxy},
+			ControllerFactory: func(opt armrpc_controller.Options) (armrpc_controller.Controller, error) {
+				return planes_ctrl.NewProxyControllerWithCredential(opt, armCredential)
+			},
 		},
 	}
 
 	ctrlOpts := armrpc_controller.Options{
-		Address:      m.options.Address,
-		PathBase:     m.options.PathBase,
-		DataProvider: m.options.DataProvider,
+		Address:        m.options.Address,
+		PathBase:       m.options.PathBase,
+		DataProvider:   m.options.DataProvider,
+		EventPublisher: m.options.EventPublisher,
 	}
 
 	for _, h := range handlerOptions {
@@ -129,3 +152,25 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 
 	return m.router, nil
 }
+
+// newARMCredential creates the credential used to authenticate requests proxied to Azure. It returns nil when UCP is
+// not configured to use the UCP credential API, in which case requests are proxied with the caller's credentials.
+func (m *Module) newARMCredential(ctx context.Context) (azcore.TokenCredential, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	if m.options.Config.Identity.AuthMethod != hostoptions.AuthUCPCredential {
+		return nil, nil
+	}
+
+	provider, err := sdk_cred.NewAzureCredentialProvider(m.options.SecretProvider, m.options.UCPConnection, &aztoken.AnonymousCredential{})
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := azcred.NewUCPCredential(azcred.UCPCredentialOptions{Provider: provider})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Configuring 'UCPCredential' authentication mode using UCP Credential API")
+	return credential, nil
+}
@@ -54,6 +54,10 @@ func Test_Routes(t *testing.T) {
 			OperationType: v1.OperationType{Type: v20231001preview.AzureCredentialType, Method: v1.OperationDelete},
 			Method:        http.MethodDelete,
 			Path:          "/planes/azure/azurecloud/providers/System.Azure/credentials/default",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.AzureCredentialType, Method: "ACTIONROTATE"},
+			Method:        http.MethodPost,
+			Path:          "/planes/azure/azurecloud/providers/System.Azure/credentials/default/rotate",
 		}, {
 			OperationType:               v1.OperationType{Type: OperationTypeUCPAzureProxy, Method: v1.OperationProxy},
 			Method:                      http.MethodGet,
@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditrecords
+
+import (
+	"context"
+	"fmt"
+	http "net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	scopeQueryParam     = "scope"
+	startTimeQueryParam = "startTime"
+	endTimeQueryParam   = "endTime"
+)
+
+var _ armrpc_controller.Controller = (*ListAuditRecords)(nil)
+
+// ListAuditRecords is the controller implementation to get the list of audit records of a plane.
+type ListAuditRecords struct {
+	armrpc_controller.BaseController
+}
+
+// NewListAuditRecords creates a new controller for listing audit records.
+func NewListAuditRecords(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+	return &ListAuditRecords{armrpc_controller.NewBaseController(opts)}, nil
+}
+
+// Run queries a page of the audit records stored in the scope of the plane, filters them by the optional scope,
+// startTime and endTime query parameters, and returns them ordered from newest to oldest within the page. The page
+// size is set by the top query parameter and the next page is returned by following the nextLink of the response.
+// A scope within a resource group is applied by the store query, other filters are applied to the page.
+func (r *ListAuditRecords) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	planeType, planeName, _, err := resources.ExtractPlanesPrefixFromURLPath(serviceCtx.ResourceID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	scope := strings.TrimSuffix(req.URL.Query().Get(scopeQueryParam), resources.SegmentSeparator)
+	startTime, err := parseTime(req.URL.Query().Get(startTimeQueryParam))
+	if err != nil {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("invalid %s: %s", startTimeQueryParam, err.Error())), nil
+	}
+	endTime, err := parseTime(req.URL.Query().Get(endTimeQueryParam))
+	if err != nil {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("invalid %s: %s", endTimeQueryParam, err.Error())), nil
+	}
+
+	query := store.Query{
+		RootScope:    resources.SegmentSeparator + resources.PlanesSegment + resources.SegmentSeparator + planeType + resources.SegmentSeparator + planeName,
+		ResourceType: audit.ResourceType,
+	}
+	if scope != "" {
+		scopeID, err := resources.Parse(scope)
+		if err != nil {
+			return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("invalid %s: %s", scopeQueryParam, err.Error())), nil
+		}
+
+		if resourceGroup := strings.ToLower(scopeID.ResourceGroupScope()); resourceGroup != "" {
+			query.Filters = []store.QueryFilter{{Field: "resourceGroup", Value: resourceGroup}}
+		}
+	}
+	logger.Info(fmt.Sprintf("Listing audit records in scope %s", query.RootScope))
+
+	result, err := r.StorageClient().Query(ctx, query, store.WithPaginationToken(serviceCtx.SkipToken), store.WithMaxQueryItemCount(serviceCtx.Top))
+	if err != nil {
+		return nil, err
+	}
+
+	records := []*audit.Record{}
+	for _, item := range result.Items {
+		record := &audit.Record{}
+		if err := item.As(record); err != nil {
+			return nil, err
+		}
+
+		if !inScope(record.ResourceID, scope) {
+			continue
+		}
+		if !startTime.IsZero() && record.Timestamp.Before(startTime) {
+			continue
+		}
+		if !endTime.IsZero() && record.Timestamp.After(endTime) {
+			continue
+		}
+
+		records = append(records, record)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Timestamp.After(records[j].Timestamp)
+	})
+
+	items := v1.PaginatedList{NextLink: nextLink(req, serviceCtx, result.PaginationToken)}
+	for _, record := range records {
+		versioned, err := converter.AuditRecordDataModelToVersioned(record, serviceCtx.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		items.Value = append(items.Value, versioned)
+	}
+
+	return armrpc_rest.NewOKResponse(&items), nil
+}
+
+// nextLink returns the URL of the next page of the list, which keeps the filters of the request, or an empty string if
+// there is no next page.
+func nextLink(req *http.Request, serviceCtx *v1.ARMRequestContext, paginationToken string) string {
+	if paginationToken == "" {
+		return ""
+	}
+
+	qps := url.Values{}
+	for key, values := range req.URL.Query() {
+		qps[key] = values
+	}
+	qps.Set(v1.SkipTokenParameterName, paginationToken)
+	qps.Set(v1.TopParameterName, strconv.Itoa(serviceCtx.Top))
+
+	return armrpc_controller.GetURLFromReqWithQueryParameters(req, qps).String()
+}
+
+// inScope returns true if the resource ID is the scope itself or a resource nested within it.
+func inScope(resourceID string, scope string) bool {
+	if scope == "" {
+		return true
+	}
+
+	id := strings.ToLower(resourceID)
+	scope = strings.ToLower(scope)
+	return id == scope || strings.HasPrefix(id, scope+resources.SegmentSeparator)
+}
+
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339Nano, value)
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditrecords
+
+import (
+	"context"
+	http "net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+func Test_ListAuditRecords(t *testing.T) {
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	records := []*audit.Record{
+		{
+			ID:         "/planes/radius/local/providers/System.Resources/auditRecords/record-1",
+			Name:       "record-1",
+			Timestamp:  now.Add(-2 * time.Hour),
+			Caller:     "alice",
+			ResourceID: "/planes/radius/local/resourceGroups/rg1/providers/Applications.Core/applications/app",
+			Method:     http.MethodPut,
+			StatusCode: http.StatusOK,
+			Result:     audit.ResultSucceeded,
+		},
+		{
+			ID:         "/planes/radius/local/providers/System.Resources/auditRecords/record-2",
+			Name:       "record-2",
+			Timestamp:  now.Add(-1 * time.Hour),
+			Caller:     "bob",
+			ResourceID: "/planes/radius/local/resourceGroups/RG1/providers/Applications.Core/applications/app",
+			Method:     http.MethodDelete,
+			StatusCode: http.StatusForbidden,
+			Result:     audit.ResultFailed,
+		},
+		{
+			ID:         "/planes/radius/local/providers/System.Resources/auditRecords/record-3",
+			Name:       "record-3",
+			Timestamp:  now,
+			ResourceID: "/planes/radius/local/resourceGroups/rg10",
+			Method:     http.MethodPut,
+			StatusCode: http.StatusOK,
+			Result:     audit.ResultSucceeded,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		filters  []store.QueryFilter
+		expected []string
+	}{
+		{
+			name:     "all records newest first",
+			query:    "",
+			expected: []string{"record-3", "record-2", "record-1"},
+		},
+		{
+			name:     "filtered by scope",
+			query:    "&scope=/planes/radius/local/resourceGroups/rg1",
+			filters:  []store.QueryFilter{{Field: "resourceGroup", Value: "/planes/radius/local/resourcegroups/rg1"}},
+			expected: []string{"record-2", "record-1"},
+		},
+		{
+			name:     "filtered by resource scope",
+			query:    "&scope=/planes/radius/local/resourceGroups/RG1/providers/Applications.Core/applications/app",
+			filters:  []store.QueryFilter{{Field: "resourceGroup", Value: "/planes/radius/local/resourcegroups/rg1"}},
+			expected: []string{"record-2", "record-1"},
+		},
+		{
+			name:     "filtered by time",
+			query:    "&startTime=" + now.Add(-90*time.Minute).Format(time.RFC3339) + "&endTime=" + now.Add(-30*time.Minute).Format(time.RFC3339),
+			expected: []string{"record-2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockStorageClient := store.NewMockStorageClient(mockCtrl)
+
+			query := store.Query{
+				RootScope:    "/planes/radius/local",
+				ResourceType: audit.ResourceType,
+				Filters:      tt.filters,
+			}
+			mockStorageClient.EXPECT().Query(gomock.Any(), query, gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+				result := &store.ObjectQueryResult{}
+				for _, record := range records {
+					result.Items = append(result.Items, store.Object{Data: record})
+				}
+				return result, nil
+			})
+
+			ctrl, err := NewListAuditRecords(armrpc_controller.Options{StorageClient: mockStorageClient})
+			require.NoError(t, err)
+
+			url := "/planes/radius/local/providers/System.Resources/auditRecords?api-version=2023-10-01-preview" + tt.query
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(request)
+
+			response, err := ctrl.Run(ctx, nil, request)
+			require.NoError(t, err)
+
+			ok, isOK := response.(*armrpc_rest.OKResponse)
+			require.True(t, isOK)
+
+			names := []string{}
+			for _, item := range ok.Body.(*v1.PaginatedList).Value {
+				names = append(names, *item.(*v20231001preview.AuditRecordResource).Name)
+			}
+			require.Equal(t, tt.expected, names)
+			require.Empty(t, ok.Body.(*v1.PaginatedList).NextLink)
+		})
+	}
+
+	t.Run("paginated", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockStorageClient := store.NewMockStorageClient(mockCtrl)
+
+		mockStorageClient.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+			config := store.NewQueryConfig(options...)
+			require.Equal(t, "page-1", config.PaginationToken)
+			require.Equal(t, 5, config.MaxQueryItemCount)
+
+			return &store.ObjectQueryResult{
+				Items:           []store.Object{{Data: records[0]}, {Data: records[1]}},
+				PaginationToken: "page-2",
+			}, nil
+		})
+
+		ctrl, err := NewListAuditRecords(armrpc_controller.Options{StorageClient: mockStorageClient})
+		require.NoError(t, err)
+
+		url := "http://localhost/planes/radius/local/providers/System.Resources/auditRecords?api-version=2023-10-01-preview&top=5&skipToken=page-1&startTime=" + now.Add(-3*time.Hour).Format(time.RFC3339)
+		request, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+
+		ok, isOK := response.(*armrpc_rest.OKResponse)
+		require.True(t, isOK)
+
+		list := ok.Body.(*v1.PaginatedList)
+		require.Len(t, list.Value, 2)
+		require.Contains(t, list.NextLink, "skipToken=page-2")
+		require.Contains(t, list.NextLink, "top=5")
+		require.Contains(t, list.NextLink, "startTime=")
+	})
+
+	t.Run("invalid startTime", func(t *testing.T) {
+		ctrl, err := NewListAuditRecords(armrpc_controller.Options{})
+		require.NoError(t, err)
+
+		url := "/planes/radius/local/providers/System.Resources/auditRecords?api-version=2023-10-01-preview&startTime=yesterday"
+		request, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.BadRequestResponse{}, response)
+	})
+}
@@ -17,7 +17,9 @@ package aws
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
@@ -59,8 +61,8 @@ func (c *CreateOrUpdateAWSCredential) Run(ctx context.Context, w http.ResponseWr
 		return nil, err
 	}
 
-	if newResource.Properties.Kind != datamodel.AWSCredentialKind {
-		return armrpc_rest.NewBadRequestResponse("Invalid Credential Kind"), nil
+	if r := validateCredential(serviceCtx.ResourceID.Name(), newResource.Properties); r != nil {
+		return r, nil
 	}
 
 	old, etag, err := c.GetResource(ctx, serviceCtx.ResourceID)
@@ -77,6 +79,10 @@ func (c *CreateOrUpdateAWSCredential) Run(ctx context.Context, w http.ResponseWr
 		newResource.Properties.Storage.InternalCredential.SecretName = secretName
 	}
 
+	// Record the kind alongside the secret so that consumers can resolve the credential without
+	// reading the metadata store.
+	newResource.Properties.AWSCredential.Kind = newResource.Properties.Kind
+
 	// Save the credential secret
 	err = secret.SaveSecret(ctx, c.secretClient, secretName, newResource.Properties.AWSCredential)
 	if err != nil {
@@ -92,5 +98,28 @@ func (c *CreateOrUpdateAWSCredential) Run(ctx context.Context, w http.ResponseWr
 		return nil, err
 	}
 
+	c.PublishResourceEvent(ctx, armrpc_controller.ResourceEventType(old), newResource)
+
 	return c.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
 }
+
+// validateCredential validates the kind-specific properties of an AWS credential. It returns a bad request
+// response if the credential is invalid.
+func validateCredential(name string, properties *datamodel.AWSCredentialResourceProperties) armrpc_rest.Response {
+	switch properties.Kind {
+	case datamodel.AWSCredentialKind:
+		return nil
+	case datamodel.AWSIRSACredentialKind, datamodel.AWSAssumeRoleCredentialKind:
+		if properties.AWSCredential == nil || properties.AWSCredential.RoleARN == "" {
+			return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("roleARN is required for %s credentials", properties.Kind))
+		}
+
+		if properties.Kind == datamodel.AWSAssumeRoleCredentialKind && strings.EqualFold(properties.AWSCredential.SourceCredential, name) {
+			return armrpc_rest.NewBadRequestResponse("sourceCredential must refer to a different credential")
+		}
+
+		return nil
+	default:
+		return armrpc_rest.NewBadRequestResponse("Invalid Credential Kind")
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"net/http"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/events"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
@@ -91,5 +92,8 @@ func (c *DeleteAWSCredential) Run(ctx context.Context, w http.ResponseWriter, re
 	}
 
 	logger.Info(fmt.Sprintf("Deleted AWS Credential %s successfully", serviceCtx.ResourceID))
+
+	c.PublishResourceEvent(ctx, events.EventTypeResourceDeleted, nil)
+
 	return rest.NewOKResponse(nil), nil
 }
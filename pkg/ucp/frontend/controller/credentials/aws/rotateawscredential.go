@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+)
+
+var _ armrpc_controller.Controller = (*RotateAWSCredential)(nil)
+
+// RotateAWSCredential is the controller implementation to rotate the secret of a UCP AWS credential.
+type RotateAWSCredential struct {
+	armrpc_controller.Operation[*datamodel.AWSCredential, datamodel.AWSCredential]
+	secretClient secret.Client
+}
+
+// NewRotateAWSCredential creates a new RotateAWSCredential controller.
+func NewRotateAWSCredential(opts armrpc_controller.Options, secretClient secret.Client) (armrpc_controller.Controller, error) {
+	return &RotateAWSCredential{
+		Operation: armrpc_controller.NewOperation(opts,
+			armrpc_controller.ResourceOptions[datamodel.AWSCredential]{
+				RequestConverter:  converter.AWSCredentialDataModelFromVersioned,
+				ResponseConverter: converter.AWSCredentialDataModelToVersioned,
+			},
+		),
+		secretClient: secretClient,
+	}, nil
+}
+
+// Run replaces the secret of an existing AWS credential with the secret in the request and updates its expiry. The
+// secret is overwritten in place, so consumers of the credential observe either the old or the new secret but never
+// a missing one. Once the secret is replaced, the rotation completes even if the credential is updated concurrently.
+// The kind of the credential cannot be changed by a rotation.
+func (c *RotateAWSCredential) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	newResource, err := c.GetResourceFromRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r := validateCredential(serviceCtx.ResourceID.Name(), newResource.Properties); r != nil {
+		return r, nil
+	}
+
+	old, etag, err := c.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if old == nil {
+		return armrpc_rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	if r, err := c.PrepareResource(ctx, req, nil, old, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	if old.Properties.Kind != newResource.Properties.Kind {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("the kind of credential %q is %s and cannot be changed by a rotation", serviceCtx.ResourceID.Name(), old.Properties.Kind)), nil
+	}
+
+	secretName := credentials.GetSecretName(serviceCtx.ResourceID)
+	if old.Properties.Storage != nil && old.Properties.Storage.InternalCredential != nil && old.Properties.Storage.InternalCredential.SecretName != "" {
+		secretName = old.Properties.Storage.InternalCredential.SecretName
+	}
+
+	newResource.Properties.AWSCredential.Kind = newResource.Properties.Kind
+	err = secret.SaveSecret(ctx, c.secretClient, secretName, newResource.Properties.AWSCredential)
+	if err != nil {
+		return nil, err
+	}
+
+	// Do not save the secret in metadata store.
+	newResource.Properties.AWSCredential.SecretAccessKey = ""
+
+	saved, newEtag, err := credentials.SaveRotatedCredential(ctx, &c.Operation, serviceCtx.ResourceID, old, etag, func(resource *datamodel.AWSCredential) {
+		resource.Properties.AWSCredential = newResource.Properties.AWSCredential
+		resource.Properties.ExpiresAt = newResource.Properties.ExpiresAt
+		// The status of the previous secret no longer applies. The credential validator records the status of the new one.
+		resource.Properties.Status = nil
+		resource.UpdatedAPIVersion = serviceCtx.APIVersion
+		resource.SystemData = v1.UpdateSystemData(&resource.SystemData, serviceCtx.SystemData())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ConstructSyncResponse(ctx, req.Method, newEtag, saved)
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AWS_Credential_Rotate(t *testing.T) {
+	testID := "/planes/aws/awscloud/providers/System.AWS/credentials/default"
+	expiresAt := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	existing := func(kind string) *store.Object {
+		return &store.Object{
+			Metadata: store.Metadata{ID: testID, ETag: "existing-etag"},
+			Data: &datamodel.AWSCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       testID,
+						Name:     "default",
+						Type:     "System.AWS/credentials",
+						Location: "West US",
+					},
+				},
+				Properties: &datamodel.AWSCredentialResourceProperties{
+					Kind: kind,
+					AWSCredential: &datamodel.AWSCredentialProperties{
+						Kind:        kind,
+						AccessKeyID: "00000000-0000-0000-0000-000000000000",
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind: datamodel.InternalStorageKind,
+						InternalCredential: &datamodel.InternalCredentialStorageProperties{
+							SecretName: "aws-awscloud-default",
+						},
+					},
+					Status: &datamodel.CredentialStatus{
+						State: datamodel.CredentialStateExpiring,
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		filename string
+		setup    func(mockStorageClient *store.MockStorageClient, mockSecretClient *secret.MockClient)
+		verify   func(t *testing.T, response armrpc_rest.Response)
+		err      error
+	}{
+		{
+			name:     "rotate_success",
+			filename: "aws-credential-rotate.json",
+			setup: func(mockStorageClient *store.MockStorageClient, mockSecretClient *secret.MockClient) {
+				mockStorageClient.EXPECT().Get(gomock.Any(), testID, gomock.Any()).Return(existing(datamodel.AWSCredentialKind), nil).Times(1)
+				mockSecretClient.EXPECT().Save(gomock.Any(), "aws-awscloud-default", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, name string, value []byte) error {
+						stored := datamodel.AWSCredentialProperties{}
+						require.NoError(t, json.Unmarshal(value, &stored))
+						require.Equal(t, "rotated-secret", stored.SecretAccessKey)
+						require.Equal(t, datamodel.AWSCredentialKind, stored.Kind)
+						return nil
+					}).Times(1)
+				mockStorageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+						saved := obj.Data.(*datamodel.AWSCredential)
+						require.Empty(t, saved.Properties.AWSCredential.SecretAccessKey)
+						require.Equal(t, expiresAt, *saved.Properties.ExpiresAt)
+						require.Nil(t, saved.Properties.Status)
+						return nil
+					}).Times(1)
+			},
+			verify: func(t *testing.T, response armrpc_rest.Response) {
+				ok, isOK := response.(*armrpc_rest.OKResponse)
+				require.True(t, isOK)
+				versioned := ok.Body.(*v20231001preview.AwsCredentialResource)
+				properties := versioned.Properties.(*v20231001preview.AwsAccessKeyCredentialProperties)
+				require.Equal(t, expiresAt, *properties.ExpiresAt)
+				require.Nil(t, properties.Status)
+			},
+		},
+		{
+			name:     "rotate_concurrent_update",
+			filename: "aws-credential-rotate.json",
+			setup: func(mockStorageClient *store.MockStorageClient, mockSecretClient *secret.MockClient) {
+				// The credential validator saves the status of the credential after the secret was replaced. The
+				// rotation is applied again to the stored credential instead of failing.
+				mockStorageClient.EXPECT().Get(gomock.Any(), testID, gomock.Any()).Return(existing(datamodel.AWSCredentialKind), nil).Times(2)
+				mockSecretClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				gomock.InOrder(
+					mockStorageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(&store.ErrConcurrency{}).Times(1),
+					mockStorageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+							saved := obj.Data.(*datamodel.AWSCredential)
+							require.Empty(t, saved.Properties.AWSCredential.SecretAccessKey)
+							require.Equal(t, expiresAt, *saved.Properties.ExpiresAt)
+							require.Nil(t, saved.Properties.Status)
+							return nil
+						}).Times(1),
+				)
+			},
+			verify: func(t *testing.T, response armrpc_rest.Response) {
+				ok, isOK := response.(*armrpc_rest.OKResponse)
+				require.True(t, isOK)
+				versioned := ok.Body.(*v20231001preview.AwsCredentialResource)
+				properties := versioned.Properties.(*v20231001preview.AwsAccessKeyCredentialProperties)
+				require.Equal(t, expiresAt, *properties.ExpiresAt)
+				require.Nil(t, properties.Status)
+			},
+		},
+		{
+			name:     "rotate_not_found",
+			filename: "aws-credential-rotate.json",
+			setup: func(mockStorageClient *store.MockStorageClient, mockSecretClient *secret.MockClient) {
+				mockStorageClient.EXPECT().Get(gomock.Any(), testID, gomock.Any()).Return(nil, &store.ErrNotFound{ID: testID}).Times(1)
+			},
+			verify: func(t *testing.T, response armrpc_rest.Response) {
+				require.Equal(t, armrpc_rest.NewNotFoundResponse(resources.MustParse(testID)), response)
+			},
+		},
+		{
+			name:     "rotate_kind_change",
+			filename: "aws-irsa-credential.json",
+			setup: func(mockStorageClient *store.MockStorageClient, mockSecretClient *secret.MockClient) {
+				mockStorageClient.EXPECT().Get(gomock.Any(), testID, gomock.Any()).Return(existing(datamodel.AWSCredentialKind), nil).Times(1)
+			},
+			verify: func(t *testing.T, response armrpc_rest.Response) {
+				require.Equal(t, armrpc_rest.NewBadRequestResponse(`the kind of credential "default" is AccessKey and cannot be changed by a rotation`), response)
+			},
+		},
+		{
+			name:     "rotate_secret_save_failure",
+			filename: "aws-credential-rotate.json",
+			setup: func(mockStorageClient *store.MockStorageClient, mockSecretClient *secret.MockClient) {
+				mockStorageClient.EXPECT().Get(gomock.Any(), testID, gomock.Any()).Return(existing(datamodel.AWSCredentialKind), nil).Times(1)
+				mockSecretClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Secret Save Failure")).Times(1)
+			},
+			err: errors.New("Secret Save Failure"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockStorageClient := store.NewMockStorageClient(mockCtrl)
+			mockSecretClient := secret.NewMockClient(mockCtrl)
+			tt.setup(mockStorageClient, mockSecretClient)
+
+			credentialCtrl, err := NewRotateAWSCredential(armrpc_controller.Options{StorageClient: mockStorageClient}, mockSecretClient)
+			require.NoError(t, err)
+
+			credentialVersionedInput := &v20231001preview.AwsCredentialResource{}
+			require.NoError(t, json.Unmarshal(testutil.ReadFixture(tt.filename), credentialVersionedInput))
+
+			request, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPost, testRotateHeaderFile, credentialVersionedInput)
+			require.NoError(t, err)
+
+			ctx := rpctest.NewARMRequestContext(request)
+			response, err := credentialCtrl.Run(ctx, nil, request)
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.verify(t, response)
+		})
+	}
+}
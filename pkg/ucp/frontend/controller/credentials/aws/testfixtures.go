@@ -19,4 +19,5 @@ package aws
 var (
 	testHeaderFile                  = "requestheaders20231001preview.json"
 	testHeaderFileWithBadAPIVersion = "requestheaders20231001preview_badapiversion.json"
+	testRotateHeaderFile            = "requestheaders20231001preview_rotate.json"
 )
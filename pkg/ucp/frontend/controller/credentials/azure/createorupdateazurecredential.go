@@ -61,8 +61,8 @@ func (c *CreateOrUpdateAzureCredential) Run(ctx context.Context, w http.Response
 		return nil, err
 	}
 
-	if newResource.Properties.Kind != datamodel.AzureCredentialKind {
-		return armrpc_rest.NewBadRequestResponse("Invalid Credential Kind"), nil
+	if r := validateCredential(newResource.Properties); r != nil {
+		return r, nil
 	}
 
 	old, etag, err := c.GetResource(ctx, serviceCtx.ResourceID)
@@ -79,6 +79,10 @@ func (c *CreateOrUpdateAzureCredential) Run(ctx context.Context, w http.Response
 		newResource.Properties.Storage.InternalCredential.SecretName = secretName
 	}
 
+	// Record the kind alongside the secret so that consumers can resolve the credential without
+	// reading the metadata store.
+	newResource.Properties.AzureCredential.Kind = newResource.Properties.Kind
+
 	// Save the credential secret
 	err = secret.SaveSecret(ctx, c.secretClient, secretName, newResource.Properties.AzureCredential)
 	if err != nil {
@@ -94,5 +98,23 @@ func (c *CreateOrUpdateAzureCredential) Run(ctx context.Context, w http.Response
 		return nil, err
 	}
 
+	c.PublishResourceEvent(ctx, armrpc_controller.ResourceEventType(old), newResource)
+
 	return c.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
 }
+
+// validateCredential validates the kind-specific properties of an Azure credential. It returns a bad request
+// response if the credential is invalid.
+func validateCredential(properties *datamodel.AzureCredentialResourceProperties) armrpc_rest.Response {
+	switch properties.Kind {
+	case datamodel.AzureCredentialKind, datamodel.AzureManagedIdentityCredentialKind:
+		return nil
+	case datamodel.AzureWorkloadIdentityCredentialKind:
+		if properties.AzureCredential == nil || properties.AzureCredential.ClientID == "" || properties.AzureCredential.TenantID == "" {
+			return armrpc_rest.NewBadRequestResponse("clientId and tenantId are required for WorkloadIdentity credentials")
+		}
+		return nil
+	default:
+		return armrpc_rest.NewBadRequestResponse("Invalid Credential Kind")
+	}
+}
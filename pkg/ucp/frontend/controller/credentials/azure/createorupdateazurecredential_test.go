@@ -65,6 +65,24 @@ func Test_Azure_Credential(t *testing.T) {
 			fn:         setupCredentialSuccessMocks,
 			err:        nil,
 		},
+		{
+			name:       "test_managed_identity_credential_creation",
+			filename:   "azure-managedidentity-credential.json",
+			headerfile: testHeaderFile,
+			url:        "/planes/azure/azurecloud/providers/System.Azure/credentials/default?api-version=2023-10-01-preview",
+			expected:   getAzureManagedIdentityResponse(),
+			fn:         setupCredentialSuccessMocks,
+			err:        nil,
+		},
+		{
+			name:       "test_workload_identity_missing_tenant",
+			filename:   "azure-workloadidentity-credential-missingtenant.json",
+			headerfile: testHeaderFile,
+			url:        "/planes/azure/azurecloud/providers/System.Azure/credentials/default?api-version=2023-10-01-preview",
+			expected:   armrpc_rest.NewBadRequestResponse("clientId and tenantId are required for WorkloadIdentity credentials"),
+			fn:         setupEmptyMocks,
+			err:        nil,
+		},
 		{
 			name:       "test_invalid_version_credential_resource",
 			filename:   "azure-credential.json",
@@ -165,6 +183,26 @@ func getAzureCredentialResponse() armrpc_rest.Response {
 	}, map[string]string{"ETag": ""})
 }
 
+func getAzureManagedIdentityResponse() armrpc_rest.Response {
+	return armrpc_rest.NewOKResponseWithHeaders(&v20231001preview.AzureCredentialResource{
+		Location: to.Ptr("West US"),
+		ID:       to.Ptr("/planes/azure/azurecloud/providers/System.Azure/credentials/default"),
+		Name:     to.Ptr("default"),
+		Type:     to.Ptr("System.Azure/credentials"),
+		Tags: map[string]*string{
+			"env": to.Ptr("dev"),
+		},
+		Properties: &v20231001preview.AzureManagedIdentityProperties{
+			ClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+			Kind:     to.Ptr(v20231001preview.AzureCredentialKindManagedIdentity),
+			Storage: &v20231001preview.InternalCredentialStorageProperties{
+				Kind:       to.Ptr(v20231001preview.CredentialStorageKindInternal),
+				SecretName: to.Ptr("azure-azurecloud-default"),
+			},
+		},
+	}, map[string]string{"ETag": ""})
+}
+
 func setupCredentialSuccessMocks(mockStorageClient store.MockStorageClient, mockSecretClient secret.MockClient) {
 	mockStorageClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
 		return nil, &store.ErrNotFound{ID: id}
@@ -22,6 +22,7 @@ import (
 	"net/http"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/events"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
@@ -91,5 +92,8 @@ func (c *DeleteAzureCredential) Run(ctx context.Context, w http.ResponseWriter,
 	}
 
 	logger.Info(fmt.Sprintf("Deleted Azure Credential %s successfully", serviceCtx.ResourceID))
+
+	c.PublishResourceEvent(ctx, events.EventTypeResourceDeleted, nil)
+
 	return armrpc_rest.NewOKResponse(nil), nil
 }
@@ -16,10 +16,21 @@ limitations under the License.
 package credentials
 
 import (
+	"context"
+	"errors"
 	"strings"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// maxRotationAttempts is the number of times the metadata of a rotated credential is saved before giving up
+	// because of concurrent updates.
+	maxRotationAttempts = 5
 )
 
 // GetSecretName takes in a resources.ID and returns a string which is the normalized name of the resource.
@@ -28,3 +39,29 @@ func GetSecretName(id resources.ID) string {
 	planeNamespace = strings.ReplaceAll(planeNamespace, "/", "-")
 	return kubernetes.NormalizeResourceName(planeNamespace + "-" + id.Name())
 }
+
+// SaveRotatedCredential applies update to the credential and saves it after its secret was rotated. The secret is
+// already replaced at this point, so a concurrent update of the credential, such as the status saved by the credential
+// validator, must not fail the rotation. When the save fails because of a concurrent update, the credential is read
+// again and update is applied to the stored credential. It returns the saved credential and its ETag.
+func SaveRotatedCredential[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any](ctx context.Context, op *armrpc_controller.Operation[P, T], id resources.ID, resource *T, etag string, update func(resource *T)) (*T, string, error) {
+	for attempt := 1; ; attempt++ {
+		update(resource)
+		newEtag, err := op.SaveResource(ctx, id.String(), resource, etag)
+		if err == nil {
+			return resource, newEtag, nil
+		} else if !errors.Is(err, &store.ErrConcurrency{}) || attempt >= maxRotationAttempts {
+			return nil, "", err
+		}
+
+		resource, etag, err = op.GetResource(ctx, id)
+		if err != nil {
+			return nil, "", err
+		} else if resource == nil {
+			return nil, "", &store.ErrNotFound{ID: id.String()}
+		}
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventsubscriptions
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+)
+
+// GetSecretName returns the name of the secret that holds the signing key of the event subscription.
+func GetSecretName(id resources.ID) string {
+	planeNamespace := strings.ReplaceAll(id.PlaneNamespace(), "/", "-")
+	return kubernetes.NormalizeResourceName(planeNamespace + "-eventsubscription-" + id.Name())
+}
+
+// SaveSecret returns an update filter that saves the signing key of the request with the secret client, so that only
+// the name of the secret is stored with the event subscription.
+func SaveSecret(secretClient secret.Client) controller.UpdateFilter[datamodel.EventSubscription] {
+	return func(ctx context.Context, newResource *datamodel.EventSubscription, oldResource *datamodel.EventSubscription, options *controller.Options) (rest.Response, error) {
+		if newResource.Properties.Secret == "" {
+			return nil, nil
+		}
+
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+		secretName := GetSecretName(serviceCtx.ResourceID)
+		if err := secret.SaveSecret(ctx, secretClient, secretName, newResource.Properties.Secret); err != nil {
+			return nil, err
+		}
+
+		newResource.Properties.SecretName = secretName
+		newResource.Properties.Secret = ""
+		return nil, nil
+	}
+}
+
+// DeleteSecret returns a delete filter that deletes the secret holding the signing key of the event subscription.
+func DeleteSecret(secretClient secret.Client) controller.DeleteFilter[datamodel.EventSubscription] {
+	return func(ctx context.Context, oldResource *datamodel.EventSubscription, options *controller.Options) (rest.Response, error) {
+		if oldResource.Properties.SecretName == "" {
+			return nil, nil
+		}
+
+		err := secretClient.Delete(ctx, oldResource.Properties.SecretName)
+		if err != nil && !errors.Is(err, &secret.ErrNotFound{}) {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+}
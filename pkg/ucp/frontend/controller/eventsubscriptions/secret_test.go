@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventsubscriptions
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSecretName(t *testing.T) {
+	id := resources.MustParse("/planes/radius/local/providers/System.Resources/eventSubscriptions/hook0")
+	require.Equal(t, "radius-local-eventsubscription-hook0", GetSecretName(id))
+}
+
+func TestSaveSecret(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	secretClient := secret.NewMockClient(mockCtrl)
+
+	value, err := json.Marshal("s3cr3t")
+	require.NoError(t, err)
+	secretClient.EXPECT().Save(gomock.Any(), "radius-local-eventsubscription-hook0", value).Return(nil)
+
+	subscription := &datamodel.EventSubscription{
+		Properties: datamodel.EventSubscriptionProperties{URL: "https://hooks.contoso.com", Secret: "s3cr3t"},
+	}
+	resp, err := SaveSecret(secretClient)(testContext(), subscription, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, resp)
+	require.Empty(t, subscription.Properties.Secret)
+	require.Equal(t, "radius-local-eventsubscription-hook0", subscription.Properties.SecretName)
+
+	// A request without a secret does not touch the secret store.
+	unsigned := &datamodel.EventSubscription{
+		Properties: datamodel.EventSubscriptionProperties{URL: "https://hooks.contoso.com"},
+	}
+	resp, err = SaveSecret(secretClient)(testContext(), unsigned, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, resp)
+	require.Empty(t, unsigned.Properties.SecretName)
+}
+
+func TestSaveSecret_Error(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	secretClient := secret.NewMockClient(mockCtrl)
+	secretClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("secret store unavailable"))
+
+	subscription := &datamodel.EventSubscription{
+		Properties: datamodel.EventSubscriptionProperties{URL: "https://hooks.contoso.com", Secret: "s3cr3t"},
+	}
+	_, err := SaveSecret(secretClient)(testContext(), subscription, nil, nil)
+	require.Error(t, err)
+}
+
+func TestDeleteSecret(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	secretClient := secret.NewMockClient(mockCtrl)
+	secretClient.EXPECT().Delete(gomock.Any(), "radius-local-eventsubscription-hook0").Return(&secret.ErrNotFound{})
+
+	subscription := &datamodel.EventSubscription{
+		Properties: datamodel.EventSubscriptionProperties{SecretName: "radius-local-eventsubscription-hook0"},
+	}
+	resp, err := DeleteSecret(secretClient)(testContext(), subscription, nil)
+	require.NoError(t, err)
+	require.Nil(t, resp)
+
+	// Unsigned subscriptions have no secret to delete.
+	resp, err = DeleteSecret(secretClient)(testContext(), &datamodel.EventSubscription{}, nil)
+	require.NoError(t, err)
+	require.Nil(t, resp)
+}
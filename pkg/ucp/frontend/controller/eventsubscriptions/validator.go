@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventsubscriptions
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/events"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ValidateRequest returns an UpdateFilter that checks that the URL of the new event subscription is an absolute http or
+// https URL allowed by the webhook address policy, and that the scope of its filter is a UCP resource ID in the same
+// plane as the subscription. If not, it returns a BadRequestResponse. The addresses a host name resolves to are only
+// checked when events are delivered.
+//
+// The reference to the signing secret and the delivery status of an existing subscription are kept when the request
+// does not set them, since they are never returned by the API.
+func ValidateRequest(policy *events.AddressPolicy) controller.UpdateFilter[datamodel.EventSubscription] {
+	return func(ctx context.Context, newResource *datamodel.EventSubscription, oldResource *datamodel.EventSubscription, options *controller.Options) (rest.Response, error) {
+		return validateRequest(ctx, policy, newResource, oldResource)
+	}
+}
+
+func validateRequest(ctx context.Context, policy *events.AddressPolicy, newResource *datamodel.EventSubscription, oldResource *datamodel.EventSubscription) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	u, err := url.Parse(newResource.Properties.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return rest.NewBadRequestResponse(fmt.Sprintf("invalid event subscription url %q: the url must be an absolute http or https URL", newResource.Properties.URL)), nil
+	}
+
+	if err := policy.CheckURL(u); err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("invalid event subscription url %q: %s", newResource.Properties.URL, err.Error())), nil
+	}
+
+	if newResource.Properties.Filter.Scope != "" {
+		scope, err := resources.Parse(newResource.Properties.Filter.Scope)
+		if err != nil || !scope.IsUCPQualified() {
+			return rest.NewBadRequestResponse(fmt.Sprintf("invalid event subscription scope %q: the scope must be a UCP resource ID such as /planes/radius/local/resourceGroups/rg1", newResource.Properties.Filter.Scope)), nil
+		}
+
+		plane := serviceCtx.ResourceID.PlaneScope()
+		if !strings.EqualFold(scope.PlaneScope(), plane) {
+			return rest.NewBadRequestResponse(fmt.Sprintf("invalid event subscription scope %q: the scope must be in the plane %s", newResource.Properties.Filter.Scope, plane)), nil
+		}
+	}
+
+	if oldResource != nil {
+		if newResource.Properties.Secret == "" {
+			newResource.Properties.SecretName = oldResource.Properties.SecretName
+		}
+		newResource.Properties.DeliveryStatus = oldResource.Properties.DeliveryStatus
+	}
+
+	return nil, nil
+}
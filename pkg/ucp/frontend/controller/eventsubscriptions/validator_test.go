@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventsubscriptions
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/events"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+func testContext() context.Context {
+	return v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{
+		ResourceID: resources.MustParse("/planes/radius/local/providers/System.Resources/eventSubscriptions/hook0"),
+	})
+}
+
+func TestValidateRequest(t *testing.T) {
+	policy, err := events.NewAddressPolicy(&events.Options{AllowedCIDRs: []string{"10.96.0.0/12"}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		url   string
+		scope string
+		valid bool
+	}{
+		{name: "https", url: "https://hooks.contoso.com/radius", valid: true},
+		{name: "http with resource group scope", url: "http://hooks.contoso.com:8080", scope: "/planes/radius/local/resourceGroups/rg1", valid: true},
+		{name: "allowed private address", url: "http://10.96.0.10/radius", valid: true},
+		{name: "localhost", url: "http://localhost:8080", valid: false},
+		{name: "loopback address", url: "http://127.0.0.1:9443", valid: false},
+		{name: "metadata endpoint", url: "http://169.254.169.254/latest/meta-data", valid: false},
+		{name: "private address", url: "https://192.168.1.10", valid: false},
+		{name: "relative url", url: "/radius", valid: false},
+		{name: "unsupported scheme", url: "ftp://hooks.contoso.com", valid: false},
+		{name: "invalid url", url: "https://[::1", valid: false},
+		{name: "other plane", url: "https://hooks.contoso.com", scope: "/planes/radius/other/resourceGroups/rg1", valid: false},
+		{name: "not ucp qualified", url: "https://hooks.contoso.com", scope: "/subscriptions/sub/resourceGroups/rg1", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscription := &datamodel.EventSubscription{
+				Properties: datamodel.EventSubscriptionProperties{
+					URL:    tt.url,
+					Filter: datamodel.EventSubscriptionFilter{Scope: tt.scope},
+				},
+			}
+
+			resp, err := ValidateRequest(policy)(testContext(), subscription, nil, nil)
+			require.NoError(t, err)
+			if tt.valid {
+				require.Nil(t, resp)
+				return
+			}
+
+			require.IsType(t, &rest.BadRequestResponse{}, resp)
+			require.Equal(t, v1.CodeInvalid, resp.(*rest.BadRequestResponse).Body.Error.Code)
+		})
+	}
+}
+
+func TestValidateRequest_KeepsSecretAndDeliveryStatus(t *testing.T) {
+	status := &datamodel.EventDeliveryStatus{State: datamodel.EventDeliveryStateSucceeded, Attempts: 1}
+	old := &datamodel.EventSubscription{
+		Properties: datamodel.EventSubscriptionProperties{URL: "https://hooks.contoso.com", SecretName: "radius-local-eventsubscription-hook", DeliveryStatus: status},
+	}
+
+	updated := &datamodel.EventSubscription{
+		Properties: datamodel.EventSubscriptionProperties{URL: "https://hooks.contoso.com/v2"},
+	}
+	resp, err := ValidateRequest(nil)(testContext(), updated, old, nil)
+	require.NoError(t, err)
+	require.Nil(t, resp)
+	require.Equal(t, "radius-local-eventsubscription-hook", updated.Properties.SecretName)
+	require.Equal(t, status, updated.Properties.DeliveryStatus)
+
+	rotated := &datamodel.EventSubscription{
+		Properties: datamodel.EventSubscriptionProperties{URL: "https://hooks.contoso.com", Secret: "new"},
+	}
+	resp, err = ValidateRequest(nil)(testContext(), rotated, old, nil)
+	require.NoError(t, err)
+	require.Nil(t, resp)
+	require.Equal(t, "new", rotated.Properties.Secret)
+	require.Empty(t, rotated.Properties.SecretName)
+}
+
+func TestValidateRequest_AllowedHosts(t *testing.T) {
+	policy, err := events.NewAddressPolicy(&events.Options{AllowedHosts: []string{"hooks.contoso.com", "*.fabrikam.com"}})
+	require.NoError(t, err)
+
+	for url, valid := range map[string]bool{
+		"https://hooks.contoso.com/radius":  true,
+		"https://ci.fabrikam.com/radius":    true,
+		"https://fabrikam.com/radius":       false,
+		"https://hooks.contoso.com.evil.io": false,
+	} {
+		subscription := &datamodel.EventSubscription{Properties: datamodel.EventSubscriptionProperties{URL: url}}
+		resp, err := ValidateRequest(policy)(testContext(), subscription, nil, nil)
+		require.NoError(t, err)
+		if valid {
+			require.Nil(t, resp, url)
+		} else {
+			require.IsType(t, &rest.BadRequestResponse{}, resp, url)
+		}
+	}
+}
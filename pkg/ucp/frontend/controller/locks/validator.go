@@ -0,0 +1,47 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ValidateRequest checks that the scope of the new lock is a UCP resource ID in the same plane as the lock.
+// If not, it returns a BadRequestResponse.
+func ValidateRequest(ctx context.Context, newResource *datamodel.Lock, oldResource *datamodel.Lock, options *controller.Options) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	scope, err := resources.Parse(newResource.Properties.Scope)
+	if err != nil || !scope.IsUCPQualified() {
+		return rest.NewBadRequestResponse(fmt.Sprintf("invalid lock scope %q: the scope must be a UCP resource ID such as /planes/radius/local/resourceGroups/rg1", newResource.Properties.Scope)), nil
+	}
+
+	plane := serviceCtx.ResourceID.PlaneScope()
+	if !strings.EqualFold(scope.PlaneScope(), plane) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("invalid lock scope %q: the scope must be in the plane %s", newResource.Properties.Scope, plane)), nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRequest(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope string
+		valid bool
+	}{
+		{name: "plane", scope: "/planes/radius/local", valid: true},
+		{name: "resource group", scope: "/planes/radius/local/resourceGroups/rg1", valid: true},
+		{name: "resource", scope: "/planes/radius/local/resourceGroups/rg1/providers/Applications.Core/environments/env0", valid: true},
+		{name: "other plane", scope: "/planes/radius/other/resourceGroups/rg1", valid: false},
+		{name: "not ucp qualified", scope: "/subscriptions/sub/resourceGroups/rg1", valid: false},
+		{name: "invalid", scope: "not-an-id", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{
+				ResourceID: resources.MustParse("/planes/radius/local/providers/System.Resources/locks/lock0"),
+			})
+			lock := &datamodel.Lock{
+				Properties: datamodel.LockProperties{
+					Level: datamodel.LockLevelCanNotDelete,
+					Scope: tt.scope,
+				},
+			}
+
+			resp, err := ValidateRequest(ctx, lock, nil, nil)
+			require.NoError(t, err)
+			if tt.valid {
+				require.Nil(t, resp)
+				return
+			}
+
+			require.IsType(t, &rest.BadRequestResponse{}, resp)
+			require.Equal(t, v1.CodeInvalid, resp.(*rest.BadRequestResponse).Body.Error.Code)
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	http "net/http"
 	"net/url"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
@@ -42,13 +43,23 @@ var _ armrpc_controller.Controller = (*ProxyController)(nil)
 // ProxyController is the controller implementation to proxy requests to appropriate RP or URL.
 type ProxyController struct {
 	armrpc_controller.Operation[*datamodel.Plane, datamodel.Plane]
+
+	// credential authenticates requests proxied to Azure planes. Optional.
+	credential azcore.TokenCredential
 }
 
 // NewProxyController creates a new ProxyPlane controller with the given options and returns it, or returns an error if the
 // controller cannot be created.
 func NewProxyController(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+	return NewProxyControllerWithCredential(opts, nil)
+}
+
+// NewProxyControllerWithCredential creates a new ProxyPlane controller which authenticates requests proxied to Azure
+// planes with the given credential. Requests are proxied with the caller's credentials when credential is nil.
+func NewProxyControllerWithCredential(opts armrpc_controller.Options, credential azcore.TokenCredential) (armrpc_controller.Controller, error) {
 	return &ProxyController{
-		Operation: armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.Plane]{}),
+		Operation:  armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.Plane]{}),
+		credential: credential,
 	}, nil
 }
 
@@ -149,6 +160,9 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 	options := proxy.ReverseProxyOptions{
 		RoundTripper: otelhttp.NewTransport(http.DefaultTransport),
 	}
+	if plane.Properties.Kind == rest.PlaneKindAzure {
+		options.TokenCredential = p.credential
+	}
 
 	refererURL := url.URL{
 		Scheme:   "http",
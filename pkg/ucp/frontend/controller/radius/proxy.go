@@ -27,6 +27,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/middleware"
@@ -195,6 +196,13 @@ func (p *ProxyController) PrepareProxyRequest(ctx context.Context, originalReq *
 	proxyReq.Header.Set("X-Forwarded-Proto", refererURL.Scheme)
 	proxyReq.Header.Set(v1.RefererHeader, refererURL.String())
 
+	// The bearer token is not forwarded to the resource provider, so forward the identity of the authenticated caller
+	// instead. The caller identity headers sent by the client are replaced so that they can't be spoofed.
+	if principal := authentication.PrincipalFromContext(ctx); principal != nil && principal.Name != "" {
+		proxyReq.Header.Set(v1.ClientPrincipalNameHeader, principal.Name)
+		proxyReq.Header.Del(v1.ClientObjectIDHeader)
+	}
+
 	return proxyReq, nil
 }
 
@@ -27,6 +27,7 @@ import (
 	"github.com/golang/mock/gomock"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/to"
@@ -325,6 +326,28 @@ func Test_ProxyController_PrepareProxyRequest(t *testing.T) {
 		require.Equal(t, "yes", proxyReq.Header.Get("Copied"))
 	})
 
+	t.Run("success (authenticated caller)", func(t *testing.T) {
+		originalURL, err := url.Parse("http://localhost:9443/path/base/planes/radius/local/resourceGroups/test-group/providers/System.TestRP?test=yes")
+		require.NoError(t, err)
+		originalReq := &http.Request{
+			Host: originalURL.Host,
+			Header: http.Header{
+				v1.ClientPrincipalNameHeader: []string{"spoofed"},
+				v1.ClientObjectIDHeader:      []string{"spoofed-id"},
+			},
+			URL: originalURL}
+
+		ctx := authentication.WithPrincipal(testcontext.New(t), &authentication.Principal{Name: "user@example.com"})
+
+		p, _, _, _, _ := createController(t)
+		proxyReq, err := p.PrepareProxyRequest(ctx, originalReq, downstream, relativePath)
+		require.NoError(t, err)
+		require.NotNil(t, proxyReq)
+
+		require.Equal(t, "user@example.com", proxyReq.Header.Get(v1.ClientPrincipalNameHeader))
+		require.Empty(t, proxyReq.Header.Get(v1.ClientObjectIDHeader))
+	})
+
 	t.Run("invalid downstream URL", func(t *testing.T) {
 		originalReq := &http.Request{Header: http.Header{}, URL: &url.URL{}}
 
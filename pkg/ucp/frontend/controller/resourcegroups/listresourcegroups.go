@@ -23,6 +23,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -51,6 +52,7 @@ func NewListResourceGroups(opts armrpc_controller.Options) (armrpc_controller.Co
 
 // Run() function extracts the plane type and name from the request URL, queries the storage client for resource groups in the
 // scope of the plane, creates a response with the list of resource groups and returns an OK response with the list of resource groups.
+// Soft-deleted resource groups are omitted unless the request includes them.
 func (r *ListResourceGroups) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
@@ -83,7 +85,7 @@ func (e *ListResourceGroups) createResponse(ctx context.Context, req *http.Reque
 	items := v1.PaginatedList{}
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
-	for _, item := range result.Items {
+	for _, item := range softdelete.Filter(req, result.Items) {
 		var rg datamodel.ResourceGroup
 		err := item.As(&rg)
 		if err != nil {
@@ -23,6 +23,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
@@ -50,7 +51,9 @@ func NewListResources(opts armrpc_controller.Options) (armrpc_controller.Control
 	}, nil
 }
 
-// Run implements controller.Controller.
+// Run implements controller.Controller. Soft-deleted resources are omitted unless the request includes them, and the
+// resources are filtered by the tag given in the request if any. A BadRequest response is returned if the request has a
+// tag value without a tag name.
 func (r *ListResources) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
 	relativePath := middleware.GetRelativePath(r.Options().PathBase, req.URL.Path)
 	id, err := resources.Parse(relativePath)
@@ -74,6 +77,13 @@ func (r *ListResources) Run(ctx context.Context, w http.ResponseWriter, req *htt
 		ResourceType: v20231001preview.ResourceType,
 	}
 
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	if serviceCtx.TagName == "" && serviceCtx.TagValue != "" {
+		return armrpc_rest.NewBadRequestResponse(v1.ErrTagValueWithoutTagName.Error()), nil
+	} else if serviceCtx.TagName != "" {
+		query.TagFilters = []store.TagFilter{{Name: serviceCtx.TagName, Value: serviceCtx.TagValue}}
+	}
+
 	result, err := r.StorageClient().Query(ctx, query)
 	if err != nil {
 		return nil, err
@@ -91,7 +101,7 @@ func (r *ListResources) createResponse(ctx context.Context, req *http.Request, r
 	items := v1.PaginatedList{}
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
-	for _, item := range result.Items {
+	for _, item := range softdelete.Filter(req, result.Items) {
 		data := datamodel.GenericResource{}
 		err := item.As(&data)
 		if err != nil {
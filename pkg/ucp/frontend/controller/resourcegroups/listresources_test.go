@@ -111,6 +111,54 @@ func Test_ListResources(t *testing.T) {
 		require.Equal(t, expected, response)
 	})
 
+	t.Run("success - tag filter", func(t *testing.T) {
+		storage, ctrl := setupListResources(t)
+
+		storage.EXPECT().
+			Get(gomock.Any(), resourceGroupID).
+			Return(&store.Object{Data: resourceGroupDatamodel}, nil).
+			Times(1)
+
+		expectedQuery := store.Query{
+			RootScope:    resourceGroupID,
+			ResourceType: v20231001preview.ResourceType,
+			TagFilters:   []store.TagFilter{{Name: "team", Value: "payments"}},
+		}
+		storage.EXPECT().
+			Query(gomock.Any(), expectedQuery).
+			Return(&store.ObjectQueryResult{Items: []store.Object{{Data: entryDatamodel}}}, nil).
+			Times(1)
+
+		expected := armrpc_rest.NewOKResponse(&v1.PaginatedList{
+			Value: []any{&entryResource},
+		})
+
+		request, err := http.NewRequest(http.MethodGet, ctrl.Options().PathBase+id+"?api-version="+v20231001preview.Version+"&tagName=team&tagValue=payments", nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+	})
+
+	t.Run("tag value without tag name", func(t *testing.T) {
+		storage, ctrl := setupListResources(t)
+
+		storage.EXPECT().
+			Get(gomock.Any(), resourceGroupID).
+			Return(&store.Object{Data: resourceGroupDatamodel}, nil).
+			Times(1)
+
+		expected := armrpc_rest.NewBadRequestResponse(v1.ErrTagValueWithoutTagName.Error())
+
+		request, err := http.NewRequest(http.MethodGet, ctrl.Options().PathBase+id+"?api-version="+v20231001preview.Version+"&tagValue=payments", nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+	})
+
 	t.Run("resource group not found", func(t *testing.T) {
 		storage, ctrl := setupListResources(t)
 
@@ -21,6 +21,7 @@ import (
 	"net/http"
 
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/events"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/hostoptions"
@@ -75,6 +76,9 @@ type Options struct {
 	// StatusManager is the async operation status manager.
 	StatusManager statusmanager.StatusManager
 
+	// EventPublisher publishes resource lifecycle events. May be nil if events are disabled.
+	EventPublisher events.Publisher
+
 	// UCPConnection is the connection used to communicate with UCP APIs.
 	UCPConnection sdk.Connection
 }
@@ -21,12 +21,18 @@ import (
 	"net/http"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/armrpc/events"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	auditrecords_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/auditrecords"
+	eventsubscriptions_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/eventsubscriptions"
+	locks_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/locks"
 	radius_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/radius"
 	resourcegroups_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
 	"github.com/radius-project/radius/pkg/validator"
@@ -36,12 +42,40 @@ const (
 	planeScope                  = "/planes/{planeType}/{planeName}"
 	resourceGroupCollectionPath = "/resourcegroups"
 	resourceGroupResourcePath   = "/resourcegroups/{resourceGroupName}"
+	auditRecordCollectionPath   = "/providers/System.Resources/auditRecords"
+	lockCollectionPath          = "/providers/System.Resources/locks"
+	lockResourcePath            = "/providers/System.Resources/locks/{lockName}"
+
+	eventSubscriptionCollectionPath = "/providers/System.Resources/eventSubscriptions"
+	eventSubscriptionResourcePath   = "/providers/System.Resources/eventSubscriptions/{eventSubscriptionName}"
 
 	// OperationTypeUCPRadiusProxy is the operation type for proxying Radius API calls.
 	OperationTypeUCPRadiusProxy = "UCPRADIUSPROXY"
 )
 
 func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
+	secretClient, err := m.options.SecretProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var eventOptions *events.Options
+	if m.options.Config != nil {
+		eventOptions = m.options.Config.Events
+	}
+
+	// Webhooks are validated against the same address policy as the one used to deliver events.
+	webhookPolicy, err := events.NewAddressPolicy(eventOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	// The output resources of soft-deleted resource groups are torn down through the APIs of the resource providers.
+	var deleter softdelete.Deleter
+	if m.options.UCPConnection != nil {
+		deleter = softdelete.NewDeleter(m.options.UCPConnection)
+	}
+
 	baseRouter := server.NewSubrouter(m.router, m.options.PathBase+planeScope)
 
 	apiValidator := validator.APIValidator(validator.Options{
@@ -53,6 +87,17 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 	resourceGroupCollectionRouter := server.NewSubrouter(baseRouter, resourceGroupCollectionPath, apiValidator)
 	resourceGroupResourceRouter := server.NewSubrouter(baseRouter, resourceGroupResourcePath, apiValidator)
 
+	// URLs for audit records
+	auditRecordCollectionRouter := server.NewSubrouter(baseRouter, auditRecordCollectionPath, apiValidator)
+
+	// URLs for lifecycle of locks
+	lockCollectionRouter := server.NewSubrouter(baseRouter, lockCollectionPath, apiValidator)
+	lockResourceRouter := server.NewSubrouter(baseRouter, lockResourcePath, apiValidator)
+
+	// URLs for lifecycle of event subscriptions
+	eventSubscriptionCollectionRouter := server.NewSubrouter(baseRouter, eventSubscriptionCollectionPath, apiValidator)
+	eventSubscriptionResourceRouter := server.NewSubrouter(baseRouter, eventSubscriptionResourcePath, apiValidator)
+
 	handlerOptions := []server.HandlerOptions{
 		{
 			ParentRouter:      resourceGroupCollectionRouter,
@@ -91,11 +136,28 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 			ResourceType: v20231001preview.ResourceGroupType,
 			Method:       v1.OperationDelete,
 			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
-				return defaultoperation.NewDefaultSyncDelete(opt,
+				return defaultoperation.NewSoftDelete(opt,
+					controller.ResourceOptions[datamodel.ResourceGroup]{
+						RequestConverter:  converter.ResourceGroupDataModelFromVersioned,
+						ResponseConverter: converter.ResourceGroupDataModelToVersioned,
+					},
+					softdelete.ResourceGroupChildren,
+					deleter,
+				)
+			},
+		},
+		{
+			ParentRouter: resourceGroupResourceRouter,
+			ResourceType: v20231001preview.ResourceGroupType,
+			Path:         "/restore",
+			Method:       v1.OperationMethod("ACTIONRESTORE"),
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewRestore(opt,
 					controller.ResourceOptions[datamodel.ResourceGroup]{
 						RequestConverter:  converter.ResourceGroupDataModelFromVersioned,
 						ResponseConverter: converter.ResourceGroupDataModelToVersioned,
 					},
+					softdelete.ResourceGroupChildren,
 				)
 			},
 		},
@@ -108,6 +170,126 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 				return resourcegroups_ctrl.NewListResources(opt)
 			},
 		},
+		{
+			ParentRouter:      auditRecordCollectionRouter,
+			ResourceType:      audit.ResourceType,
+			Method:            v1.OperationList,
+			ControllerFactory: auditrecords_ctrl.NewListAuditRecords,
+		},
+		{
+			ParentRouter: lockCollectionRouter,
+			ResourceType: datamodel.LockResourceType,
+			Method:       v1.OperationList,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewListResources(opt,
+					controller.ResourceOptions[datamodel.Lock]{
+						RequestConverter:  converter.LockDataModelFromVersioned,
+						ResponseConverter: converter.LockDataModelToVersioned,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: lockResourceRouter,
+			ResourceType: datamodel.LockResourceType,
+			Method:       v1.OperationGet,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewGetResource(opt,
+					controller.ResourceOptions[datamodel.Lock]{
+						RequestConverter:  converter.LockDataModelFromVersioned,
+						ResponseConverter: converter.LockDataModelToVersioned,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: lockResourceRouter,
+			ResourceType: datamodel.LockResourceType,
+			Method:       v1.OperationPut,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncPut(opt,
+					controller.ResourceOptions[datamodel.Lock]{
+						RequestConverter:  converter.LockDataModelFromVersioned,
+						ResponseConverter: converter.LockDataModelToVersioned,
+						UpdateFilters: []controller.UpdateFilter[datamodel.Lock]{
+							locks_ctrl.ValidateRequest,
+						},
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: lockResourceRouter,
+			ResourceType: datamodel.LockResourceType,
+			Method:       v1.OperationDelete,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncDelete(opt,
+					controller.ResourceOptions[datamodel.Lock]{
+						RequestConverter:  converter.LockDataModelFromVersioned,
+						ResponseConverter: converter.LockDataModelToVersioned,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: eventSubscriptionCollectionRouter,
+			ResourceType: datamodel.EventSubscriptionResourceType,
+			Method:       v1.OperationList,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewListResources(opt,
+					controller.ResourceOptions[datamodel.EventSubscription]{
+						RequestConverter:  converter.EventSubscriptionDataModelFromVersioned,
+						ResponseConverter: converter.EventSubscriptionDataModelToVersioned,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: eventSubscriptionResourceRouter,
+			ResourceType: datamodel.EventSubscriptionResourceType,
+			Method:       v1.OperationGet,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewGetResource(opt,
+					controller.ResourceOptions[datamodel.EventSubscription]{
+						RequestConverter:  converter.EventSubscriptionDataModelFromVersioned,
+						ResponseConverter: converter.EventSubscriptionDataModelToVersioned,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: eventSubscriptionResourceRouter,
+			ResourceType: datamodel.EventSubscriptionResourceType,
+			Method:       v1.OperationPut,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncPut(opt,
+					controller.ResourceOptions[datamodel.EventSubscription]{
+						RequestConverter:  converter.EventSubscriptionDataModelFromVersioned,
+						ResponseConverter: converter.EventSubscriptionDataModelToVersioned,
+						UpdateFilters: []controller.UpdateFilter[datamodel.EventSubscription]{
+							eventsubscriptions_ctrl.ValidateRequest(webhookPolicy),
+							eventsubscriptions_ctrl.SaveSecret(secretClient),
+						},
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: eventSubscriptionResourceRouter,
+			ResourceType: datamodel.EventSubscriptionResourceType,
+			Method:       v1.OperationDelete,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncDelete(opt,
+					controller.ResourceOptions[datamodel.EventSubscription]{
+						RequestConverter:  converter.EventSubscriptionDataModelFromVersioned,
+						ResponseConverter: converter.EventSubscriptionDataModelToVersioned,
+						DeleteFilters: []controller.DeleteFilter[datamodel.EventSubscription]{
+							eventsubscriptions_ctrl.DeleteSecret(secretClient),
+						},
+					},
+				)
+			},
+		},
 		// Chi router uses radix tree so that it doesn't linear search the matched one. So, to catch all requests,
 		// we need to use CatchAllPath(/*) at the above matched routes path in chi router.
 		//
@@ -129,10 +311,15 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 	}
 
 	ctrlOptions := controller.Options{
-		Address:       m.options.Address,
-		PathBase:      m.options.PathBase,
-		DataProvider:  m.options.DataProvider,
-		StatusManager: m.options.StatusManager,
+		Address:        m.options.Address,
+		PathBase:       m.options.PathBase,
+		DataProvider:   m.options.DataProvider,
+		StatusManager:  m.options.StatusManager,
+		EventPublisher: m.options.EventPublisher,
+	}
+
+	if m.options.Config != nil && m.options.Config.Server != nil {
+		ctrlOptions.SoftDelete = m.options.Config.Server.SoftDelete
 	}
 
 	for _, h := range handlerOptions {
@@ -24,8 +24,10 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/golang/mock/gomock"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
 	"github.com/radius-project/radius/pkg/ucp/hostoptions"
@@ -63,6 +65,46 @@ func Test_Routes(t *testing.T) {
 			OperationType: v1.OperationType{Type: v20231001preview.ResourceGroupType, Method: v1.OperationDelete},
 			Method:        http.MethodDelete,
 			Path:          "/planes/radius/local/resourcegroups/test-rg",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.ResourceGroupType, Method: "ACTIONRESTORE"},
+			Method:        http.MethodPost,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/restore",
+		}, {
+			OperationType: v1.OperationType{Type: audit.ResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Resources/auditRecords",
+		}, {
+			OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Resources/locks",
+		}, {
+			OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Resources/locks/test-lock",
+		}, {
+			OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/local/providers/System.Resources/locks/test-lock",
+		}, {
+			OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/local/providers/System.Resources/locks/test-lock",
+		}, {
+			OperationType: v1.OperationType{Type: datamodel.EventSubscriptionResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Resources/eventSubscriptions",
+		}, {
+			OperationType: v1.OperationType{Type: datamodel.EventSubscriptionResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Resources/eventSubscriptions/test-hook",
+		}, {
+			OperationType: v1.OperationType{Type: datamodel.EventSubscriptionResourceType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/local/providers/System.Resources/eventSubscriptions/test-hook",
+		}, {
+			OperationType: v1.OperationType{Type: datamodel.EventSubscriptionResourceType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/local/providers/System.Resources/eventSubscriptions/test-hook",
 		}, {
 			OperationType:               v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
 			Method:                      http.MethodGet,
@@ -17,10 +17,13 @@ limitations under the License.
 package hostoptions
 
 import (
+	"github.com/radius-project/radius/pkg/armrpc/events"
+	armrpc_hostoptions "github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	metricsprovider "github.com/radius-project/radius/pkg/metrics/provider"
 	profilerprovider "github.com/radius-project/radius/pkg/profiler/provider"
 	"github.com/radius-project/radius/pkg/trace"
 	"github.com/radius-project/radius/pkg/ucp/config"
+	"github.com/radius-project/radius/pkg/ucp/credentials/validation"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	qprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
 	"github.com/radius-project/radius/pkg/ucp/rest"
@@ -41,6 +44,16 @@ type UCPConfig struct {
 	Identity         Identity                                 `yaml:"identity,omitempty"`
 	UCP              config.UCPOptions                        `yaml:"ucp"`
 	Location         string                                   `yaml:"location"`
+
+	// Server configures authentication and authorization of the UCP API. The listening port and path base are
+	// configured through the PORT and BASE_PATH environment variables.
+	Server *armrpc_hostoptions.ServerOptions `yaml:"server,omitempty"`
+
+	// Events configures the delivery of resource lifecycle events to webhook subscriptions.
+	Events *events.Options `yaml:"events,omitempty"`
+
+	// CredentialValidation configures the background validation of the UCP credentials.
+	CredentialValidation *validation.Options `yaml:"credentialValidation,omitempty"`
 }
 
 const (
@@ -54,4 +67,9 @@ const (
 type Identity struct {
 	// AuthMethod represents the method of authentication for authenticating with external systems like Azure and AWS.
 	AuthMethod string `yaml:"authMethod"`
+
+	// AllowAmbientCredentials allows AWS AssumeRole credentials without a source credential to assume the role with
+	// the ambient credentials of UCP, such as its pod identity. It is disabled by default because any caller of the
+	// UCP API can create credentials.
+	AllowAmbientCredentials bool `yaml:"allowAmbientCredentials,omitempty"`
 }
@@ -17,15 +17,30 @@ limitations under the License.
 package proxy
 
 import (
+	"net/http"
 	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
 )
 
 // NewARMProxy creates a ReverseProxy with custom directors, transport and responders to process requests and responses.
 func NewARMProxy(options ReverseProxyOptions, downstream *url.URL, configure func(builder *ReverseProxyBuilder)) ReverseProxy {
+	transport := options.RoundTripper
+	if options.TokenCredential != nil {
+		transport = &armAuthTransport{
+			inner:      transport,
+			credential: options.TokenCredential,
+			scope:      downstream.Scheme + "://" + downstream.Host + "/.default",
+		}
+	}
+
 	builder := ReverseProxyBuilder{
 		Downstream:    downstream,
 		EnableLogging: true,
-		Transport:     options.RoundTripper,
+		Transport:     transport,
 		Responders:    []ResponderFunc{ProcessAsyncOperationHeaders},
 	}
 
@@ -35,3 +50,37 @@ func NewARMProxy(options ReverseProxyOptions, downstream *url.URL, configure fun
 
 	return builder.Build()
 }
+
+// armAuthTransport is a http.RoundTripper that authenticates requests to Azure Resource Manager with a bearer token
+// from the UCP Azure credential.
+type armAuthTransport struct {
+	inner      http.RoundTripper
+	credential azcore.TokenCredential
+	scope      string
+}
+
+// RoundTrip implements http.RoundTripper by setting the Authorization header to a token from the UCP Azure credential.
+//
+// Requests that carry their own Authorization header are sent as-is so that callers with an Azure token keep using
+// it. The exception is a caller authenticated by UCP: its Authorization header holds the UCP bearer token, which is
+// never forwarded to Azure and is replaced with a token from the UCP Azure credential.
+func (t *armAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	inner := t.inner
+	if inner == nil {
+		inner = http.DefaultTransport
+	}
+
+	if req.Header.Get("Authorization") != "" && authentication.PrincipalFromContext(req.Context()) == nil {
+		return inner.RoundTrip(req)
+	}
+
+	token, err := t.credential.GetToken(req.Context(), policy.TokenRequestOptions{Scopes: []string{t.scope}})
+	if err != nil {
+		return nil, err
+	}
+
+	// RoundTrippers must not modify the original request.
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token.Token)
+	return inner.RoundTrip(req)
+}
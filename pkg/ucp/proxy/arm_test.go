@@ -17,12 +17,20 @@ limitations under the License.
 package proxy
 
 import (
+	"context"
+	"io"
+	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/radius-project/radius/test/ucp/httpbaseline"
 
@@ -68,6 +76,86 @@ func Test_ARM_Baselines(t *testing.T) {
 	}
 }
 
+type fakeTokenCredential struct {
+	scopes []string
+}
+
+func (c *fakeTokenCredential) GetToken(ctx context.Context, options policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	c.scopes = options.Scopes
+	return azcore.AccessToken{Token: "ucp-token"}, nil
+}
+
+type captureRoundTripper struct {
+	request *http.Request
+}
+
+func (c *captureRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	c.request = req
+	return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("")), Request: req}, nil
+}
+
+func Test_ARM_TokenCredential(t *testing.T) {
+	downstream, err := url.Parse("https://management.azure.com")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		authorization string
+		principal     *authentication.Principal
+		expected      string
+		expectToken   bool
+	}{
+		{
+			name:        "adds token",
+			expected:    "Bearer ucp-token",
+			expectToken: true,
+		},
+		{
+			name:          "keeps caller token",
+			authorization: "Bearer caller-token",
+			expected:      "Bearer caller-token",
+		},
+		{
+			name:          "replaces token of authenticated caller",
+			authorization: "Bearer ucp-oidc-token",
+			principal:     &authentication.Principal{Name: "alice"},
+			expected:      "Bearer ucp-token",
+			expectToken:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := testcontext.NewWithCancel(t)
+			t.Cleanup(cancel)
+			if tt.principal != nil {
+				ctx = authentication.WithPrincipal(ctx, tt.principal)
+			}
+
+			capture := &captureRoundTripper{}
+			credential := &fakeTokenCredential{}
+			pp := NewARMProxy(ReverseProxyOptions{RoundTripper: capture, TokenCredential: credential}, downstream, nil)
+
+			req := httptest.NewRequest(http.MethodGet, "/subscriptions/sub/resourceGroups/rg", nil).WithContext(ctx)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+
+			w := httptest.NewRecorder()
+			pp.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Result().StatusCode)
+			require.NotNil(t, capture.request)
+			require.Equal(t, tt.expected, capture.request.Header.Get("Authorization"))
+			if tt.expectToken {
+				require.Equal(t, []string{"https://management.azure.com/.default"}, credential.scopes)
+			} else {
+				require.Nil(t, credential.scopes)
+			}
+		})
+	}
+}
+
 func readBaselines() ([]baseline, error) {
 	baselines := []baseline{}
 	base := filepath.Join(".", "testdata", "arm")
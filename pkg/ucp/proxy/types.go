@@ -20,6 +20,8 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 )
 
 // DirectorFunc is a function that modifies the request before it is sent to the downstream server.
@@ -40,6 +42,11 @@ type ReverseProxy interface {
 type ReverseProxyOptions struct {
 	// RoundTripper is the round tripper used by the reverse proxy to send requests.
 	RoundTripper http.RoundTripper
+
+	// TokenCredential is the credential used to authenticate requests sent to Azure Resource Manager. Requests that
+	// already have an Authorization header are sent as-is, unless the caller was authenticated by UCP, in which case
+	// the UCP bearer token is replaced. Optional.
+	TokenCredential azcore.TokenCredential
 }
 
 type ReverseProxyBuilder struct {
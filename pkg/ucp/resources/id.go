@@ -262,6 +262,23 @@ func (ri ID) PlaneScope() string {
 	return SegmentSeparator + joined
 }
 
+// ResourceGroupScope returns the scope of the resource group the resource is in, or an empty string if the resource
+// is not in a resource group.
+//
+// Examples:
+//
+//	/subscriptions/{guid}/resourceGroups/cool-group
+//	/planes/radius/local/resourceGroups/cool-group
+func (ri ID) ResourceGroupScope() string {
+	for _, t := range ri.scopeSegments {
+		if strings.EqualFold(t.Type, "resourcegroups") && t.Name != "" {
+			return ri.PlaneScope() + SegmentSeparator + t.Type + SegmentSeparator + t.Name
+		}
+	}
+
+	return ""
+}
+
 // ProviderNamespace returns the namespace of the resource provider. Will be empty if the resource ID
 // is empty or refers to a scope.
 //
@@ -825,6 +825,43 @@ func TestPlaneScope(t *testing.T) {
 	}
 }
 
+func TestResourceGroupScope(t *testing.T) {
+	tests := []struct {
+		desc               string
+		id                 string
+		resourceGroupScope string
+	}{
+		{
+			desc:               "Azure resource id",
+			id:                 "/subscriptions/s1/resourceGroups/r1/providers/Applications.Core/applications/cool-app",
+			resourceGroupScope: "/subscriptions/s1/resourceGroups/r1",
+		},
+		{
+			desc:               "UCP resource id",
+			id:                 "/planes/radius/local/resourceGroups/r1/providers/Applications.Core/applications/cool-app",
+			resourceGroupScope: "/planes/radius/local/resourceGroups/r1",
+		},
+		{
+			desc:               "Resource group scope",
+			id:                 "/planes/radius/local/resourcegroups/r1",
+			resourceGroupScope: "/planes/radius/local/resourcegroups/r1",
+		},
+		{
+			desc:               "No resource group",
+			id:                 "/planes/radius/local/providers/System.Resources/locks/lock",
+			resourceGroupScope: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			rID, err := Parse(tt.id)
+			require.NoError(t, err)
+			require.Equal(t, tt.resourceGroupScope, rID.ResourceGroupScope())
+		})
+	}
+}
+
 func TestPlaneNamespace(t *testing.T) {
 	tests := []struct {
 		desc     string
@@ -41,14 +41,18 @@ const (
 
 // Lookup map to get the group/Kind information from kubernetes resource kind.
 var providerLookup map[string]string = map[string]string{
-	strings.ToLower(KindDeployment):          ResourceTypeDeployment,
-	strings.ToLower(KindService):             ResourceTypeService,
-	strings.ToLower(KindSecret):              ResourceTypeSecret,
-	strings.ToLower(KindServiceAccount):      ResourceTypeServiceAccount,
-	strings.ToLower(KindRole):                ResourceTypeRole,
-	strings.ToLower(KindRoleBinding):         ResourceTypeRoleBinding,
-	strings.ToLower(KindSecretProviderClass): ResourceTypeSecretProviderClass,
-	strings.ToLower(KindContourHTTPProxy):    ResourceTypeContourHTTPProxy,
+	strings.ToLower(KindDeployment):            ResourceTypeDeployment,
+	strings.ToLower(KindService):               ResourceTypeService,
+	strings.ToLower(KindSecret):                ResourceTypeSecret,
+	strings.ToLower(KindServiceAccount):        ResourceTypeServiceAccount,
+	strings.ToLower(KindRole):                  ResourceTypeRole,
+	strings.ToLower(KindRoleBinding):           ResourceTypeRoleBinding,
+	strings.ToLower(KindSecretProviderClass):   ResourceTypeSecretProviderClass,
+	strings.ToLower(KindContourHTTPProxy):      ResourceTypeContourHTTPProxy,
+	strings.ToLower(KindPodDisruptionBudget):   ResourceTypePodDisruptionBudget,
+	strings.ToLower(KindJob):                   ResourceTypeJob,
+	strings.ToLower(KindCronJob):               ResourceTypeCronJob,
+	strings.ToLower(KindPersistentVolumeClaim): ResourceTypePersistentVolumeClaim,
 }
 
 // ToParts returns the component parts of the given UCP resource ID.
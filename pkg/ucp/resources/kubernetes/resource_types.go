@@ -43,6 +43,22 @@ const (
 	KindRoleBinding = "RoleBinding"
 	// ResourceTypeRoleBinding is the resource type of a Kubernetes RoleBinding.
 	ResourceTypeRoleBinding = "rbac.authorization.k8s.io/RoleBinding"
+	// KindPodDisruptionBudget is the kind of a Kubernetes PodDisruptionBudget.
+	KindPodDisruptionBudget = "PodDisruptionBudget"
+	// ResourceTypePodDisruptionBudget is the resource type of a Kubernetes PodDisruptionBudget.
+	ResourceTypePodDisruptionBudget = "policy/PodDisruptionBudget"
+	// KindJob is the kind of a Kubernetes Job.
+	KindJob = "Job"
+	// ResourceTypeJob is the resource type of a Kubernetes Job.
+	ResourceTypeJob = "batch/Job"
+	// KindCronJob is the kind of a Kubernetes CronJob.
+	KindCronJob = "CronJob"
+	// ResourceTypeCronJob is the resource type of a Kubernetes CronJob.
+	ResourceTypeCronJob = "batch/CronJob"
+	// KindPersistentVolumeClaim is the kind of a Kubernetes PersistentVolumeClaim.
+	KindPersistentVolumeClaim = "PersistentVolumeClaim"
+	// ResourceTypePersistentVolumeClaim is the resource type of a Kubernetes PersistentVolumeClaim.
+	ResourceTypePersistentVolumeClaim = "core/PersistentVolumeClaim"
 	// KindSecretProviderClass is the kind of a Kubernetes SecretProviderClass.
 	KindSecretProviderClass = "SecretProviderClass"
 	// ResourceTypeSecretProviderClass is the resource type of a Kubernetes SecretProviderClass.
@@ -23,7 +23,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/radius-project/radius/pkg/armrpc/audit"
 	hostopts "github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/armrpc/softdelete"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/kubeutil"
 	metricsprovider "github.com/radius-project/radius/pkg/metrics/provider"
 	metricsservice "github.com/radius-project/radius/pkg/metrics/service"
@@ -31,8 +34,11 @@ import (
 	profilerservice "github.com/radius-project/radius/pkg/profiler/service"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/trace"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/backend"
 	"github.com/radius-project/radius/pkg/ucp/config"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/credentials/validation"
 	"github.com/radius-project/radius/pkg/ucp/data"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/frontend/api"
@@ -147,8 +153,8 @@ func NewServerOptionsFromEnvironment() (Options, error) {
 	}, nil
 }
 
-// NewServer creates a new hosting.Host instance with services for API, EmbeddedETCD, Metrics, Profiler and Backend (if
-// enabled) based on the given Options.
+// NewServer creates a new hosting.Host instance with services for API, EmbeddedETCD, Metrics, Profiler, Backend,
+// credential validation (if enabled) and the purge of soft-deleted resource groups (if enabled) based on the given Options.
 func NewServer(options *Options) (*hosting.Host, error) {
 	hostingServices := []hosting.Service{
 		api.NewService(api.ServiceOptions{
@@ -199,10 +205,40 @@ func NewServer(options *Options) (*hosting.Host, error) {
 			MetricsProvider:  options.MetricsProviderOptions,
 			TracerProvider:   options.TracerProviderOptions,
 			ProfilerProvider: options.ProfilerProviderOptions,
+			Events:           options.Config.Events,
 		},
 	}
 	hostingServices = append(hostingServices, backend.NewService(backendServiceOptions))
 
+	if options.Config.CredentialValidation != nil && options.Config.CredentialValidation.Enabled {
+		secretProvider := provider.NewSecretProvider(options.SecretProviderOptions)
+		awsProvider, err := sdk_cred.NewAWSCredentialProvider(secretProvider, options.UCPConnection, &aztoken.AnonymousCredential{})
+		if err != nil {
+			return nil, err
+		}
+
+		hostingServices = append(hostingServices, validation.NewService(
+			*options.Config.CredentialValidation,
+			dataprovider.NewStorageProvider(options.StorageProviderOptions),
+			secretProvider,
+			validation.NewCloudVerifier(awsProvider, options.Config.Identity.AllowAmbientCredentials)))
+	}
+
+	if options.Config.Server != nil && options.Config.Server.Audit.IsStoreEnabled() {
+		hostingServices = append(hostingServices, audit.NewPurgeService(
+			options.Config.Server.Audit,
+			dataprovider.NewStorageProvider(options.StorageProviderOptions)))
+	}
+
+	if options.Config.Server != nil && options.Config.Server.SoftDelete.IsEnabled() {
+		hostingServices = append(hostingServices, softdelete.NewPurgeService(
+			options.Config.Server.SoftDelete,
+			v20231001preview.ResourceGroupType,
+			softdelete.ResourceGroupChildren,
+			dataprovider.NewStorageProvider(options.StorageProviderOptions),
+			softdelete.NewDeleter(options.UCPConnection)))
+	}
+
 	options.TracerProviderOptions.ServiceName = "ucp"
 	hostingServices = append(hostingServices, &trace.Service{Options: options.TracerProviderOptions})
 
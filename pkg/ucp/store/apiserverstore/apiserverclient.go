@@ -148,6 +148,13 @@ func (c *APIServerClient) Query(ctx context.Context, query store.Query, options
 					continue
 				}
 
+				match, err = converted.MatchesTagFilters(query.TagFilters)
+				if err != nil {
+					return nil, err
+				} else if !match {
+					continue
+				}
+
 				results.Items = append(results.Items, *converted)
 			}
 		}
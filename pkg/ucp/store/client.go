@@ -75,6 +75,9 @@ type Query struct {
 
 	// Filters is an query filter to filter the specific property value.
 	Filters []QueryFilter
+
+	// TagFilters is an optional list of filters on the tags of the resources. A resource must match every filter.
+	TagFilters []TagFilter
 }
 
 // QueryFilter is the filter which filters property in resource entity.
@@ -82,3 +85,12 @@ type QueryFilter struct {
 	Field string
 	Value string
 }
+
+// TagFilter is the filter which filters resources by tag. A resource matches when it has a tag named Name and, if Value
+// is not empty, the tag has the given value.
+//
+// Tag names may contain '.' characters, so tags can not be filtered with a QueryFilter.
+type TagFilter struct {
+	Name  string
+	Value string
+}
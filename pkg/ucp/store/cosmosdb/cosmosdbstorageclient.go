@@ -223,6 +223,29 @@ func constructCosmosDBQuery(query store.Query) (*cosmosapi.Query, error) {
 		})
 	}
 
+	for i, filter := range query.TagFilters {
+		if whereParam != "" {
+			whereParam += " and "
+		}
+		// Tag names are passed as parameters and used as property names, they may contain characters that are not
+		// valid in a property path.
+		tagNameParam := fmt.Sprintf("tagName%d", i)
+		whereParam += fmt.Sprintf("IS_DEFINED(c.entity.tags[@%s])", tagNameParam)
+		queryParams = append(queryParams, cosmosapi.QueryParam{
+			Name:  "@" + tagNameParam,
+			Value: filter.Name,
+		})
+
+		if filter.Value != "" {
+			tagValueParam := fmt.Sprintf("tagValue%d", i)
+			whereParam += fmt.Sprintf(" and c.entity.tags[@%s] = @%s", tagNameParam, tagValueParam)
+			queryParams = append(queryParams, cosmosapi.QueryParam{
+				Name:  "@" + tagValueParam,
+				Value: filter.Value,
+			})
+		}
+	}
+
 	if whereParam == "" {
 		return nil, &store.ErrInvalid{Message: "invalid Query parameters"}
 	}
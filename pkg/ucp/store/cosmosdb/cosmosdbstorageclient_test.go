@@ -227,6 +227,35 @@ func TestConstructCosmosDBQuery(t *testing.T) {
 			}},
 			err: nil,
 		},
+		{
+			desc: "tag-filters",
+			storeQuery: store.Query{
+				RootScope:    "/planes/radius/local/resourcegroups/testgroup",
+				ResourceType: "applications.core/containers",
+				TagFilters: []store.TagFilter{
+					{Name: "team", Value: "payments"},
+					{Name: "app.kubernetes.io/part-of"},
+				},
+			},
+			queryString: "SELECT * FROM c WHERE c.rootScope = @rootScope and STRINGEQUALS(c.entity.type, @rtype, true) and IS_DEFINED(c.entity.tags[@tagName0]) and c.entity.tags[@tagName0] = @tagValue0 and IS_DEFINED(c.entity.tags[@tagName1])",
+			params: []cosmosapi.QueryParam{{
+				Name:  "@rootScope",
+				Value: "/planes/radius/local/resourcegroups/testgroup",
+			}, {
+				Name:  "@rtype",
+				Value: "applications.core/containers",
+			}, {
+				Name:  "@tagName0",
+				Value: "team",
+			}, {
+				Name:  "@tagValue0",
+				Value: "payments",
+			}, {
+				Name:  "@tagName1",
+				Value: "app.kubernetes.io/part-of",
+			}},
+			err: nil,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
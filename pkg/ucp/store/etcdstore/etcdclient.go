@@ -112,6 +112,13 @@ func (c *ETCDClient) Query(ctx context.Context, query store.Query, options ...st
 				continue
 			}
 
+			match, err = value.MatchesTagFilters(query.TagFilters)
+			if err != nil {
+				return nil, err
+			} else if !match {
+				continue
+			}
+
 			value.ETag = etag.NewFromRevision(kv.ModRevision)
 			results.Items = append(results.Items, value)
 		}
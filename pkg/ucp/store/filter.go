@@ -45,22 +45,31 @@ func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 
 	for _, filter := range filters {
 		value := reflect.ValueOf(data)
-		fields := strings.Split(filter.Field, ".")
-		for i, field := range fields {
+		for _, field := range strings.Split(filter.Field, ".") {
+			if value.Kind() == reflect.Interface {
+				// Unwrap interface{}
+				value = value.Elem()
+			}
+
+			if value.Kind() != reflect.Map || value.Type().Key().Kind() != reflect.String {
+				// not an object, the field doesn't exist!
+				return false, nil
+			}
+
 			value = value.MapIndex(reflect.ValueOf(field))
-			if i < len(fields)-1 {
-				// Need to go further into the nested fields
-				value = reflect.ValueOf(value.Interface())
+			if !value.IsValid() {
+				// the field doesn't exist!
+				return false, nil
 			}
 		}
 		comparator := reflect.ValueOf(filter.Value)
 
-		if value.Type().Kind() == reflect.Interface {
+		if value.Kind() == reflect.Interface {
 			// Unwrap interface{}
-			value = reflect.ValueOf(value.Interface())
+			value = value.Elem()
 		}
 
-		if value.Type().Kind() != reflect.String {
+		if value.Kind() != reflect.String {
 			// not a string, can't compare!
 			return false, nil
 		}
@@ -73,3 +82,33 @@ func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 
 	return true, nil
 }
+
+// MatchesTagFilters checks if the object's tags match the given tag filters and returns a boolean and an error.
+func (o Object) MatchesTagFilters(filters []TagFilter) (bool, error) {
+	if len(filters) == 0 {
+		return true, nil
+	}
+
+	data := struct {
+		Tags map[string]string `json:"tags"`
+	}{}
+	if o.Data != nil {
+		err := o.As(&data)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	for _, filter := range filters {
+		value, ok := data.Tags[filter.Name]
+		if !ok {
+			return false, nil
+		}
+
+		if filter.Value != "" && value != filter.Value {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -113,6 +113,30 @@ func Test_MatchesFilters(t *testing.T) {
 			Filters:       []QueryFilter{{Field: "properties.value", Value: "warm"}},
 			ExpectedMatch: false,
 		},
+		{
+			Description:   "nested_missing_field",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{"other": "freezing"}}},
+			Filters:       []QueryFilter{{Field: "properties.value", Value: "freezing"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "nested_missing_parent",
+			Obj:           &Object{Data: map[string]any{"value": "freezing"}},
+			Filters:       []QueryFilter{{Field: "properties.value", Value: "freezing"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "nested_parent_not_object",
+			Obj:           &Object{Data: map[string]any{"properties": "freezing"}},
+			Filters:       []QueryFilter{{Field: "properties.value", Value: "freezing"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "nested_null_value",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{"value": nil}}},
+			Filters:       []QueryFilter{{Field: "properties.value", Value: "freezing"}},
+			ExpectedMatch: false,
+		},
 	}
 
 	for _, testcase := range cases {
@@ -123,3 +147,79 @@ func Test_MatchesFilters(t *testing.T) {
 		})
 	}
 }
+
+func Test_MatchesTagFilters(t *testing.T) {
+	type taggedstruct struct {
+		Tags map[string]string `json:"tags"`
+	}
+
+	cases := []struct {
+		Description   string
+		Obj           *Object
+		Filters       []TagFilter
+		ExpectedMatch bool
+	}{
+		{
+			Description:   "empty",
+			Obj:           &Object{},
+			Filters:       []TagFilter{},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "nil_data",
+			Obj:           &Object{},
+			Filters:       []TagFilter{{Name: "team"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "name_match",
+			Obj:           &Object{Data: map[string]any{"tags": map[string]any{"team": "payments"}}},
+			Filters:       []TagFilter{{Name: "team"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "name_not_match",
+			Obj:           &Object{Data: map[string]any{"tags": map[string]any{"team": "payments"}}},
+			Filters:       []TagFilter{{Name: "owner"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "name_and_value_match",
+			Obj:           &Object{Data: map[string]any{"tags": map[string]any{"team": "payments"}}},
+			Filters:       []TagFilter{{Name: "team", Value: "payments"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "name_and_value_not_match",
+			Obj:           &Object{Data: map[string]any{"tags": map[string]any{"team": "payments"}}},
+			Filters:       []TagFilter{{Name: "team", Value: "billing"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "name_with_dots_match",
+			Obj:           &Object{Data: map[string]any{"tags": map[string]any{"app.kubernetes.io/part-of": "shop"}}},
+			Filters:       []TagFilter{{Name: "app.kubernetes.io/part-of", Value: "shop"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "struct_match",
+			Obj:           &Object{Data: &taggedstruct{Tags: map[string]string{"team": "payments", "env": "prod"}}},
+			Filters:       []TagFilter{{Name: "team", Value: "payments"}, {Name: "env", Value: "prod"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "multi_not_match",
+			Obj:           &Object{Data: &taggedstruct{Tags: map[string]string{"team": "payments", "env": "dev"}}},
+			Filters:       []TagFilter{{Name: "team", Value: "payments"}, {Name: "env", Value: "prod"}},
+			ExpectedMatch: false,
+		},
+	}
+
+	for _, testcase := range cases {
+		t.Run(testcase.Description, func(t *testing.T) {
+			match, err := testcase.Obj.MatchesTagFilters(testcase.Filters)
+			require.NoError(t, err)
+			require.Equal(t, testcase.ExpectedMatch, match)
+		})
+	}
+}
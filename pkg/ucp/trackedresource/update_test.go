@@ -258,6 +258,7 @@ func Test_run(t *testing.T) {
 			"id":         testID.String(),
 			"name":       testID.Name(),
 			"type":       testID.Type(),
+			"tags":       map[string]any{"team": "payments"},
 			"properties": map[string]any{},
 		}
 
@@ -282,6 +283,7 @@ func Test_run(t *testing.T) {
 				require.Equal(t, IDFor(testID).String(), dm.ID)
 				require.Equal(t, testID.String(), dm.Properties.ID)
 				require.Equal(t, apiVersion, dm.Properties.APIVersion)
+				require.Equal(t, map[string]string{"team": "payments"}, dm.Tags)
 				return nil
 			}).
 			Times(1)
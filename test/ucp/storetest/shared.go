@@ -74,6 +74,9 @@ var Data1 = map[string]any{
 	"properties": map[string]any{
 		"resource": "1",
 	},
+	"tags": map[string]any{
+		"team": "payments",
+	},
 }
 var Data2 = map[string]any{
 	"value": "2",
@@ -87,6 +90,10 @@ var NestedData1 = map[string]any{
 	"properties": map[string]any{
 		"resource": "3",
 	},
+	"tags": map[string]any{
+		"team":                      "billing",
+		"app.kubernetes.io/part-of": "shop",
+	},
 }
 
 var RadiusPlaneData = map[string]any{
@@ -416,6 +423,37 @@ func RunTest(t *testing.T, client store.StorageClient, clear func(t *testing.T))
 			CompareObjectLists(t, expected, objs.Items)
 		})
 
+		t.Run("query_resources_at_resource_group_scope_with_tag_name_filter", func(t *testing.T) {
+			tagFilters := []store.TagFilter{{Name: "team"}}
+			objs, err := client.Query(ctx, store.Query{RootScope: ResourceGroup1Scope, TagFilters: tagFilters})
+			require.NoError(t, err)
+			expected := []store.Object{
+				obj1,
+				nested1,
+			}
+			CompareObjectLists(t, expected, objs.Items)
+		})
+
+		t.Run("query_resources_at_resource_group_scope_with_tag_filter", func(t *testing.T) {
+			tagFilters := []store.TagFilter{{Name: "team", Value: "payments"}}
+			objs, err := client.Query(ctx, store.Query{RootScope: ResourceGroup1Scope, TagFilters: tagFilters})
+			require.NoError(t, err)
+			expected := []store.Object{
+				obj1,
+			}
+			CompareObjectLists(t, expected, objs.Items)
+		})
+
+		t.Run("query_resources_at_plane_scope_recursive_with_tag_filter", func(t *testing.T) {
+			tagFilters := []store.TagFilter{{Name: "app.kubernetes.io/part-of", Value: "shop"}}
+			objs, err := client.Query(ctx, store.Query{RootScope: RadiusScope, ScopeRecursive: true, TagFilters: tagFilters})
+			require.NoError(t, err)
+			expected := []store.Object{
+				nested1,
+			}
+			CompareObjectLists(t, expected, objs.Items)
+		})
+
 		t.Run("query_resources_at_resource_group_scope_with_prefix", func(t *testing.T) {
 			objs, err := client.Query(ctx, store.Query{RootScope: ResourceGroup1Scope, RoutingScopePrefix: ResourcePath1})
 			require.NoError(t, err)
@@ -103,7 +103,7 @@ func DeleteRPResource(ctx context.Context, t *testing.T, cli *radcli.CLI, client
 func ValidateRPResources(ctx context.Context, t *testing.T, expected *RPResourceSet, client clients.ApplicationsManagementClient) {
 	for _, expectedResource := range expected.Resources {
 		if expectedResource.Type == EnvironmentsResource {
-			envs, err := client.ListEnvironmentsInResourceGroup(ctx)
+			envs, err := client.ListEnvironmentsInResourceGroup(ctx, nil)
 			require.NoError(t, err)
 			require.NotEmpty(t, envs)
 